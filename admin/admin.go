@@ -8,6 +8,7 @@ import (
 
 	"github.com/goliatone/go-admin/admin/routing"
 	"github.com/goliatone/go-admin/internal/primitives"
+	httptransport "github.com/goliatone/go-admin/pkg/go-sync/transport/http"
 	translationservices "github.com/goliatone/go-admin/translations/services"
 	auth "github.com/goliatone/go-auth"
 	gocommand "github.com/goliatone/go-command"
@@ -119,9 +120,20 @@ type Admin struct {
 	traitWorkflowDefaults           map[string]string
 	translationPolicy               TranslationPolicy
 	translationFamilyStore          translationservices.FamilyStore
+	translationGlossaryStore        TranslationGlossaryStore
+	translationMemoryStore          TranslationMemoryStore
+	revisionStore                   RevisionStore
+	contentScheduleStore            ContentScheduleStore
+	searchIndex                     PanelSearchIndexer
+	panelImports                    *PanelImportService
+	exportSchedules                 *ExportScheduleService
+	translationSLAPolicies          TranslationSLAPolicies
+	translationQARules              *TranslationQARuleRegistry
 	translationActorOptionProvider  TranslationActorOptionProvider
 	translationSuggestionService    TranslationSuggestionService
 	translationSuggestionDeps       TranslationSuggestionServiceDependencies
+	translationDraftSyncMu          sync.Mutex
+	translationDraftSync            *httptransport.Handler
 	cmsWorkflowDefaults             bool
 	cmsWorkflowActions              []Action
 	cmsWorkflowActionsSet           bool
@@ -328,6 +340,178 @@ func (a *Admin) WithTranslationFamilyStore(store translationservices.FamilyStore
 	return a
 }
 
+// WithTranslationGlossaryStore wires the glossary store used by the translation editor and QA.
+func (a *Admin) WithTranslationGlossaryStore(store TranslationGlossaryStore) *Admin {
+	if a == nil {
+		return a
+	}
+	a.translationGlossaryStore = store
+	return a
+}
+
+// TranslationGlossaryStore returns the configured translation glossary store.
+func (a *Admin) TranslationGlossaryStore() TranslationGlossaryStore {
+	if a == nil {
+		return nil
+	}
+	return a.translationGlossaryStore
+}
+
+// WithTranslationMemoryStore wires the translation memory store used for TMX exchange and editor matches.
+func (a *Admin) WithTranslationMemoryStore(store TranslationMemoryStore) *Admin {
+	if a == nil {
+		return a
+	}
+	a.translationMemoryStore = store
+	return a
+}
+
+// TranslationMemoryStore returns the configured translation memory store.
+func (a *Admin) TranslationMemoryStore() TranslationMemoryStore {
+	if a == nil {
+		return nil
+	}
+	return a.translationMemoryStore
+}
+
+// WithRevisionStore enables field-level revision history for panels registered
+// afterwards. Panels built with their own store keep it.
+func (a *Admin) WithRevisionStore(store RevisionStore) *Admin {
+	if a == nil {
+		return a
+	}
+	a.revisionStore = store
+	return a
+}
+
+// RevisionStore returns the configured revision store, nil when revision history is off.
+func (a *Admin) RevisionStore() RevisionStore {
+	if a == nil {
+		return nil
+	}
+	return a.revisionStore
+}
+
+// WithContentScheduleStore enables publish_at/unpublish_at scheduling for
+// workflow panels registered afterwards. Run the content schedule job with
+// RegisterContentScheduleCommands to apply due schedules.
+func (a *Admin) WithContentScheduleStore(store ContentScheduleStore) *Admin {
+	if a == nil {
+		return a
+	}
+	a.contentScheduleStore = store
+	return a
+}
+
+// ContentScheduleStore returns the configured content schedule store, nil when scheduling is off.
+func (a *Admin) ContentScheduleStore() ContentScheduleStore {
+	if a == nil {
+		return nil
+	}
+	return a.contentScheduleStore
+}
+
+// WithSearchIndex sets the search index for panels that opt in with
+// PanelBuilder.Searchable. Other panels are not indexed, and panels built with
+// their own index keep it. Register the index's global adapter and site
+// provider separately to serve queries from it.
+func (a *Admin) WithSearchIndex(index PanelSearchIndexer) *Admin {
+	if a == nil {
+		return a
+	}
+	a.searchIndex = index
+	return a
+}
+
+// SearchIndex returns the configured panel search index, nil when indexing is off.
+func (a *Admin) SearchIndex() PanelSearchIndexer {
+	if a == nil {
+		return nil
+	}
+	return a.searchIndex
+}
+
+// WithPanelImportJobStore persists panel import jobs in store so they survive
+// restarts. Initialize resumes interrupted jobs.
+func (a *Admin) WithPanelImportJobStore(store PanelImportJobStore) *Admin {
+	if a == nil || store == nil {
+		return a
+	}
+	if svc, err := NewPanelImportService(store, a.registry, WithPanelImportLogger(a.loggerFor("admin.panel_import"))); err == nil {
+		a.panelImports = svc
+	}
+	return a
+}
+
+// PanelImportService returns the service running panel import jobs.
+func (a *Admin) PanelImportService() *PanelImportService {
+	if a == nil {
+		return nil
+	}
+	return a.panelImports
+}
+
+// WithExportSchedules enables recurring exports generated by runner and kept
+// in store (in memory when nil). Runs act as the schedule owner resolved
+// through the user service, re-check the schedule permission with the admin
+// authorizer, and notify the owner. Options override these defaults; register
+// RegisterExportScheduleCommands to run due schedules.
+func (a *Admin) WithExportSchedules(store ExportScheduleStore, runner ScheduledExportRunner, opts ...ExportScheduleServiceOption) (*ExportScheduleService, error) {
+	if a == nil {
+		return nil, serviceNotConfiguredDomainError("admin", nil)
+	}
+	defaults := []ExportScheduleServiceOption{
+		WithExportScheduleIdentity(ExportScheduleIdentityFunc(func(ctx context.Context, schedule ExportSchedule) (context.Context, error) {
+			return NewUserExportScheduleIdentity(a.users).ResolveExportScheduleIdentity(ctx, schedule)
+		})),
+		WithExportScheduleAuthorizer(a.authorizer, ""),
+		WithExportScheduleNotifications(a.notifications),
+		WithExportScheduleActivitySink(a.activity),
+		WithExportScheduleLogger(a.loggerFor("admin.export_schedules")),
+	}
+	svc, err := NewExportScheduleService(store, runner, append(defaults, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	a.exportSchedules = svc
+	return svc, nil
+}
+
+// ExportScheduleService returns the recurring export service, nil until
+// WithExportSchedules is called.
+func (a *Admin) ExportScheduleService() *ExportScheduleService {
+	if a == nil {
+		return nil
+	}
+	return a.exportSchedules
+}
+
+// WithTranslationSLAPolicies configures the SLA policies reported on the translation dashboard.
+func (a *Admin) WithTranslationSLAPolicies(policies TranslationSLAPolicies) *Admin {
+	if a == nil {
+		return a
+	}
+	a.translationSLAPolicies = append(TranslationSLAPolicies{}, policies...)
+	return a
+}
+
+// TranslationSLAPolicies returns the configured translation SLA policies.
+func (a *Admin) TranslationSLAPolicies() TranslationSLAPolicies {
+	if a == nil {
+		return nil
+	}
+	return append(TranslationSLAPolicies{}, a.translationSLAPolicies...)
+}
+
+// TranslationQARules exposes the translation QA rule registry so hosts can
+// register rules or override built-in severities per tenant or content type.
+func (a *Admin) TranslationQARules() *TranslationQARuleRegistry {
+	if a == nil {
+		return nil
+	}
+	return a.translationQARules
+}
+
 // WithTranslationActorOptionProvider wires host-owned assignee/reviewer option lookup.
 func (a *Admin) WithTranslationActorOptionProvider(provider TranslationActorOptionProvider) *Admin {
 	if a == nil {
@@ -1209,6 +1393,15 @@ func (a *Admin) buildPanel(name string, builder *PanelBuilder) (*Panel, error) {
 	if builder.activity == nil {
 		builder.activity = a.activity
 	}
+	if builder.revisions == nil {
+		builder.revisions = a.revisionStore
+	}
+	if builder.schedules == nil {
+		builder.schedules = a.contentScheduleStore
+	}
+	if builder.searchIndex == nil && builder.searchable {
+		builder.searchIndex = a.searchIndex
+	}
 	if builder.authorizer == nil || builder.authorizerInherited {
 		builder.authorizer = a.authorizer
 		builder.authorizerInherited = true
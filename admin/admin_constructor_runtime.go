@@ -132,7 +132,7 @@ func resolveAdminRuntimeState(state adminConstructorState, deps Dependencies) (a
 	state.exportRegistry = deps.ExportRegistry
 	state.exportRegistrar = deps.ExportRegistrar
 	state.exportMetadata = deps.ExportMetadata
-	state.bulkSvc = resolveBulkService(deps.BulkService, state.featureGate)
+	state.bulkSvc = resolveBulkService(deps.BulkService, deps.BulkJobStore, state.registry, state.featureGate, resolveNamedLogger("admin.bulk", state.loggerProvider, state.logger))
 	state.urlManager, err = resolveAdminURLManager(state.cfg, deps.URLManager, state.featureGate)
 	if err != nil {
 		return state, err
@@ -210,7 +210,7 @@ func newAdminFromConstructorState(state adminConstructorState, deps Dependencies
 		replCommandCatalog:             state.replCommandCatalog,
 		debugSessionStore:              state.debugSessionStore,
 		nav:                            NewNavigation(state.container.MenuService(), deps.Authorizer),
-		search:                         NewSearchEngine(deps.Authorizer),
+		search:                         NewSearchEngine(deps.Authorizer).WithLogger(resolveNamedLogger("admin.search", state.loggerProvider, state.logger)),
 		authorizer:                     deps.Authorizer,
 		notifications:                  state.notifSvc,
 		notificationEvents:             state.notifRuntime.events,
@@ -255,6 +255,13 @@ func newAdminFromConstructorState(state adminConstructorState, deps Dependencies
 		workflowRuntime:                deps.WorkflowRuntime,
 		translationPolicy:              deps.TranslationPolicy,
 		translationFamilyStore:         deps.TranslationFamilyStore,
+		translationGlossaryStore:       resolveTranslationGlossaryStore(deps.TranslationGlossaryStore),
+		translationMemoryStore:         resolveTranslationMemoryStore(deps.TranslationMemoryStore),
+		revisionStore:                  deps.RevisionStore,
+		contentScheduleStore:           deps.ContentScheduleStore,
+		searchIndex:                    deps.SearchIndex,
+		panelImports:                   resolvePanelImportService(deps.PanelImportJobStore, state.registry, resolveNamedLogger("admin.panel_import", state.loggerProvider, state.logger)),
+		translationQARules:             NewDefaultTranslationQARuleRegistry(),
 		preview:                        NewPreviewService(state.cfg.PreviewSecret),
 		iconService:                    state.iconService,
 		menuBuilder:                    NewMenuBuilderService(),
@@ -295,6 +302,17 @@ func applyCMSDependencyConfig(cfg Config, deps Dependencies) Config {
 	return cfg
 }
 
+func resolvePanelImportService(store PanelImportJobStore, registry *Registry, logger Logger) *PanelImportService {
+	if store == nil {
+		store = NewInMemoryPanelImportJobStore()
+	}
+	svc, err := NewPanelImportService(store, registry, WithPanelImportLogger(logger))
+	if err != nil {
+		return nil
+	}
+	return svc
+}
+
 func resolveRegistryDependency(registry *Registry) *Registry {
 	if registry != nil {
 		return registry
@@ -472,14 +490,21 @@ func resolveNotificationRuntime(cfg Config, deps Dependencies, featureGate fggat
 	return legacyNotificationRuntime(service), nil
 }
 
-func resolveBulkService(bulkSvc BulkService, featureGate fggate.FeatureGate) BulkService {
+// resolveBulkService prefers an explicit service, then a durable service over
+// the configured job store, then the in-memory demo service.
+func resolveBulkService(bulkSvc BulkService, store BulkJobStore, registry *Registry, featureGate fggate.FeatureGate, logger Logger) BulkService {
 	if bulkSvc != nil {
 		return bulkSvc
 	}
-	if featureEnabled(featureGate, FeatureBulk) {
-		return NewInMemoryBulkService()
+	if !featureEnabled(featureGate, FeatureBulk) {
+		return DisabledBulkService{}
+	}
+	if store != nil {
+		if svc, err := NewDurableBulkService(store, PanelBulkRepositoryResolver(registry), WithDurableBulkLogger(logger)); err == nil {
+			return svc
+		}
 	}
-	return DisabledBulkService{}
+	return NewInMemoryBulkService()
 }
 
 func resolveAdminURLManager(cfg Config, urlManager *urlkit.RouteManager, featureGate fggate.FeatureGate) (*urlkit.RouteManager, error) {
@@ -26,6 +26,24 @@ func (a *Admin) decorateSchemaWithContext(ctx context.Context, schema *Schema, p
 		schema.Media = a.resolveMediaSchemaConfig()
 		applyMediaHints(schema, schema.Media)
 	}
+	schema.Trash = a.resolvePanelTrashConfig(panelName)
+}
+
+func (a *Admin) resolvePanelTrashConfig(panelName string) *TrashConfig {
+	if a == nil || a.registry == nil {
+		return nil
+	}
+	panel, ok := a.registry.Panel(panelName)
+	if !ok || !panel.SoftDeleteEnabled() {
+		return nil
+	}
+	config := &TrashConfig{
+		Endpoint: resolveURLWith(a.urlManager, adminAPIGroupName(a.config), "panel.trash", map[string]string{"panel": panelName}, nil),
+	}
+	if panel.softDelete.Retention > 0 {
+		config.Retention = panel.softDelete.Retention.String()
+	}
+	return config
 }
 
 func (a *Admin) resolveMediaSchemaConfig() *MediaConfig {
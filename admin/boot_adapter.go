@@ -320,6 +320,22 @@ func (a *Admin) BootTranslationFamilies() boot.TranslationFamiliesBinding {
 	return newTranslationFamilyBinding(a)
 }
 
+// BootTranslationGlossary exposes glossary import/export bindings.
+func (a *Admin) BootTranslationGlossary() boot.TranslationGlossaryBinding {
+	if !featureEnabled(a.featureGate, FeatureTranslationQueue) || a.translationGlossaryStore == nil {
+		return nil
+	}
+	return newTranslationGlossaryBinding(a)
+}
+
+// BootTranslationMemory exposes translation memory TMX import/export bindings.
+func (a *Admin) BootTranslationMemory() boot.TranslationMemoryBinding {
+	if !featureEnabled(a.featureGate, FeatureTranslationQueue) || a.translationMemoryStore == nil {
+		return nil
+	}
+	return newTranslationMemoryBinding(a)
+}
+
 // BootTranslationQueue exposes translation queue aggregate bindings.
 func (a *Admin) BootTranslationQueue() boot.TranslationQueueBinding {
 	if !featureEnabled(a.featureGate, FeatureTranslationQueue) {
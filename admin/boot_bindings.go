@@ -103,7 +103,7 @@ func (p *panelBinding) List(c router.Context, locale string, opts boot.ListOptio
 	baseSchema := p.panel.Schema()
 	requestedListOpts, groupedByTranslationGroup := p.requestedListOptions(baseSchema, locale, listOpts)
 	listStarted := time.Now()
-	records, total, err := p.listRecords(ctx, requestedListOpts, groupedByTranslationGroup)
+	records, total, nextCursor, err := p.listRecords(ctx, requestedListOpts, groupedByTranslationGroup)
 	logCMSContentListTiming(ctx.Context, "panel_records", listStarted,
 		"panel", p.name,
 		"locale", locale,
@@ -152,7 +152,7 @@ func (p *panelBinding) List(c router.Context, locale string, opts boot.ListOptio
 		return nil, 0, nil, nil, nil, err
 	}
 	metaStarted := time.Now()
-	meta, err := p.listMeta(ctx, total, schema.BulkActions, requestedListOpts)
+	meta, err := p.listMeta(ctx, total, nextCursor, schema.BulkActions, requestedListOpts)
 	logCMSContentListTiming(ctx.Context, "panel_meta", metaStarted,
 		"panel", p.name,
 		"locale", locale,
@@ -209,6 +209,7 @@ func panelListOptions(opts boot.ListOptions) ListOptions {
 		Filters:  opts.Filters,
 		Fields:   append([]string{}, opts.Fields...),
 		Search:   opts.Search,
+		Cursor:   opts.Cursor,
 	}
 	if len(opts.Predicates) > 0 {
 		listOpts.Predicates = make([]ListPredicate, 0, len(opts.Predicates))
@@ -244,20 +245,24 @@ func (p *panelBinding) requestedListOptions(baseSchema Schema, locale string, li
 	return requestedListOpts, groupedByTranslationGroup
 }
 
-func (p *panelBinding) listRecords(ctx AdminContext, requestedListOpts ListOptions, groupedByTranslationGroup bool) ([]map[string]any, int, error) {
+// listRecords returns the page records, total and, for keyset-capable panels,
+// the cursor of the next page. Grouped and readiness-filtered lists page by offset.
+func (p *panelBinding) listRecords(ctx AdminContext, requestedListOpts ListOptions, groupedByTranslationGroup bool) ([]map[string]any, int, string, error) {
 	baseListOpts, readinessPredicates := splitTranslationReadinessPredicates(requestedListOpts)
 	if groupedByTranslationGroup {
 		ctx.Context = withTranslationFamilyExpansion(ctx.Context)
-		return p.listGroupedByTranslationGroup(ctx, baseListOpts, requestedListOpts, readinessPredicates)
+		records, total, err := p.listGroupedByTranslationGroup(ctx, baseListOpts, requestedListOpts, readinessPredicates)
+		return records, total, "", err
 	}
 	if len(readinessPredicates) > 0 {
-		return p.listWithTranslationReadinessPredicates(ctx, baseListOpts, requestedListOpts, readinessPredicates)
+		records, total, err := p.listWithTranslationReadinessPredicates(ctx, baseListOpts, requestedListOpts, readinessPredicates)
+		return records, total, "", err
 	}
-	records, total, err := p.panel.List(ctx, requestedListOpts)
+	page, err := p.panel.ListPage(ctx, requestedListOpts)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
-	return p.withTranslationReadiness(ctx, records, requestedListOpts.Filters), total, nil
+	return p.withTranslationReadiness(ctx, page.Records, requestedListOpts.Filters), page.Total, page.NextCursor, nil
 }
 
 func (p *panelBinding) listForm(ctx AdminContext) (PanelFormRequest, error) {
@@ -275,8 +280,11 @@ func (p *panelBinding) listForm(ctx AdminContext) (PanelFormRequest, error) {
 	return form, nil
 }
 
-func (p *panelBinding) listMeta(ctx AdminContext, total int, bulkActions []Action, requestedListOpts ListOptions) (map[string]any, error) {
+func (p *panelBinding) listMeta(ctx AdminContext, total int, nextCursor string, bulkActions []Action, requestedListOpts ListOptions) (map[string]any, error) {
 	meta := map[string]any{"count": total}
+	if nextCursor != "" {
+		meta["next_cursor"] = nextCursor
+	}
 	bulkActionState, err := p.bulkActionState(ctx, bulkActions, requestedListOpts)
 	if err != nil {
 		return nil, err
@@ -413,6 +421,116 @@ func (p *panelBinding) Delete(c router.Context, locale string, id string) error
 	return p.panel.Delete(ctx, id)
 }
 
+func (p *panelBinding) TrashEnabled() bool {
+	return p.panel.SoftDeleteEnabled()
+}
+
+func (p *panelBinding) Trash(c router.Context, locale string, opts boot.ListOptions) ([]map[string]any, int, error) {
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	return p.panel.Trash(ctx, panelListOptions(opts))
+}
+
+func (p *panelBinding) Restore(c router.Context, locale string, id string) (map[string]any, error) {
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	return p.panel.Restore(ctx, id)
+}
+
+func (p *panelBinding) Purge(c router.Context, locale string, id string) error {
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	return p.panel.Purge(ctx, id)
+}
+
+func (p *panelBinding) ImportEnabled() bool {
+	return p.panel.ImportEnabled()
+}
+
+func (p *panelBinding) ImportTemplate(c router.Context, locale, format string) (boot.PanelImportTemplate, error) {
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	if err := p.panel.requireImport(ctx); err != nil {
+		return boot.PanelImportTemplate{}, err
+	}
+	template, err := p.panel.ImportTemplate(format)
+	if err != nil {
+		return boot.PanelImportTemplate{}, err
+	}
+	return boot.PanelImportTemplate{FileName: template.FileName, ContentType: template.ContentType, Body: template.Body}, nil
+}
+
+func (p *panelBinding) PreviewImport(c router.Context, locale string, upload boot.PanelImportUpload) (any, error) {
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	return p.panel.PreviewImport(ctx, panelImportRequest(upload))
+}
+
+func (p *panelBinding) StartImport(c router.Context, locale string, upload boot.PanelImportUpload) (any, error) {
+	svc, err := p.importService()
+	if err != nil {
+		return nil, err
+	}
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	return svc.Start(ctx, p.name, panelImportRequest(upload))
+}
+
+func (p *panelBinding) ImportJobs(c router.Context, locale string) (any, error) {
+	svc, err := p.importService()
+	if err != nil {
+		return nil, err
+	}
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	if err := p.panel.requireImport(ctx); err != nil {
+		return nil, err
+	}
+	jobs, err := svc.Jobs(ctx, p.name)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"total": len(jobs), "jobs": jobs}, nil
+}
+
+func (p *panelBinding) ImportJob(c router.Context, locale, id string) (any, error) {
+	svc, err := p.importService()
+	if err != nil {
+		return nil, err
+	}
+	ctx := p.admin.adminContextFromRequest(c, locale)
+	if err := p.panel.requireImport(ctx); err != nil {
+		return nil, err
+	}
+	job, err := svc.Job(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Panel != p.name {
+		return nil, ErrNotFound
+	}
+	rows, err := svc.Rows(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"job": job, "rows": rows}, nil
+}
+
+func (p *panelBinding) importService() (*PanelImportService, error) {
+	if svc := p.admin.PanelImportService(); svc != nil {
+		return svc, nil
+	}
+	return nil, serviceNotConfiguredDomainError("panel import service", map[string]any{
+		"component": "panel_import",
+		"panel":     p.name,
+	})
+}
+
+func panelImportRequest(upload boot.PanelImportUpload) PanelImportRequest {
+	req := PanelImportRequest{
+		Format:   upload.Format,
+		FileName: upload.File.FileName,
+		Reader:   upload.File.Reader,
+	}
+	if upload.Mapping != nil {
+		req.Mapping = PanelImportMapping(upload.Mapping)
+	}
+	return req
+}
+
 func normalizeFallbackContextRecord(record map[string]any, requestedLocale string) map[string]any {
 	record = primitives.CloneAnyMap(record)
 	if record == nil {
@@ -1184,6 +1302,7 @@ func cloneListOptions(opts ListOptions) ListOptions {
 		SortDesc: opts.SortDesc,
 		Fields:   append([]string{}, opts.Fields...),
 		Search:   opts.Search,
+		Cursor:   opts.Cursor,
 	}
 	if len(opts.Filters) > 0 {
 		cloned.Filters = primitives.CloneAnyMap(opts.Filters)
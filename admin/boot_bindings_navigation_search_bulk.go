@@ -36,17 +36,18 @@ func newSearchBinding(a *Admin) boot.SearchBinding {
 	return &searchBinding{admin: a}
 }
 
-func (s *searchBinding) Query(c router.Context, locale, query string, limit int) ([]any, error) {
+func (s *searchBinding) Query(c router.Context, locale, query string, limit int) (map[string]any, error) {
 	ctx := s.admin.adminContextFromRequest(c, locale)
-	results, err := s.admin.search.Query(ctx, query, limit)
+	response, err := s.admin.search.QueryReport(ctx, query, limit)
 	if err != nil {
 		return nil, err
 	}
-	out := []any{}
-	for _, result := range results {
-		out = append(out, result)
-	}
-	return out, nil
+	return map[string]any{
+		"results":  response.Results,
+		"groups":   response.Groups,
+		"adapters": response.Adapters,
+		"partial":  response.Partial,
+	}, nil
 }
 
 type bulkBinding struct {
@@ -146,7 +146,7 @@ func (a *Admin) prepareCoreServices() {
 		a.nav.UseCMS(featureEnabled(a.featureGate, FeatureCMS))
 	}
 	if a.search == nil {
-		a.search = NewSearchEngine(a.authorizer)
+		a.search = NewSearchEngine(a.authorizer).WithLogger(a.loggerFor("admin.search"))
 	}
 	if a.search != nil {
 		a.search.Enable(featureEnabled(a.featureGate, FeatureSearch))
@@ -181,6 +181,9 @@ func (a *Admin) runPrepareLifecycle(ctx context.Context) error {
 	if err := a.syncPrepareJobs(ctx); err != nil {
 		return err
 	}
+	if err := a.resumeBackgroundJobs(ctx); err != nil {
+		return err
+	}
 	return a.ensureSettingsNavigation(ctx)
 }
 
@@ -200,6 +203,30 @@ func (a *Admin) syncPrepareJobs(ctx context.Context) error {
 	return a.jobs.Sync(ctx)
 }
 
+// bulkJobResumer is implemented by bulk services that persist jobs across
+// restarts.
+type bulkJobResumer interface {
+	Resume(ctx context.Context) error
+}
+
+// resumeBackgroundJobs restarts durable jobs interrupted by a previous process.
+func (a *Admin) resumeBackgroundJobs(ctx context.Context) error {
+	if a == nil {
+		return nil
+	}
+	if resumer, ok := a.bulkSvc.(bulkJobResumer); ok {
+		if err := resumer.Resume(context.WithoutCancel(ctx)); err != nil {
+			return err
+		}
+	}
+	if a.panelImports != nil {
+		if err := a.panelImports.Resume(context.WithoutCancel(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *Admin) startTranslationExchangeRuntime(ctx context.Context) error {
 	if a == nil || !featureEnabled(a.featureGate, FeatureTranslationExchange) || a.translationExchangeRuntime == nil {
 		return nil
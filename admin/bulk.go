@@ -33,6 +33,9 @@ type BulkJob struct {
 	StartedAt         time.Time      `json:"started_at"`
 	CompletedAt       time.Time      `json:"completed_at"`
 	RollbackAvailable bool           `json:"rollback_available,omitempty"`
+	CreatedBy         string         `json:"created_by,omitempty"`
+	TenantID          string         `json:"tenant_id,omitempty"`
+	OrgID             string         `json:"org_id,omitempty"`
 }
 
 // BulkService manages bulk jobs.
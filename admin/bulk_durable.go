@@ -0,0 +1,818 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	auth "github.com/goliatone/go-auth"
+	"github.com/google/uuid"
+)
+
+// Bulk job statuses persisted by DurableBulkService.
+const (
+	BulkJobStatusRunning     = "running"
+	BulkJobStatusCompleted   = "completed"
+	BulkJobStatusFailed      = "failed"
+	BulkJobStatusRollingBack = "rolling_back"
+	BulkJobStatusRolledBack  = "rolled_back"
+)
+
+// Bulk record statuses track each record through apply and rollback.
+const (
+	BulkRecordStatusPending        = "pending"
+	BulkRecordStatusSnapshotted    = "snapshotted"
+	BulkRecordStatusApplied        = "applied"
+	BulkRecordStatusFailed         = "failed"
+	BulkRecordStatusRolledBack     = "rolled_back"
+	BulkRecordStatusRollbackFailed = "rollback_failed"
+)
+
+// BulkActionDelete deletes each record; every other action updates records
+// with the payload "values" map.
+const BulkActionDelete = "delete"
+
+const (
+	defaultBulkChunkSize = 200
+	defaultBulkLeaseTTL  = 2 * time.Minute
+	// maxBulkJobErrors bounds BulkJob.Errors; the full list stays on the
+	// per-record rows.
+	maxBulkJobErrors = 100
+)
+
+// BulkJobRecord is one record targeted by a bulk job, with the snapshot taken
+// before it was mutated.
+type BulkJobRecord struct {
+	JobID     string         `json:"job_id"`
+	RecordID  string         `json:"record_id"`
+	Position  int            `json:"position"`
+	Status    string         `json:"status"`
+	Snapshot  map[string]any `json:"snapshot,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// BulkJobStore persists bulk jobs and their per-record progress.
+type BulkJobStore interface {
+	CreateJob(ctx context.Context, job BulkJob, recordIDs []string) error
+	GetJob(ctx context.Context, id string) (BulkJob, error)
+	ListJobs(ctx context.Context) ([]BulkJob, error)
+	// ListActiveJobs returns jobs that are running or rolling back.
+	ListActiveJobs(ctx context.Context) ([]BulkJob, error)
+	SaveJob(ctx context.Context, job BulkJob) error
+	// ClaimJob takes or renews the processing lease for owner. It reports false
+	// while another owner holds an unexpired lease.
+	ClaimJob(ctx context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error)
+	ReleaseJob(ctx context.Context, id, owner string) error
+	// ListJobRecords returns up to limit records in one of statuses, ordered by position.
+	ListJobRecords(ctx context.Context, jobID string, statuses []string, limit int) ([]BulkJobRecord, error)
+	CountJobRecords(ctx context.Context, jobID string, statuses []string) (int, error)
+	SaveJobRecord(ctx context.Context, record BulkJobRecord) error
+}
+
+// BulkRepositoryResolver resolves the panel repository a bulk job mutates.
+type BulkRepositoryResolver func(ctx context.Context, panel string) (Repository, error)
+
+// bulkJobAuthorizer is implemented by resolved repositories that gate who may
+// start a bulk action. It is checked once in Start, not per record.
+type bulkJobAuthorizer interface {
+	authorizeBulk(ctx context.Context, action string) error
+}
+
+// bulkTrashRestorer is implemented by resolved repositories whose deletes
+// move records to a trash; rollback restores them from it.
+type bulkTrashRestorer interface {
+	SoftDeleteEnabled() bool
+	Restore(ctx context.Context, id string) (map[string]any, error)
+}
+
+// PanelBulkRepositoryResolver resolves repositories from registered panels.
+// Writes go through the panel write path, so hooks, activity, revisions and
+// search indexing apply to every record.
+func PanelBulkRepositoryResolver(registry *Registry) BulkRepositoryResolver {
+	return func(_ context.Context, panel string) (Repository, error) {
+		if registry == nil {
+			return nil, serviceNotConfiguredDomainError("panel registry", map[string]any{"component": "bulk"})
+		}
+		resolved, ok := registry.Panel(strings.TrimSpace(panel))
+		if !ok || resolved == nil || resolved.repo == nil {
+			return nil, notFoundDomainError("panel not found", map[string]any{"component": "bulk", "panel": panel})
+		}
+		return panelBulkRepository{Repository: resolved.repo, panel: resolved}, nil
+	}
+}
+
+// panelBulkRepository adapts a panel to Repository for bulk jobs. Reads go to
+// the panel repository; writes run the panel pipelines as the job creator.
+type panelBulkRepository struct {
+	Repository
+	panel *Panel
+}
+
+func (r panelBulkRepository) authorizeBulk(ctx context.Context, action string) error {
+	permission := r.panel.permissions.Edit
+	if action == BulkActionDelete {
+		permission = r.panel.permissions.Delete
+	}
+	return requirePermissionWithAuthorizer(r.panel.authorizer, ctx, permission, r.panel.name)
+}
+
+func (r panelBulkRepository) Create(ctx context.Context, record map[string]any) (map[string]any, error) {
+	return r.panel.createRecord(bulkAdminContext(ctx), record)
+}
+
+func (r panelBulkRepository) Update(ctx context.Context, id string, record map[string]any) (map[string]any, error) {
+	return r.panel.updateRecord(bulkAdminContext(ctx), id, record)
+}
+
+func (r panelBulkRepository) Delete(ctx context.Context, id string) error {
+	return r.panel.deleteRecord(bulkAdminContext(ctx), id)
+}
+
+func (r panelBulkRepository) SoftDeleteEnabled() bool {
+	return r.panel.SoftDeleteEnabled()
+}
+
+func (r panelBulkRepository) Restore(ctx context.Context, id string) (map[string]any, error) {
+	repo, err := r.panel.requireTrash()
+	if err != nil {
+		return nil, err
+	}
+	return r.panel.restoreTrashedRecord(bulkAdminContext(ctx), repo, id)
+}
+
+func bulkAdminContext(ctx context.Context) AdminContext {
+	return AdminContext{
+		Context:  ctx,
+		UserID:   primitives.FirstNonEmptyRaw(actorFromContext(ctx), userIDFromContext(ctx)),
+		TenantID: tenantIDFromContext(ctx),
+		OrgID:    orgIDFromContext(ctx),
+		Locale:   localeFromContext(ctx),
+	}
+}
+
+// DurableBulkServiceOption customizes DurableBulkService.
+type DurableBulkServiceOption func(*DurableBulkService)
+
+// WithDurableBulkChunkSize sets how many records are processed between progress checkpoints.
+func WithDurableBulkChunkSize(size int) DurableBulkServiceOption {
+	return func(s *DurableBulkService) {
+		if size > 0 {
+			s.chunkSize = size
+		}
+	}
+}
+
+// WithDurableBulkLeaseTTL sets how long a worker owns a job without renewing its lease.
+func WithDurableBulkLeaseTTL(ttl time.Duration) DurableBulkServiceOption {
+	return func(s *DurableBulkService) {
+		if ttl > 0 {
+			s.leaseTTL = ttl
+		}
+	}
+}
+
+// WithDurableBulkLogger sets the logger used for background job failures.
+func WithDurableBulkLogger(logger Logger) DurableBulkServiceOption {
+	return func(s *DurableBulkService) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
+
+// DurableBulkService runs bulk jobs against panel repositories and persists
+// job state through a BulkJobStore. The payload names the panel ("panel"),
+// the record ids ("ids"), and for non-delete actions the fields to write
+// ("values"). Records are processed in chunks; each record is snapshotted
+// before it is mutated so Rollback can restore it. Jobs run as the actor,
+// tenant and organization that started them, including after a restart.
+// Call Resume at startup to continue jobs interrupted by a restart.
+type DurableBulkService struct {
+	store     BulkJobStore
+	repos     BulkRepositoryResolver
+	logger    Logger
+	owner     string
+	chunkSize int
+	leaseTTL  time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	running map[string]struct{}
+	workers sync.WaitGroup
+}
+
+var (
+	_ BulkService    = (*DurableBulkService)(nil)
+	_ BulkRollbacker = (*DurableBulkService)(nil)
+)
+
+// NewDurableBulkService builds a durable bulk service.
+func NewDurableBulkService(store BulkJobStore, repos BulkRepositoryResolver, opts ...DurableBulkServiceOption) (*DurableBulkService, error) {
+	if store == nil {
+		return nil, serviceNotConfiguredDomainError("bulk job store", map[string]any{"component": "bulk"})
+	}
+	if repos == nil {
+		return nil, serviceNotConfiguredDomainError("bulk repository resolver", map[string]any{"component": "bulk"})
+	}
+	svc := &DurableBulkService{
+		store:     store,
+		repos:     repos,
+		logger:    ensureLogger(nil),
+		owner:     "bulk_" + uuid.NewString(),
+		chunkSize: defaultBulkChunkSize,
+		leaseTTL:  defaultBulkLeaseTTL,
+		now:       time.Now,
+		running:   map[string]struct{}{},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(svc)
+		}
+	}
+	return svc, nil
+}
+
+// Start validates and persists a job, then processes it in the background.
+func (s *DurableBulkService) Start(ctx context.Context, req BulkRequest) (BulkJob, error) {
+	if req.Name == "" {
+		return BulkJob{}, requiredFieldDomainError("name", map[string]any{"component": "bulk"})
+	}
+	panel := strings.TrimSpace(toString(req.Payload["panel"]))
+	if panel == "" {
+		return BulkJob{}, requiredFieldDomainError("panel", map[string]any{"component": "bulk"})
+	}
+	ids := commandIDsFromPayload(nil, req.Payload)
+	if err := requireIDs(ids, "bulk job requires record ids"); err != nil {
+		return BulkJob{}, err
+	}
+	if req.Action != BulkActionDelete && len(bulkValues(req.Payload)) == 0 {
+		return BulkJob{}, requiredFieldDomainError("values", map[string]any{"component": "bulk", "action": req.Action})
+	}
+	repo, err := s.repos(ctx, panel)
+	if err != nil {
+		return BulkJob{}, err
+	}
+	if authorizer, ok := repo.(bulkJobAuthorizer); ok {
+		if err := authorizer.authorizeBulk(ctx, req.Action); err != nil {
+			return BulkJob{}, err
+		}
+	}
+
+	job := BulkJob{
+		ID:        uuid.NewString(),
+		Name:      req.Name,
+		Action:    req.Action,
+		Status:    BulkJobStatusRunning,
+		Total:     len(ids),
+		Payload:   primitives.CloneAnyMap(req.Payload),
+		Errors:    []string{},
+		CreatedBy: primitives.FirstNonEmptyRaw(actorFromContext(ctx), userIDFromContext(ctx)),
+		TenantID:  tenantIDFromContext(ctx),
+		OrgID:     orgIDFromContext(ctx),
+		StartedAt: s.now(),
+	}
+	if err := s.store.CreateJob(ctx, job, ids); err != nil {
+		return BulkJob{}, err
+	}
+	s.spawn(context.WithoutCancel(ctx), job.ID)
+	return job, nil
+}
+
+// List returns persisted jobs newest first.
+func (s *DurableBulkService) List(ctx context.Context) []BulkJob {
+	jobs, err := s.store.ListJobs(ctx)
+	if err != nil {
+		s.logger.Error("bulk job list failed", "error", err)
+		return nil
+	}
+	for i := range jobs {
+		jobs[i] = withBulkProgress(jobs[i])
+	}
+	return jobs
+}
+
+// Job returns a single persisted job.
+func (s *DurableBulkService) Job(ctx context.Context, id string) (BulkJob, error) {
+	job, err := s.store.GetJob(ctx, id)
+	if err != nil {
+		return BulkJob{}, err
+	}
+	return withBulkProgress(job), nil
+}
+
+// Rollback restores every record the job mutated from its snapshot. The
+// restore runs in the background; the returned job is in rolling_back state.
+func (s *DurableBulkService) Rollback(ctx context.Context, id string) (BulkJob, error) {
+	job, err := s.store.GetJob(ctx, id)
+	if err != nil {
+		return BulkJob{}, err
+	}
+	switch job.Status {
+	case BulkJobStatusRollingBack, BulkJobStatusRolledBack:
+		return withBulkProgress(job), nil
+	case BulkJobStatusRunning:
+		return BulkJob{}, conflictDomainError("bulk job is still running", map[string]any{"component": "bulk", "id": id})
+	}
+	if !job.RollbackAvailable {
+		return BulkJob{}, validationDomainError("bulk job has nothing to roll back", map[string]any{"component": "bulk", "id": id})
+	}
+	job.Status = BulkJobStatusRollingBack
+	job.CompletedAt = time.Time{}
+	if err := s.store.SaveJob(ctx, job); err != nil {
+		return BulkJob{}, err
+	}
+	s.spawn(context.WithoutCancel(ctx), job.ID)
+	return withBulkProgress(job), nil
+}
+
+// Resume restarts processing for jobs left running or rolling back by a
+// previous process. Jobs whose lease is still held elsewhere are skipped.
+func (s *DurableBulkService) Resume(ctx context.Context) error {
+	jobs, err := s.store.ListActiveJobs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		s.spawn(ctx, job.ID)
+	}
+	return nil
+}
+
+// Wait blocks until background workers started by this service return.
+func (s *DurableBulkService) Wait() {
+	s.workers.Wait()
+}
+
+func (s *DurableBulkService) spawn(ctx context.Context, id string) {
+	s.mu.Lock()
+	if _, ok := s.running[id]; ok {
+		s.mu.Unlock()
+		return
+	}
+	s.running[id] = struct{}{}
+	s.mu.Unlock()
+
+	s.workers.Go(func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.running, id)
+			s.mu.Unlock()
+		}()
+		if err := s.process(ctx, id); err != nil {
+			s.logger.Error("bulk job processing failed", "job_id", id, "error", err)
+		}
+	})
+}
+
+func (s *DurableBulkService) process(ctx context.Context, id string) error {
+	claimed, err := s.store.ClaimJob(ctx, id, s.owner, s.now(), s.leaseTTL)
+	if err != nil || !claimed {
+		return err
+	}
+	defer func() {
+		if err := s.store.ReleaseJob(context.WithoutCancel(ctx), id, s.owner); err != nil {
+			s.logger.Warn("bulk job lease release failed", "job_id", id, "error", err)
+		}
+	}()
+	ctx, stop := s.renewLease(ctx, id)
+	defer stop()
+
+	job, err := s.store.GetJob(ctx, id)
+	if err != nil {
+		return err
+	}
+	ctx = bulkJobContext(ctx, job)
+	repo, err := s.repos(ctx, toString(job.Payload["panel"]))
+	if err != nil {
+		job.Errors = appendBulkError(job.Errors, err.Error())
+		if job.Status == BulkJobStatusRunning {
+			job.Status = BulkJobStatusFailed
+		}
+		job.CompletedAt = s.now()
+		return errors.Join(err, s.store.SaveJob(ctx, job))
+	}
+
+	switch job.Status {
+	case BulkJobStatusRunning:
+		err = s.apply(ctx, repo, job)
+	case BulkJobStatusRollingBack:
+		err = s.rollback(ctx, repo, job)
+	}
+	if cause := context.Cause(ctx); cause != nil && err != nil {
+		return cause
+	}
+	return err
+}
+
+// renewLease keeps the job lease alive while the worker runs. The returned
+// context is cancelled with a conflict error if the lease is lost, so a
+// worker never keeps mutating a job another process has taken over.
+func (s *DurableBulkService) renewLease(ctx context.Context, id string) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+	var renewer sync.WaitGroup
+	renewer.Go(func() {
+		ticker := time.NewTicker(max(s.leaseTTL/3, time.Millisecond))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			claimed, err := s.store.ClaimJob(ctx, id, s.owner, s.now(), s.leaseTTL)
+			if err != nil {
+				s.logger.Warn("bulk job lease renewal failed", "job_id", id, "error", err)
+				continue
+			}
+			if !claimed {
+				cancel(conflictDomainError("bulk job lease lost", map[string]any{"component": "bulk", "id": id}))
+				return
+			}
+		}
+	})
+	return ctx, func() {
+		close(done)
+		renewer.Wait()
+		cancel(nil)
+	}
+}
+
+// bulkJobContext restores the identity a job was started with, so hooks and
+// activity attribute background writes to that actor and scope.
+func bulkJobContext(ctx context.Context, job BulkJob) context.Context {
+	identity := adminRouterIdentity{
+		userID:   job.CreatedBy,
+		tenantID: job.TenantID,
+		orgID:    job.OrgID,
+	}
+	if _, ok := auth.ActorFromContext(ctx); ok {
+		return withAdminRouterIdentity(ctx, identity)
+	}
+	return withAdminRouterIdentity(ctx, ensureAdminRouterActor(identity))
+}
+
+func (s *DurableBulkService) apply(ctx context.Context, repo Repository, job BulkJob) error {
+	processed, err := s.store.CountJobRecords(ctx, job.ID, []string{BulkRecordStatusApplied, BulkRecordStatusFailed})
+	if err != nil {
+		return err
+	}
+	job.Processed = processed
+	values := bulkValues(job.Payload)
+
+	for {
+		records, err := s.store.ListJobRecords(ctx, job.ID, []string{BulkRecordStatusPending, BulkRecordStatusSnapshotted}, s.chunkSize)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			break
+		}
+		for _, record := range records {
+			if err := context.Cause(ctx); err != nil {
+				return err
+			}
+			_ = s.applyRecord(ctx, repo, job.Action, values, &record) //nolint:errcheck // the failure is kept on the record row.
+			if err := context.Cause(ctx); err != nil {
+				// The write was cut short; resume picks the record up again.
+				return err
+			}
+			if err := s.store.SaveJobRecord(ctx, record); err != nil {
+				return err
+			}
+			job.Processed++
+		}
+		if err := s.checkpoint(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	applied, err := s.store.CountJobRecords(ctx, job.ID, []string{BulkRecordStatusApplied})
+	if err != nil {
+		return err
+	}
+	failed, err := s.store.CountJobRecords(ctx, job.ID, []string{BulkRecordStatusFailed})
+	if err != nil {
+		return err
+	}
+	if err := s.summarizeRecordErrors(ctx, &job, failed); err != nil {
+		return err
+	}
+	job.Status = BulkJobStatusCompleted
+	if applied == 0 && failed > 0 {
+		job.Status = BulkJobStatusFailed
+	}
+	job.RollbackAvailable = applied > 0
+	job.CompletedAt = s.now()
+	return s.store.SaveJob(ctx, job)
+}
+
+// applyRecord snapshots then mutates one record. A record found already
+// snapshotted was interrupted mid-flight: its original snapshot is kept and
+// a delete that already happened counts as applied.
+func (s *DurableBulkService) applyRecord(ctx context.Context, repo Repository, action string, values map[string]any, record *BulkJobRecord) error {
+	resumed := record.Status == BulkRecordStatusSnapshotted
+	record.UpdatedAt = s.now()
+	if !resumed {
+		snapshot, err := repo.Get(ctx, record.RecordID)
+		if err != nil {
+			return failBulkRecord(record, err)
+		}
+		record.Snapshot = snapshot
+		record.Status = BulkRecordStatusSnapshotted
+		if err := s.store.SaveJobRecord(ctx, *record); err != nil {
+			return failBulkRecord(record, err)
+		}
+	}
+
+	var err error
+	if action == BulkActionDelete {
+		err = repo.Delete(ctx, record.RecordID)
+		if resumed && errors.Is(err, ErrNotFound) {
+			err = nil
+		}
+	} else {
+		_, err = repo.Update(ctx, record.RecordID, primitives.CloneAnyMap(values))
+	}
+	if err != nil {
+		return failBulkRecord(record, err)
+	}
+	record.Status = BulkRecordStatusApplied
+	record.Error = ""
+	return nil
+}
+
+func (s *DurableBulkService) rollback(ctx context.Context, repo Repository, job BulkJob) error {
+	for {
+		records, err := s.store.ListJobRecords(ctx, job.ID, []string{BulkRecordStatusApplied, BulkRecordStatusSnapshotted}, s.chunkSize)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			break
+		}
+		for _, record := range records {
+			if err := context.Cause(ctx); err != nil {
+				return err
+			}
+			if err := s.restoreRecord(ctx, repo, job.Action, &record); err != nil {
+				record.Status = BulkRecordStatusRollbackFailed
+				record.Error = err.Error()
+				job.Errors = appendBulkError(job.Errors, fmt.Sprintf("rollback %s: %v", record.RecordID, err))
+			} else {
+				record.Status = BulkRecordStatusRolledBack
+				record.Error = ""
+			}
+			record.UpdatedAt = s.now()
+			if err := s.store.SaveJobRecord(ctx, record); err != nil {
+				return err
+			}
+		}
+		if err := s.checkpoint(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	job.Status = BulkJobStatusRolledBack
+	job.RollbackAvailable = false
+	job.CompletedAt = s.now()
+	return s.store.SaveJob(ctx, job)
+}
+
+// summarizeRecordErrors rebuilds job.Errors from the failed record rows, so
+// the summary and the omitted count stay correct across resumed runs and
+// are not confused with job-level errors.
+func (s *DurableBulkService) summarizeRecordErrors(ctx context.Context, job *BulkJob, failed int) error {
+	records, err := s.store.ListJobRecords(ctx, job.ID, []string{BulkRecordStatusFailed}, maxBulkJobErrors)
+	if err != nil {
+		return err
+	}
+	errs := make([]string, 0, len(records)+1)
+	for _, record := range records {
+		errs = append(errs, fmt.Sprintf("%s: %s", record.RecordID, record.Error))
+	}
+	if hidden := failed - len(records); hidden > 0 {
+		errs = append(errs, fmt.Sprintf("%d more record errors omitted", hidden))
+	}
+	job.Errors = errs
+	return nil
+}
+
+// restoreRecord writes a record's snapshot back. Deleted records are
+// recreated from the snapshot, including its id; repositories that assign
+// their own ids will restore the data under a new id. Trashed records are
+// restored from the trash instead.
+func (s *DurableBulkService) restoreRecord(ctx context.Context, repo Repository, action string, record *BulkJobRecord) error {
+	if len(record.Snapshot) == 0 {
+		return fmt.Errorf("record %s has no snapshot", record.RecordID)
+	}
+	if action != BulkActionDelete {
+		_, err := repo.Update(ctx, record.RecordID, primitives.CloneAnyMap(record.Snapshot))
+		return err
+	}
+	if record.Status == BulkRecordStatusSnapshotted {
+		// The delete may not have happened before the interruption.
+		if _, err := repo.Get(ctx, record.RecordID); err == nil {
+			return nil
+		}
+	}
+	if trash, ok := repo.(bulkTrashRestorer); ok && trash.SoftDeleteEnabled() {
+		_, err := trash.Restore(ctx, record.RecordID)
+		return err
+	}
+	_, err := repo.Create(ctx, primitives.CloneAnyMap(record.Snapshot))
+	return err
+}
+
+// checkpoint persists progress between chunks.
+func (s *DurableBulkService) checkpoint(ctx context.Context, job BulkJob) error {
+	if err := context.Cause(ctx); err != nil {
+		return err
+	}
+	return s.store.SaveJob(ctx, job)
+}
+
+func failBulkRecord(record *BulkJobRecord, err error) error {
+	record.Status = BulkRecordStatusFailed
+	record.Error = err.Error()
+	return err
+}
+
+func appendBulkError(errs []string, message string) []string {
+	if len(errs) >= maxBulkJobErrors {
+		return errs
+	}
+	return append(errs, message)
+}
+
+func bulkValues(payload map[string]any) map[string]any {
+	values, _ := payload["values"].(map[string]any)
+	return values
+}
+
+func withBulkProgress(job BulkJob) BulkJob {
+	if job.Total > 0 {
+		job.Progress = float64(job.Processed) / float64(job.Total)
+	}
+	return job
+}
+
+// InMemoryBulkJobStore is a process-local BulkJobStore for tests and demos.
+type InMemoryBulkJobStore struct {
+	mu      sync.Mutex
+	jobs    map[string]BulkJob
+	records map[string][]BulkJobRecord
+	leases  map[string]bulkJobLease
+}
+
+type bulkJobLease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+var _ BulkJobStore = (*InMemoryBulkJobStore)(nil)
+
+// NewInMemoryBulkJobStore builds an empty in-memory job store.
+func NewInMemoryBulkJobStore() *InMemoryBulkJobStore {
+	return &InMemoryBulkJobStore{
+		jobs:    map[string]BulkJob{},
+		records: map[string][]BulkJobRecord{},
+		leases:  map[string]bulkJobLease{},
+	}
+}
+
+func (s *InMemoryBulkJobStore) CreateJob(_ context.Context, job BulkJob, recordIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; ok {
+		return conflictDomainError("bulk job already exists", map[string]any{"component": "bulk", "id": job.ID})
+	}
+	s.jobs[job.ID] = cloneBulkJob(job)
+	records := make([]BulkJobRecord, 0, len(recordIDs))
+	for i, id := range recordIDs {
+		records = append(records, BulkJobRecord{JobID: job.ID, RecordID: id, Position: i, Status: BulkRecordStatusPending})
+	}
+	s.records[job.ID] = records
+	return nil
+}
+
+func (s *InMemoryBulkJobStore) GetJob(_ context.Context, id string) (BulkJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return BulkJob{}, ErrNotFound
+	}
+	return cloneBulkJob(job), nil
+}
+
+func (s *InMemoryBulkJobStore) ListJobs(_ context.Context) ([]BulkJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]BulkJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		out = append(out, cloneBulkJob(job))
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].StartedAt.After(out[j].StartedAt) })
+	return out, nil
+}
+
+func (s *InMemoryBulkJobStore) ListActiveJobs(ctx context.Context) ([]BulkJob, error) {
+	jobs, err := s.ListJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(jobs, func(job BulkJob) bool {
+		return job.Status != BulkJobStatusRunning && job.Status != BulkJobStatusRollingBack
+	}), nil
+}
+
+func (s *InMemoryBulkJobStore) SaveJob(_ context.Context, job BulkJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; !ok {
+		return ErrNotFound
+	}
+	s.jobs[job.ID] = cloneBulkJob(job)
+	return nil
+}
+
+func (s *InMemoryBulkJobStore) ClaimJob(_ context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[id]; !ok {
+		return false, ErrNotFound
+	}
+	if lease, ok := s.leases[id]; ok && lease.owner != owner && now.Before(lease.expiresAt) {
+		return false, nil
+	}
+	s.leases[id] = bulkJobLease{owner: owner, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+func (s *InMemoryBulkJobStore) ReleaseJob(_ context.Context, id, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lease, ok := s.leases[id]; ok && lease.owner == owner {
+		delete(s.leases, id)
+	}
+	return nil
+}
+
+func (s *InMemoryBulkJobStore) ListJobRecords(_ context.Context, jobID string, statuses []string, limit int) ([]BulkJobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []BulkJobRecord{}
+	for _, record := range s.records[jobID] {
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+		if slices.Contains(statuses, record.Status) {
+			out = append(out, cloneBulkJobRecord(record))
+		}
+	}
+	return out, nil
+}
+
+func (s *InMemoryBulkJobStore) CountJobRecords(_ context.Context, jobID string, statuses []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, record := range s.records[jobID] {
+		if slices.Contains(statuses, record.Status) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *InMemoryBulkJobStore) SaveJobRecord(_ context.Context, record BulkJobRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := s.records[record.JobID]
+	if record.Position < 0 || record.Position >= len(records) {
+		return ErrNotFound
+	}
+	records[record.Position] = cloneBulkJobRecord(record)
+	return nil
+}
+
+func cloneBulkJob(job BulkJob) BulkJob {
+	job.Payload = primitives.CloneAnyMap(job.Payload)
+	job.Errors = append([]string{}, job.Errors...)
+	return job
+}
+
+func cloneBulkJobRecord(record BulkJobRecord) BulkJobRecord {
+	record.Snapshot = primitives.CloneAnyMap(record.Snapshot)
+	return record
+}
@@ -0,0 +1,386 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDurableBulkServiceAppliesChunksAndReportsRecordErrors(t *testing.T) {
+	ctx := context.Background()
+	repo, ids := seedBulkRepository(t, "draft", "draft", "draft")
+	store := NewInMemoryBulkJobStore()
+	svc := mustNewDurableBulkService(t, store, repo, WithDurableBulkChunkSize(2))
+
+	job, err := svc.Start(ctx, BulkRequest{
+		Name:   "publish",
+		Action: "update",
+		Payload: map[string]any{
+			"panel":  "posts",
+			"ids":    append(ids, "missing"),
+			"values": map[string]any{"status": "published"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if job.Total != 4 || job.Status != BulkJobStatusRunning {
+		t.Fatalf("expected running job over 4 records, got %+v", job)
+	}
+	svc.Wait()
+
+	job, err = svc.Job(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("job: %v", err)
+	}
+	if job.Status != BulkJobStatusCompleted || job.Processed != 4 || !job.RollbackAvailable {
+		t.Fatalf("expected completed job with rollback, got %+v", job)
+	}
+	if len(job.Errors) != 1 || !strings.HasPrefix(job.Errors[0], "missing: ") {
+		t.Fatalf("expected one per-record error for missing id, got %v", job.Errors)
+	}
+	for _, id := range ids {
+		record, err := repo.Get(ctx, id)
+		if err != nil || record["status"] != "published" {
+			t.Fatalf("expected record %s published, got %+v err=%v", id, record, err)
+		}
+	}
+}
+
+func TestDurableBulkServiceRollbackRestoresSnapshots(t *testing.T) {
+	ctx := context.Background()
+	repo, ids := seedBulkRepository(t, "draft", "review")
+	svc := mustNewDurableBulkService(t, NewInMemoryBulkJobStore(), repo)
+
+	job, err := svc.Start(ctx, BulkRequest{
+		Name:   "archive",
+		Action: "update",
+		Payload: map[string]any{
+			"panel":  "posts",
+			"ids":    ids,
+			"values": map[string]any{"status": "archived"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	svc.Wait()
+
+	if _, err := svc.Rollback(ctx, job.ID); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	svc.Wait()
+
+	job, err = svc.Job(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("job: %v", err)
+	}
+	if job.Status != BulkJobStatusRolledBack || job.RollbackAvailable {
+		t.Fatalf("expected rolled back job, got %+v", job)
+	}
+	for i, want := range []string{"draft", "review"} {
+		record, err := repo.Get(ctx, ids[i])
+		if err != nil || record["status"] != want {
+			t.Fatalf("expected record %s restored to %s, got %+v err=%v", ids[i], want, record, err)
+		}
+	}
+}
+
+func TestDurableBulkServiceRollbackRecreatesDeletedRecords(t *testing.T) {
+	ctx := context.Background()
+	repo, ids := seedBulkRepository(t, "draft", "draft")
+	svc := mustNewDurableBulkService(t, NewInMemoryBulkJobStore(), repo)
+
+	job, err := svc.Start(ctx, BulkRequest{
+		Name:    "purge",
+		Action:  BulkActionDelete,
+		Payload: map[string]any{"panel": "posts", "ids": ids},
+	})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	svc.Wait()
+	if _, total, _ := repo.List(ctx, ListOptions{}); total != 0 {
+		t.Fatalf("expected records deleted, got %d", total)
+	}
+
+	if _, err := svc.Rollback(ctx, job.ID); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	svc.Wait()
+	if _, total, _ := repo.List(ctx, ListOptions{}); total != 2 {
+		t.Fatalf("expected deleted records recreated, got %d", total)
+	}
+}
+
+func TestDurableBulkServiceResumesInterruptedJobWithOriginalSnapshots(t *testing.T) {
+	ctx := context.Background()
+	repo, ids := seedBulkRepository(t, "draft", "draft")
+	store := NewInMemoryBulkJobStore()
+	job := BulkJob{
+		ID:        "bulk-1",
+		Name:      "publish",
+		Action:    "update",
+		Status:    BulkJobStatusRunning,
+		Total:     len(ids),
+		Payload:   map[string]any{"panel": "posts", "values": map[string]any{"status": "published"}},
+		StartedAt: time.Now(),
+	}
+	if err := store.CreateJob(ctx, job, ids); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	// The previous process snapshotted and mutated the first record, then died.
+	if _, err := repo.Update(ctx, ids[0], map[string]any{"status": "published"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := store.SaveJobRecord(ctx, BulkJobRecord{
+		JobID:    job.ID,
+		RecordID: ids[0],
+		Position: 0,
+		Status:   BulkRecordStatusSnapshotted,
+		Snapshot: map[string]any{"id": ids[0], "title": "post 0", "status": "draft"},
+	}); err != nil {
+		t.Fatalf("save record: %v", err)
+	}
+
+	svc := mustNewDurableBulkService(t, store, repo)
+	if err := svc.Resume(ctx); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	svc.Wait()
+
+	resumed, err := svc.Job(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("job: %v", err)
+	}
+	if resumed.Status != BulkJobStatusCompleted || resumed.Processed != 2 {
+		t.Fatalf("expected resumed job completed, got %+v", resumed)
+	}
+	records, err := store.ListJobRecords(ctx, job.ID, []string{BulkRecordStatusApplied}, 0)
+	if err != nil {
+		t.Fatalf("list records: %v", err)
+	}
+	if len(records) != 2 || records[0].Snapshot["status"] != "draft" {
+		t.Fatalf("expected original snapshot kept for interrupted record, got %+v", records)
+	}
+}
+
+func TestDurableBulkServiceRejectsJobsWithoutRecordIDs(t *testing.T) {
+	repo, _ := seedBulkRepository(t)
+	svc := mustNewDurableBulkService(t, NewInMemoryBulkJobStore(), repo)
+
+	_, err := svc.Start(context.Background(), BulkRequest{
+		Name:    "purge",
+		Action:  BulkActionDelete,
+		Payload: map[string]any{"panel": "posts"},
+	})
+	if err == nil {
+		t.Fatal("expected missing ids error")
+	}
+}
+
+func TestDurableBulkServiceWritesThroughPanelPipeline(t *testing.T) {
+	repo, ids := seedBulkRepository(t, "draft", "draft")
+	sink := &recordingSink{}
+	authz := mapAuthorizer{allowed: map[string]bool{}}
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(repo).
+		WithAuthorizer(authz).
+		WithActivitySink(sink).
+		SoftDelete(PanelSoftDeleteConfig{Enabled: true}).
+		Permissions(PanelPermissions{Delete: "posts.delete", Restore: "posts.restore"}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("posts", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	svc, err := NewDurableBulkService(NewInMemoryBulkJobStore(), PanelBulkRepositoryResolver(registry))
+	if err != nil {
+		t.Fatalf("new durable bulk service: %v", err)
+	}
+	ctx := withAdminRouterIdentity(context.Background(), ensureAdminRouterActor(adminRouterIdentity{userID: "editor-1", tenantID: "tenant-1"}))
+	req := BulkRequest{
+		Name:    "trash",
+		Action:  BulkActionDelete,
+		Payload: map[string]any{"panel": "posts", "ids": ids},
+	}
+	if _, err := svc.Start(ctx, req); err == nil {
+		t.Fatal("expected start without delete permission to be rejected")
+	}
+
+	authz.allowed["posts.delete"] = true
+	job, err := svc.Start(ctx, req)
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if job.CreatedBy != "editor-1" || job.TenantID != "tenant-1" {
+		t.Fatalf("expected job identity captured, got %+v", job)
+	}
+	svc.Wait()
+	if _, trashed, _ := repo.ListTrash(context.Background(), ListOptions{}); trashed != 2 {
+		t.Fatalf("expected records moved to trash, got %d", trashed)
+	}
+	deletes := 0
+	for _, entry := range sink.entries {
+		if entry.Action == "panel.delete" && entry.Actor == "editor-1" && entry.Metadata["soft_delete"] == true {
+			deletes++
+		}
+	}
+	if deletes != 2 {
+		t.Fatalf("expected per-record panel.delete activity, got %+v", sink.entries)
+	}
+
+	if _, err := svc.Rollback(ctx, job.ID); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	svc.Wait()
+	if _, total, _ := repo.List(context.Background(), ListOptions{}); total != 2 {
+		t.Fatalf("expected trashed records restored, got %d", total)
+	}
+	if last := sink.entries[len(sink.entries)-1]; last.Action != "panel.restore" || last.Actor != "editor-1" {
+		t.Fatalf("expected panel.restore activity, got %+v", last)
+	}
+}
+
+func TestDurableBulkServiceSummarizesRecordErrorsAcrossResume(t *testing.T) {
+	ctx := context.Background()
+	repo, ids := seedBulkRepository(t, "draft")
+	store := NewInMemoryBulkJobStore()
+	job := BulkJob{
+		ID:        "bulk-1",
+		Name:      "publish",
+		Action:    "update",
+		Status:    BulkJobStatusRunning,
+		Total:     3,
+		Payload:   map[string]any{"panel": "posts", "values": map[string]any{"status": "published"}},
+		Errors:    []string{"gone-1: not found"},
+		StartedAt: time.Now(),
+	}
+	if err := store.CreateJob(ctx, job, []string{"gone-1", "gone-2", ids[0]}); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	// The previous process failed the first record, then died.
+	if err := store.SaveJobRecord(ctx, BulkJobRecord{JobID: job.ID, RecordID: "gone-1", Position: 0, Status: BulkRecordStatusFailed, Error: "not found"}); err != nil {
+		t.Fatalf("save record: %v", err)
+	}
+
+	svc := mustNewDurableBulkService(t, store, repo)
+	if err := svc.Resume(ctx); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	svc.Wait()
+
+	resumed, err := svc.Job(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("job: %v", err)
+	}
+	if len(resumed.Errors) != 2 || resumed.Errors[0] != "gone-1: not found" || !strings.HasPrefix(resumed.Errors[1], "gone-2: ") {
+		t.Fatalf("expected one error per failed record, got %v", resumed.Errors)
+	}
+}
+
+func TestDurableBulkServiceStopsWhenLeaseIsLost(t *testing.T) {
+	ctx := context.Background()
+	repo, ids := seedBulkRepository(t, "draft", "draft")
+	store := &stealingBulkJobStore{InMemoryBulkJobStore: NewInMemoryBulkJobStore()}
+	svc := mustNewDurableBulkService(t, store, blockingBulkRepository{Repository: repo}, WithDurableBulkLeaseTTL(3*time.Millisecond))
+
+	job, err := svc.Start(ctx, BulkRequest{
+		Name:    "publish",
+		Action:  "update",
+		Payload: map[string]any{"panel": "posts", "ids": ids, "values": map[string]any{"status": "published"}},
+	})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	svc.Wait()
+
+	stored, err := svc.Job(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("job: %v", err)
+	}
+	if stored.Status != BulkJobStatusRunning || stored.Processed != 0 {
+		t.Fatalf("expected worker to stop without finishing the job, got %+v", stored)
+	}
+	pending, _ := store.CountJobRecords(ctx, job.ID, []string{BulkRecordStatusPending, BulkRecordStatusSnapshotted})
+	if pending != 2 {
+		t.Fatalf("expected interrupted records left for resume, got %d", pending)
+	}
+}
+
+// stealingBulkJobStore hands the lease to another owner on the first renewal.
+type stealingBulkJobStore struct {
+	*InMemoryBulkJobStore
+	claims atomic.Int32
+}
+
+func (s *stealingBulkJobStore) ClaimJob(ctx context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	if s.claims.Add(1) > 1 {
+		return false, nil
+	}
+	return s.InMemoryBulkJobStore.ClaimJob(ctx, id, owner, now, ttl)
+}
+
+// blockingBulkRepository holds updates until the worker context ends.
+type blockingBulkRepository struct {
+	Repository
+}
+
+func (blockingBulkRepository) Update(ctx context.Context, _ string, _ map[string]any) (map[string]any, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestInMemoryBulkJobStoreClaimHonorsForeignLease(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryBulkJobStore()
+	if err := store.CreateJob(ctx, BulkJob{ID: "bulk-1", Status: BulkJobStatusRunning}, []string{"1"}); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	now := time.Now()
+	if ok, err := store.ClaimJob(ctx, "bulk-1", "worker-a", now, time.Minute); err != nil || !ok {
+		t.Fatalf("expected first claim, got %v %v", ok, err)
+	}
+	if ok, _ := store.ClaimJob(ctx, "bulk-1", "worker-b", now, time.Minute); ok {
+		t.Fatal("expected foreign lease to block claim")
+	}
+	if ok, _ := store.ClaimJob(ctx, "bulk-1", "worker-b", now.Add(2*time.Minute), time.Minute); !ok {
+		t.Fatal("expected expired lease to be reclaimed")
+	}
+}
+
+func seedBulkRepository(t *testing.T, statuses ...string) (*MemoryRepository, []string) {
+	t.Helper()
+	repo := NewMemoryRepository()
+	ids := make([]string, 0, len(statuses))
+	for i, status := range statuses {
+		record, err := repo.Create(context.Background(), map[string]any{
+			"title":  "post " + toString(i),
+			"status": status,
+		})
+		if err != nil {
+			t.Fatalf("seed record: %v", err)
+		}
+		ids = append(ids, toString(record["id"]))
+	}
+	return repo, ids
+}
+
+func mustNewDurableBulkService(t *testing.T, store BulkJobStore, repo Repository, opts ...DurableBulkServiceOption) *DurableBulkService {
+	t.Helper()
+	svc, err := NewDurableBulkService(store, func(_ context.Context, panel string) (Repository, error) {
+		if panel != "posts" {
+			return nil, ErrNotFound
+		}
+		return repo, nil
+	}, opts...)
+	if err != nil {
+		t.Fatalf("new durable bulk service: %v", err)
+	}
+	return svc
+}
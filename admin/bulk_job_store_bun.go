@@ -0,0 +1,367 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// BunBulkJobStore persists bulk jobs in the bulk_jobs and bulk_job_records tables.
+type BunBulkJobStore struct {
+	db *bun.DB
+}
+
+var _ BulkJobStore = (*BunBulkJobStore)(nil)
+
+func NewBunBulkJobStore(db *bun.DB) *BunBulkJobStore {
+	if db == nil {
+		return nil
+	}
+	return &BunBulkJobStore{db: db}
+}
+
+type bunBulkJobRecord struct {
+	bun.BaseModel `bun:"table:bulk_jobs,alias:bj"`
+
+	ID                string     `bun:"id,pk" json:"id"`
+	Name              string     `bun:"name" json:"name"`
+	Action            string     `bun:"action" json:"action"`
+	Status            string     `bun:"status" json:"status"`
+	Total             int        `bun:"total" json:"total"`
+	Processed         int        `bun:"processed" json:"processed"`
+	PayloadJSON       string     `bun:"payload_json" json:"payload_json"`
+	ErrorsJSON        string     `bun:"errors_json" json:"errors_json"`
+	RollbackAvailable bool       `bun:"rollback_available" json:"rollback_available"`
+	CreatedBy         string     `bun:"created_by" json:"created_by"`
+	TenantID          string     `bun:"tenant_id" json:"tenant_id"`
+	OrgID             string     `bun:"org_id" json:"org_id"`
+	LeaseOwner        string     `bun:"lease_owner" json:"lease_owner"`
+	LeaseExpiresAt    *time.Time `bun:"lease_expires_at" json:"lease_expires_at"`
+	StartedAt         time.Time  `bun:"started_at" json:"started_at"`
+	CompletedAt       *time.Time `bun:"completed_at" json:"completed_at"`
+	UpdatedAt         time.Time  `bun:"updated_at" json:"updated_at"`
+}
+
+type bunBulkJobRecordRow struct {
+	bun.BaseModel `bun:"table:bulk_job_records,alias:bjr"`
+
+	JobID        string    `bun:"job_id,pk" json:"job_id"`
+	Position     int       `bun:"position,pk" json:"position"`
+	RecordID     string    `bun:"record_id" json:"record_id"`
+	Status       string    `bun:"status" json:"status"`
+	SnapshotJSON string    `bun:"snapshot_json" json:"snapshot_json"`
+	Error        string    `bun:"error" json:"error"`
+	UpdatedAt    time.Time `bun:"updated_at" json:"updated_at"`
+}
+
+func (s *BunBulkJobStore) CreateJob(ctx context.Context, job BulkJob, recordIDs []string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	record, err := bunBulkJobRecordFromJob(job)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	rows := make([]bunBulkJobRecordRow, 0, len(recordIDs))
+	for i, id := range recordIDs {
+		rows = append(rows, bunBulkJobRecordRow{
+			JobID:        job.ID,
+			Position:     i,
+			RecordID:     id,
+			Status:       BulkRecordStatusPending,
+			SnapshotJSON: "{}",
+			UpdatedAt:    now,
+		})
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(&record).Exec(ctx); err != nil {
+			return err
+		}
+		for start := 0; start < len(rows); start += bulkJobInsertBatch {
+			batch := rows[start:min(start+bulkJobInsertBatch, len(rows))]
+			if _, err := tx.NewInsert().Model(&batch).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// bulkJobInsertBatch keeps multi-row inserts under driver bind-parameter limits.
+const bulkJobInsertBatch = 500
+
+func (s *BunBulkJobStore) GetJob(ctx context.Context, id string) (BulkJob, error) {
+	if err := s.ready(); err != nil {
+		return BulkJob{}, err
+	}
+	record := bunBulkJobRecord{}
+	err := s.db.NewSelect().
+		Model(&record).
+		Where("id = ?", strings.TrimSpace(id)).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return BulkJob{}, ErrNotFound
+	}
+	if err != nil {
+		return BulkJob{}, err
+	}
+	return bulkJobFromBunRecord(record)
+}
+
+func (s *BunBulkJobStore) ListJobs(ctx context.Context) ([]BulkJob, error) {
+	return s.listJobs(ctx, nil)
+}
+
+func (s *BunBulkJobStore) ListActiveJobs(ctx context.Context) ([]BulkJob, error) {
+	return s.listJobs(ctx, []string{BulkJobStatusRunning, BulkJobStatusRollingBack})
+}
+
+func (s *BunBulkJobStore) listJobs(ctx context.Context, statuses []string) ([]BulkJob, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	records := []bunBulkJobRecord{}
+	query := s.db.NewSelect().Model(&records).OrderExpr("started_at DESC, id DESC")
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(statuses))
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	jobs := make([]BulkJob, 0, len(records))
+	for _, record := range records {
+		job, err := bulkJobFromBunRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (s *BunBulkJobStore) SaveJob(ctx context.Context, job BulkJob) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	record, err := bunBulkJobRecordFromJob(job)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.NewUpdate().
+		Model(&record).
+		Column("name", "action", "status", "total", "processed", "payload_json", "errors_json", "rollback_available", "completed_at", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunBulkJobStore) ClaimJob(ctx context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	if err := s.ready(); err != nil {
+		return false, err
+	}
+	now = now.UTC()
+	result, err := s.db.NewUpdate().
+		Model((*bunBulkJobRecord)(nil)).
+		Set("lease_owner = ?", owner).
+		Set("lease_expires_at = ?", now.Add(ttl)).
+		Set("updated_at = ?", now).
+		Where("id = ?", strings.TrimSpace(id)).
+		Where("(lease_owner = '' OR lease_owner = ? OR lease_expires_at IS NULL OR lease_expires_at < ?)", owner, now).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (s *BunBulkJobStore) ReleaseJob(ctx context.Context, id, owner string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	_, err := s.db.NewUpdate().
+		Model((*bunBulkJobRecord)(nil)).
+		Set("lease_owner = ''").
+		Set("lease_expires_at = NULL").
+		Where("id = ?", strings.TrimSpace(id)).
+		Where("lease_owner = ?", owner).
+		Exec(ctx)
+	return err
+}
+
+func (s *BunBulkJobStore) ListJobRecords(ctx context.Context, jobID string, statuses []string, limit int) ([]BulkJobRecord, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	rows := []bunBulkJobRecordRow{}
+	query := s.db.NewSelect().
+		Model(&rows).
+		Where("job_id = ?", strings.TrimSpace(jobID)).
+		Where("status IN (?)", bun.In(statuses)).
+		OrderExpr("position ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	records := make([]BulkJobRecord, 0, len(rows))
+	for _, row := range rows {
+		record := BulkJobRecord{
+			JobID:     row.JobID,
+			RecordID:  row.RecordID,
+			Position:  row.Position,
+			Status:    row.Status,
+			Error:     row.Error,
+			UpdatedAt: row.UpdatedAt,
+		}
+		if err := json.Unmarshal([]byte(row.SnapshotJSON), &record.Snapshot); err != nil {
+			return nil, err
+		}
+		if len(record.Snapshot) == 0 {
+			record.Snapshot = nil
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (s *BunBulkJobStore) CountJobRecords(ctx context.Context, jobID string, statuses []string) (int, error) {
+	if err := s.ready(); err != nil {
+		return 0, err
+	}
+	return s.db.NewSelect().
+		Model((*bunBulkJobRecordRow)(nil)).
+		Where("job_id = ?", strings.TrimSpace(jobID)).
+		Where("status IN (?)", bun.In(statuses)).
+		Count(ctx)
+}
+
+func (s *BunBulkJobStore) SaveJobRecord(ctx context.Context, record BulkJobRecord) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	snapshot := record.Snapshot
+	if snapshot == nil {
+		snapshot = map[string]any{}
+	}
+	snapshotJSON, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	updatedAt := record.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now()
+	}
+	result, err := s.db.NewUpdate().
+		Model((*bunBulkJobRecordRow)(nil)).
+		Set("status = ?", record.Status).
+		Set("snapshot_json = ?", string(snapshotJSON)).
+		Set("error = ?", record.Error).
+		Set("updated_at = ?", updatedAt.UTC()).
+		Where("job_id = ?", strings.TrimSpace(record.JobID)).
+		Where("position = ?", record.Position).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunBulkJobStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("bulk job store", map[string]any{
+			"component": "bulk_job_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunBulkJobRecordFromJob(job BulkJob) (bunBulkJobRecord, error) {
+	payload := job.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return bunBulkJobRecord{}, err
+	}
+	errs := job.Errors
+	if errs == nil {
+		errs = []string{}
+	}
+	errorsJSON, err := json.Marshal(errs)
+	if err != nil {
+		return bunBulkJobRecord{}, err
+	}
+	record := bunBulkJobRecord{
+		ID:                strings.TrimSpace(job.ID),
+		Name:              job.Name,
+		Action:            job.Action,
+		Status:            job.Status,
+		Total:             job.Total,
+		Processed:         job.Processed,
+		PayloadJSON:       string(payloadJSON),
+		ErrorsJSON:        string(errorsJSON),
+		RollbackAvailable: job.RollbackAvailable,
+		CreatedBy:         job.CreatedBy,
+		TenantID:          job.TenantID,
+		OrgID:             job.OrgID,
+		StartedAt:         job.StartedAt.UTC(),
+		UpdatedAt:         time.Now().UTC(),
+	}
+	if !job.CompletedAt.IsZero() {
+		completedAt := job.CompletedAt.UTC()
+		record.CompletedAt = &completedAt
+	}
+	return record, nil
+}
+
+func bulkJobFromBunRecord(record bunBulkJobRecord) (BulkJob, error) {
+	job := BulkJob{
+		ID:                record.ID,
+		Name:              record.Name,
+		Action:            record.Action,
+		Status:            record.Status,
+		Total:             record.Total,
+		Processed:         record.Processed,
+		RollbackAvailable: record.RollbackAvailable,
+		CreatedBy:         record.CreatedBy,
+		TenantID:          record.TenantID,
+		OrgID:             record.OrgID,
+		StartedAt:         record.StartedAt,
+	}
+	if record.CompletedAt != nil {
+		job.CompletedAt = *record.CompletedAt
+	}
+	if err := json.Unmarshal([]byte(record.PayloadJSON), &job.Payload); err != nil {
+		return BulkJob{}, err
+	}
+	if err := json.Unmarshal([]byte(record.ErrorsJSON), &job.Errors); err != nil {
+		return BulkJob{}, err
+	}
+	return job, nil
+}
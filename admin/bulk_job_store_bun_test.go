@@ -0,0 +1,112 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunBulkJobStorePersistsJobsAndRecords(t *testing.T) {
+	ctx := context.Background()
+	store := NewBunBulkJobStore(setupMigratedSQLite(t, admindata.BulkJobMigrations(), "0017_bulk_jobs.up.sql"))
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := BulkJob{
+		ID:        "bulk-1",
+		Name:      "publish",
+		Action:    "update",
+		Status:    BulkJobStatusRunning,
+		Total:     3,
+		Payload:   map[string]any{"panel": "posts", "values": map[string]any{"status": "published"}},
+		CreatedBy: "editor-1",
+		TenantID:  "tenant-1",
+		OrgID:     "org-1",
+		StartedAt: started,
+	}
+	if err := store.CreateJob(ctx, job, []string{"a", "b", "c"}); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if err := store.CreateJob(ctx, BulkJob{ID: "bulk-0", Name: "old", Status: BulkJobStatusCompleted, StartedAt: started.Add(-time.Hour)}, nil); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+
+	loaded, err := store.GetJob(ctx, "bulk-1")
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	if loaded.CreatedBy != "editor-1" || loaded.TenantID != "tenant-1" || loaded.OrgID != "org-1" || bulkValues(loaded.Payload)["status"] != "published" {
+		t.Fatalf("expected identity and payload round-tripped, got %+v", loaded)
+	}
+	if _, err := store.GetJob(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	jobs, err := store.ListJobs(ctx)
+	if err != nil || len(jobs) != 2 || jobs[0].ID != "bulk-1" {
+		t.Fatalf("expected jobs newest first, got %+v (%v)", jobs, err)
+	}
+	active, err := store.ListActiveJobs(ctx)
+	if err != nil || len(active) != 1 || active[0].ID != "bulk-1" {
+		t.Fatalf("expected only the running job active, got %+v (%v)", active, err)
+	}
+
+	if err := store.SaveJobRecord(ctx, BulkJobRecord{JobID: "bulk-1", RecordID: "a", Position: 0, Status: BulkRecordStatusApplied, Snapshot: map[string]any{"status": "draft"}}); err != nil {
+		t.Fatalf("save record: %v", err)
+	}
+	if err := store.SaveJobRecord(ctx, BulkJobRecord{JobID: "bulk-1", RecordID: "b", Position: 1, Status: BulkRecordStatusFailed, Error: "not found"}); err != nil {
+		t.Fatalf("save record: %v", err)
+	}
+	if err := store.SaveJobRecord(ctx, BulkJobRecord{JobID: "bulk-1", Position: 9, Status: BulkRecordStatusApplied}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown record, got %v", err)
+	}
+	records, err := store.ListJobRecords(ctx, "bulk-1", []string{BulkRecordStatusApplied, BulkRecordStatusPending}, 0)
+	if err != nil || len(records) != 2 || records[0].Snapshot["status"] != "draft" || records[1].RecordID != "c" {
+		t.Fatalf("expected applied and pending records in order, got %+v (%v)", records, err)
+	}
+	if failed, err := store.CountJobRecords(ctx, "bulk-1", []string{BulkRecordStatusFailed}); err != nil || failed != 1 {
+		t.Fatalf("expected one failed record, got %d (%v)", failed, err)
+	}
+
+	loaded.Status = BulkJobStatusCompleted
+	loaded.Processed = 3
+	loaded.Errors = []string{"b: not found"}
+	loaded.CompletedAt = started.Add(time.Minute)
+	if err := store.SaveJob(ctx, loaded); err != nil {
+		t.Fatalf("save job: %v", err)
+	}
+	saved, err := store.GetJob(ctx, "bulk-1")
+	if err != nil || saved.Status != BulkJobStatusCompleted || len(saved.Errors) != 1 || saved.CompletedAt.IsZero() {
+		t.Fatalf("expected saved job state, got %+v (%v)", saved, err)
+	}
+}
+
+func TestBunBulkJobStoreLeases(t *testing.T) {
+	ctx := context.Background()
+	store := NewBunBulkJobStore(setupMigratedSQLite(t, admindata.BulkJobMigrations(), "0017_bulk_jobs.up.sql"))
+	if err := store.CreateJob(ctx, BulkJob{ID: "bulk-1", Name: "publish", Status: BulkJobStatusRunning, StartedAt: time.Now()}, []string{"a"}); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	now := time.Now()
+	if ok, err := store.ClaimJob(ctx, "bulk-1", "worker-a", now, time.Minute); err != nil || !ok {
+		t.Fatalf("expected first claim, got %v %v", ok, err)
+	}
+	if ok, err := store.ClaimJob(ctx, "bulk-1", "worker-a", now.Add(30*time.Second), time.Minute); err != nil || !ok {
+		t.Fatalf("expected owner to renew its lease, got %v %v", ok, err)
+	}
+	if ok, _ := store.ClaimJob(ctx, "bulk-1", "worker-b", now.Add(time.Minute), time.Minute); ok {
+		t.Fatal("expected renewed lease to block another owner")
+	}
+	if err := store.ReleaseJob(ctx, "bulk-1", "worker-b"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if ok, _ := store.ClaimJob(ctx, "bulk-1", "worker-b", now.Add(time.Minute), time.Minute); ok {
+		t.Fatal("expected release by a non-owner to keep the lease")
+	}
+	if err := store.ReleaseJob(ctx, "bulk-1", "worker-a"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if ok, err := store.ClaimJob(ctx, "bulk-1", "worker-b", now.Add(time.Minute), time.Minute); err != nil || !ok {
+		t.Fatalf("expected released lease to be claimable, got %v %v", ok, err)
+	}
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/driver/sqliteshim"
+)
+
+// setupMigratedSQLite opens a file-backed sqlite database and applies the
+// named up migrations from migrations in order.
+func setupMigratedSQLite(t *testing.T, migrations fs.FS, files ...string) *bun.DB {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "admin.db") + "?cache=shared&_pragma=foreign_keys(1)"
+	sqlDB, err := sql.Open(sqliteshim.ShimName, dsn)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	db := bun.NewDB(sqlDB, sqlitedialect.New())
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("close sqlite database: %v", err)
+		}
+	})
+	for _, file := range files {
+		migration, err := fs.ReadFile(migrations, file)
+		if err != nil {
+			t.Fatalf("read migration %s: %v", file, err)
+		}
+		if _, err := db.ExecContext(context.Background(), string(migration)); err != nil {
+			t.Fatalf("apply migration %s: %v", file, err)
+		}
+	}
+	return db
+}
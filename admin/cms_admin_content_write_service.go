@@ -25,6 +25,7 @@ type goCMSAdminContentWriteService struct {
 	contentTypes              CMSContentTypeService
 	entryNavigationOptions    EntryNavigationOptions
 	entryNavigationOptionsSet bool
+	revisions                 RevisionStore
 }
 
 // NewAdminContentWriteService builds the local admin content write-service boundary.
@@ -105,6 +106,14 @@ func (s goCMSAdminContentWriteService) Update(ctx context.Context, id string, re
 	content.ID = id
 	content.Locale = s.resolveUpdateContentLocale(ctx, id, content.Locale)
 	existing := s.resolveExistingContentForUpdate(ctx, id, &content)
+	var before map[string]any
+	if s.revisions != nil && existing != nil {
+		before = normalizeRevisionSnapshot(cmsContentRecord(*existing, cmsContentRecordOptions{
+			includeBlocks:   true,
+			includeData:     true,
+			includeMetadata: true,
+		}))
+	}
 	content, err := s.applyUpdateNavigationPolicy(ctx, id, record, content, existing)
 	if err != nil {
 		return nil, err
@@ -125,12 +134,22 @@ func (s goCMSAdminContentWriteService) Update(ctx context.Context, id string, re
 		includeData:     true,
 		includeMetadata: true,
 	})
+	if s.revisions != nil {
+		captureRevision(ctx, s.revisions, RevisionResourceContent, primitives.FirstNonEmptyRaw(updated.ID, id), before, result) //nolint:errcheck // the content update is committed; the store retries version conflicts itself.
+	}
 	if policy, ok := s.resolveContentNavigationPolicy(ctx, primitives.FirstNonEmptyRaw(updated.ContentTypeSlug, updated.ContentType)); ok {
 		result = applyContentEntryNavigationReadContract(result, policy)
 	}
 	return result, nil
 }
 
+// withRevisionStore returns a copy of the service that records a revision for
+// every content update.
+func (s goCMSAdminContentWriteService) withRevisionStore(store RevisionStore) goCMSAdminContentWriteService {
+	s.revisions = store
+	return s
+}
+
 func (s goCMSAdminContentWriteService) resolveUpdateContentLocale(ctx context.Context, id, locale string) string {
 	locale = strings.TrimSpace(locale)
 	if locale != "" {
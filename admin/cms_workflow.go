@@ -87,6 +87,12 @@ func defaultCMSWorkflowDefinitions() []WorkflowDefinition {
 				From:        "approval",
 				To:          "published",
 			},
+			{
+				Name:        "unpublish",
+				Description: "Unpublish",
+				From:        "published",
+				To:          "draft",
+			},
 		},
 	}
 
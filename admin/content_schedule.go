@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ContentScheduleActionPublish applies the publish workflow event at RunAt.
+	ContentScheduleActionPublish = "publish"
+	// ContentScheduleActionUnpublish applies the unpublish workflow event at RunAt.
+	ContentScheduleActionUnpublish = "unpublish"
+
+	ContentScheduleStatusScheduled = "scheduled"
+	ContentScheduleStatusRunning   = "running"
+	ContentScheduleStatusApplied   = "applied"
+	ContentScheduleStatusCancelled = "cancelled"
+	// ContentScheduleStatusBlocked marks schedules stopped by a translation policy blocker.
+	ContentScheduleStatusBlocked = "blocked"
+	// ContentScheduleStatusSkipped marks schedules whose transition was not
+	// available from the record's state when they came due.
+	ContentScheduleStatusSkipped = "skipped"
+	ContentScheduleStatusFailed  = "failed"
+
+	// contentScheduleRunningLease is how long a schedule may stay running
+	// before the job treats its runner as gone and schedules it again.
+	contentScheduleRunningLease = 10 * time.Minute
+
+	contentSchedulePublishAtField   = "publish_at"
+	contentScheduleUnpublishAtField = "unpublish_at"
+	contentScheduleStateField       = "schedule_state"
+)
+
+// ContentSchedule is a pending or finished scheduled workflow transition for
+// one panel record.
+type ContentSchedule struct {
+	ID        string    `json:"id"`
+	Panel     string    `json:"panel"`
+	RecordID  string    `json:"record_id"`
+	Action    string    `json:"action"`
+	RunAt     time.Time `json:"run_at"`
+	Status    string    `json:"status"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	// TenantID and OrgID are the scope the schedule was saved in; the job
+	// applies the transition in that scope.
+	TenantID string `json:"tenant_id,omitempty"`
+	OrgID    string `json:"org_id,omitempty"`
+	// State is the workflow state the transition moved the record to.
+	State     string     `json:"state,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ContentScheduleFilter narrows schedule listings. Empty fields are unconstrained;
+// a non-zero DueBefore keeps schedules with RunAt at or before it.
+type ContentScheduleFilter struct {
+	Panel     string    `json:"panel"`
+	RecordIDs []string  `json:"record_ids"`
+	Statuses  []string  `json:"statuses"`
+	DueBefore time.Time `json:"due_before"`
+}
+
+// ContentScheduleStore persists content schedules. Each record has at most one
+// scheduled entry per action: SaveSchedule cancels the pending one it replaces.
+// TransitionSchedule only moves a schedule that is still in the expected
+// status, so concurrent job runners claim each schedule once.
+type ContentScheduleStore interface {
+	SaveSchedule(ctx context.Context, schedule ContentSchedule) (ContentSchedule, error)
+	ListSchedules(ctx context.Context, filter ContentScheduleFilter) ([]ContentSchedule, error)
+	TransitionSchedule(ctx context.Context, id, from string, next ContentSchedule) (bool, error)
+}
+
+func normalizeContentScheduleAction(action string) string {
+	switch strings.ToLower(strings.TrimSpace(action)) {
+	case ContentScheduleActionPublish:
+		return ContentScheduleActionPublish
+	case ContentScheduleActionUnpublish:
+		return ContentScheduleActionUnpublish
+	}
+	return ""
+}
+
+func normalizeContentScheduleFilter(filter ContentScheduleFilter) ContentScheduleFilter {
+	filter.Panel = strings.TrimSpace(filter.Panel)
+	ids := []string{}
+	for _, id := range filter.RecordIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	filter.RecordIDs = ids
+	return filter
+}
+
+func contentScheduleMatches(schedule ContentSchedule, filter ContentScheduleFilter) bool {
+	if filter.Panel != "" && schedule.Panel != filter.Panel {
+		return false
+	}
+	if len(filter.RecordIDs) > 0 && !slices.Contains(filter.RecordIDs, schedule.RecordID) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, schedule.Status) {
+		return false
+	}
+	if !filter.DueBefore.IsZero() && schedule.RunAt.After(filter.DueBefore) {
+		return false
+	}
+	return true
+}
+
+// parseContentScheduleTime reads publish_at/unpublish_at payload values. An
+// empty value means "cancel".
+func parseContentScheduleTime(value any) (time.Time, error) {
+	switch typed := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return typed.UTC(), nil
+	case *time.Time:
+		if typed == nil {
+			return time.Time{}, nil
+		}
+		return typed.UTC(), nil
+	}
+	raw := strings.TrimSpace(toString(value))
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, validationDomainError("schedule time must be RFC3339", map[string]any{
+			"value": raw,
+		})
+	}
+	return parsed.UTC(), nil
+}
+
+// InMemoryContentScheduleStore keeps content schedules in process memory.
+type InMemoryContentScheduleStore struct {
+	mu        sync.Mutex
+	schedules map[string]ContentSchedule
+}
+
+var _ ContentScheduleStore = (*InMemoryContentScheduleStore)(nil)
+
+// NewInMemoryContentScheduleStore builds an empty schedule store.
+func NewInMemoryContentScheduleStore() *InMemoryContentScheduleStore {
+	return &InMemoryContentScheduleStore{schedules: map[string]ContentSchedule{}}
+}
+
+func (s *InMemoryContentScheduleStore) SaveSchedule(_ context.Context, schedule ContentSchedule) (ContentSchedule, error) {
+	if s == nil {
+		return ContentSchedule{}, serviceNotConfiguredDomainError("content schedule store", map[string]any{"component": "content_schedule"})
+	}
+	now := time.Now().UTC()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, existing := range s.schedules {
+		if existing.Panel == schedule.Panel && existing.RecordID == schedule.RecordID &&
+			existing.Action == schedule.Action && existing.Status == ContentScheduleStatusScheduled {
+			existing.Status = ContentScheduleStatusCancelled
+			existing.UpdatedAt = now
+			s.schedules[id] = existing
+		}
+	}
+	schedule.ID = uuid.NewString()
+	schedule.Status = ContentScheduleStatusScheduled
+	schedule.CreatedAt = now
+	schedule.UpdatedAt = now
+	s.schedules[schedule.ID] = schedule
+	return schedule, nil
+}
+
+func (s *InMemoryContentScheduleStore) ListSchedules(_ context.Context, filter ContentScheduleFilter) ([]ContentSchedule, error) {
+	if s == nil {
+		return nil, serviceNotConfiguredDomainError("content schedule store", map[string]any{"component": "content_schedule"})
+	}
+	filter = normalizeContentScheduleFilter(filter)
+	s.mu.Lock()
+	out := []ContentSchedule{}
+	for _, schedule := range s.schedules {
+		if contentScheduleMatches(schedule, filter) {
+			out = append(out, schedule)
+		}
+	}
+	s.mu.Unlock()
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].RunAt.Equal(out[j].RunAt) {
+			return out[i].RunAt.Before(out[j].RunAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+func (s *InMemoryContentScheduleStore) TransitionSchedule(_ context.Context, id, from string, next ContentSchedule) (bool, error) {
+	if s == nil {
+		return false, serviceNotConfiguredDomainError("content schedule store", map[string]any{"component": "content_schedule"})
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.schedules[strings.TrimSpace(id)]
+	if !ok {
+		return false, ErrNotFound
+	}
+	if current.Status != from {
+		return false, nil
+	}
+	current.Status = next.Status
+	current.State = next.State
+	current.Error = next.Error
+	current.AppliedAt = next.AppliedAt
+	current.UpdatedAt = time.Now().UTC()
+	s.schedules[current.ID] = current
+	return true, nil
+}
@@ -0,0 +1,132 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-command"
+	"github.com/goliatone/go-command/dispatcher"
+)
+
+const (
+	contentScheduleCommandName     = "jobs.content.schedule.apply"
+	contentScheduleDefaultSchedule = "* * * * *"
+)
+
+// ContentScheduleResult reports how the due schedules of one run ended.
+type ContentScheduleResult struct {
+	Applied int `json:"applied"`
+	Blocked int `json:"blocked"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+	// Recovered counts schedules left running past the lease and scheduled again.
+	Recovered int `json:"recovered"`
+}
+
+// ContentScheduleInput triggers one content schedule run.
+type ContentScheduleInput struct {
+	Result *ContentScheduleResult `json:"-"`
+}
+
+func (ContentScheduleInput) Type() string { return contentScheduleCommandName }
+
+func (ContentScheduleInput) Validate() error { return nil }
+
+// ContentScheduleCommand applies due publish_at/unpublish_at schedules of every
+// scheduling panel, on demand or on its cron schedule. A failing schedule is
+// recorded on the schedule and does not stop the run. Schedules left running
+// longer than RunningLease (ten minutes when zero) are scheduled again first.
+type ContentScheduleCommand struct {
+	Schedule      string              `json:"schedule"`
+	Registry      *Registry           `json:"registry"`
+	Notifications NotificationService `json:"-"`
+	Now           func() time.Time    `json:"-"`
+	RunningLease  time.Duration       `json:"running_lease"`
+}
+
+var _ command.Commander[ContentScheduleInput] = (*ContentScheduleCommand)(nil)
+var _ command.CronCommand = (*ContentScheduleCommand)(nil)
+
+func (c *ContentScheduleCommand) Execute(ctx context.Context, msg ContentScheduleInput) error {
+	if c == nil || c.Registry == nil {
+		return serviceNotConfiguredDomainError("panel registry", map[string]any{
+			"command": contentScheduleCommandName,
+		})
+	}
+	now := time.Now().UTC()
+	if c.Now != nil {
+		now = c.Now()
+	}
+	panels := c.Registry.Panels()
+	names := make([]string, 0, len(panels))
+	for name, panel := range panels {
+		if panel.SchedulesEnabled() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	result := ContentScheduleResult{}
+	for _, name := range names {
+		panel := panels[name]
+		recovered, err := panel.RecoverStaleContentSchedules(ctx, now, c.RunningLease)
+		if err != nil {
+			return err
+		}
+		result.Recovered += recovered
+		due, err := panel.schedules.ListSchedules(ctx, ContentScheduleFilter{
+			Panel:     name,
+			Statuses:  []string{ContentScheduleStatusScheduled},
+			DueBefore: now,
+		})
+		if err != nil {
+			return err
+		}
+		for _, schedule := range due {
+			applied, _ := panel.ApplyContentSchedule(ctx, schedule, c.Notifications) //nolint:errcheck // failures are stored on the schedule and counted below.
+			switch applied.Status {
+			case ContentScheduleStatusApplied:
+				result.Applied++
+			case ContentScheduleStatusBlocked:
+				result.Blocked++
+			case ContentScheduleStatusSkipped:
+				result.Skipped++
+			case ContentScheduleStatusFailed:
+				result.Failed++
+			}
+		}
+	}
+	if msg.Result != nil {
+		*msg.Result = result
+	}
+	return nil
+}
+
+func (c *ContentScheduleCommand) CronHandler() func() error {
+	return func() error {
+		return dispatcher.Dispatch(context.Background(), ContentScheduleInput{})
+	}
+}
+
+func (c *ContentScheduleCommand) CronOptions() command.HandlerConfig {
+	if c == nil {
+		return command.HandlerConfig{}
+	}
+	schedule := strings.TrimSpace(c.Schedule)
+	if schedule == "" {
+		schedule = contentScheduleDefaultSchedule
+	}
+	return command.HandlerConfig{Expression: schedule}
+}
+
+// RegisterContentScheduleCommands registers the scheduled publish/unpublish
+// job. An empty schedule runs every minute.
+func RegisterContentScheduleCommands(bus *CommandBus, registry *Registry, notifications NotificationService, schedule string) error {
+	_, err := RegisterCommand(bus, &ContentScheduleCommand{
+		Schedule:      schedule,
+		Registry:      registry,
+		Notifications: notifications,
+	})
+	return err
+}
@@ -0,0 +1,190 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// BunContentScheduleStore persists content schedules in the content_schedules table.
+type BunContentScheduleStore struct {
+	db *bun.DB
+}
+
+var _ ContentScheduleStore = (*BunContentScheduleStore)(nil)
+
+func NewBunContentScheduleStore(db *bun.DB) *BunContentScheduleStore {
+	if db == nil {
+		return nil
+	}
+	return &BunContentScheduleStore{db: db}
+}
+
+type bunContentScheduleRecord struct {
+	bun.BaseModel `bun:"table:content_schedules,alias:cs"`
+
+	ID        string     `bun:"id,pk" json:"id"`
+	Panel     string     `bun:"panel" json:"panel"`
+	RecordID  string     `bun:"record_id" json:"record_id"`
+	Action    string     `bun:"action" json:"action"`
+	RunAt     time.Time  `bun:"run_at" json:"run_at"`
+	Status    string     `bun:"status" json:"status"`
+	CreatedBy string     `bun:"created_by" json:"created_by"`
+	TenantID  string     `bun:"tenant_id" json:"tenant_id"`
+	OrgID     string     `bun:"org_id" json:"org_id"`
+	State     string     `bun:"state" json:"state"`
+	Error     string     `bun:"error" json:"error"`
+	AppliedAt *time.Time `bun:"applied_at,nullzero" json:"applied_at"`
+	CreatedAt time.Time  `bun:"created_at" json:"created_at"`
+	UpdatedAt time.Time  `bun:"updated_at" json:"updated_at"`
+}
+
+func (s *BunContentScheduleStore) SaveSchedule(ctx context.Context, schedule ContentSchedule) (ContentSchedule, error) {
+	if err := s.ready(); err != nil {
+		return ContentSchedule{}, err
+	}
+	now := time.Now().UTC()
+	schedule.ID = uuid.NewString()
+	schedule.Status = ContentScheduleStatusScheduled
+	schedule.RunAt = schedule.RunAt.UTC()
+	schedule.CreatedAt = now
+	schedule.UpdatedAt = now
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model((*bunContentScheduleRecord)(nil)).
+			Set("status = ?", ContentScheduleStatusCancelled).
+			Set("updated_at = ?", now).
+			Where("panel = ?", schedule.Panel).
+			Where("record_id = ?", schedule.RecordID).
+			Where("action = ?", schedule.Action).
+			Where("status = ?", ContentScheduleStatusScheduled).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		record := bunContentScheduleRecordFromSchedule(schedule)
+		_, err = tx.NewInsert().Model(&record).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return ContentSchedule{}, err
+	}
+	return schedule, nil
+}
+
+func (s *BunContentScheduleStore) ListSchedules(ctx context.Context, filter ContentScheduleFilter) ([]ContentSchedule, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	filter = normalizeContentScheduleFilter(filter)
+	records := []bunContentScheduleRecord{}
+	query := s.db.NewSelect().Model(&records)
+	if filter.Panel != "" {
+		query = query.Where("panel = ?", filter.Panel)
+	}
+	if len(filter.RecordIDs) > 0 {
+		query = query.Where("record_id IN (?)", bun.In(filter.RecordIDs))
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(filter.Statuses))
+	}
+	if !filter.DueBefore.IsZero() {
+		query = query.Where("run_at <= ?", filter.DueBefore.UTC())
+	}
+	if err := query.OrderExpr("run_at ASC, id ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+	out := make([]ContentSchedule, 0, len(records))
+	for _, record := range records {
+		out = append(out, contentScheduleFromBunRecord(record))
+	}
+	return out, nil
+}
+
+func (s *BunContentScheduleStore) TransitionSchedule(ctx context.Context, id, from string, next ContentSchedule) (bool, error) {
+	if err := s.ready(); err != nil {
+		return false, err
+	}
+	id = strings.TrimSpace(id)
+	res, err := s.db.NewUpdate().
+		Model((*bunContentScheduleRecord)(nil)).
+		Set("status = ?", next.Status).
+		Set("state = ?", next.State).
+		Set("error = ?", next.Error).
+		Set("applied_at = ?", next.AppliedAt).
+		Set("updated_at = ?", time.Now().UTC()).
+		Where("id = ?", id).
+		Where("status = ?", from).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected > 0 {
+		return true, nil
+	}
+	exists, err := s.db.NewSelect().
+		Model((*bunContentScheduleRecord)(nil)).
+		Where("id = ?", id).
+		Exists(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, ErrNotFound
+	}
+	return false, nil
+}
+
+func (s *BunContentScheduleStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("content schedule store", map[string]any{
+			"component": "content_schedule_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunContentScheduleRecordFromSchedule(schedule ContentSchedule) bunContentScheduleRecord {
+	return bunContentScheduleRecord{
+		ID:        schedule.ID,
+		Panel:     schedule.Panel,
+		RecordID:  schedule.RecordID,
+		Action:    schedule.Action,
+		RunAt:     schedule.RunAt,
+		Status:    schedule.Status,
+		CreatedBy: schedule.CreatedBy,
+		TenantID:  schedule.TenantID,
+		OrgID:     schedule.OrgID,
+		State:     schedule.State,
+		Error:     schedule.Error,
+		AppliedAt: schedule.AppliedAt,
+		CreatedAt: schedule.CreatedAt,
+		UpdatedAt: schedule.UpdatedAt,
+	}
+}
+
+func contentScheduleFromBunRecord(record bunContentScheduleRecord) ContentSchedule {
+	return ContentSchedule{
+		ID:        record.ID,
+		Panel:     record.Panel,
+		RecordID:  record.RecordID,
+		Action:    record.Action,
+		RunAt:     record.RunAt.UTC(),
+		Status:    record.Status,
+		CreatedBy: record.CreatedBy,
+		TenantID:  record.TenantID,
+		OrgID:     record.OrgID,
+		State:     record.State,
+		Error:     record.Error,
+		AppliedAt: record.AppliedAt,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
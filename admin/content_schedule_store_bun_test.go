@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunContentScheduleStoreSavesClaimsAndScopesSchedules(t *testing.T) {
+	ctx := context.Background()
+	db := setupMigratedSQLite(t, admindata.ContentScheduleMigrations(), "0021_content_schedules.up.sql")
+	store := NewBunContentScheduleStore(db)
+	runAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	first, err := store.SaveSchedule(ctx, ContentSchedule{Panel: "pages", RecordID: "page-1", Action: ContentScheduleActionPublish, RunAt: runAt, CreatedBy: "editor-1"})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	replacement, err := store.SaveSchedule(ctx, ContentSchedule{
+		Panel:     "pages",
+		RecordID:  "page-1",
+		Action:    ContentScheduleActionPublish,
+		RunAt:     runAt.Add(time.Hour),
+		CreatedBy: "editor-1",
+		TenantID:  "tenant-1",
+		OrgID:     "org-1",
+	})
+	if err != nil {
+		t.Fatalf("save replacement: %v", err)
+	}
+
+	pending, err := store.ListSchedules(ctx, ContentScheduleFilter{Panel: "pages", Statuses: []string{ContentScheduleStatusScheduled}})
+	if err != nil || len(pending) != 1 || pending[0].ID != replacement.ID {
+		t.Fatalf("expected the replacement to cancel the earlier schedule, got %+v (%v)", pending, err)
+	}
+	if pending[0].TenantID != "tenant-1" || pending[0].OrgID != "org-1" || !pending[0].RunAt.Equal(runAt.Add(time.Hour)) {
+		t.Fatalf("expected scope and run time round-tripped, got %+v", pending[0])
+	}
+	all, _ := store.ListSchedules(ctx, ContentScheduleFilter{RecordIDs: []string{"page-1"}})
+	if len(all) != 2 || all[0].ID != first.ID || all[0].Status != ContentScheduleStatusCancelled {
+		t.Fatalf("expected cancelled schedule kept in history, got %+v", all)
+	}
+	if due, _ := store.ListSchedules(ctx, ContentScheduleFilter{Statuses: []string{ContentScheduleStatusScheduled}, DueBefore: runAt}); len(due) != 0 {
+		t.Fatalf("expected nothing due before the replacement run time, got %+v", due)
+	}
+
+	claimed, err := store.TransitionSchedule(ctx, replacement.ID, ContentScheduleStatusScheduled, ContentSchedule{Status: ContentScheduleStatusRunning})
+	if err != nil || !claimed {
+		t.Fatalf("expected claim, got %v (%v)", claimed, err)
+	}
+	if again, err := store.TransitionSchedule(ctx, replacement.ID, ContentScheduleStatusScheduled, ContentSchedule{Status: ContentScheduleStatusRunning}); err != nil || again {
+		t.Fatalf("expected a running schedule not to be claimed twice, got %v (%v)", again, err)
+	}
+	appliedAt := runAt.Add(time.Hour)
+	if ok, err := store.TransitionSchedule(ctx, replacement.ID, ContentScheduleStatusRunning, ContentSchedule{Status: ContentScheduleStatusApplied, State: "published", AppliedAt: &appliedAt}); err != nil || !ok {
+		t.Fatalf("expected apply transition, got %v (%v)", ok, err)
+	}
+	applied, _ := store.ListSchedules(ctx, ContentScheduleFilter{Statuses: []string{ContentScheduleStatusApplied}})
+	if len(applied) != 1 || applied[0].State != "published" || applied[0].AppliedAt == nil {
+		t.Fatalf("expected applied schedule with state, got %+v", applied)
+	}
+	if _, err := store.TransitionSchedule(ctx, "missing", ContentScheduleStatusScheduled, ContentSchedule{Status: ContentScheduleStatusRunning}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -47,6 +47,12 @@ type Dependencies struct {
 	WorkflowRuntime                WorkflowRuntime                 `json:"workflow_runtime"`
 	TranslationPolicy              TranslationPolicy               `json:"translation_policy"`
 	TranslationFamilyStore         translationservices.FamilyStore `json:"translation_family_store"`
+	TranslationGlossaryStore       TranslationGlossaryStore        `json:"translation_glossary_store"`
+	TranslationMemoryStore         TranslationMemoryStore          `json:"translation_memory_store"`
+	RevisionStore                  RevisionStore                   `json:"revision_store"`
+	ContentScheduleStore           ContentScheduleStore            `json:"content_schedule_store"`
+	SearchIndex                    PanelSearchIndexer              `json:"search_index"`
+	PanelImportJobStore            PanelImportJobStore             `json:"panel_import_job_store"`
 	ActivitySink                   ActivitySink                    `json:"activity_sink"`
 	ActivityRepository             types.ActivityRepository        `json:"activity_repository"`
 	ActivityAccessPolicy           activity.ActivityAccessPolicy   `json:"activity_access_policy"`
@@ -72,6 +78,7 @@ type Dependencies struct {
 	ExportRegistrar                 ExportHTTPRegistrar             `json:"export_registrar"`
 	ExportMetadata                  ExportMetadataProvider          `json:"export_metadata"`
 	BulkService                     BulkService                     `json:"bulk_service"`
+	BulkJobStore                    BulkJobStore                    `json:"bulk_job_store"`
 	MediaLibrary                    MediaLibrary                    `json:"media_library"`
 	MediaActivityHook               MediaActivityHook               `json:"media_activity_hook"`
 	MediaDeliveryRegistry           *MediaDeliveryRegistry          `json:"media_delivery_registry"`
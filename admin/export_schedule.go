@@ -0,0 +1,403 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+)
+
+const (
+	ExportScheduleCadenceHourly  = "hourly"
+	ExportScheduleCadenceDaily   = "daily"
+	ExportScheduleCadenceWeekly  = "weekly"
+	ExportScheduleCadenceMonthly = "monthly"
+
+	ExportScheduleRunSucceeded = "succeeded"
+	ExportScheduleRunFailed    = "failed"
+	// ExportScheduleRunDenied marks runs stopped because the owner can no
+	// longer sign in or lost the schedule permission.
+	ExportScheduleRunDenied = "denied"
+
+	exportScheduleResource = "exports"
+)
+
+// exportScheduleCadencePresets maps named cadences to cron expressions using
+// the same times as the @hourly/@daily/@weekly/@monthly cron descriptors.
+var exportScheduleCadencePresets = map[string]string{
+	ExportScheduleCadenceHourly:  "0 * * * *",
+	ExportScheduleCadenceDaily:   "0 0 * * *",
+	ExportScheduleCadenceWeekly:  "0 0 * * 0",
+	ExportScheduleCadenceMonthly: "0 0 1 * *",
+}
+
+// ExportSchedule is a saved export request that runs on a recurring cadence
+// as its owner and delivers a signed download link.
+type ExportSchedule struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Definition string         `json:"definition"`
+	Variant    string         `json:"variant,omitempty"`
+	Format     string         `json:"format"`
+	Columns    []string       `json:"columns,omitempty"`
+	Query      map[string]any `json:"query,omitempty"`
+	// Cadence is a five-field cron expression or one of hourly, daily,
+	// weekly, or monthly. It is evaluated in Timezone (UTC when empty).
+	Cadence  string `json:"cadence"`
+	Timezone string `json:"timezone,omitempty"`
+	// Recipients receive the download link by email; the owner is notified
+	// in-app unless SkipNotification is set.
+	Recipients       []string `json:"recipients,omitempty"`
+	SkipNotification bool     `json:"skip_notification,omitempty"`
+	Paused           bool     `json:"paused,omitempty"`
+
+	OwnerID  string `json:"owner_id"`
+	TenantID string `json:"tenant_id,omitempty"`
+	OrgID    string `json:"org_id,omitempty"`
+	Locale   string `json:"locale,omitempty"`
+
+	NextRunAt *time.Time         `json:"next_run_at,omitempty"`
+	LastRun   *ExportScheduleRun `json:"last_run,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// ExportScheduleRun reports the outcome of one schedule run.
+type ExportScheduleRun struct {
+	Status      string    `json:"status"`
+	ExportID    string    `json:"export_id,omitempty"`
+	FileName    string    `json:"file_name,omitempty"`
+	Rows        int64     `json:"rows,omitempty"`
+	DownloadURL string    `json:"download_url,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Error       string    `json:"error,omitempty"`
+	RanAt       time.Time `json:"ran_at"`
+}
+
+// ExportScheduleFilter narrows schedule listings. A non-zero DueBefore keeps
+// unpaused schedules whose next run is at or before it.
+type ExportScheduleFilter struct {
+	OwnerID   string    `json:"owner_id"`
+	DueBefore time.Time `json:"due_before"`
+}
+
+// ExportScheduleStore persists export schedules. ClaimSchedule only advances
+// a schedule that still has the expected next run, so concurrent job runners
+// start each occurrence once. RecordRun stores the last run without touching
+// fields the owner may be editing.
+type ExportScheduleStore interface {
+	SaveSchedule(ctx context.Context, schedule ExportSchedule) (ExportSchedule, error)
+	GetSchedule(ctx context.Context, id string) (ExportSchedule, error)
+	ListSchedules(ctx context.Context, filter ExportScheduleFilter) ([]ExportSchedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+	ClaimSchedule(ctx context.Context, id string, expected, next time.Time) (bool, error)
+	RecordRun(ctx context.Context, id string, run ExportScheduleRun) error
+}
+
+// exportCadence is a parsed five-field cron expression.
+type exportCadence struct {
+	minutes, hours, days, months, weekdays []bool
+	anyDay, anyWeekday                     bool
+	location                               *time.Location
+}
+
+// parseExportCadence accepts a named cadence or a standard five-field cron
+// expression (minute hour day-of-month month day-of-week) with lists,
+// ranges, steps, and three-letter month/weekday names.
+func parseExportCadence(cadence, timezone string) (exportCadence, error) {
+	expr := strings.ToLower(strings.TrimSpace(cadence))
+	if preset, ok := exportScheduleCadencePresets[strings.TrimPrefix(expr, "@")]; ok {
+		expr = preset
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return exportCadence{}, validationDomainError("cadence must be hourly, daily, weekly, monthly, or a five-field cron expression", map[string]any{
+			"field":   "cadence",
+			"cadence": cadence,
+		})
+	}
+	location := time.UTC
+	if tz := strings.TrimSpace(timezone); tz != "" {
+		loaded, err := time.LoadLocation(tz)
+		if err != nil {
+			return exportCadence{}, validationDomainError("unknown timezone", map[string]any{"field": "timezone", "timezone": tz})
+		}
+		location = loaded
+	}
+	out := exportCadence{location: location}
+	specs := []struct {
+		target   *[]bool
+		min, max int
+		names    []string
+	}{
+		{&out.minutes, 0, 59, nil},
+		{&out.hours, 0, 23, nil},
+		{&out.days, 1, 31, nil},
+		{&out.months, 1, 12, []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+		{&out.weekdays, 0, 7, []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+	}
+	for i, spec := range specs {
+		set, err := parseExportCadenceField(fields[i], spec.min, spec.max, spec.names)
+		if err != nil {
+			return exportCadence{}, validationDomainError("invalid cron expression", map[string]any{
+				"field":   "cadence",
+				"cadence": cadence,
+				"error":   err.Error(),
+			})
+		}
+		*spec.target = set
+	}
+	if out.weekdays[7] {
+		out.weekdays[0] = true
+	}
+	out.anyDay = fields[2] == "*"
+	out.anyWeekday = fields[4] == "*"
+	return out, nil
+}
+
+func parseExportCadenceField(field string, minValue, maxValue int, names []string) ([]bool, error) {
+	set := make([]bool, maxValue+1)
+	value := func(raw string) (int, error) {
+		if idx := slices.Index(names, raw); idx >= 0 {
+			return idx + minValue, nil
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < minValue || n > maxValue {
+			return 0, fmt.Errorf("value %q out of range %d-%d", raw, minValue, maxValue)
+		}
+		return n, nil
+	}
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			step = n
+		}
+		start, end := minValue, maxValue
+		if rangePart != "*" {
+			lo, hi, isRange := strings.Cut(rangePart, "-")
+			n, err := value(lo)
+			if err != nil {
+				return nil, err
+			}
+			start, end = n, n
+			if isRange {
+				if end, err = value(hi); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				end = maxValue
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+		}
+		for n := start; n <= end; n += step {
+			set[n] = true
+		}
+	}
+	return set, nil
+}
+
+// next returns the first matching minute strictly after after, or the zero
+// time when the expression never matches (for example "0 0 31 2 *").
+func (c exportCadence) next(after time.Time) time.Time {
+	t := after.In(c.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !c.months[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.location)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location)
+		case !c.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.location)
+		case !c.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches follows cron semantics: when both day fields are restricted a
+// day matching either one runs.
+func (c exportCadence) dayMatches(t time.Time) bool {
+	day := c.days[t.Day()]
+	weekday := c.weekdays[int(t.Weekday())]
+	switch {
+	case c.anyDay && c.anyWeekday:
+		return true
+	case c.anyDay:
+		return weekday
+	case c.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
+
+func normalizeExportScheduleRecipients(recipients []string) ([]string, error) {
+	out := make([]string, 0, len(recipients))
+	for _, raw := range recipients {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		addr, err := mail.ParseAddress(raw)
+		if err != nil {
+			return nil, validationDomainError("invalid recipient email", map[string]any{"field": "recipients", "recipient": raw})
+		}
+		email := strings.ToLower(addr.Address)
+		if !slices.Contains(out, email) {
+			out = append(out, email)
+		}
+	}
+	return out, nil
+}
+
+// InMemoryExportScheduleStore keeps export schedules in memory.
+type InMemoryExportScheduleStore struct {
+	mu        sync.Mutex
+	schedules map[string]ExportSchedule
+}
+
+var _ ExportScheduleStore = (*InMemoryExportScheduleStore)(nil)
+
+// NewInMemoryExportScheduleStore constructs an empty in-memory schedule store.
+func NewInMemoryExportScheduleStore() *InMemoryExportScheduleStore {
+	return &InMemoryExportScheduleStore{schedules: map[string]ExportSchedule{}}
+}
+
+func (s *InMemoryExportScheduleStore) SaveSchedule(_ context.Context, schedule ExportSchedule) (ExportSchedule, error) {
+	if s == nil {
+		return ExportSchedule{}, serviceNotConfiguredDomainError("export schedule store", nil)
+	}
+	if strings.TrimSpace(schedule.ID) == "" {
+		return ExportSchedule{}, requiredFieldDomainError("id", nil)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.schedules[schedule.ID]; ok {
+		schedule.LastRun = existing.LastRun
+	}
+	s.schedules[schedule.ID] = cloneExportSchedule(schedule)
+	return cloneExportSchedule(schedule), nil
+}
+
+func (s *InMemoryExportScheduleStore) GetSchedule(_ context.Context, id string) (ExportSchedule, error) {
+	if s == nil {
+		return ExportSchedule{}, serviceNotConfiguredDomainError("export schedule store", nil)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	schedule, ok := s.schedules[strings.TrimSpace(id)]
+	if !ok {
+		return ExportSchedule{}, ErrNotFound
+	}
+	return cloneExportSchedule(schedule), nil
+}
+
+func (s *InMemoryExportScheduleStore) ListSchedules(_ context.Context, filter ExportScheduleFilter) ([]ExportSchedule, error) {
+	if s == nil {
+		return nil, serviceNotConfiguredDomainError("export schedule store", nil)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []ExportSchedule{}
+	for _, schedule := range s.schedules {
+		if filter.OwnerID != "" && schedule.OwnerID != filter.OwnerID {
+			continue
+		}
+		if !filter.DueBefore.IsZero() && (schedule.Paused || schedule.NextRunAt == nil || schedule.NextRunAt.After(filter.DueBefore)) {
+			continue
+		}
+		out = append(out, cloneExportSchedule(schedule))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+func (s *InMemoryExportScheduleStore) DeleteSchedule(_ context.Context, id string) error {
+	if s == nil {
+		return serviceNotConfiguredDomainError("export schedule store", nil)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id = strings.TrimSpace(id)
+	if _, ok := s.schedules[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.schedules, id)
+	return nil
+}
+
+func (s *InMemoryExportScheduleStore) ClaimSchedule(_ context.Context, id string, expected, next time.Time) (bool, error) {
+	if s == nil {
+		return false, serviceNotConfiguredDomainError("export schedule store", nil)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	schedule, ok := s.schedules[strings.TrimSpace(id)]
+	if !ok || schedule.NextRunAt == nil || !schedule.NextRunAt.Equal(expected) {
+		return false, nil
+	}
+	schedule.NextRunAt = exportScheduleTimePtr(next)
+	s.schedules[schedule.ID] = schedule
+	return true, nil
+}
+
+func (s *InMemoryExportScheduleStore) RecordRun(_ context.Context, id string, run ExportScheduleRun) error {
+	if s == nil {
+		return serviceNotConfiguredDomainError("export schedule store", nil)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	schedule, ok := s.schedules[strings.TrimSpace(id)]
+	if !ok {
+		return ErrNotFound
+	}
+	schedule.LastRun = &run
+	s.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func cloneExportSchedule(schedule ExportSchedule) ExportSchedule {
+	schedule.Columns = slices.Clone(schedule.Columns)
+	schedule.Recipients = slices.Clone(schedule.Recipients)
+	schedule.Query = primitives.CloneAnyMap(schedule.Query)
+	if schedule.NextRunAt != nil {
+		next := *schedule.NextRunAt
+		schedule.NextRunAt = &next
+	}
+	if schedule.LastRun != nil {
+		run := *schedule.LastRun
+		schedule.LastRun = &run
+	}
+	return schedule
+}
+
+func exportScheduleTimePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
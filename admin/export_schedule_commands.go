@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-command"
+	"github.com/goliatone/go-command/dispatcher"
+)
+
+const (
+	exportScheduleCommandName     = "jobs.exports.schedule.run"
+	exportScheduleDefaultSchedule = "* * * * *"
+)
+
+// ExportScheduleInput triggers one scheduled export run.
+type ExportScheduleInput struct {
+	Result *ExportScheduleRunResult `json:"-"`
+}
+
+func (ExportScheduleInput) Type() string { return exportScheduleCommandName }
+
+func (ExportScheduleInput) Validate() error { return nil }
+
+// ExportScheduleCommand runs the due recurring exports, on demand or on its
+// cron schedule. A failing schedule is recorded on the schedule and does not
+// stop the run.
+type ExportScheduleCommand struct {
+	Schedule string                 `json:"schedule"`
+	Service  *ExportScheduleService `json:"-"`
+	Now      func() time.Time       `json:"-"`
+}
+
+var _ command.Commander[ExportScheduleInput] = (*ExportScheduleCommand)(nil)
+var _ command.CronCommand = (*ExportScheduleCommand)(nil)
+
+func (c *ExportScheduleCommand) Execute(ctx context.Context, msg ExportScheduleInput) error {
+	if c == nil || c.Service == nil {
+		return serviceNotConfiguredDomainError("export schedule service", map[string]any{
+			"command": exportScheduleCommandName,
+		})
+	}
+	now := time.Now().UTC()
+	if c.Now != nil {
+		now = c.Now()
+	}
+	result, err := c.Service.RunDue(ctx, now)
+	if msg.Result != nil {
+		*msg.Result = result
+	}
+	return err
+}
+
+func (c *ExportScheduleCommand) CronHandler() func() error {
+	return func() error {
+		return dispatcher.Dispatch(context.Background(), ExportScheduleInput{})
+	}
+}
+
+func (c *ExportScheduleCommand) CronOptions() command.HandlerConfig {
+	if c == nil {
+		return command.HandlerConfig{}
+	}
+	schedule := strings.TrimSpace(c.Schedule)
+	if schedule == "" {
+		schedule = exportScheduleDefaultSchedule
+	}
+	return command.HandlerConfig{Expression: schedule}
+}
+
+// RegisterExportScheduleCommands registers the recurring export job. An empty
+// schedule checks for due exports every minute.
+func RegisterExportScheduleCommands(bus *CommandBus, service *ExportScheduleService, schedule string) error {
+	_, err := RegisterCommand(bus, &ExportScheduleCommand{
+		Schedule: schedule,
+		Service:  service,
+	})
+	return err
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	auth "github.com/goliatone/go-auth"
+)
+
+// NewUserExportScheduleIdentity resolves schedule owners through the user
+// service. The owner must still exist and be active; runs carry the owner's
+// current role as go-auth claims so the authorizer resolves their permissions
+// as of the run rather than as of when the schedule was saved.
+func NewUserExportScheduleIdentity(users *UserManagementService) ExportScheduleIdentityResolver {
+	return ExportScheduleIdentityFunc(func(ctx context.Context, schedule ExportSchedule) (context.Context, error) {
+		if users == nil {
+			return nil, serviceNotConfiguredDomainError("user service", map[string]any{
+				"component": "export_schedules",
+			})
+		}
+		user, err := users.GetUser(ctx, schedule.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		if status := strings.ToLower(strings.TrimSpace(user.Status)); status != "" && status != "active" {
+			return nil, permissionDenied(PermAdminExportsSchedule, exportScheduleResource)
+		}
+		ctx = auth.WithClaimsContext(ctx, &auth.JWTClaims{UID: user.ID, UserRole: user.Role})
+		ctx = withAdminRouterIdentity(ctx, adminRouterIdentity{
+			userID:   user.ID,
+			tenantID: schedule.TenantID,
+			orgID:    schedule.OrgID,
+			actor: &auth.ActorContext{
+				ActorID:        user.ID,
+				Subject:        user.ID,
+				Role:           user.Role,
+				TenantID:       schedule.TenantID,
+				OrganizationID: schedule.OrgID,
+			},
+		})
+		if schedule.Locale != "" {
+			ctx = WithLocale(ctx, schedule.Locale)
+		}
+		return ctx, nil
+	})
+}
@@ -0,0 +1,616 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	"github.com/google/uuid"
+)
+
+const defaultExportScheduleLinkTTL = 7 * 24 * time.Hour
+
+// ScheduledExportRequest is the export a schedule asks its runner to generate.
+type ScheduledExportRequest struct {
+	ScheduleID string         `json:"schedule_id"`
+	Definition string         `json:"definition"`
+	Variant    string         `json:"variant,omitempty"`
+	Format     string         `json:"format"`
+	Columns    []string       `json:"columns,omitempty"`
+	Query      map[string]any `json:"query,omitempty"`
+	FileName   string         `json:"file_name"`
+}
+
+// ScheduledExportArtifact describes a generated export held by the host's
+// artifact store.
+type ScheduledExportArtifact struct {
+	ExportID    string `json:"export_id"`
+	Key         string `json:"key"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Rows        int64  `json:"rows"`
+	Bytes       int64  `json:"bytes"`
+}
+
+// ScheduledExportRunner generates and stores one export as the identity
+// carried by ctx, so the export engine's own authorization applies to it.
+type ScheduledExportRunner interface {
+	RunScheduledExport(ctx context.Context, req ScheduledExportRequest) (ScheduledExportArtifact, error)
+}
+
+// ExportScheduleIdentityResolver returns a context acting as the schedule
+// owner with their current roles, or an error when the owner can no longer act.
+type ExportScheduleIdentityResolver interface {
+	ResolveExportScheduleIdentity(ctx context.Context, schedule ExportSchedule) (context.Context, error)
+}
+
+// ExportScheduleIdentityFunc adapts a function to ExportScheduleIdentityResolver.
+type ExportScheduleIdentityFunc func(ctx context.Context, schedule ExportSchedule) (context.Context, error)
+
+func (f ExportScheduleIdentityFunc) ResolveExportScheduleIdentity(ctx context.Context, schedule ExportSchedule) (context.Context, error) {
+	return f(ctx, schedule)
+}
+
+// ScheduledExportEmail is the message sent to schedule recipients.
+type ScheduledExportEmail struct {
+	To          []string                `json:"to"`
+	Subject     string                  `json:"subject"`
+	Schedule    ExportSchedule          `json:"schedule"`
+	Artifact    ScheduledExportArtifact `json:"artifact"`
+	DownloadURL string                  `json:"download_url"`
+	ExpiresAt   time.Time               `json:"expires_at"`
+}
+
+// ScheduledExportMailer delivers download links to schedule recipients.
+type ScheduledExportMailer interface {
+	SendScheduledExport(ctx context.Context, email ScheduledExportEmail) error
+}
+
+// ExportScheduleRunResult counts how the due schedules of one run ended.
+// Skipped schedules were claimed by another runner.
+type ExportScheduleRunResult struct {
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Denied    int `json:"denied"`
+	Skipped   int `json:"skipped"`
+}
+
+// ExportScheduleServiceOption customizes an ExportScheduleService.
+type ExportScheduleServiceOption func(*ExportScheduleService)
+
+// WithExportScheduleIdentity sets how runs resolve the schedule owner.
+func WithExportScheduleIdentity(resolver ExportScheduleIdentityResolver) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		s.identity = resolver
+	}
+}
+
+// WithExportScheduleLinks signs download links with signer; linkURL turns a
+// token into the absolute URL of the host's signed download route.
+func WithExportScheduleLinks(signer *ExportDownloadSigner, linkURL func(token string) string) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		s.signer = signer
+		s.linkURL = linkURL
+	}
+}
+
+// WithExportScheduleLinkTTL sets how long download links stay valid.
+func WithExportScheduleLinkTTL(ttl time.Duration) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		if ttl > 0 {
+			s.linkTTL = ttl
+		}
+	}
+}
+
+// WithExportScheduleNotifications notifies schedule owners in-app.
+func WithExportScheduleNotifications(notifications NotificationService) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		s.notifications = notifications
+	}
+}
+
+// WithExportScheduleMailer emails download links to schedule recipients.
+func WithExportScheduleMailer(mailer ScheduledExportMailer) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		s.mailer = mailer
+	}
+}
+
+// WithExportScheduleAuthorizer checks permission when schedules are managed
+// and again, as the owner, before every run.
+func WithExportScheduleAuthorizer(authorizer Authorizer, permission string) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		s.authorizer = authorizer
+		if permission = strings.TrimSpace(permission); permission != "" {
+			s.permission = permission
+		}
+	}
+}
+
+// WithExportScheduleRecipientDomains restricts schedule recipients to the
+// given email domains ("example.com"). Schedules may email anyone when no
+// domain is configured.
+func WithExportScheduleRecipientDomains(domains ...string) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		for _, domain := range domains {
+			domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+			if domain != "" && !slices.Contains(s.recipientDomains, domain) {
+				s.recipientDomains = append(s.recipientDomains, domain)
+			}
+		}
+	}
+}
+
+// WithExportScheduleActivitySink records schedule changes and runs.
+func WithExportScheduleActivitySink(sink ActivitySink) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		s.activity = sink
+	}
+}
+
+// WithExportScheduleLogger sets the logger used for best-effort failures.
+func WithExportScheduleLogger(logger Logger) ExportScheduleServiceOption {
+	return func(s *ExportScheduleService) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
+
+// ExportScheduleService manages recurring exports and runs the due ones.
+type ExportScheduleService struct {
+	store         ExportScheduleStore
+	runner        ScheduledExportRunner
+	identity      ExportScheduleIdentityResolver
+	signer        *ExportDownloadSigner
+	linkURL       func(string) string
+	linkTTL       time.Duration
+	notifications NotificationService
+	mailer        ScheduledExportMailer
+	// recipientDomains limits recipient emails; empty allows any domain.
+	recipientDomains []string
+	authorizer       Authorizer
+	permission       string
+	activity         ActivitySink
+	logger           Logger
+	now              func() time.Time
+}
+
+// NewExportScheduleService builds a schedule service; a nil store keeps
+// schedules in memory.
+func NewExportScheduleService(store ExportScheduleStore, runner ScheduledExportRunner, opts ...ExportScheduleServiceOption) (*ExportScheduleService, error) {
+	if runner == nil {
+		return nil, serviceNotConfiguredDomainError("scheduled export runner", map[string]any{
+			"component": "export_schedules",
+		})
+	}
+	if store == nil {
+		store = NewInMemoryExportScheduleStore()
+	}
+	svc := &ExportScheduleService{
+		store:      store,
+		runner:     runner,
+		linkTTL:    defaultExportScheduleLinkTTL,
+		permission: PermAdminExportsSchedule,
+		logger:     ensureLogger(nil),
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(svc)
+		}
+	}
+	return svc, nil
+}
+
+// Signer returns the download link signer, nil when links are not configured.
+func (s *ExportScheduleService) Signer() *ExportDownloadSigner {
+	if s == nil {
+		return nil
+	}
+	return s.signer
+}
+
+// Create saves a schedule owned by the current user.
+func (s *ExportScheduleService) Create(ctx context.Context, schedule ExportSchedule) (ExportSchedule, error) {
+	if err := s.requireManage(ctx); err != nil {
+		return ExportSchedule{}, err
+	}
+	owner := userIDFromContext(ctx)
+	if owner == "" {
+		return ExportSchedule{}, ErrForbidden
+	}
+	schedule, cadence, err := normalizeExportSchedule(schedule)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	if err := s.checkRecipients(schedule.Recipients); err != nil {
+		return ExportSchedule{}, err
+	}
+	now := s.now()
+	schedule.ID = uuid.NewString()
+	schedule.OwnerID = owner
+	schedule.TenantID = tenantIDFromContext(ctx)
+	schedule.OrgID = orgIDFromContext(ctx)
+	schedule.Locale = primitives.FirstNonEmptyRaw(schedule.Locale, localeFromContext(ctx))
+	if schedule.NextRunAt, err = nextExportScheduleRun(cadence, schedule.Cadence, now); err != nil {
+		return ExportSchedule{}, err
+	}
+	schedule.LastRun = nil
+	schedule.CreatedAt = now
+	schedule.UpdatedAt = now
+	saved, err := s.store.SaveSchedule(ctx, schedule)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	s.recordActivity(ctx, owner, "export.schedule.create", saved, nil)
+	return saved, nil
+}
+
+// Update replaces the editable fields of one of the current user's schedules
+// and reschedules it from now.
+func (s *ExportScheduleService) Update(ctx context.Context, id string, schedule ExportSchedule) (ExportSchedule, error) {
+	if err := s.requireManage(ctx); err != nil {
+		return ExportSchedule{}, err
+	}
+	existing, err := s.owned(ctx, id)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	schedule, cadence, err := normalizeExportSchedule(schedule)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	if err := s.checkRecipients(schedule.Recipients); err != nil {
+		return ExportSchedule{}, err
+	}
+	now := s.now()
+	schedule.ID = existing.ID
+	schedule.OwnerID = existing.OwnerID
+	schedule.TenantID = existing.TenantID
+	schedule.OrgID = existing.OrgID
+	schedule.Locale = primitives.FirstNonEmptyRaw(schedule.Locale, existing.Locale)
+	if schedule.NextRunAt, err = nextExportScheduleRun(cadence, schedule.Cadence, now); err != nil {
+		return ExportSchedule{}, err
+	}
+	schedule.LastRun = existing.LastRun
+	schedule.CreatedAt = existing.CreatedAt
+	schedule.UpdatedAt = now
+	saved, err := s.store.SaveSchedule(ctx, schedule)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	s.recordActivity(ctx, existing.OwnerID, "export.schedule.update", saved, nil)
+	return saved, nil
+}
+
+// Delete removes one of the current user's schedules.
+func (s *ExportScheduleService) Delete(ctx context.Context, id string) error {
+	if err := s.requireManage(ctx); err != nil {
+		return err
+	}
+	existing, err := s.owned(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.store.DeleteSchedule(ctx, existing.ID); err != nil {
+		return err
+	}
+	s.recordActivity(ctx, existing.OwnerID, "export.schedule.delete", existing, nil)
+	return nil
+}
+
+// Get returns one of the current user's schedules.
+func (s *ExportScheduleService) Get(ctx context.Context, id string) (ExportSchedule, error) {
+	if err := s.requireManage(ctx); err != nil {
+		return ExportSchedule{}, err
+	}
+	return s.owned(ctx, id)
+}
+
+// List returns the current user's schedules, oldest first.
+func (s *ExportScheduleService) List(ctx context.Context) ([]ExportSchedule, error) {
+	if err := s.requireManage(ctx); err != nil {
+		return nil, err
+	}
+	owner := userIDFromContext(ctx)
+	if owner == "" {
+		return nil, ErrForbidden
+	}
+	return s.store.ListSchedules(ctx, ExportScheduleFilter{OwnerID: owner})
+}
+
+// RunNow runs one of the current user's schedules immediately without
+// moving its next scheduled run.
+func (s *ExportScheduleService) RunNow(ctx context.Context, id string) (ExportScheduleRun, error) {
+	if err := s.requireManage(ctx); err != nil {
+		return ExportScheduleRun{}, err
+	}
+	schedule, err := s.owned(ctx, id)
+	if err != nil {
+		return ExportScheduleRun{}, err
+	}
+	return s.run(ctx, schedule, s.now()), nil
+}
+
+// RunDue runs every unpaused schedule due at now. Each schedule is claimed by
+// advancing its next run past now first, so occurrences missed while no
+// runner was active collapse into a single run.
+func (s *ExportScheduleService) RunDue(ctx context.Context, now time.Time) (ExportScheduleRunResult, error) {
+	result := ExportScheduleRunResult{}
+	if s == nil {
+		return result, serviceNotConfiguredDomainError("export schedule service", nil)
+	}
+	now = now.UTC()
+	due, err := s.store.ListSchedules(ctx, ExportScheduleFilter{DueBefore: now})
+	if err != nil {
+		return result, err
+	}
+	for _, schedule := range due {
+		next := time.Time{}
+		if cadence, err := parseExportCadence(schedule.Cadence, schedule.Timezone); err == nil {
+			next = cadence.next(now)
+		}
+		claimed, err := s.store.ClaimSchedule(ctx, schedule.ID, *schedule.NextRunAt, next)
+		if err != nil {
+			return result, err
+		}
+		if !claimed {
+			result.Skipped++
+			continue
+		}
+		switch s.run(ctx, schedule, now).Status {
+		case ExportScheduleRunSucceeded:
+			result.Succeeded++
+		case ExportScheduleRunDenied:
+			result.Denied++
+		default:
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// run generates the export as the owner, re-checking their identity and
+// permission, then delivers the link. The outcome is stored on the schedule.
+func (s *ExportScheduleService) run(ctx context.Context, schedule ExportSchedule, now time.Time) ExportScheduleRun {
+	run := ExportScheduleRun{Status: ExportScheduleRunFailed, RanAt: now}
+	if s.identity == nil {
+		run.Error = "export schedule identity resolver not configured"
+		return s.finish(ctx, schedule, run)
+	}
+	ownerCtx, err := s.ownerContext(ctx, schedule)
+	if err != nil {
+		run.Status = ExportScheduleRunDenied
+		run.Error = err.Error()
+		return s.finish(ctx, schedule, run)
+	}
+	artifact, err := s.runner.RunScheduledExport(ownerCtx, ScheduledExportRequest{
+		ScheduleID: schedule.ID,
+		Definition: schedule.Definition,
+		Variant:    schedule.Variant,
+		Format:     schedule.Format,
+		Columns:    slices.Clone(schedule.Columns),
+		Query:      primitives.CloneAnyMap(schedule.Query),
+		FileName:   fmt.Sprintf("%s-%s.%s", slugify(schedule.Name), now.Format("20060102-1504"), schedule.Format),
+	})
+	if err != nil {
+		run.Error = err.Error()
+		return s.finish(ctx, schedule, run)
+	}
+	run.ExportID = artifact.ExportID
+	run.FileName = artifact.FileName
+	run.Rows = artifact.Rows
+	if s.signer != nil && s.linkURL != nil {
+		run.ExpiresAt = now.Add(s.linkTTL)
+		token, err := s.signer.Sign(ExportDownloadClaims{
+			ScheduleID:  schedule.ID,
+			ExportID:    artifact.ExportID,
+			Key:         artifact.Key,
+			FileName:    artifact.FileName,
+			ContentType: artifact.ContentType,
+			ExpiresAt:   run.ExpiresAt,
+		})
+		if err != nil {
+			run.Error = err.Error()
+			return s.finish(ctx, schedule, run)
+		}
+		run.DownloadURL = s.linkURL(token)
+	}
+	if err := s.deliver(ownerCtx, schedule, artifact, run); err != nil {
+		run.Error = err.Error()
+		return s.finish(ctx, schedule, run)
+	}
+	run.Status = ExportScheduleRunSucceeded
+	return s.finish(ctx, schedule, run)
+}
+
+func (s *ExportScheduleService) deliver(ctx context.Context, schedule ExportSchedule, artifact ScheduledExportArtifact, run ExportScheduleRun) error {
+	var errs []error
+	if !schedule.SkipNotification && s.notifications != nil {
+		_, err := s.notifications.Add(ctx, Notification{
+			Title:     "Scheduled export ready",
+			Message:   fmt.Sprintf("%s is ready to download (%d rows).", schedule.Name, artifact.Rows),
+			Locale:    schedule.Locale,
+			ActionURL: run.DownloadURL,
+			UserID:    schedule.OwnerID,
+			Metadata: map[string]any{
+				"schedule_id": schedule.ID,
+				"export_id":   artifact.ExportID,
+				"file_name":   artifact.FileName,
+			},
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(schedule.Recipients) > 0 {
+		switch err := s.checkRecipients(schedule.Recipients); {
+		case err != nil:
+			errs = append(errs, err)
+		case s.mailer == nil:
+			errs = append(errs, serviceNotConfiguredDomainError("scheduled export mailer", nil))
+		case run.DownloadURL == "":
+			errs = append(errs, serviceNotConfiguredDomainError("export download links", nil))
+		default:
+			errs = append(errs, s.mailer.SendScheduledExport(ctx, ScheduledExportEmail{
+				To:          slices.Clone(schedule.Recipients),
+				Subject:     fmt.Sprintf("Scheduled export: %s", schedule.Name),
+				Schedule:    schedule,
+				Artifact:    artifact,
+				DownloadURL: run.DownloadURL,
+				ExpiresAt:   run.ExpiresAt,
+			}))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *ExportScheduleService) finish(ctx context.Context, schedule ExportSchedule, run ExportScheduleRun) ExportScheduleRun {
+	if err := s.store.RecordRun(ctx, schedule.ID, run); err != nil {
+		s.logger.Warn("export schedule run not recorded", "schedule_id", schedule.ID, "error", err)
+	}
+	s.recordActivity(ctx, schedule.OwnerID, "export.schedule.run", schedule, map[string]any{
+		"status":    run.Status,
+		"export_id": run.ExportID,
+		"rows":      run.Rows,
+		"error":     run.Error,
+	})
+	return run
+}
+
+// VerifyDownload checks a signed download token and confirms its schedule
+// still exists and its owner may still run it, so deleting a schedule or
+// revoking the owner's access invalidates links already sent.
+func (s *ExportScheduleService) VerifyDownload(ctx context.Context, token string) (ExportDownloadClaims, error) {
+	if s == nil {
+		return ExportDownloadClaims{}, serviceNotConfiguredDomainError("export schedule service", nil)
+	}
+	claims, err := s.signer.Verify(token, s.now())
+	if err != nil {
+		return ExportDownloadClaims{}, err
+	}
+	if strings.TrimSpace(claims.ScheduleID) == "" {
+		return ExportDownloadClaims{}, ErrForbidden
+	}
+	if s.identity == nil {
+		return ExportDownloadClaims{}, serviceNotConfiguredDomainError("export schedule identity resolver", nil)
+	}
+	schedule, err := s.store.GetSchedule(ctx, claims.ScheduleID)
+	if errors.Is(err, ErrNotFound) {
+		return ExportDownloadClaims{}, ErrForbidden
+	}
+	if err != nil {
+		return ExportDownloadClaims{}, err
+	}
+	if _, err := s.ownerContext(ctx, schedule); err != nil {
+		s.logger.Warn("export download denied", "schedule_id", schedule.ID, "error", err)
+		return ExportDownloadClaims{}, ErrForbidden
+	}
+	return claims, nil
+}
+
+// ownerContext resolves the schedule owner and re-checks their permission.
+func (s *ExportScheduleService) ownerContext(ctx context.Context, schedule ExportSchedule) (context.Context, error) {
+	ownerCtx, err := s.identity.ResolveExportScheduleIdentity(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePermissionWithAuthorizer(s.authorizer, ownerCtx, s.permission, exportScheduleResource); err != nil {
+		return nil, err
+	}
+	return ownerCtx, nil
+}
+
+func (s *ExportScheduleService) checkRecipients(recipients []string) error {
+	if len(s.recipientDomains) == 0 {
+		return nil
+	}
+	for _, recipient := range recipients {
+		domain := strings.ToLower(recipient[strings.LastIndex(recipient, "@")+1:])
+		if !slices.Contains(s.recipientDomains, domain) {
+			return validationDomainError("recipient domain not allowed", map[string]any{"field": "recipients", "recipient": recipient})
+		}
+	}
+	return nil
+}
+
+func (s *ExportScheduleService) owned(ctx context.Context, id string) (ExportSchedule, error) {
+	schedule, err := s.store.GetSchedule(ctx, id)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	if owner := userIDFromContext(ctx); owner == "" || owner != schedule.OwnerID {
+		return ExportSchedule{}, ErrNotFound
+	}
+	return schedule, nil
+}
+
+func (s *ExportScheduleService) requireManage(ctx context.Context) error {
+	if s == nil {
+		return serviceNotConfiguredDomainError("export schedule service", nil)
+	}
+	return requirePermissionWithAuthorizer(s.authorizer, ctx, s.permission, exportScheduleResource)
+}
+
+func (s *ExportScheduleService) recordActivity(ctx context.Context, actor, action string, schedule ExportSchedule, metadata map[string]any) {
+	if s.activity == nil {
+		return
+	}
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	metadata["definition"] = schedule.Definition
+	metadata["format"] = schedule.Format
+	metadata["cadence"] = schedule.Cadence
+	_ = s.activity.Record(ctx, ActivityEntry{ //nolint:errcheck // best-effort telemetry must not fail the primary operation.
+		Actor:    actor,
+		Action:   action,
+		Object:   "export_schedule:" + schedule.ID,
+		Metadata: metadata,
+	})
+}
+
+func nextExportScheduleRun(cadence exportCadence, expr string, now time.Time) (*time.Time, error) {
+	next := exportScheduleTimePtr(cadence.next(now))
+	if next == nil {
+		return nil, validationDomainError("cadence never matches a date", map[string]any{"field": "cadence", "cadence": expr})
+	}
+	return next, nil
+}
+
+func normalizeExportSchedule(schedule ExportSchedule) (ExportSchedule, exportCadence, error) {
+	schedule.Definition = strings.TrimSpace(schedule.Definition)
+	if schedule.Definition == "" {
+		return ExportSchedule{}, exportCadence{}, requiredFieldDomainError("definition", nil)
+	}
+	schedule.Format = strings.ToLower(strings.TrimSpace(schedule.Format))
+	if schedule.Format == "" {
+		return ExportSchedule{}, exportCadence{}, requiredFieldDomainError("format", nil)
+	}
+	schedule.Name = primitives.FirstNonEmptyRaw(strings.TrimSpace(schedule.Name), schedule.Definition)
+	schedule.Variant = strings.TrimSpace(schedule.Variant)
+	schedule.Cadence = strings.ToLower(strings.TrimSpace(schedule.Cadence))
+	schedule.Timezone = strings.TrimSpace(schedule.Timezone)
+	schedule.Locale = strings.TrimSpace(schedule.Locale)
+	cadence, err := parseExportCadence(schedule.Cadence, schedule.Timezone)
+	if err != nil {
+		return ExportSchedule{}, exportCadence{}, err
+	}
+	columns := make([]string, 0, len(schedule.Columns))
+	for _, column := range schedule.Columns {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	schedule.Columns = columns
+	if schedule.Recipients, err = normalizeExportScheduleRecipients(schedule.Recipients); err != nil {
+		return ExportSchedule{}, exportCadence{}, err
+	}
+	return schedule, cadence, nil
+}
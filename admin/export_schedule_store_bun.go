@@ -0,0 +1,312 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// BunExportScheduleStore persists export schedules in the export_schedules table.
+type BunExportScheduleStore struct {
+	db *bun.DB
+}
+
+var _ ExportScheduleStore = (*BunExportScheduleStore)(nil)
+
+func NewBunExportScheduleStore(db *bun.DB) *BunExportScheduleStore {
+	if db == nil {
+		return nil
+	}
+	return &BunExportScheduleStore{db: db}
+}
+
+type bunExportScheduleRecord struct {
+	bun.BaseModel `bun:"table:export_schedules,alias:es"`
+
+	ID               string     `bun:"id,pk" json:"id"`
+	Name             string     `bun:"name" json:"name"`
+	Definition       string     `bun:"definition" json:"definition"`
+	Variant          string     `bun:"variant" json:"variant"`
+	Format           string     `bun:"format" json:"format"`
+	ColumnsJSON      string     `bun:"columns_json" json:"columns_json"`
+	QueryJSON        string     `bun:"query_json" json:"query_json"`
+	Cadence          string     `bun:"cadence" json:"cadence"`
+	Timezone         string     `bun:"timezone" json:"timezone"`
+	RecipientsJSON   string     `bun:"recipients_json" json:"recipients_json"`
+	SkipNotification bool       `bun:"skip_notification" json:"skip_notification"`
+	Paused           bool       `bun:"paused" json:"paused"`
+	OwnerID          string     `bun:"owner_id" json:"owner_id"`
+	TenantID         string     `bun:"tenant_id" json:"tenant_id"`
+	OrgID            string     `bun:"org_id" json:"org_id"`
+	Locale           string     `bun:"locale" json:"locale"`
+	NextRunAt        *time.Time `bun:"next_run_at" json:"next_run_at"`
+	LastRunJSON      string     `bun:"last_run_json" json:"last_run_json"`
+	CreatedAt        time.Time  `bun:"created_at" json:"created_at"`
+	UpdatedAt        time.Time  `bun:"updated_at" json:"updated_at"`
+}
+
+func (s *BunExportScheduleStore) SaveSchedule(ctx context.Context, schedule ExportSchedule) (ExportSchedule, error) {
+	if err := s.ready(); err != nil {
+		return ExportSchedule{}, err
+	}
+	if strings.TrimSpace(schedule.ID) == "" {
+		return ExportSchedule{}, requiredFieldDomainError("id", nil)
+	}
+	record, err := bunExportScheduleRecordFromSchedule(schedule)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	_, err = s.db.NewInsert().
+		Model(&record).
+		On("CONFLICT (id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Set("definition = EXCLUDED.definition").
+		Set("variant = EXCLUDED.variant").
+		Set("format = EXCLUDED.format").
+		Set("columns_json = EXCLUDED.columns_json").
+		Set("query_json = EXCLUDED.query_json").
+		Set("cadence = EXCLUDED.cadence").
+		Set("timezone = EXCLUDED.timezone").
+		Set("recipients_json = EXCLUDED.recipients_json").
+		Set("skip_notification = EXCLUDED.skip_notification").
+		Set("paused = EXCLUDED.paused").
+		Set("next_run_at = EXCLUDED.next_run_at").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	return s.GetSchedule(ctx, record.ID)
+}
+
+func (s *BunExportScheduleStore) GetSchedule(ctx context.Context, id string) (ExportSchedule, error) {
+	if err := s.ready(); err != nil {
+		return ExportSchedule{}, err
+	}
+	record := bunExportScheduleRecord{}
+	err := s.db.NewSelect().
+		Model(&record).
+		Where("id = ?", strings.TrimSpace(id)).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ExportSchedule{}, ErrNotFound
+	}
+	if err != nil {
+		return ExportSchedule{}, err
+	}
+	return exportScheduleFromBunRecord(record)
+}
+
+func (s *BunExportScheduleStore) ListSchedules(ctx context.Context, filter ExportScheduleFilter) ([]ExportSchedule, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	records := []bunExportScheduleRecord{}
+	query := s.db.NewSelect().Model(&records)
+	if filter.OwnerID != "" {
+		query = query.Where("owner_id = ?", filter.OwnerID)
+	}
+	if !filter.DueBefore.IsZero() {
+		query = query.
+			Where("paused = ?", false).
+			Where("next_run_at IS NOT NULL").
+			Where("next_run_at <= ?", filter.DueBefore.UTC())
+	}
+	if err := query.OrderExpr("created_at ASC, id ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+	out := make([]ExportSchedule, 0, len(records))
+	for _, record := range records {
+		schedule, err := exportScheduleFromBunRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, schedule)
+	}
+	return out, nil
+}
+
+func (s *BunExportScheduleStore) DeleteSchedule(ctx context.Context, id string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	result, err := s.db.NewDelete().
+		Model((*bunExportScheduleRecord)(nil)).
+		Where("id = ?", strings.TrimSpace(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunExportScheduleStore) ClaimSchedule(ctx context.Context, id string, expected, next time.Time) (bool, error) {
+	if err := s.ready(); err != nil {
+		return false, err
+	}
+	result, err := s.db.NewUpdate().
+		Model((*bunExportScheduleRecord)(nil)).
+		Set("next_run_at = ?", exportScheduleTimePtr(next)).
+		Where("id = ?", strings.TrimSpace(id)).
+		Where("next_run_at = ?", expected.UTC()).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (s *BunExportScheduleStore) RecordRun(ctx context.Context, id string, run ExportScheduleRun) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	payload, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.NewUpdate().
+		Model((*bunExportScheduleRecord)(nil)).
+		Set("last_run_json = ?", string(payload)).
+		Where("id = ?", strings.TrimSpace(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunExportScheduleStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("export schedule store", map[string]any{
+			"component": "export_schedule_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunExportScheduleRecordFromSchedule(schedule ExportSchedule) (bunExportScheduleRecord, error) {
+	columns := schedule.Columns
+	if columns == nil {
+		columns = []string{}
+	}
+	columnsJSON, err := json.Marshal(columns)
+	if err != nil {
+		return bunExportScheduleRecord{}, err
+	}
+	query := schedule.Query
+	if query == nil {
+		query = map[string]any{}
+	}
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return bunExportScheduleRecord{}, err
+	}
+	recipients := schedule.Recipients
+	if recipients == nil {
+		recipients = []string{}
+	}
+	recipientsJSON, err := json.Marshal(recipients)
+	if err != nil {
+		return bunExportScheduleRecord{}, err
+	}
+	record := bunExportScheduleRecord{
+		ID:               strings.TrimSpace(schedule.ID),
+		Name:             schedule.Name,
+		Definition:       schedule.Definition,
+		Variant:          schedule.Variant,
+		Format:           schedule.Format,
+		ColumnsJSON:      string(columnsJSON),
+		QueryJSON:        string(queryJSON),
+		Cadence:          schedule.Cadence,
+		Timezone:         schedule.Timezone,
+		RecipientsJSON:   string(recipientsJSON),
+		SkipNotification: schedule.SkipNotification,
+		Paused:           schedule.Paused,
+		OwnerID:          schedule.OwnerID,
+		TenantID:         schedule.TenantID,
+		OrgID:            schedule.OrgID,
+		Locale:           schedule.Locale,
+		CreatedAt:        schedule.CreatedAt.UTC(),
+		UpdatedAt:        schedule.UpdatedAt.UTC(),
+	}
+	if schedule.NextRunAt != nil {
+		record.NextRunAt = exportScheduleTimePtr(schedule.NextRunAt.UTC())
+	}
+	if schedule.LastRun != nil {
+		lastRunJSON, err := json.Marshal(schedule.LastRun)
+		if err != nil {
+			return bunExportScheduleRecord{}, err
+		}
+		record.LastRunJSON = string(lastRunJSON)
+	}
+	return record, nil
+}
+
+func exportScheduleFromBunRecord(record bunExportScheduleRecord) (ExportSchedule, error) {
+	schedule := ExportSchedule{
+		ID:               record.ID,
+		Name:             record.Name,
+		Definition:       record.Definition,
+		Variant:          record.Variant,
+		Format:           record.Format,
+		Cadence:          record.Cadence,
+		Timezone:         record.Timezone,
+		SkipNotification: record.SkipNotification,
+		Paused:           record.Paused,
+		OwnerID:          record.OwnerID,
+		TenantID:         record.TenantID,
+		OrgID:            record.OrgID,
+		Locale:           record.Locale,
+		CreatedAt:        record.CreatedAt,
+		UpdatedAt:        record.UpdatedAt,
+	}
+	if record.NextRunAt != nil {
+		schedule.NextRunAt = exportScheduleTimePtr(record.NextRunAt.UTC())
+	}
+	if err := json.Unmarshal([]byte(record.ColumnsJSON), &schedule.Columns); err != nil {
+		return ExportSchedule{}, err
+	}
+	if err := json.Unmarshal([]byte(record.QueryJSON), &schedule.Query); err != nil {
+		return ExportSchedule{}, err
+	}
+	if err := json.Unmarshal([]byte(record.RecipientsJSON), &schedule.Recipients); err != nil {
+		return ExportSchedule{}, err
+	}
+	if len(schedule.Columns) == 0 {
+		schedule.Columns = nil
+	}
+	if len(schedule.Query) == 0 {
+		schedule.Query = nil
+	}
+	if len(schedule.Recipients) == 0 {
+		schedule.Recipients = nil
+	}
+	if record.LastRunJSON != "" {
+		run := ExportScheduleRun{}
+		if err := json.Unmarshal([]byte(record.LastRunJSON), &run); err != nil {
+			return ExportSchedule{}, err
+		}
+		schedule.LastRun = &run
+	}
+	return schedule, nil
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunExportScheduleStoreSavesClaimsAndRecordsRuns(t *testing.T) {
+	ctx := context.Background()
+	db := setupMigratedSQLite(t, admindata.ExportScheduleMigrations(), "0024_export_schedules.up.sql")
+	store := NewBunExportScheduleStore(db)
+	created := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	next := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+
+	schedule, err := store.SaveSchedule(ctx, ExportSchedule{
+		ID:         "sched-1",
+		Name:       "Daily orders",
+		Definition: "orders",
+		Format:     "csv",
+		Columns:    []string{"id", "total"},
+		Query:      map[string]any{"status": "paid"},
+		Cadence:    "daily",
+		Timezone:   "Europe/Madrid",
+		Recipients: []string{"finance@example.com"},
+		OwnerID:    "finance",
+		TenantID:   "tenant-1",
+		OrgID:      "org-1",
+		NextRunAt:  &next,
+		CreatedAt:  created,
+		UpdatedAt:  created,
+	})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if schedule.Query["status"] != "paid" || len(schedule.Columns) != 2 || schedule.Recipients[0] != "finance@example.com" || schedule.TenantID != "tenant-1" || !schedule.NextRunAt.Equal(next) {
+		t.Fatalf("expected schedule round-tripped, got %+v", schedule)
+	}
+	if _, err := store.SaveSchedule(ctx, ExportSchedule{ID: "sched-2", Definition: "users", Format: "json", Cadence: "hourly", OwnerID: "sales", Paused: true, NextRunAt: &next, CreatedAt: created.Add(time.Minute)}); err != nil {
+		t.Fatalf("save second: %v", err)
+	}
+	if _, err := store.SaveSchedule(ctx, ExportSchedule{}); err == nil {
+		t.Fatalf("expected a schedule without an id to be rejected")
+	}
+	if _, err := store.GetSchedule(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	schedule.Name = "Orders"
+	schedule.OwnerID = "someone-else"
+	if updated, err := store.SaveSchedule(ctx, schedule); err != nil || updated.Name != "Orders" || updated.OwnerID != "finance" {
+		t.Fatalf("expected update to keep the owner, got %+v (%v)", updated, err)
+	}
+	if owned, _ := store.ListSchedules(ctx, ExportScheduleFilter{OwnerID: "finance"}); len(owned) != 1 || owned[0].ID != "sched-1" {
+		t.Fatalf("expected schedules filtered by owner, got %+v", owned)
+	}
+	if due, _ := store.ListSchedules(ctx, ExportScheduleFilter{DueBefore: next.Add(-time.Minute)}); len(due) != 0 {
+		t.Fatalf("expected nothing due yet, got %+v", due)
+	}
+	if due, _ := store.ListSchedules(ctx, ExportScheduleFilter{DueBefore: next}); len(due) != 1 || due[0].ID != "sched-1" {
+		t.Fatalf("expected only the unpaused schedule due, got %+v", due)
+	}
+
+	following := next.Add(24 * time.Hour)
+	if claimed, err := store.ClaimSchedule(ctx, "sched-1", next, following); err != nil || !claimed {
+		t.Fatalf("expected claim, got %v (%v)", claimed, err)
+	}
+	if claimed, _ := store.ClaimSchedule(ctx, "sched-1", next, following); claimed {
+		t.Fatalf("expected a claimed occurrence not to be claimed twice")
+	}
+
+	run := ExportScheduleRun{Status: ExportScheduleRunSucceeded, ExportID: "exp-1", Rows: 42, RanAt: next}
+	if err := store.RecordRun(ctx, "sched-1", run); err != nil {
+		t.Fatalf("record run: %v", err)
+	}
+	if err := store.RecordRun(ctx, "missing", run); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown schedule, got %v", err)
+	}
+	loaded, _ := store.GetSchedule(ctx, "sched-1")
+	if loaded.LastRun == nil || loaded.LastRun.ExportID != "exp-1" || loaded.LastRun.Rows != 42 || !loaded.NextRunAt.Equal(following) {
+		t.Fatalf("expected run and next occurrence stored, got %+v", loaded)
+	}
+
+	if err := store.DeleteSchedule(ctx, "sched-1"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.DeleteSchedule(ctx, "sched-1"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
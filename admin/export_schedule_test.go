@@ -0,0 +1,527 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeScheduledExportRunner struct {
+	mu       sync.Mutex
+	requests []ScheduledExportRequest
+	owners   []string
+	err      error
+}
+
+func (r *fakeScheduledExportRunner) RunScheduledExport(ctx context.Context, req ScheduledExportRequest) (ScheduledExportArtifact, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	r.owners = append(r.owners, userIDFromContext(ctx))
+	if r.err != nil {
+		return ScheduledExportArtifact{}, r.err
+	}
+	return ScheduledExportArtifact{
+		ExportID:    "exp-1",
+		Key:         "exports/exp-1.csv",
+		FileName:    req.FileName,
+		ContentType: "text/csv",
+		Rows:        42,
+	}, nil
+}
+
+type fakeScheduledExportMailer struct {
+	emails []ScheduledExportEmail
+}
+
+func (m *fakeScheduledExportMailer) SendScheduledExport(_ context.Context, email ScheduledExportEmail) error {
+	m.emails = append(m.emails, email)
+	return nil
+}
+
+// exportScheduleOwnerAuthorizer grants the schedule permission per user.
+type exportScheduleOwnerAuthorizer struct {
+	mu      sync.Mutex
+	allowed map[string]bool
+}
+
+func (a *exportScheduleOwnerAuthorizer) Can(ctx context.Context, _ string, _ string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.allowed[userIDFromContext(ctx)]
+}
+
+func (a *exportScheduleOwnerAuthorizer) set(user string, allowed bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.allowed[user] = allowed
+}
+
+// claimlessExportScheduleStore simulates another runner claiming every schedule first.
+type claimlessExportScheduleStore struct {
+	*InMemoryExportScheduleStore
+}
+
+func (claimlessExportScheduleStore) ClaimSchedule(context.Context, string, time.Time, time.Time) (bool, error) {
+	return false, nil
+}
+
+func exportScheduleUserContext(user string) context.Context {
+	return context.WithValue(context.Background(), userIDContextKey, user)
+}
+
+func exportScheduleTestIdentity(disabled ...string) ExportScheduleIdentityResolver {
+	return ExportScheduleIdentityFunc(func(ctx context.Context, schedule ExportSchedule) (context.Context, error) {
+		for _, user := range disabled {
+			if user == schedule.OwnerID {
+				return nil, errors.New("user suspended")
+			}
+		}
+		return context.WithValue(ctx, userIDContextKey, schedule.OwnerID), nil
+	})
+}
+
+func TestExportCadenceNext(t *testing.T) {
+	cases := []struct {
+		name     string
+		cadence  string
+		timezone string
+		after    time.Time
+		want     time.Time
+	}{
+		{"daily", "daily", "", time.Date(2026, 3, 10, 15, 4, 0, 0, time.UTC), time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{"weekly descriptor", "@weekly", "", time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"monthly", "monthly", "", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"strictly after", "0 0 * * *", "", time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)},
+		{"weekday names", "30 9 * * MON-FRI", "", time.Date(2026, 3, 13, 10, 0, 0, 0, time.UTC), time.Date(2026, 3, 16, 9, 30, 0, 0, time.UTC)},
+		{"steps and ranges", "*/15 8-9 * * *", "", time.Date(2026, 3, 10, 9, 50, 0, 0, time.UTC), time.Date(2026, 3, 11, 8, 0, 0, 0, time.UTC)},
+		{"day of month or weekday", "0 12 1 * 7", "", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)},
+		{"month names", "0 6 1 jan,jul *", "", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 7, 1, 6, 0, 0, 0, time.UTC)},
+		{"timezone", "0 9 * * 1", "America/New_York", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 16, 13, 0, 0, 0, time.UTC)},
+		{"never", "0 0 31 2 *", "", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cadence, err := parseExportCadence(tc.cadence, tc.timezone)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if got := cadence.next(tc.after); !got.Equal(tc.want) {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseExportCadenceRejectsInvalidExpressions(t *testing.T) {
+	for _, tc := range []struct{ cadence, timezone string }{
+		{"fortnightly", ""},
+		{"* * *", ""},
+		{"60 * * * *", ""},
+		{"0 0 * * xyz", ""},
+		{"*/0 * * * *", ""},
+		{"0 9-5 * * *", ""},
+		{"daily", "Mars/Olympus"},
+	} {
+		if _, err := parseExportCadence(tc.cadence, tc.timezone); err == nil {
+			t.Fatalf("expected %q (%q) to be rejected", tc.cadence, tc.timezone)
+		}
+	}
+}
+
+func TestExportDownloadSignerRoundTrip(t *testing.T) {
+	if _, err := NewExportDownloadSigner([]byte("short")); err == nil {
+		t.Fatalf("expected short secret to be rejected")
+	}
+	signer, err := NewExportDownloadSigner([]byte(strings.Repeat("s", 32)))
+	if err != nil {
+		t.Fatalf("signer: %v", err)
+	}
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	token, err := signer.Sign(ExportDownloadClaims{
+		ExportID:  "exp-1",
+		Key:       "exports/exp-1.csv",
+		FileName:  "orders.csv",
+		ExpiresAt: now.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	claims, err := signer.Verify(token, now)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if claims.Key != "exports/exp-1.csv" || claims.FileName != "orders.csv" {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	if _, err := signer.Verify(token, now.Add(time.Hour)); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected expired token to be forbidden, got %v", err)
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+	tampered := payload[:len(payload)-1] + "A" + "." + signature
+	if tampered == token {
+		tampered = payload[:len(payload)-1] + "B" + "." + signature
+	}
+	if _, err := signer.Verify(tampered, now); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected tampered token to be forbidden, got %v", err)
+	}
+	other, _ := NewExportDownloadSigner([]byte(strings.Repeat("o", 32)))
+	if _, err := other.Verify(token, now); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected token from another secret to be forbidden, got %v", err)
+	}
+}
+
+func TestExportScheduleServiceScopesSchedulesToOwner(t *testing.T) {
+	authz := &exportScheduleOwnerAuthorizer{allowed: map[string]bool{"finance": true, "sales": true}}
+	svc, err := NewExportScheduleService(nil, &fakeScheduledExportRunner{},
+		WithExportScheduleAuthorizer(authz, ""),
+	)
+	if err != nil {
+		t.Fatalf("service: %v", err)
+	}
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	finance := exportScheduleUserContext("finance")
+	created, err := svc.Create(finance, ExportSchedule{
+		Definition: "orders",
+		Format:     "CSV",
+		Columns:    []string{" id ", "", "total"},
+		Cadence:    "weekly",
+		Recipients: []string{"Finance <Finance@Example.com>", "finance@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.OwnerID != "finance" || created.Name != "orders" || created.Format != "csv" {
+		t.Fatalf("unexpected schedule: %+v", created)
+	}
+	if len(created.Columns) != 2 || len(created.Recipients) != 1 || created.Recipients[0] != "finance@example.com" {
+		t.Fatalf("expected normalized columns and recipients, got %+v", created)
+	}
+	if created.NextRunAt == nil || !created.NextRunAt.Equal(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected next run: %v", created.NextRunAt)
+	}
+
+	sales := exportScheduleUserContext("sales")
+	if list, err := svc.List(sales); err != nil || len(list) != 0 {
+		t.Fatalf("expected no schedules for another user, got %v %v", list, err)
+	}
+	if _, err := svc.Get(sales, created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for another user, got %v", err)
+	}
+	if err := svc.Delete(sales, created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected delete by another user to fail, got %v", err)
+	}
+
+	updated, err := svc.Update(finance, created.ID, ExportSchedule{
+		Name:       "Weekly orders",
+		Definition: "orders",
+		Format:     "xlsx",
+		Cadence:    "0 7 * * mon",
+		Timezone:   "Europe/Madrid",
+	})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.OwnerID != "finance" || !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("expected ownership to be preserved, got %+v", updated)
+	}
+	if updated.NextRunAt == nil || !updated.NextRunAt.Equal(time.Date(2026, 3, 16, 6, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected next run after update: %v", updated.NextRunAt)
+	}
+
+	if _, err := svc.Create(finance, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "0 0 31 2 *"}); err == nil {
+		t.Fatalf("expected a cadence that never matches to be rejected")
+	}
+	if _, err := svc.Create(finance, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", Recipients: []string{"not-an-email"}}); err == nil {
+		t.Fatalf("expected invalid recipient to be rejected")
+	}
+	authz.set("finance", false)
+	if _, err := svc.List(finance); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected forbidden without the schedule permission, got %v", err)
+	}
+}
+
+func TestExportScheduleRunDueDeliversSignedLinks(t *testing.T) {
+	signer, err := NewExportDownloadSigner([]byte(strings.Repeat("k", 32)))
+	if err != nil {
+		t.Fatalf("signer: %v", err)
+	}
+	runner := &fakeScheduledExportRunner{}
+	mailer := &fakeScheduledExportMailer{}
+	notifications := NewInMemoryNotificationService()
+	sink := &recordingSink{}
+	svc, err := NewExportScheduleService(nil, runner,
+		WithExportScheduleIdentity(exportScheduleTestIdentity()),
+		WithExportScheduleLinks(signer, func(token string) string { return "https://admin.example.com/exports/scheduled/" + token }),
+		WithExportScheduleLinkTTL(48*time.Hour),
+		WithExportScheduleNotifications(notifications),
+		WithExportScheduleMailer(mailer),
+		WithExportScheduleActivitySink(sink),
+	)
+	if err != nil {
+		t.Fatalf("service: %v", err)
+	}
+	created := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return created }
+	schedule, err := svc.Create(exportScheduleUserContext("finance"), ExportSchedule{
+		Name:       "Weekly Orders",
+		Definition: "orders",
+		Variant:    "finance",
+		Format:     "csv",
+		Query:      map[string]any{"status": "paid"},
+		Cadence:    "weekly",
+		Recipients: []string{"finance@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if result, err := svc.RunDue(context.Background(), created.Add(time.Hour)); err != nil || result != (ExportScheduleRunResult{}) {
+		t.Fatalf("expected nothing due before the first run, got %+v %v", result, err)
+	}
+
+	due := schedule.NextRunAt.Add(90 * time.Minute)
+	result, err := svc.RunDue(context.Background(), due)
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	if result.Succeeded != 1 {
+		t.Fatalf("expected one successful run, got %+v", result)
+	}
+	if len(runner.requests) != 1 || runner.owners[0] != "finance" {
+		t.Fatalf("expected export to run as the owner, got %+v %v", runner.requests, runner.owners)
+	}
+	req := runner.requests[0]
+	if req.Definition != "orders" || req.Variant != "finance" || req.Query["status"] != "paid" || req.FileName != "weekly-orders-20260315-0130.csv" {
+		t.Fatalf("unexpected export request: %+v", req)
+	}
+
+	if len(mailer.emails) != 1 || mailer.emails[0].To[0] != "finance@example.com" {
+		t.Fatalf("expected one email to the recipients, got %+v", mailer.emails)
+	}
+	email := mailer.emails[0]
+	token, ok := strings.CutPrefix(email.DownloadURL, "https://admin.example.com/exports/scheduled/")
+	if !ok {
+		t.Fatalf("unexpected download url %q", email.DownloadURL)
+	}
+	claims, err := signer.Verify(token, due)
+	if err != nil {
+		t.Fatalf("verify link: %v", err)
+	}
+	if claims.Key != "exports/exp-1.csv" || claims.ScheduleID != schedule.ID || !claims.ExpiresAt.Equal(due.Add(48*time.Hour)) {
+		t.Fatalf("unexpected link claims: %+v", claims)
+	}
+
+	inbox, _ := notifications.List(context.Background())
+	if len(inbox) != 1 || inbox[0].UserID != "finance" || inbox[0].ActionURL != email.DownloadURL {
+		t.Fatalf("expected owner notification with the link, got %+v", inbox)
+	}
+
+	stored, err := svc.Get(exportScheduleUserContext("finance"), schedule.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if stored.LastRun == nil || stored.LastRun.Status != ExportScheduleRunSucceeded || stored.LastRun.Rows != 42 {
+		t.Fatalf("expected last run to be recorded, got %+v", stored.LastRun)
+	}
+	if stored.NextRunAt == nil || !stored.NextRunAt.Equal(time.Date(2026, 3, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected next run a week later, got %v", stored.NextRunAt)
+	}
+	if result, _ := svc.RunDue(context.Background(), due); result.Succeeded != 0 {
+		t.Fatalf("expected a claimed occurrence not to run twice, got %+v", result)
+	}
+	if len(sink.entries) == 0 || sink.entries[len(sink.entries)-1].Action != "export.schedule.run" {
+		t.Fatalf("expected run activity, got %+v", sink.entries)
+	}
+}
+
+func TestExportScheduleRunDueRechecksOwnerAccess(t *testing.T) {
+	authz := &exportScheduleOwnerAuthorizer{allowed: map[string]bool{"finance": true, "former": true}}
+	runner := &fakeScheduledExportRunner{}
+	store := NewInMemoryExportScheduleStore()
+	svc, err := NewExportScheduleService(store, runner,
+		WithExportScheduleIdentity(exportScheduleTestIdentity("former")),
+		WithExportScheduleAuthorizer(authz, ""),
+	)
+	if err != nil {
+		t.Fatalf("service: %v", err)
+	}
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	for _, owner := range []string{"finance", "former"} {
+		if _, err := svc.Create(exportScheduleUserContext(owner), ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", SkipNotification: true}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	authz.set("finance", false)
+
+	result, err := svc.RunDue(context.Background(), now.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	if result.Denied != 2 || len(runner.requests) != 0 {
+		t.Fatalf("expected both runs to be denied without exporting, got %+v %d", result, len(runner.requests))
+	}
+	schedules, _ := store.ListSchedules(context.Background(), ExportScheduleFilter{})
+	for _, schedule := range schedules {
+		if schedule.LastRun == nil || schedule.LastRun.Status != ExportScheduleRunDenied {
+			t.Fatalf("expected denied run to be recorded, got %+v", schedule.LastRun)
+		}
+	}
+}
+
+func TestExportScheduleRunDueSkipsClaimedSchedulesAndRecordsFailures(t *testing.T) {
+	store := NewInMemoryExportScheduleStore()
+	runner := &fakeScheduledExportRunner{err: errors.New("definition not found")}
+	svc, _ := NewExportScheduleService(store, runner, WithExportScheduleIdentity(exportScheduleTestIdentity()))
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	schedule, err := svc.Create(exportScheduleUserContext("finance"), ExportSchedule{
+		Definition: "orders",
+		Format:     "csv",
+		Cadence:    "hourly",
+		Recipients: []string{"finance@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	claimless, _ := NewExportScheduleService(claimlessExportScheduleStore{store}, runner, WithExportScheduleIdentity(exportScheduleTestIdentity()))
+	if result, err := claimless.RunDue(context.Background(), now.Add(time.Hour)); err != nil || result.Skipped != 1 || len(runner.requests) != 0 {
+		t.Fatalf("expected schedule claimed elsewhere to be skipped, got %+v %v", result, err)
+	}
+
+	result, err := svc.RunDue(context.Background(), now.Add(time.Hour))
+	if err != nil || result.Failed != 1 {
+		t.Fatalf("expected failed run, got %+v %v", result, err)
+	}
+	stored, _ := store.GetSchedule(context.Background(), schedule.ID)
+	if stored.LastRun == nil || stored.LastRun.Status != ExportScheduleRunFailed || stored.LastRun.Error != "definition not found" {
+		t.Fatalf("expected failure to be recorded, got %+v", stored.LastRun)
+	}
+
+	runner.err = nil
+	run, err := svc.RunNow(exportScheduleUserContext("finance"), schedule.ID)
+	if err != nil {
+		t.Fatalf("run now: %v", err)
+	}
+	if run.Status != ExportScheduleRunFailed || !strings.Contains(run.Error, "mailer") {
+		t.Fatalf("expected email delivery without a mailer to fail, got %+v", run)
+	}
+}
+
+func TestExportScheduleCommandRunsDueSchedules(t *testing.T) {
+	runner := &fakeScheduledExportRunner{}
+	svc, _ := NewExportScheduleService(nil, runner, WithExportScheduleIdentity(exportScheduleTestIdentity()))
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	if _, err := svc.Create(exportScheduleUserContext("finance"), ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cmd := &ExportScheduleCommand{Service: svc, Now: func() time.Time { return now.Add(24 * time.Hour) }}
+	result := ExportScheduleRunResult{}
+	if err := cmd.Execute(context.Background(), ExportScheduleInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Succeeded != 1 || len(runner.requests) != 1 {
+		t.Fatalf("expected one run, got %+v", result)
+	}
+	if got := cmd.CronOptions().Expression; got != exportScheduleDefaultSchedule {
+		t.Fatalf("unexpected default schedule %q", got)
+	}
+}
+
+func TestExportScheduleVerifyDownloadRechecksScheduleAndOwner(t *testing.T) {
+	signer, _ := NewExportDownloadSigner([]byte(strings.Repeat("k", 32)))
+	authz := &exportScheduleOwnerAuthorizer{allowed: map[string]bool{"finance": true}}
+	svc, err := NewExportScheduleService(nil, &fakeScheduledExportRunner{},
+		WithExportScheduleIdentity(exportScheduleTestIdentity()),
+		WithExportScheduleAuthorizer(authz, ""),
+		WithExportScheduleLinks(signer, func(token string) string { return token }),
+	)
+	if err != nil {
+		t.Fatalf("service: %v", err)
+	}
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	finance := exportScheduleUserContext("finance")
+	schedule, err := svc.Create(finance, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", SkipNotification: true})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	run, err := svc.RunNow(finance, schedule.ID)
+	if err != nil || run.Status != ExportScheduleRunSucceeded {
+		t.Fatalf("expected a successful run, got %+v (%v)", run, err)
+	}
+
+	claims, err := svc.VerifyDownload(context.Background(), run.DownloadURL)
+	if err != nil || claims.ScheduleID != schedule.ID || claims.Key != "exports/exp-1.csv" {
+		t.Fatalf("expected link to verify, got %+v (%v)", claims, err)
+	}
+	orphan, _ := signer.Sign(ExportDownloadClaims{Key: "exports/exp-1.csv", ExpiresAt: now.Add(time.Hour)})
+	if _, err := svc.VerifyDownload(context.Background(), orphan); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected a link without a schedule to be rejected, got %v", err)
+	}
+
+	authz.set("finance", false)
+	if _, err := svc.VerifyDownload(context.Background(), run.DownloadURL); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected a revoked owner's link to be rejected, got %v", err)
+	}
+	authz.set("finance", true)
+	if err := svc.Delete(finance, schedule.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := svc.VerifyDownload(context.Background(), run.DownloadURL); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected a deleted schedule's link to be rejected, got %v", err)
+	}
+}
+
+func TestExportScheduleRecipientDomainsRestrictRecipients(t *testing.T) {
+	signer, _ := NewExportDownloadSigner([]byte(strings.Repeat("k", 32)))
+	store := NewInMemoryExportScheduleStore()
+	mailer := &fakeScheduledExportMailer{}
+	svc, err := NewExportScheduleService(store, &fakeScheduledExportRunner{},
+		WithExportScheduleIdentity(exportScheduleTestIdentity()),
+		WithExportScheduleLinks(signer, func(token string) string { return token }),
+		WithExportScheduleMailer(mailer),
+		WithExportScheduleRecipientDomains(" @Example.com ", "partner.example"),
+	)
+	if err != nil {
+		t.Fatalf("service: %v", err)
+	}
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	finance := exportScheduleUserContext("finance")
+
+	if _, err := svc.Create(finance, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", Recipients: []string{"finance@example.com", "leak@evil.example"}}); err == nil {
+		t.Fatalf("expected a recipient outside the allowlist to be rejected")
+	}
+	if _, err := svc.Create(finance, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", Recipients: []string{"finance@sub.example.com"}}); err == nil {
+		t.Fatalf("expected subdomains not to match an allowed domain")
+	}
+	schedule, err := svc.Create(finance, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", Recipients: []string{"Finance@Example.com", "ops@partner.example"}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := svc.Update(finance, schedule.ID, ExportSchedule{Definition: "orders", Format: "csv", Cadence: "daily", Recipients: []string{"leak@evil.example"}}); err == nil {
+		t.Fatalf("expected update to enforce the allowlist")
+	}
+
+	// A recipient saved before the allowlist tightened is not emailed.
+	schedule.Recipients = append(schedule.Recipients, "leak@evil.example")
+	if _, err := store.SaveSchedule(context.Background(), schedule); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	run, err := svc.RunNow(finance, schedule.ID)
+	if err != nil {
+		t.Fatalf("run now: %v", err)
+	}
+	if run.Status != ExportScheduleRunFailed || !strings.Contains(run.Error, "recipient domain not allowed") || len(mailer.emails) != 0 {
+		t.Fatalf("expected delivery to be refused, got %+v (%d emails)", run, len(mailer.emails))
+	}
+}
@@ -51,6 +51,8 @@ const (
 	QueryPerPage        = "per_page"
 	QueryLimit          = "limit"
 	QueryOffset         = "offset"
+	QueryCursor         = "cursor"
+	QueryAfter          = "after"
 	QueryFields         = "fields"
 	QuerySearch         = "search"
 	QueryQ              = "q"
@@ -59,6 +59,8 @@ type stubCtx struct {
 	families      TranslationFamiliesBinding
 	exchange      TranslationExchangeBinding
 	queue         TranslationQueueBinding
+	glossary      TranslationGlossaryBinding
+	memory        TranslationMemoryBinding
 	registry      SchemaRegistryBinding
 	overrides     FeatureOverridesBinding
 	notifications NotificationsBinding
@@ -123,6 +125,12 @@ func (s *stubCtx) BootTranslationExchange() TranslationExchangeBinding {
 func (s *stubCtx) BootTranslationQueue() TranslationQueueBinding {
 	return s.queue
 }
+func (s *stubCtx) BootTranslationGlossary() TranslationGlossaryBinding {
+	return s.glossary
+}
+func (s *stubCtx) BootTranslationMemory() TranslationMemoryBinding {
+	return s.memory
+}
 func (s *stubCtx) BootNotifications() NotificationsBinding {
 	return s.notifications
 }
@@ -250,6 +258,7 @@ func newTestURLManager(basePath string) *urlkit.RouteManager {
 							"translations.my_work":                        "/translations/my-work",
 							"translations.queue":                          "/translations/queue",
 							"translations.sync.resources.id":              "/translations/sync/resources/:kind/:id",
+							"translations.sync.resources.events":          "/translations/sync/resources/:kind/:id/events",
 							"translations.options.entity_types":           "/translations/options/entity-types",
 							"translations.options.source_records":         "/translations/options/source-records",
 							"translations.options.locales":                "/translations/options/locales",
@@ -259,6 +268,10 @@ func newTestURLManager(basePath string) *urlkit.RouteManager {
 							"translations.jobs.id":                        "/translations/exchange/jobs/:job_id",
 							"translations.import.validate":                "/translations/exchange/import/validate",
 							"translations.import.apply":                   "/translations/exchange/import/apply",
+							"translations.glossary.export":                "/translations/glossary/export",
+							"translations.glossary.import":                "/translations/glossary/import",
+							"translations.memory.export":                  "/translations/memory/export",
+							"translations.memory.import":                  "/translations/memory/import",
 							"schemas":                                     "/schemas",
 							"schemas.resource":                            "/schemas/:resource",
 							"panel":                                       "/panels/:panel",
@@ -268,6 +281,13 @@ func newTestURLManager(basePath string) *urlkit.RouteManager {
 							"panel.bulk":                                  "/panels/:panel/bulk/:action",
 							"panel.preview":                               "/panels/:panel/:id/preview",
 							"panel.subresource":                           "/panels/:panel/:id/:subresource/:value",
+							"panel.trash":                                 "/trash/:panel",
+							"panel.trash.id":                              "/trash/:panel/:id",
+							"panel.trash.restore":                         "/trash/:panel/:id/restore",
+							"panel.import":                                "/import/:panel",
+							"panel.import.template":                       "/import/:panel/template",
+							"panel.import.preview":                        "/import/:panel/preview",
+							"panel.import.job":                            "/import/:panel/jobs/:id",
 						},
 					},
 				},
@@ -642,6 +662,147 @@ func TestPanelStepRegistersHandlers(t *testing.T) {
 	require.Equal(t, "en", binding.lastLocale)
 }
 
+type stubTrashPanelBinding struct {
+	*stubPanelBinding
+	enabled    bool
+	restoredID string
+	purgedID   string
+}
+
+func (s *stubTrashPanelBinding) TrashEnabled() bool { return s.enabled }
+func (s *stubTrashPanelBinding) Trash(router.Context, string, ListOptions) ([]map[string]any, int, error) {
+	return []map[string]any{{"id": "1", "deleted_by": "editor"}}, 1, nil
+}
+func (s *stubTrashPanelBinding) Restore(_ router.Context, _ string, id string) (map[string]any, error) {
+	s.restoredID = id
+	return map[string]any{"id": id}, nil
+}
+func (s *stubTrashPanelBinding) Purge(_ router.Context, _ string, id string) error {
+	s.purgedID = id
+	return nil
+}
+
+func TestPanelStepRegistersTrashRoutesForSoftDeletePanels(t *testing.T) {
+	rr := &recordRouter{}
+	resp := &stubResponder{}
+	binding := &stubTrashPanelBinding{stubPanelBinding: &stubPanelBinding{name: "articles"}, enabled: true}
+	ctx := &stubCtx{
+		router:     rr,
+		responder:  resp,
+		basePath:   "/admin",
+		defaultLoc: "en",
+		panels:     []PanelBinding{binding},
+	}
+
+	require.NoError(t, PanelStep(ctx))
+	require.Len(t, rr.calls, 13)
+	params := map[string]string{"panel": "articles"}
+	calls := map[string]int{}
+	for i, call := range rr.calls {
+		calls[call.method+" "+call.path] = i
+	}
+	listIdx, ok := calls["GET "+mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.trash", params)]
+	require.True(t, ok)
+	restoreIdx, ok := calls["POST "+mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.trash.restore", params)]
+	require.True(t, ok)
+	purgeIdx, ok := calls["DELETE "+mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.trash.id", params)]
+	require.True(t, ok)
+
+	listCtx := router.NewMockContext()
+	require.NoError(t, rr.calls[listIdx].handler(listCtx))
+	payload, ok := resp.lastJSON.(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, 1, payload["total"])
+
+	restoreCtx := router.NewMockContext()
+	restoreCtx.ParamsM["id"] = "7"
+	require.NoError(t, rr.calls[restoreIdx].handler(restoreCtx))
+	require.Equal(t, "7", binding.restoredID)
+
+	purgeCtx := router.NewMockContext()
+	purgeCtx.ParamsM["id"] = "8"
+	require.NoError(t, rr.calls[purgeIdx].handler(purgeCtx))
+	require.Equal(t, "8", binding.purgedID)
+	require.Equal(t, map[string]string{"status": "purged"}, resp.lastJSON)
+
+	disabled := &recordRouter{}
+	ctx.router = disabled
+	binding.enabled = false
+	require.NoError(t, PanelStep(ctx))
+	require.Len(t, disabled.calls, 10)
+}
+
+type stubImportPanelBinding struct {
+	*stubPanelBinding
+	enabled  bool
+	jobCalls []string
+}
+
+func (s *stubImportPanelBinding) ImportEnabled() bool { return s.enabled }
+func (s *stubImportPanelBinding) ImportTemplate(router.Context, string, string) (PanelImportTemplate, error) {
+	return PanelImportTemplate{FileName: "articles-import.csv", ContentType: "text/csv", Body: []byte("id,title\n")}, nil
+}
+func (s *stubImportPanelBinding) PreviewImport(router.Context, string, PanelImportUpload) (any, error) {
+	return map[string]any{"rows": []any{}}, nil
+}
+func (s *stubImportPanelBinding) StartImport(router.Context, string, PanelImportUpload) (any, error) {
+	return map[string]any{"id": "job-1"}, nil
+}
+func (s *stubImportPanelBinding) ImportJobs(router.Context, string) (any, error) {
+	return map[string]any{"total": 1}, nil
+}
+func (s *stubImportPanelBinding) ImportJob(_ router.Context, _ string, id string) (any, error) {
+	s.jobCalls = append(s.jobCalls, id)
+	return map[string]any{"job": map[string]any{"id": id}}, nil
+}
+
+func TestPanelStepRegistersImportRoutesForImportPanels(t *testing.T) {
+	rr := &recordRouter{}
+	resp := &stubResponder{}
+	binding := &stubImportPanelBinding{stubPanelBinding: &stubPanelBinding{name: "articles"}, enabled: true}
+	ctx := &stubCtx{
+		router:     rr,
+		responder:  resp,
+		basePath:   "/admin",
+		defaultLoc: "en",
+		panels:     []PanelBinding{binding},
+	}
+
+	require.NoError(t, PanelStep(ctx))
+	require.Len(t, rr.calls, 15)
+	params := map[string]string{"panel": "articles"}
+	calls := map[string]int{}
+	for i, call := range rr.calls {
+		calls[call.method+" "+call.path] = i
+	}
+	for _, key := range []string{
+		"GET " + mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.import.template", params),
+		"POST " + mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.import.preview", params),
+		"POST " + mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.import", params),
+	} {
+		_, ok := calls[key]
+		require.True(t, ok, key)
+	}
+	listIdx, ok := calls["GET "+mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.import", params)]
+	require.True(t, ok)
+	jobIdx, ok := calls["GET "+mustRoutePathWithParams(t, ctx, ctx.AdminAPIGroup(), "panel.import.job", params)]
+	require.True(t, ok)
+
+	require.NoError(t, rr.calls[listIdx].handler(router.NewMockContext()))
+	require.Equal(t, map[string]any{"total": 1}, resp.lastJSON)
+
+	jobCtx := router.NewMockContext()
+	jobCtx.ParamsM["id"] = "job-1"
+	require.NoError(t, rr.calls[jobIdx].handler(jobCtx))
+	require.Equal(t, []string{"job-1"}, binding.jobCalls)
+
+	disabled := &recordRouter{}
+	ctx.router = disabled
+	binding.enabled = false
+	require.NoError(t, PanelStep(ctx))
+	require.Len(t, disabled.calls, 10)
+}
+
 func TestPanelStepClearsMountedPanelSnapshotWhenNoPanelsRemain(t *testing.T) {
 	ctx := &stubCtx{
 		router:    &recordRouter{},
@@ -1702,6 +1863,80 @@ func TestTranslationFamiliesRouteStepRegistersRoutes(t *testing.T) {
 	require.Equal(t, 1, binding.exportSelectedCalled)
 }
 
+type stubTranslationGlossaryBinding struct {
+	exportCalled int
+	importCalled int
+}
+
+func (s *stubTranslationGlossaryBinding) Export(_ router.Context) error {
+	s.exportCalled++
+	return nil
+}
+
+func (s *stubTranslationGlossaryBinding) Import(_ router.Context) (any, error) {
+	s.importCalled++
+	return map[string]any{"created": 1}, nil
+}
+
+func TestTranslationGlossaryRouteStepRegistersRoutes(t *testing.T) {
+	rr := &recordRouter{}
+	resp := &stubResponder{}
+	binding := &stubTranslationGlossaryBinding{}
+	ctx := &stubCtx{
+		router:    rr,
+		responder: resp,
+		basePath:  "/admin",
+		glossary:  binding,
+	}
+
+	require.NoError(t, TranslationGlossaryRouteStep(ctx))
+	require.Len(t, rr.calls, 2)
+	require.Equal(t, "GET "+mustRoutePath(t, ctx, ctx.AdminAPIGroup(), "translations.glossary.export"), rr.calls[0].method+" "+rr.calls[0].path)
+	require.Equal(t, "POST "+mustRoutePath(t, ctx, ctx.AdminAPIGroup(), "translations.glossary.import"), rr.calls[1].method+" "+rr.calls[1].path)
+
+	require.NoError(t, rr.calls[0].handler(router.NewMockContext()))
+	require.NoError(t, rr.calls[1].handler(router.NewMockContext()))
+	require.Equal(t, 1, binding.exportCalled)
+	require.Equal(t, 1, binding.importCalled)
+}
+
+type stubTranslationMemoryBinding struct {
+	exportCalled int
+	importCalled int
+}
+
+func (s *stubTranslationMemoryBinding) Export(_ router.Context) error {
+	s.exportCalled++
+	return nil
+}
+
+func (s *stubTranslationMemoryBinding) Import(_ router.Context) (any, error) {
+	s.importCalled++
+	return map[string]any{"created": 1}, nil
+}
+
+func TestTranslationMemoryRouteStepRegistersRoutes(t *testing.T) {
+	rr := &recordRouter{}
+	resp := &stubResponder{}
+	binding := &stubTranslationMemoryBinding{}
+	ctx := &stubCtx{
+		router:    rr,
+		responder: resp,
+		basePath:  "/admin",
+		memory:    binding,
+	}
+
+	require.NoError(t, TranslationMemoryRouteStep(ctx))
+	require.Len(t, rr.calls, 2)
+	require.Equal(t, "GET "+mustRoutePath(t, ctx, ctx.AdminAPIGroup(), "translations.memory.export"), rr.calls[0].method+" "+rr.calls[0].path)
+	require.Equal(t, "POST "+mustRoutePath(t, ctx, ctx.AdminAPIGroup(), "translations.memory.import"), rr.calls[1].method+" "+rr.calls[1].path)
+
+	require.NoError(t, rr.calls[0].handler(router.NewMockContext()))
+	require.NoError(t, rr.calls[1].handler(router.NewMockContext()))
+	require.Equal(t, 1, binding.exportCalled)
+	require.Equal(t, 1, binding.importCalled)
+}
+
 func TestTranslationQueueRouteStepRegistersRoutes(t *testing.T) {
 	rr := &recordRouter{}
 	resp := &stubResponder{}
@@ -1780,6 +2015,38 @@ func TestTranslationQueueRouteStepRegistersRoutes(t *testing.T) {
 	require.Equal(t, 1, binding.reviewersOptionsCalled)
 }
 
+type stubTranslationQueueWatchBinding struct {
+	stubTranslationQueueBinding
+	watchDraftSyncCalled int
+}
+
+func (s *stubTranslationQueueWatchBinding) WatchDraftSync(_ router.Context) error {
+	s.watchDraftSyncCalled++
+	return nil
+}
+
+func TestTranslationQueueRouteStepRegistersWatchRouteWhenSupported(t *testing.T) {
+	rr := &recordRouter{}
+	binding := &stubTranslationQueueWatchBinding{}
+	ctx := &stubCtx{
+		router:    rr,
+		responder: &stubResponder{},
+		basePath:  "/admin",
+		queue:     binding,
+	}
+
+	require.NoError(t, TranslationQueueRouteStep(ctx))
+	require.Len(t, rr.calls, 19)
+	eventsPath := mustRoutePath(t, ctx, ctx.AdminAPIGroup(), "translations.sync.resources.events")
+	for _, call := range rr.calls {
+		if call.method != "GET" || call.path != eventsPath {
+			continue
+		}
+		require.NoError(t, call.handler(router.NewMockContext()))
+	}
+	require.Equal(t, 1, binding.watchDraftSyncCalled)
+}
+
 func TestPanelAndTranslationQueueRoutesDoNotShadowEachOther(t *testing.T) {
 	rr := &recordRouter{}
 	resp := &stubResponder{}
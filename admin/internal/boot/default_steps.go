@@ -24,6 +24,8 @@ func DefaultBootSteps() []Step {
 		TranslationFamiliesRouteStep,
 		TranslationExchangeRouteStep,
 		TranslationQueueRouteStep,
+		TranslationGlossaryRouteStep,
+		TranslationMemoryRouteStep,
 		NotificationsRouteStep,
 		ActivityRouteStep,
 		JobsStep,
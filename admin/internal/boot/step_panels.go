@@ -1,6 +1,7 @@
 package boot
 
 import (
+	"encoding/json"
 	"strings"
 
 	"github.com/goliatone/go-admin/admin/internal/adminkeys"
@@ -127,9 +128,238 @@ func panelRoutes(ctx BootCtx, responder Responder, panelLookup panelBindingLooku
 		panelBulkRoute(ctx, responder, panelLookup, panelName, routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.bulk", params)),
 		panelPreviewRoute(ctx, responder, panelLookup, panelName, routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.preview", params)),
 	}
+	routes = append(routes, panelTrashRoutes(ctx, responder, panelLookup, panelName)...)
+	routes = append(routes, panelImportRoutes(ctx, responder, panelLookup, panelName)...)
 	return append(routes, panelSubresourceRoutes(ctx, responder, panelLookup, panelName)...)
 }
 
+func panelTrashRoutes(ctx BootCtx, responder Responder, panelLookup panelBindingLookup, panelName string) []RouteSpec {
+	binding, err := panelLookup(panelName)
+	if err != nil || binding == nil {
+		return nil
+	}
+	if trash, ok := binding.(PanelTrashBinding); !ok || !trash.TrashEnabled() {
+		return nil
+	}
+	params := map[string]string{"panel": panelName}
+	lookup := func() (PanelTrashBinding, error) {
+		binding, err := panelLookup(panelName)
+		if err != nil {
+			return nil, err
+		}
+		trash, ok := binding.(PanelTrashBinding)
+		if !ok {
+			return nil, goerrors.New("not found", goerrors.CategoryNotFound).
+				WithCode(404).
+				WithTextCode("NOT_FOUND").
+				WithMetadata(map[string]any{"panel": panelName})
+		}
+		return trash, nil
+	}
+	return []RouteSpec{
+		{
+			Method: "GET",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.trash", params),
+			Handler: func(c router.Context) error {
+				trash, err := lookup()
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				records, total, err := trash.Trash(c, panelLocale(ctx, c), parseListOptions(c))
+				if err != nil {
+					return responder.WriteError(c, panelRouteError(panelName, "list trash", nil, err))
+				}
+				return responder.WriteJSON(c, map[string]any{
+					"total":   total,
+					"records": records,
+					"items":   records,
+				})
+			},
+		},
+		{
+			Method: "POST",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.trash.restore", params),
+			Handler: func(c router.Context) error {
+				trash, err := lookup()
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				id := c.Param("id", "")
+				if id == "" {
+					return responder.WriteError(c, errMissingID)
+				}
+				restored, err := trash.Restore(c, panelLocale(ctx, c), id)
+				if err != nil {
+					return responder.WriteError(c, panelRouteError(panelName, "restore record", map[string]string{"id": id}, err))
+				}
+				return responder.WriteJSON(c, restored)
+			},
+		},
+		{
+			Method: "DELETE",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.trash.id", params),
+			Handler: func(c router.Context) error {
+				trash, err := lookup()
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				id := c.Param("id", "")
+				if id == "" {
+					return responder.WriteError(c, errMissingID)
+				}
+				if err := trash.Purge(c, panelLocale(ctx, c), id); err != nil {
+					return responder.WriteError(c, panelRouteError(panelName, "purge record", map[string]string{"id": id}, err))
+				}
+				return responder.WriteJSON(c, map[string]string{"status": "purged"})
+			},
+		},
+	}
+}
+
+func panelImportRoutes(ctx BootCtx, responder Responder, panelLookup panelBindingLookup, panelName string) []RouteSpec {
+	binding, err := panelLookup(panelName)
+	if err != nil || binding == nil {
+		return nil
+	}
+	if imports, ok := binding.(PanelImportBinding); !ok || !imports.ImportEnabled() {
+		return nil
+	}
+	params := map[string]string{"panel": panelName}
+	lookup := func() (PanelImportBinding, error) {
+		binding, err := panelLookup(panelName)
+		if err != nil {
+			return nil, err
+		}
+		imports, ok := binding.(PanelImportBinding)
+		if !ok {
+			return nil, goerrors.New("not found", goerrors.CategoryNotFound).
+				WithCode(404).
+				WithTextCode("NOT_FOUND").
+				WithMetadata(map[string]any{"panel": panelName})
+		}
+		return imports, nil
+	}
+	upload := func(c router.Context, run func(PanelImportBinding, PanelImportUpload) (any, error), operation string) (any, error) {
+		imports, err := lookup()
+		if err != nil {
+			return nil, err
+		}
+		req, err := parsePanelImportUpload(c)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = req.File.Reader.Close() }()
+		payload, err := run(imports, req)
+		if err != nil {
+			return nil, panelRouteError(panelName, operation, nil, err)
+		}
+		return payload, nil
+	}
+	return []RouteSpec{
+		{
+			Method: "GET",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.import.template", params),
+			Handler: func(c router.Context) error {
+				imports, err := lookup()
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				template, err := imports.ImportTemplate(c, panelLocale(ctx, c), c.Query("format"))
+				if err != nil {
+					return responder.WriteError(c, panelRouteError(panelName, "render import template", nil, err))
+				}
+				c.SetHeader("Content-Type", template.ContentType)
+				c.SetHeader("Content-Disposition", "attachment; filename="+template.FileName)
+				return c.Send(template.Body)
+			},
+		},
+		{
+			Method: "POST",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.import.preview", params),
+			Handler: func(c router.Context) error {
+				payload, err := upload(c, func(imports PanelImportBinding, req PanelImportUpload) (any, error) {
+					return imports.PreviewImport(c, panelLocale(ctx, c), req)
+				}, "preview import")
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				return responder.WriteJSON(c, payload)
+			},
+		},
+		{
+			Method: "POST",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.import", params),
+			Handler: func(c router.Context) error {
+				payload, err := upload(c, func(imports PanelImportBinding, req PanelImportUpload) (any, error) {
+					return imports.StartImport(c, panelLocale(ctx, c), req)
+				}, "start import")
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				return responder.WriteJSONStatus(c, 202, payload)
+			},
+		},
+		{
+			Method: "GET",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.import", params),
+			Handler: func(c router.Context) error {
+				imports, err := lookup()
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				jobs, err := imports.ImportJobs(c, panelLocale(ctx, c))
+				if err != nil {
+					return responder.WriteError(c, panelRouteError(panelName, "list import jobs", nil, err))
+				}
+				return responder.WriteJSON(c, jobs)
+			},
+		},
+		{
+			Method: "GET",
+			Path:   routePathWithParams(ctx, ctx.AdminAPIGroup(), "panel.import.job", params),
+			Handler: func(c router.Context) error {
+				imports, err := lookup()
+				if err != nil {
+					return responder.WriteError(c, err)
+				}
+				id := c.Param("id", "")
+				if id == "" {
+					return responder.WriteError(c, errMissingID)
+				}
+				job, err := imports.ImportJob(c, panelLocale(ctx, c), id)
+				if err != nil {
+					return responder.WriteError(c, panelRouteError(panelName, "get import job", map[string]string{"id": id}, err))
+				}
+				return responder.WriteJSON(c, job)
+			},
+		},
+	}
+}
+
+func parsePanelImportUpload(c router.Context) (PanelImportUpload, error) {
+	header, err := c.FormFile("file")
+	if err != nil || header == nil {
+		return PanelImportUpload{}, bootValidationError("file", "file required")
+	}
+	req := PanelImportUpload{Format: strings.TrimSpace(c.FormValue("format"))}
+	if raw := strings.TrimSpace(c.FormValue("mapping")); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &req.Mapping); err != nil {
+			return PanelImportUpload{}, bootValidationError("mapping", "mapping must be a JSON object of column to field names")
+		}
+	}
+	file, err := header.Open()
+	if err != nil {
+		return PanelImportUpload{}, bootValidationError("file", "file required")
+	}
+	req.File = MultipartFile{
+		FileName:    header.Filename,
+		ContentType: strings.TrimSpace(header.Header.Get("Content-Type")),
+		Size:        header.Size,
+		Reader:      file,
+	}
+	return req, nil
+}
+
 func panelLocale(ctx BootCtx, c router.Context) string {
 	locale := c.Query(adminkeys.KeyLocale)
 	if locale == "" {
@@ -167,6 +397,9 @@ func panelListRoute(ctx BootCtx, responder Responder, panelLookup panelBindingLo
 			}
 			if len(meta) > 0 {
 				payload["$meta"] = meta
+				if nextCursor, ok := meta["next_cursor"]; ok {
+					payload["next_cursor"] = nextCursor
+				}
 			}
 			return responder.WriteJSON(c, payload)
 		},
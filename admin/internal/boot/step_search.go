@@ -33,8 +33,8 @@ func SearchStep(ctx BootCtx) error {
 				if locale == "" {
 					locale = defaultLocale
 				}
-				results, err := binding.Query(c, locale, query, limit)
-				return writeJSONOrError(responder, c, map[string]any{"results": results}, err)
+				payload, err := binding.Query(c, locale, query, limit)
+				return writeJSONOrError(responder, c, payload, err)
 			}),
 		},
 		{
@@ -50,8 +50,8 @@ func SearchStep(ctx BootCtx) error {
 				if locale == "" {
 					locale = defaultLocale
 				}
-				results, err := binding.Query(c, locale, query, limit)
-				return writeJSONOrError(responder, c, map[string]any{"results": results}, err)
+				payload, err := binding.Query(c, locale, query, limit)
+				return writeJSONOrError(responder, c, payload, err)
 			}),
 		},
 	}
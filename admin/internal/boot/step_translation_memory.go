@@ -0,0 +1,37 @@
+package boot
+
+import router "github.com/goliatone/go-router"
+
+// TranslationMemoryRouteStep registers translation memory TMX import/export HTTP routes.
+func TranslationMemoryRouteStep(ctx BootCtx) error {
+	if ctx == nil || ctx.Router() == nil {
+		return nil
+	}
+	binding := ctx.BootTranslationMemory()
+	if binding == nil {
+		return nil
+	}
+	responder := ctx.Responder()
+	if responder == nil {
+		return nil
+	}
+	gates := ctx.Gates()
+	routes := []RouteSpec{
+		{
+			Method: "GET",
+			Path:   routePath(ctx, ctx.AdminAPIGroup(), "translations.memory.export"),
+			Handler: withFeatureGate(responder, gates, FeatureTranslationQueue, func(c router.Context) error {
+				return binding.Export(c)
+			}),
+		},
+		{
+			Method: "POST",
+			Path:   routePath(ctx, ctx.AdminAPIGroup(), "translations.memory.import"),
+			Handler: withFeatureGate(responder, gates, FeatureTranslationQueue, func(c router.Context) error {
+				payload, err := binding.Import(c)
+				return writeJSONOrError(responder, c, payload, err)
+			}),
+		},
+	}
+	return applyRoutes(ctx, routes)
+}
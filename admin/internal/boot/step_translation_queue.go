@@ -23,7 +23,7 @@ func TranslationQueueRouteStep(ctx BootCtx) error {
 }
 
 func translationQueueRouteSpecs(ctx BootCtx, responder Responder, gates FeatureGates, binding TranslationQueueBinding) []RouteSpec {
-	return []RouteSpec{
+	routes := []RouteSpec{
 		translationQueueReadRoute(ctx, responder, gates, "translations.dashboard", binding.Dashboard),
 		translationQueueReadRoute(ctx, responder, gates, "translations.assignments", binding.Assignments),
 		translationQueueFamilyAssignmentsRoute(ctx, responder, gates, binding),
@@ -43,6 +43,10 @@ func translationQueueRouteSpecs(ctx BootCtx, responder Responder, gates FeatureG
 		translationQueueReadRoute(ctx, responder, gates, "translations.options.assignees", binding.AssigneesOptions),
 		translationQueueReadRoute(ctx, responder, gates, "translations.options.reviewers", binding.ReviewersOptions),
 	}
+	if watcher, ok := binding.(TranslationQueueWatchBinding); ok {
+		routes = append(routes, translationQueueDraftSyncWatchRoute(ctx, responder, gates, watcher))
+	}
+	return routes
 }
 
 func translationQueueFamilyAssignmentsRoute(ctx BootCtx, responder Responder, gates FeatureGates, binding TranslationQueueBinding) RouteSpec {
@@ -167,3 +171,16 @@ func translationQueueDraftSyncMutateRoute(ctx BootCtx, responder Responder, gate
 		}),
 	}
 }
+
+func translationQueueDraftSyncWatchRoute(ctx BootCtx, responder Responder, gates FeatureGates, binding TranslationQueueWatchBinding) RouteSpec {
+	return RouteSpec{
+		Method: "GET",
+		Path:   routePath(ctx, ctx.AdminAPIGroup(), "translations.sync.resources.events"),
+		Handler: withFeatureGate(responder, gates, FeatureTranslationQueue, func(c router.Context) error {
+			if err := binding.WatchDraftSync(c); err != nil {
+				return responder.WriteError(c, err)
+			}
+			return nil
+		}),
+	}
+}
@@ -88,6 +88,7 @@ type ListOptions struct {
 	Predicates []ListPredicate `json:"predicates"`
 	Fields     []string        `json:"fields"`
 	Search     string          `json:"search"`
+	Cursor     string          `json:"cursor,omitempty"`
 }
 
 // ListPredicate defines an operator-aware list filter predicate for boot bindings.
@@ -119,6 +120,41 @@ type PanelBinding interface {
 	HandleSubresource(router.Context, string, string, string, string) error
 }
 
+// PanelTrashBinding is implemented by panel bindings whose panel keeps a trash
+// of soft-deleted records.
+type PanelTrashBinding interface {
+	TrashEnabled() bool
+	Trash(router.Context, string, ListOptions) ([]map[string]any, int, error)
+	Restore(router.Context, string, string) (map[string]any, error)
+	Purge(router.Context, string, string) error
+}
+
+// PanelImportBinding is implemented by panel bindings whose panel accepts
+// file imports.
+type PanelImportBinding interface {
+	ImportEnabled() bool
+	ImportTemplate(router.Context, string, string) (PanelImportTemplate, error)
+	PreviewImport(router.Context, string, PanelImportUpload) (any, error)
+	StartImport(router.Context, string, PanelImportUpload) (any, error)
+	ImportJobs(router.Context, string) (any, error)
+	ImportJob(router.Context, string, string) (any, error)
+}
+
+// PanelImportTemplate is a downloadable import template.
+type PanelImportTemplate struct {
+	FileName    string
+	ContentType string
+	Body        []byte
+}
+
+// PanelImportUpload is an uploaded import file with its optional column
+// mapping. A nil Mapping lets the panel suggest one.
+type PanelImportUpload struct {
+	File    MultipartFile
+	Format  string
+	Mapping map[string]string
+}
+
 // DashboardBinding exposes dashboard handlers.
 type DashboardBinding interface {
 	Enabled() bool
@@ -135,9 +171,10 @@ type NavigationBinding interface {
 	Resolve(router.Context, string, string) (items any, theme map[string]map[string]string)
 }
 
-// SearchBinding exposes search queries.
+// SearchBinding exposes search queries. The payload carries the ranked
+// results plus grouping and per-adapter report fields.
 type SearchBinding interface {
-	Query(router.Context, string, string, int) ([]any, error)
+	Query(router.Context, string, string, int) (map[string]any, error)
 }
 
 // ExportRouteOptions configures export route registration.
@@ -196,6 +233,18 @@ type TranslationExchangeBinding interface {
 	DeleteJob(router.Context, string) (any, error)
 }
 
+// TranslationGlossaryBinding exposes glossary term import/export operations.
+type TranslationGlossaryBinding interface {
+	Export(router.Context) error
+	Import(router.Context) (any, error)
+}
+
+// TranslationMemoryBinding exposes translation memory TMX import/export operations.
+type TranslationMemoryBinding interface {
+	Export(router.Context) error
+	Import(router.Context) (any, error)
+}
+
 // TranslationFamiliesBinding exposes translation family read-model operations.
 type TranslationFamiliesBinding interface {
 	List(router.Context) (any, error)
@@ -229,6 +278,12 @@ type TranslationQueueBinding interface {
 	ReviewersOptions(router.Context) (any, error)
 }
 
+// TranslationQueueWatchBinding is implemented by translation queue bindings
+// that can stream draft sync revisions as server-sent events.
+type TranslationQueueWatchBinding interface {
+	WatchDraftSync(router.Context) error
+}
+
 // NotificationsBinding exposes notifications operations.
 type NotificationCapabilities struct {
 	Deliveries bool `json:"deliveries"`
@@ -335,6 +390,8 @@ type BootCtx interface {
 	BootTranslationFamilies() TranslationFamiliesBinding
 	BootTranslationExchange() TranslationExchangeBinding
 	BootTranslationQueue() TranslationQueueBinding
+	BootTranslationGlossary() TranslationGlossaryBinding
+	BootTranslationMemory() TranslationMemoryBinding
 	BootNotifications() NotificationsBinding
 	BootActivity() ActivityBinding
 	BootJobs() JobsBinding
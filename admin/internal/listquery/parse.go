@@ -27,6 +27,7 @@ type Result struct {
 	Filters    map[string]any `json:"filters"`
 	Predicates []Predicate    `json:"predicates"`
 	Fields     []string       `json:"fields"`
+	Cursor     string         `json:"cursor,omitempty"`
 }
 
 // Options projects Result into consumer-specific predicate types.
@@ -40,6 +41,7 @@ type Options[T any] struct {
 	Predicates []T            `json:"predicates"`
 	Fields     []string       `json:"fields"`
 	Search     string         `json:"search"`
+	Cursor     string         `json:"cursor,omitempty"`
 }
 
 // ParseContext extracts list query options from a router context.
@@ -59,6 +61,7 @@ func ParseContext(c router.Context, defaultPage, defaultPerPage int) Result {
 		Filters:    filters,
 		Predicates: predicates,
 		Fields:     fields,
+		Cursor:     resolveCursor(c),
 	}
 }
 
@@ -81,6 +84,11 @@ func resolvePagination(c router.Context, defaultPage, defaultPerPage int) (int,
 	return page, perPage
 }
 
+// resolveCursor reads the opaque keyset cursor; "after" is accepted as an alias.
+func resolveCursor(c router.Context) string {
+	return strings.TrimSpace(primitives.FirstNonEmptyRaw(c.Query(adminkeys.QueryCursor), c.Query(adminkeys.QueryAfter)))
+}
+
 func resolveSearch(c router.Context) string {
 	search := strings.TrimSpace(c.Query(adminkeys.QuerySearch))
 	if search != "" {
@@ -164,6 +172,8 @@ func reservedQueryKeys() map[string]struct{} {
 		adminkeys.QueryQ:                    {},
 		adminkeys.QueryLimit:                {},
 		adminkeys.QueryOffset:               {},
+		adminkeys.QueryCursor:               {},
+		adminkeys.QueryAfter:                {},
 		adminkeys.QueryOrder:                {},
 		adminkeys.QueryFields:               {},
 		adminkeys.QueryState:                {},
@@ -196,6 +206,7 @@ func ParseOptions[T any](c router.Context, defaultPage, defaultPerPage int, mapp
 		Predicates: MapPredicates(parsed.Predicates, mapper),
 		Fields:     append([]string{}, parsed.Fields...),
 		Search:     parsed.Search,
+		Cursor:     parsed.Cursor,
 	}
 }
 
@@ -97,6 +97,70 @@ func TestPanelRepositoryListPaginationContracts(t *testing.T) {
 	})
 }
 
+func TestPanelRepositoryCursorPaginationContracts(t *testing.T) {
+	records := func() []map[string]any {
+		return []map[string]any{
+			{"name": "Alpha", "status": "published"},
+			{"name": "Bravo", "status": "published"},
+			{"name": "Bravo", "status": "published"},
+			{"name": "Charlie", "status": "draft"},
+			{"name": "Delta", "status": "published"},
+			{"name": "Echo", "status": "published"},
+		}
+	}
+
+	t.Run("crud adapter", func(t *testing.T) {
+		service := newStubCRUDService()
+		for idx, record := range records() {
+			record["id"] = fmt.Sprint(idx + 1)
+			service.records = append(service.records, record)
+		}
+		adapter := NewCRUDRepositoryAdapter(service)
+
+		admincontract.AssertCursorPaginationContract(t, cursorListContractFromRepository(adapter), admincontract.PaginationContractConfig{
+			TotalExpected: 5,
+			PerPage:       2,
+			SortBy:        "name",
+			Filters:       map[string]any{"status": "published"},
+			UniqueKey:     "id",
+		})
+	})
+
+	for _, desc := range []bool{false, true} {
+		t.Run(fmt.Sprintf("bun adapter desc=%v", desc), func(t *testing.T) {
+			db := setupTestBunDB(t)
+			t.Cleanup(func() { _ = db.Close() }) //nolint:errcheck // test cleanup failure cannot change the already-asserted behavior.
+
+			adapter := NewBunRepositoryAdapter[*bunTestProduct](newTestProductRepo(db))
+			seedRepositoryRecords(t, adapter, records())
+
+			admincontract.AssertCursorPaginationContract(t, cursorListContractFromRepository(adapter), admincontract.PaginationContractConfig{
+				TotalExpected: 5,
+				PerPage:       2,
+				SortBy:        "name",
+				SortDesc:      desc,
+				Filters:       map[string]any{"status": "published"},
+				UniqueKey:     "id",
+			})
+		})
+	}
+
+	t.Run("rejects cursor from another sort order", func(t *testing.T) {
+		db := setupTestBunDB(t)
+		t.Cleanup(func() { _ = db.Close() }) //nolint:errcheck // test cleanup failure cannot change the already-asserted behavior.
+
+		adapter := NewBunRepositoryAdapter[*bunTestProduct](newTestProductRepo(db))
+		seedRepositoryRecords(t, adapter, records())
+		page, err := adapter.ListPage(context.Background(), ListOptions{PerPage: 2, SortBy: "name"})
+		if err != nil || page.NextCursor == "" {
+			t.Fatalf("expected next cursor, got %+v (%v)", page, err)
+		}
+		if _, _, err := adapter.List(context.Background(), ListOptions{PerPage: 2, SortBy: "created_at", Cursor: page.NextCursor}); err == nil {
+			t.Fatalf("expected cursor issued for another sort to be rejected")
+		}
+	})
+}
+
 func seedRepositoryRecords(t *testing.T, repo Repository, records []map[string]any) {
 	t.Helper()
 	for idx, record := range records {
@@ -112,6 +176,13 @@ func listContractFromRepository(repo Repository) admincontract.ListFunc {
 	}
 }
 
+func cursorListContractFromRepository(repo CursorRepository) admincontract.CursorListFunc {
+	return func(ctx context.Context, opts admincontract.ListOptions) ([]map[string]any, int, string, error) {
+		page, err := repo.ListPage(ctx, toAdminListOptions(opts))
+		return page.Records, page.Total, page.NextCursor, err
+	}
+}
+
 func toAdminListOptions(opts admincontract.ListOptions) ListOptions {
 	converted := ListOptions{
 		Page:     opts.Page,
@@ -121,6 +192,7 @@ func toAdminListOptions(opts admincontract.ListOptions) ListOptions {
 		Filters:  map[string]any{},
 		Fields:   append([]string{}, opts.Fields...),
 		Search:   opts.Search,
+		Cursor:   opts.Cursor,
 	}
 	if len(opts.Filters) > 0 {
 		maps.Copy(converted.Filters, opts.Filters)
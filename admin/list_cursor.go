@@ -0,0 +1,235 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	repository "github.com/goliatone/go-repository-bun"
+	"github.com/uptrace/bun"
+)
+
+const listCursorIDField = "id"
+
+// ListPage is a list result carrying the keyset cursor for the following page.
+// In cursor mode Total may count only the records remaining after the cursor.
+type ListPage struct {
+	Records    []map[string]any `json:"records"`
+	Total      int              `json:"total"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+// CursorRepository is implemented by repositories that honor ListOptions.Cursor
+// with keyset queries on the sort column plus id, keeping deep pages stable and
+// cheap where offsets grow with the table.
+type CursorRepository interface {
+	Repository
+	ListPage(ctx context.Context, opts ListOptions) (ListPage, error)
+}
+
+// listCursor is the decoded form of the opaque ListOptions.Cursor token.
+// Null marks a NULL sort value; Value alone cannot tell it from a zero value.
+type listCursor struct {
+	SortBy   string `json:"s,omitempty"`
+	SortDesc bool   `json:"d,omitempty"`
+	Value    any    `json:"v"`
+	Null     bool   `json:"n,omitempty"`
+	Time     bool   `json:"t,omitempty"`
+	ID       string `json:"id"`
+}
+
+func encodeListCursor(cursor listCursor) string {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeListCursor parses token and checks it was issued for the same sort order.
+// Numbers decode as int64 when integral so large ids keep their precision.
+func decodeListCursor(token, sortBy string, sortDesc bool) (listCursor, error) {
+	invalid := func(reason string) error {
+		return validationDomainError("invalid list cursor", map[string]any{
+			"field":  "cursor",
+			"reason": reason,
+		})
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(token))
+	if err != nil {
+		return listCursor{}, invalid("malformed")
+	}
+	var cursor listCursor
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&cursor); err != nil || strings.TrimSpace(cursor.ID) == "" {
+		return listCursor{}, invalid("malformed")
+	}
+	if !strings.EqualFold(cursor.SortBy, listCursorSortField(sortBy)) || cursor.SortDesc != sortDesc {
+		return listCursor{}, invalid("sort order changed")
+	}
+	switch value := cursor.Value.(type) {
+	case nil:
+		if cursor.SortBy != "" && !cursor.Null {
+			return listCursor{}, invalid("malformed")
+		}
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			cursor.Value = n
+		} else if f, err := value.Float64(); err == nil {
+			cursor.Value = f
+		} else {
+			return listCursor{}, invalid("malformed")
+		}
+	case map[string]any, []any:
+		return listCursor{}, invalid("malformed")
+	}
+	if cursor.Null {
+		cursor.Value = nil
+	} else if cursor.Time {
+		parsed, err := time.Parse(time.RFC3339Nano, fmt.Sprint(cursor.Value))
+		if err != nil {
+			return listCursor{}, invalid("malformed")
+		}
+		cursor.Value = parsed
+	}
+	return cursor, nil
+}
+
+// nextListCursor returns the cursor after the last record of a full page.
+// last must be keyed by model column, the names the keyset predicate compares.
+func nextListCursor(opts ListOptions, last map[string]any, count, perPage int) string {
+	if len(last) == 0 || count < perPage {
+		return ""
+	}
+	id := strings.TrimSpace(toString(last[listCursorIDField]))
+	if id == "" {
+		return ""
+	}
+	cursor := listCursor{
+		SortBy:   listCursorSortField(opts.SortBy),
+		SortDesc: opts.SortDesc,
+		ID:       id,
+	}
+	if cursor.SortBy != "" {
+		value, ok := listCursorColumnValue(last, cursor.SortBy)
+		if !ok {
+			return ""
+		}
+		switch typed := value.(type) {
+		case nil:
+			cursor.Null = true
+		case time.Time:
+			cursor.Value = typed.UTC().Format(time.RFC3339Nano)
+			cursor.Time = true
+		default:
+			cursor.Value = typed
+		}
+	}
+	return encodeListCursor(cursor)
+}
+
+// listCursorColumnValue reads column from record, unwrapping pointers and
+// driver values so NULLs surface as nil.
+func listCursorColumnValue(record map[string]any, column string) (any, bool) {
+	value, ok := record[column]
+	if !ok {
+		for key, candidate := range record {
+			if strings.EqualFold(key, column) {
+				value, ok = candidate, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+	if _, isTime := value.(time.Time); !isTime {
+		if valuer, isValuer := value.(driver.Valuer); isValuer {
+			if rv := reflect.ValueOf(valuer); rv.Kind() == reflect.Pointer && rv.IsNil() {
+				return nil, true
+			}
+			resolved, err := valuer.Value()
+			if err != nil {
+				return nil, false
+			}
+			value = resolved
+		}
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, true
+		}
+		value = rv.Elem().Interface()
+	}
+	return value, true
+}
+
+// listCursorSortField returns the keyset sort column; sorting by id needs no
+// separate column because id is always the tie-breaker.
+func listCursorSortField(sortBy string) string {
+	field, ok := normalizeRepositoryAdapterIdentifier(sortBy)
+	if !ok || strings.EqualFold(field, listCursorIDField) {
+		return ""
+	}
+	return field
+}
+
+// bunListCursorCriteria owns the keyset ORDER BY: the sort column with NULLs
+// last ascending and first descending on every dialect, then id as the
+// tie-breaker. When a cursor is present it keeps only rows after it.
+func bunListCursorCriteria(cursor *listCursor, sortBy string, sortDesc bool) repository.SelectCriteria {
+	op, dir := ">", "ASC"
+	if sortDesc {
+		op, dir = "<", "DESC"
+	}
+	sortField := listCursorSortField(sortBy)
+	idColumn := "?TableAlias." + listCursorIDField
+	return repository.SelectRawProcessor(func(q *bun.SelectQuery) *bun.SelectQuery {
+		if cursor != nil {
+			q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				if cursor.SortBy == "" {
+					return q.Where(fmt.Sprintf("%s %s ?", idColumn, op), cursor.ID)
+				}
+				column := "?TableAlias." + cursor.SortBy
+				if cursor.Null {
+					q = q.Where(fmt.Sprintf("%s IS NULL AND %s %s ?", column, idColumn, op), cursor.ID)
+					if sortDesc {
+						q = q.WhereOr(fmt.Sprintf("%s IS NOT NULL", column))
+					}
+					return q
+				}
+				q = q.Where(fmt.Sprintf("%s %s ?", column, op), cursor.Value).
+					WhereOr(fmt.Sprintf("%s = ? AND %s %s ?", column, idColumn, op), cursor.Value, cursor.ID)
+				if !sortDesc {
+					q = q.WhereOr(fmt.Sprintf("%s IS NULL", column))
+				}
+				return q
+			})
+		}
+		if sortField != "" {
+			column := "?TableAlias." + sortField
+			q = q.OrderExpr(fmt.Sprintf("(%s IS NULL) %s", column, dir)).
+				OrderExpr(fmt.Sprintf("%s %s", column, dir))
+		}
+		return q.OrderExpr(fmt.Sprintf("%s %s", idColumn, dir))
+	})
+}
+
+// resolveBunListCursor decodes the query cursor for Bun-backed adapters.
+func resolveBunListCursor(query normalizedRepositoryAdapterListQuery) (*listCursor, error) {
+	if query.Cursor == "" {
+		return nil, nil
+	}
+	cursor, err := decodeListCursor(query.Cursor, query.SortBy, query.SortDesc)
+	if err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
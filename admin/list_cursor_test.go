@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"testing"
+
+	repository "github.com/goliatone/go-repository-bun"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+)
+
+type bunCursorTestItem struct {
+	bun.BaseModel `bun:"table:bun_cursor_items,alias:ci"`
+
+	ID    uuid.UUID `bun:"id,pk,notnull" json:"id"`
+	Label string    `bun:"label,notnull" json:"displayLabel"`
+	Rank  *int64    `bun:"rank" json:"position"`
+}
+
+func setupCursorTestItems(t *testing.T, ranks map[string]*int64) *BunRepositoryAdapter[*bunCursorTestItem] {
+	t.Helper()
+	sqldb, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqldb.SetMaxOpenConns(1)
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+	t.Cleanup(func() { mustClose(t, "db", db) })
+	if _, err := db.NewCreateTable().Model((*bunCursorTestItem)(nil)).Exec(context.Background()); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	repo := repository.MustNewRepository[*bunCursorTestItem](db, repository.ModelHandlers[*bunCursorTestItem]{
+		NewRecord:          func() *bunCursorTestItem { return &bunCursorTestItem{} },
+		GetID:              func(item *bunCursorTestItem) uuid.UUID { return item.ID },
+		SetID:              func(item *bunCursorTestItem, id uuid.UUID) { item.ID = id },
+		GetIdentifier:      func() string { return "label" },
+		GetIdentifierValue: func(item *bunCursorTestItem) string { return item.Label },
+	})
+	for label, rank := range ranks {
+		if _, err := repo.Create(context.Background(), &bunCursorTestItem{ID: uuid.New(), Label: label, Rank: rank}); err != nil {
+			t.Fatalf("seed %s: %v", label, err)
+		}
+	}
+	// The record mapper exposes JSON names, so the cursor cannot read "rank" from the payload.
+	return NewBunRepositoryAdapter[*bunCursorTestItem](repo, WithBunRecordMapper(BunRecordMapper[*bunCursorTestItem]{
+		ToRecord: func(record map[string]any) (*bunCursorTestItem, error) {
+			return &bunCursorTestItem{Label: toString(record["displayLabel"])}, nil
+		},
+		ToMap: func(item *bunCursorTestItem) (map[string]any, error) {
+			return map[string]any{"id": item.ID.String(), "displayLabel": item.Label, "position": item.Rank}, nil
+		},
+	}))
+}
+
+func TestDecodeListCursorKeepsIntegerPrecision(t *testing.T) {
+	token := encodeListCursor(listCursor{SortBy: "rank", Value: int64(9007199254740993), ID: "item-1"})
+	cursor, err := decodeListCursor(token, "rank", false)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if cursor.Value != int64(9007199254740993) {
+		t.Fatalf("expected exact int64 cursor value, got %T %v", cursor.Value, cursor.Value)
+	}
+
+	token = encodeListCursor(listCursor{SortBy: "rank", Null: true, ID: "item-1"})
+	if cursor, err = decodeListCursor(token, "rank", false); err != nil || !cursor.Null || cursor.Value != nil {
+		t.Fatalf("expected NULL cursor, got %+v (%v)", cursor, err)
+	}
+	if _, err := decodeListCursor(encodeListCursor(listCursor{SortBy: "rank", ID: "item-1"}), "rank", false); err == nil {
+		t.Fatalf("expected a missing sort value without the NULL flag to be rejected")
+	}
+}
+
+func TestBunRepositoryAdapterCursorPagesNullAndLargeSortValues(t *testing.T) {
+	rank := func(v int64) *int64 { return &v }
+	adapter := setupCursorTestItems(t, map[string]*int64{
+		"one":   rank(1),
+		"two":   rank(2),
+		"three": rank(3),
+		"big":   rank(9007199254740993),
+		"big-1": rank(9007199254740992),
+		"none":  nil,
+		"nil":   nil,
+	})
+
+	for _, tc := range []struct {
+		desc bool
+		want []string
+	}{
+		{desc: false, want: []string{"one", "two", "three", "big-1", "big", "*", "*"}},
+		{desc: true, want: []string{"*", "*", "big", "big-1", "three", "two", "one"}},
+	} {
+		t.Run(fmt.Sprintf("desc=%v", tc.desc), func(t *testing.T) {
+			var labels []string
+			seen := map[string]bool{}
+			cursor := ""
+			for range 10 {
+				page, err := adapter.ListPage(context.Background(), ListOptions{PerPage: 2, SortBy: "rank", SortDesc: tc.desc, Cursor: cursor})
+				if err != nil {
+					t.Fatalf("list page: %v", err)
+				}
+				for _, record := range page.Records {
+					id := toString(record["id"])
+					if seen[id] {
+						t.Fatalf("record %s returned twice", id)
+					}
+					seen[id] = true
+					label := toString(record["displayLabel"])
+					if record["position"].(*int64) == nil {
+						label = "*"
+					}
+					labels = append(labels, label)
+				}
+				if page.NextCursor == "" {
+					break
+				}
+				cursor = page.NextCursor
+			}
+			if !slices.Equal(labels, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, labels)
+			}
+		})
+	}
+}
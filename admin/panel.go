@@ -44,6 +44,13 @@ type PanelBuilder struct {
 	actionStateResolver            BatchActionStateResolver
 	bulkActionStateResolver        BulkActionStateResolver
 	breadcrumbs                    PanelBreadcrumbConfig
+	softDelete                     PanelSoftDeleteConfig
+	revisions                      RevisionStore
+	revisionsRequired              bool
+	schedules                      ContentScheduleStore
+	searchIndex                    PanelSearchIndexer
+	searchable                     bool
+	imports                        PanelImportConfig
 }
 
 // Panel represents a registered panel.
@@ -78,6 +85,12 @@ type Panel struct {
 	actionStateResolver            BatchActionStateResolver
 	bulkActionStateResolver        BulkActionStateResolver
 	breadcrumbs                    PanelBreadcrumbConfig
+	softDelete                     PanelSoftDeleteConfig
+	revisions                      RevisionStore
+	revisionsRequired              bool
+	schedules                      ContentScheduleStore
+	searchIndex                    PanelSearchIndexer
+	imports                        PanelImportConfig
 }
 
 // PanelUIRouteMode declares who owns the panel's HTML UI route surface.
@@ -142,6 +155,9 @@ type ListOptions struct {
 	Predicates []ListPredicate `json:"predicates"`
 	Fields     []string        `json:"fields"`
 	Search     string          `json:"search"`
+	// Cursor is an opaque keyset cursor returned as NextCursor by a previous page.
+	// Repositories implementing CursorRepository ignore Page when it is set.
+	Cursor string `json:"cursor,omitempty"`
 }
 
 // ListPredicate defines an operator-aware list filter predicate.
@@ -242,6 +258,13 @@ type PanelPermissions struct {
 	Create string `json:"create"`
 	Edit   string `json:"edit"`
 	Delete string `json:"delete"`
+	// Restore and Purge guard the trash of soft-delete panels. Restore falls
+	// back to Delete. Purge has no fallback: when Delete is set and Purge is
+	// not, permanent purges by hand are denied.
+	Restore string `json:"restore,omitempty"`
+	Purge   string `json:"purge,omitempty"`
+	// Revert guards reverting to a revision; it falls back to Edit.
+	Revert string `json:"revert,omitempty"`
 }
 
 // PanelHooks contains lifecycle callbacks.
@@ -280,6 +303,7 @@ type Schema struct {
 	Export                *ExportConfig                `json:"export,omitempty"`
 	Bulk                  *BulkConfig                  `json:"bulk,omitempty"`
 	Media                 *MediaConfig                 `json:"media,omitempty"`
+	Trash                 *TrashConfig                 `json:"trash,omitempty"`
 }
 
 // ExportConfig captures export metadata for UI consumers.
@@ -495,6 +519,13 @@ func (b *PanelBuilder) WithBulkActionStateResolver(resolver BulkActionStateResol
 	return b
 }
 
+// SoftDelete moves deleted records to a trash; the repository must implement
+// SoftDeleteRepository.
+func (b *PanelBuilder) SoftDelete(cfg PanelSoftDeleteConfig) *PanelBuilder {
+	b.softDelete = cfg
+	return b
+}
+
 // WithBreadcrumbs configures package-level breadcrumb defaults for the panel.
 func (b *PanelBuilder) WithBreadcrumbs(cfg PanelBreadcrumbConfig) *PanelBuilder {
 	b.breadcrumbs = normalizePanelBreadcrumbConfig(cfg)
@@ -511,6 +542,11 @@ func (b *PanelBuilder) Build() (*Panel, error) {
 	if err := validatePanelCreateUIContract(b); err != nil {
 		return nil, err
 	}
+	if err := validatePanelSoftDeleteContract(b); err != nil {
+		return nil, err
+	}
+	bindPanelRevisionStore(b)
+	bindPanelSearchIndex(b)
 	if b.workflow != nil {
 		workflowHook := buildWorkflowUpdateHook(b.repo, b.workflow, b.workflowAuth, b.translationPolicy, b.name)
 		b.hooks.BeforeUpdateWithID = chainBeforeUpdateWithID(b.hooks.BeforeUpdateWithID, workflowHook)
@@ -546,6 +582,12 @@ func (b *PanelBuilder) Build() (*Panel, error) {
 		actionStateResolver:            b.actionStateResolver,
 		bulkActionStateResolver:        b.bulkActionStateResolver,
 		breadcrumbs:                    normalizePanelBreadcrumbConfig(b.breadcrumbs),
+		softDelete:                     b.softDelete,
+		imports:                        b.imports,
+		revisions:                      b.revisions,
+		revisionsRequired:              b.revisionsRequired,
+		schedules:                      b.schedules,
+		searchIndex:                    b.searchIndex,
 	}, nil
 }
 
@@ -0,0 +1,599 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	router "github.com/goliatone/go-router"
+)
+
+const (
+	// PanelSubresourceSchedule lists a record's schedules (value "all").
+	PanelSubresourceSchedule = "schedule"
+	// PanelSubresourceUnschedule cancels the pending schedule for the action
+	// named by the value (publish or unpublish).
+	PanelSubresourceUnschedule = "unschedule"
+
+	panelScheduleListValue = "all"
+)
+
+// contentScheduleRequest is a publish_at/unpublish_at value taken from a write
+// payload. A zero RunAt cancels the pending schedule for the action.
+type contentScheduleRequest struct {
+	Action string
+	RunAt  time.Time
+}
+
+// WithContentSchedules lets editors schedule publish and unpublish transitions
+// with publish_at/unpublish_at on create and update. Scheduling requires a
+// workflow engine; the transitions are applied by the content schedule job.
+func (b *PanelBuilder) WithContentSchedules(store ContentScheduleStore) *PanelBuilder {
+	b.schedules = store
+	return b
+}
+
+// SchedulesEnabled reports whether the panel accepts scheduled transitions.
+func (p *Panel) SchedulesEnabled() bool {
+	return p != nil && p.schedules != nil && p.workflow != nil
+}
+
+func (p *Panel) scheduleSubresources() []PanelSubresource {
+	if !p.SchedulesEnabled() {
+		return nil
+	}
+	return []PanelSubresource{
+		{Name: PanelSubresourceSchedule, Label: "Schedule", Method: "GET", Permission: p.permissions.View},
+		{Name: PanelSubresourceUnschedule, Label: "Unschedule", Method: "POST", Permission: p.permissions.Edit},
+	}
+}
+
+// extractContentSchedules removes publish_at/unpublish_at from a write payload
+// so repositories never see them.
+func (p *Panel) extractContentSchedules(record map[string]any) ([]contentScheduleRequest, error) {
+	if !p.SchedulesEnabled() || record == nil {
+		return nil, nil
+	}
+	requests := []contentScheduleRequest{}
+	for _, item := range []struct{ field, action string }{
+		{contentSchedulePublishAtField, ContentScheduleActionPublish},
+		{contentScheduleUnpublishAtField, ContentScheduleActionUnpublish},
+	} {
+		value, ok := record[item.field]
+		if !ok {
+			continue
+		}
+		delete(record, item.field)
+		runAt, err := parseContentScheduleTime(value)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, contentScheduleRequest{Action: item.action, RunAt: runAt})
+	}
+	delete(record, contentScheduleStateField)
+	if len(requests) == 2 && !requests[0].RunAt.IsZero() && !requests[1].RunAt.IsZero() && !requests[1].RunAt.After(requests[0].RunAt) {
+		return nil, validationDomainError("unpublish_at must be after publish_at", map[string]any{
+			"field": contentScheduleUnpublishAtField,
+			"panel": p.name,
+		})
+	}
+	return requests, nil
+}
+
+// authorizeContentSchedules checks the workflow authorizer for every transition
+// a write schedules, before the write happens. The job checks the schedule
+// author again when the schedule comes due.
+func (p *Panel) authorizeContentSchedules(ctx AdminContext, id string, requests []contentScheduleRequest) error {
+	if p.workflowAuth == nil {
+		return nil
+	}
+	for _, request := range requests {
+		if request.RunAt.IsZero() {
+			continue
+		}
+		req := buildWorkflowApplyRequest(ctx.Context, p.name, id, "", "", nil)
+		req.Event = request.Action
+		if !p.workflowAuth.CanApplyEvent(ctx.Context, req) {
+			return permissionDenied("workflow.transition", p.name)
+		}
+	}
+	return nil
+}
+
+// validateContentSchedules checks an update that moves one side of a schedule
+// against the record's other pending side, before the write happens, so
+// unpublish_at can never end up at or before publish_at.
+func (p *Panel) validateContentSchedules(ctx context.Context, id string, requests []contentScheduleRequest) error {
+	id = strings.TrimSpace(id)
+	if len(requests) != 1 || requests[0].RunAt.IsZero() || id == "" {
+		return nil
+	}
+	pending, err := p.schedules.ListSchedules(ctx, ContentScheduleFilter{
+		Panel:     p.name,
+		RecordIDs: []string{id},
+		Statuses:  []string{ContentScheduleStatusScheduled},
+	})
+	if err != nil {
+		return err
+	}
+	request := requests[0]
+	for _, schedule := range pending {
+		if schedule.Action == request.Action {
+			continue
+		}
+		publishAt, unpublishAt := request.RunAt, schedule.RunAt
+		if request.Action == ContentScheduleActionUnpublish {
+			publishAt, unpublishAt = schedule.RunAt, request.RunAt
+		}
+		if !unpublishAt.After(publishAt) {
+			return validationDomainError("unpublish_at must be after publish_at", map[string]any{
+				"field": contentScheduleUnpublishAtField,
+				"panel": p.name,
+			})
+		}
+	}
+	return nil
+}
+
+// rollbackContentScheduleWrite undoes a write whose schedules could not be
+// saved, running restore to delete the created record or put the updated one
+// back.
+func (p *Panel) rollbackContentScheduleWrite(id string, cause error, restore func() error) error {
+	if err := restore(); err != nil {
+		ensureLogger(nil).Error("panel content schedule rollback failed",
+			"panel", p.name,
+			"id", id,
+			"error", err,
+		)
+		return errors.Join(cause, err)
+	}
+	return cause
+}
+
+// saveContentSchedules stores or cancels the schedules requested with a write.
+// When a save fails, the schedules already stored by this call are cancelled
+// so the caller can roll the write back.
+func (p *Panel) saveContentSchedules(ctx AdminContext, id string, requests []contentScheduleRequest) error {
+	id = strings.TrimSpace(id)
+	if len(requests) == 0 || id == "" {
+		return nil
+	}
+	actor := ctx.UserID
+	if actor == "" {
+		actor = actorFromContext(ctx.Context)
+	}
+	saved := []string{}
+	for _, request := range requests {
+		if request.RunAt.IsZero() {
+			if _, err := p.cancelContentSchedules(ctx.Context, id, request.Action); err != nil {
+				return p.cancelSavedContentSchedules(ctx.Context, saved, err)
+			}
+			continue
+		}
+		schedule, err := p.schedules.SaveSchedule(ctx.Context, ContentSchedule{
+			Panel:     p.name,
+			RecordID:  id,
+			Action:    request.Action,
+			RunAt:     request.RunAt,
+			CreatedBy: actor,
+			TenantID:  primitives.FirstNonEmptyRaw(ctx.TenantID, tenantIDFromContext(ctx.Context)),
+			OrgID:     primitives.FirstNonEmptyRaw(ctx.OrgID, orgIDFromContext(ctx.Context)),
+		})
+		if err != nil {
+			return p.cancelSavedContentSchedules(ctx.Context, saved, err)
+		}
+		saved = append(saved, schedule.ID)
+		p.recordActivity(ctx, "panel.schedule", map[string]any{
+			"id":     id,
+			"panel":  p.name,
+			"action": request.Action,
+			"run_at": request.RunAt.Format(time.RFC3339),
+		})
+	}
+	return nil
+}
+
+func (p *Panel) cancelSavedContentSchedules(ctx context.Context, ids []string, cause error) error {
+	errs := []error{cause}
+	for _, id := range ids {
+		if _, err := p.schedules.TransitionSchedule(ctx, id, ContentScheduleStatusScheduled, ContentSchedule{
+			Status: ContentScheduleStatusCancelled,
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Panel) cancelContentSchedules(ctx context.Context, id, action string) (int, error) {
+	pending, err := p.schedules.ListSchedules(ctx, ContentScheduleFilter{
+		Panel:     p.name,
+		RecordIDs: []string{id},
+		Statuses:  []string{ContentScheduleStatusScheduled},
+	})
+	if err != nil {
+		return 0, err
+	}
+	cancelled := 0
+	for _, schedule := range pending {
+		if schedule.Action != action {
+			continue
+		}
+		ok, err := p.schedules.TransitionSchedule(ctx, schedule.ID, ContentScheduleStatusScheduled, ContentSchedule{
+			Status: ContentScheduleStatusCancelled,
+		})
+		if err != nil {
+			return cancelled, err
+		}
+		if ok {
+			cancelled++
+		}
+	}
+	return cancelled, nil
+}
+
+// decorateContentSchedules adds the pending publish_at/unpublish_at values and
+// a "scheduled" schedule_state to records. Decoration is best-effort so a
+// schedule store outage never hides content.
+func (p *Panel) decorateContentSchedules(ctx context.Context, records ...map[string]any) {
+	if !p.SchedulesEnabled() || len(records) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(records))
+	seen := map[string]bool{}
+	for _, record := range records {
+		if id := extractRecordID(record); id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	// One lookup per page of records, never one per record.
+	pending, err := p.schedules.ListSchedules(ctx, ContentScheduleFilter{
+		Panel:     p.name,
+		RecordIDs: ids,
+		Statuses:  []string{ContentScheduleStatusScheduled},
+	})
+	if err != nil || len(pending) == 0 {
+		return
+	}
+	byRecord := map[string][]ContentSchedule{}
+	for _, schedule := range pending {
+		byRecord[schedule.RecordID] = append(byRecord[schedule.RecordID], schedule)
+	}
+	for _, record := range records {
+		schedules := byRecord[extractRecordID(record)]
+		if record == nil || len(schedules) == 0 {
+			continue
+		}
+		for _, schedule := range schedules {
+			field := contentSchedulePublishAtField
+			if schedule.Action == ContentScheduleActionUnpublish {
+				field = contentScheduleUnpublishAtField
+			}
+			record[field] = schedule.RunAt.UTC().Format(time.RFC3339)
+		}
+		record[contentScheduleStateField] = ContentScheduleStatusScheduled
+	}
+}
+
+// Schedules lists every schedule of a record, pending and finished, by run time.
+func (p *Panel) Schedules(ctx AdminContext, id string) ([]ContentSchedule, error) {
+	if err := p.requireSchedules(); err != nil {
+		return nil, err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.View, p.name); err != nil {
+		return nil, err
+	}
+	return p.schedules.ListSchedules(ctx.Context, ContentScheduleFilter{
+		Panel:     p.name,
+		RecordIDs: []string{strings.TrimSpace(id)},
+	})
+}
+
+// CancelSchedule cancels the pending publish or unpublish schedule of a record.
+func (p *Panel) CancelSchedule(ctx AdminContext, id, action string) error {
+	if err := p.requireSchedules(); err != nil {
+		return err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.Edit, p.name); err != nil {
+		return err
+	}
+	normalized := normalizeContentScheduleAction(action)
+	if normalized == "" {
+		return validationDomainError("schedule action must be publish or unpublish", map[string]any{
+			"field":  "action",
+			"panel":  p.name,
+			"action": strings.TrimSpace(action),
+		})
+	}
+	id = strings.TrimSpace(id)
+	cancelled, err := p.cancelContentSchedules(ctx.Context, id, normalized)
+	if err != nil {
+		return err
+	}
+	if cancelled == 0 {
+		return notFoundDomainError("no pending schedule for record", map[string]any{
+			"panel":  p.name,
+			"id":     id,
+			"action": normalized,
+		})
+	}
+	p.recordActivity(ctx, "panel.schedule.cancel", map[string]any{
+		"id":     id,
+		"panel":  p.name,
+		"action": normalized,
+	})
+	return nil
+}
+
+// ApplyContentSchedule applies a due schedule through the workflow engine as
+// the system actor, in the tenant and org the schedule was saved in. The
+// schedule is claimed first, so a schedule is applied at most once even when
+// several job runners pick it up, and the workflow request carries an
+// idempotency key derived from the schedule. Schedules whose transition is not
+// available from the record's current state are skipped; translation policy
+// blockers mark the schedule blocked and notify its author.
+func (p *Panel) ApplyContentSchedule(ctx context.Context, schedule ContentSchedule, notifications NotificationService) (ContentSchedule, error) {
+	if err := p.requireSchedules(); err != nil {
+		return schedule, err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	claimed, err := p.schedules.TransitionSchedule(ctx, schedule.ID, ContentScheduleStatusScheduled, ContentSchedule{
+		Status: ContentScheduleStatusRunning,
+	})
+	if err != nil || !claimed {
+		return schedule, err
+	}
+	schedule.Status = ContentScheduleStatusRunning
+
+	systemCtx := withAdminRouterIdentity(ctx, adminRouterIdentity{
+		userID:   ActivityActorTypeSystem,
+		tenantID: schedule.TenantID,
+		orgID:    schedule.OrgID,
+	})
+	adminCtx := AdminContext{
+		Context:  systemCtx,
+		UserID:   ActivityActorTypeSystem,
+		TenantID: schedule.TenantID,
+		OrgID:    schedule.OrgID,
+	}
+	next, applyErr := p.applyContentSchedule(adminCtx, schedule, notifications)
+	if applyErr != nil && next.Status == ContentScheduleStatusRunning {
+		next.Status = ContentScheduleStatusFailed
+		next.Error = applyErr.Error()
+	}
+	if _, err := p.schedules.TransitionSchedule(ctx, schedule.ID, ContentScheduleStatusRunning, next); err != nil {
+		return next, err
+	}
+	if next.Status == ContentScheduleStatusFailed {
+		return next, applyErr
+	}
+	return next, nil
+}
+
+// RecoverStaleContentSchedules puts schedules left running longer than lease
+// back to scheduled, so a runner that died after claiming one does not strand
+// it. Reapplying is safe: the workflow request carries the schedule's
+// idempotency key.
+func (p *Panel) RecoverStaleContentSchedules(ctx context.Context, now time.Time, lease time.Duration) (int, error) {
+	if err := p.requireSchedules(); err != nil {
+		return 0, err
+	}
+	if lease <= 0 {
+		lease = contentScheduleRunningLease
+	}
+	running, err := p.schedules.ListSchedules(ctx, ContentScheduleFilter{
+		Panel:    p.name,
+		Statuses: []string{ContentScheduleStatusRunning},
+	})
+	if err != nil {
+		return 0, err
+	}
+	cutoff := now.Add(-lease)
+	recovered := 0
+	for _, schedule := range running {
+		if schedule.UpdatedAt.IsZero() || !schedule.UpdatedAt.Before(cutoff) {
+			continue
+		}
+		ok, err := p.schedules.TransitionSchedule(ctx, schedule.ID, ContentScheduleStatusRunning, ContentSchedule{
+			Status: ContentScheduleStatusScheduled,
+		})
+		if err != nil {
+			return recovered, err
+		}
+		if ok {
+			recovered++
+		}
+	}
+	return recovered, nil
+}
+
+// contentScheduleAuthorContext carries the schedule author and scope, so the
+// workflow authorizer decides as of the run whether the author may still apply
+// the transition.
+func contentScheduleAuthorContext(ctx context.Context, schedule ContentSchedule) context.Context {
+	return withAdminRouterIdentity(ctx, ensureAdminRouterActor(adminRouterIdentity{
+		userID:   schedule.CreatedBy,
+		tenantID: schedule.TenantID,
+		orgID:    schedule.OrgID,
+	}))
+}
+
+func (p *Panel) applyContentSchedule(ctx AdminContext, schedule ContentSchedule, notifications NotificationService) (ContentSchedule, error) {
+	record, err := p.repo.Get(ctx.Context, schedule.RecordID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			schedule.Status = ContentScheduleStatusSkipped
+			schedule.Error = "record not found"
+			return schedule, nil
+		}
+		return schedule, err
+	}
+	state := strings.TrimSpace(toString(record["status"]))
+	transitions, err := workflowSnapshotTransitions(ctx.Context, p.workflow, p.name, schedule.RecordID, state, record, false)
+	if err != nil {
+		return schedule, err
+	}
+	var transition *WorkflowTransitionInfo
+	candidates := workflowTransitionCandidates(schedule.Action)
+	for i := range transitions {
+		if containsTransitionEvent(candidates, transitions[i].Event) {
+			transition = &transitions[i]
+			break
+		}
+	}
+	if transition == nil {
+		schedule.Status = ContentScheduleStatusSkipped
+		schedule.Error = fmt.Sprintf("transition %q not available from state %q", schedule.Action, state)
+		return schedule, nil
+	}
+	event := strings.TrimSpace(transition.Event)
+	target := workflowTransitionTargetState(*transition)
+
+	policyInput := buildTranslationPolicyInput(ctx.Context, p.name, schedule.RecordID, state, event, record)
+	if policyInput.RequestedLocale == "" {
+		policyInput.RequestedLocale = requestedLocaleFromPayload(record, localeFromContext(ctx.Context))
+	}
+	if policyInput.Environment == "" {
+		policyInput.Environment = resolvePolicyEnvironment(record, environmentFromContext(ctx.Context))
+	}
+	if policyInput.PolicyEntity == "" {
+		policyInput.PolicyEntity = resolvePolicyEntity(record, p.name)
+	}
+	if err := applyTranslationPolicyWithQueueHook(ctx.Context, p.translationPolicy, policyInput, record, p.translationQueueAutoCreateHook); err != nil {
+		var missing MissingTranslationsError
+		if !errors.As(err, &missing) {
+			return schedule, err
+		}
+		schedule.Status = ContentScheduleStatusBlocked
+		schedule.Error = err.Error()
+		p.reportBlockedContentSchedule(ctx, schedule, policyInput, missing, notifications)
+		return schedule, nil
+	}
+
+	req := buildWorkflowApplyRequest(ctx.Context, p.name, schedule.RecordID, state, target, map[string]any{
+		"idempotency_key": "content_schedule:" + schedule.ID,
+	})
+	req.Event = event
+	if p.workflowAuth != nil {
+		authorCtx := contentScheduleAuthorContext(ctx.Context, schedule)
+		authorReq := buildWorkflowApplyRequest(authorCtx, p.name, schedule.RecordID, state, target, nil)
+		authorReq.Event = event
+		if !p.workflowAuth.CanApplyEvent(authorCtx, authorReq) {
+			return schedule, permissionDenied("workflow.transition", p.name)
+		}
+	}
+	response, err := p.workflow.ApplyEvent(ctx.Context, req)
+	if err != nil {
+		return schedule, err
+	}
+	nextState := workflowCurrentStateFromResponse(response)
+	if nextState == "" {
+		nextState = target
+	}
+	updateCtx := p.revisionContext(ctx)
+	updated, err := p.repo.Update(updateCtx, schedule.RecordID, map[string]any{"status": nextState})
+	if err != nil {
+		return schedule, err
+	}
+	var revisionErr error
+	if p.capturesRevisions() {
+		revisionErr = captureRevision(updateCtx, p.revisions, p.revisionResource(), schedule.RecordID, record, updated)
+		if revisionErr != nil {
+			if err := p.handleRevisionCaptureFailure(ctx.Context, schedule.RecordID, record, revisionErr); err != nil {
+				return schedule, err
+			}
+		}
+	}
+	appliedAt := time.Now().UTC()
+	schedule.Status = ContentScheduleStatusApplied
+	schedule.State = nextState
+	schedule.Error = ""
+	schedule.AppliedAt = &appliedAt
+	metadata := map[string]any{
+		"id":          schedule.RecordID,
+		"panel":       p.name,
+		"action":      schedule.Action,
+		"schedule_id": schedule.ID,
+		"transition":  event,
+		"from_state":  state,
+		"to_state":    nextState,
+	}
+	if revisionErr != nil {
+		metadata["revision_error"] = revisionErr.Error()
+	}
+	p.recordActivity(ctx, "panel.schedule.applied", tagActivityActorType(metadata, ActivityActorTypeSystem))
+	return schedule, nil
+}
+
+func (p *Panel) reportBlockedContentSchedule(ctx AdminContext, schedule ContentSchedule, input TranslationPolicyInput, missing MissingTranslationsError, notifications NotificationService) {
+	metadata := map[string]any{
+		"id":               schedule.RecordID,
+		"panel":            p.name,
+		"action":           schedule.Action,
+		"schedule_id":      schedule.ID,
+		"locale":           strings.TrimSpace(input.RequestedLocale),
+		"channel":          strings.TrimSpace(input.Environment),
+		"policy_entity":    strings.TrimSpace(input.PolicyEntity),
+		"translation_code": TextCodeTranslationMissing,
+		"missing_locales":  normalizeLocaleList(missing.MissingLocales),
+	}
+	p.recordActivity(ctx, "panel.schedule.blocked", tagActivityActorType(metadata, ActivityActorTypeSystem))
+	target := strings.TrimSpace(schedule.CreatedBy)
+	if notifications == nil || target == "" || target == ActivityActorTypeSystem {
+		return
+	}
+	title := "Scheduled Publish Blocked"
+	if schedule.Action == ContentScheduleActionUnpublish {
+		title = "Scheduled Unpublish Blocked"
+	}
+	_, _ = notifications.Add(ctx.Context, Notification{ //nolint:errcheck // notification delivery must not fail the schedule run.
+		Title:    title,
+		Message:  fmt.Sprintf("%s %s is missing translations: %s", p.name, schedule.RecordID, strings.Join(normalizeLocaleList(missing.MissingLocales), ", ")),
+		Metadata: metadata,
+		UserID:   target,
+	})
+}
+
+func (p *Panel) requireSchedules() error {
+	if p.SchedulesEnabled() {
+		return nil
+	}
+	return notFoundDomainError("panel content scheduling not enabled", map[string]any{
+		"component": "panel",
+		"panel":     p.name,
+	})
+}
+
+// serveScheduleSubresource answers the built-in schedule and unschedule
+// subresources. Permissions were checked against the subresource spec.
+func (p *Panel) serveScheduleSubresource(ctx AdminContext, c router.Context, id, subresource, value string) (bool, error) {
+	if !p.SchedulesEnabled() {
+		return false, nil
+	}
+	switch strings.ToLower(subresource) {
+	case PanelSubresourceSchedule:
+		if !strings.EqualFold(value, panelScheduleListValue) {
+			return true, ErrNotFound
+		}
+	case PanelSubresourceUnschedule:
+		if err := p.CancelSchedule(ctx, id, value); err != nil {
+			return true, err
+		}
+	default:
+		return false, nil
+	}
+	schedules, err := p.Schedules(ctx, id)
+	if err != nil {
+		return true, err
+	}
+	return true, c.JSON(200, map[string]any{"data": schedules})
+}
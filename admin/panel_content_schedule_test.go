@@ -0,0 +1,354 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func scheduledPagesPanel(t *testing.T, repo Repository, store ContentScheduleStore, sink ActivitySink, policy TranslationPolicy) *Panel {
+	t.Helper()
+	engine := NewFSMWorkflowEngine()
+	if err := engine.RegisterWorkflow("pages", WorkflowDefinition{
+		EntityType:   "pages",
+		InitialState: "draft",
+		Transitions: []WorkflowTransition{
+			{Name: "submit_for_approval", From: "draft", To: "approval"},
+			{Name: "publish", From: "approval", To: "published"},
+			{Name: "unpublish", From: "published", To: "draft"},
+		},
+	}); err != nil {
+		t.Fatalf("register workflow: %v", err)
+	}
+	builder := (&PanelBuilder{name: "pages"}).
+		WithRepository(repo).
+		WithWorkflow(engine).
+		WithActivitySink(sink).
+		WithContentSchedules(store)
+	if policy != nil {
+		builder.WithTranslationPolicy(policy)
+	}
+	panel, err := builder.Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	return panel
+}
+
+func TestPanelContentScheduleAppliesWorkflowTransitions(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := NewInMemoryContentScheduleStore()
+	sink := &recordingSink{}
+	panel := scheduledPagesPanel(t, repo, store, sink, nil)
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	publishAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	unpublishAt := publishAt.Add(24 * time.Hour)
+
+	created, err := panel.Create(ctx, map[string]any{
+		"title":        "Launch",
+		"status":       "approval",
+		"publish_at":   publishAt.Format(time.RFC3339),
+		"unpublish_at": unpublishAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := toString(created["id"])
+	stored, _ := repo.Get(context.Background(), id)
+	if _, leaked := stored["publish_at"]; leaked {
+		t.Fatalf("expected schedule fields kept out of the repository, got %+v", stored)
+	}
+	records, _, err := panel.List(ctx, ListOptions{})
+	if err != nil || len(records) != 1 {
+		t.Fatalf("list: %v", err)
+	}
+	if records[0]["schedule_state"] != ContentScheduleStatusScheduled || records[0]["publish_at"] != publishAt.Format(time.RFC3339) {
+		t.Fatalf("expected list to show scheduled state, got %+v", records[0])
+	}
+
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("pages", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	cmd := &ContentScheduleCommand{Registry: registry, Now: func() time.Time { return publishAt.Add(time.Minute) }}
+	var result ContentScheduleResult
+	if err := cmd.Execute(context.Background(), ContentScheduleInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Applied != 1 {
+		t.Fatalf("expected publish applied, got %+v", result)
+	}
+	published, _ := repo.Get(context.Background(), id)
+	if published["status"] != "published" {
+		t.Fatalf("expected record published, got %+v", published)
+	}
+	last := sink.entries[len(sink.entries)-1]
+	if last.Action != "panel.schedule.applied" || last.Actor != ActivityActorTypeSystem || last.Metadata["to_state"] != "published" {
+		t.Fatalf("expected system schedule activity, got %+v", last)
+	}
+	if err := cmd.Execute(context.Background(), ContentScheduleInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Applied != 0 {
+		t.Fatalf("expected applied schedule not to run twice, got %+v", result)
+	}
+
+	cmd.Now = func() time.Time { return unpublishAt.Add(time.Minute) }
+	if err := cmd.Execute(context.Background(), ContentScheduleInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	unpublished, _ := repo.Get(context.Background(), id)
+	if result.Applied != 1 || unpublished["status"] != "draft" {
+		t.Fatalf("expected unpublish applied, got %+v / %+v", result, unpublished)
+	}
+	schedules, err := panel.Schedules(ctx, id)
+	if err != nil || len(schedules) != 2 {
+		t.Fatalf("expected two schedules, got %+v (%v)", schedules, err)
+	}
+	for _, schedule := range schedules {
+		if schedule.Status != ContentScheduleStatusApplied || schedule.AppliedAt == nil || schedule.CreatedBy != "editor-1" {
+			t.Fatalf("expected applied schedule, got %+v", schedule)
+		}
+	}
+}
+
+func TestPanelContentScheduleCancelAndSkip(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := NewInMemoryContentScheduleStore()
+	panel := scheduledPagesPanel(t, repo, store, nil, nil)
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	publishAt := time.Now().UTC().Add(time.Hour)
+
+	if _, err := panel.Create(ctx, map[string]any{
+		"title":        "Bad",
+		"publish_at":   publishAt.Format(time.RFC3339),
+		"unpublish_at": publishAt.Add(-time.Minute).Format(time.RFC3339),
+	}); err == nil {
+		t.Fatalf("expected unpublish_at before publish_at to be rejected")
+	}
+	created, err := panel.Create(ctx, map[string]any{"title": "Draft", "status": "draft", "publish_at": publishAt.Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := toString(created["id"])
+	updated, err := panel.Update(ctx, id, map[string]any{"title": "Draft", "publish_at": ""})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, scheduled := updated["schedule_state"]; scheduled {
+		t.Fatalf("expected empty publish_at to cancel the schedule, got %+v", updated)
+	}
+	if err := panel.CancelSchedule(ctx, id, ContentScheduleActionPublish); err == nil {
+		t.Fatalf("expected no pending schedule left to cancel")
+	}
+
+	if _, err := panel.Update(ctx, id, map[string]any{"publish_at": publishAt.Format(time.RFC3339)}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	pending, _ := store.ListSchedules(context.Background(), ContentScheduleFilter{Statuses: []string{ContentScheduleStatusScheduled}})
+	if len(pending) != 1 {
+		t.Fatalf("expected one pending schedule, got %+v", pending)
+	}
+	applied, err := panel.ApplyContentSchedule(context.Background(), pending[0], nil)
+	if err != nil || applied.Status != ContentScheduleStatusSkipped {
+		t.Fatalf("expected publish from draft skipped, got %+v (%v)", applied, err)
+	}
+	record, _ := repo.Get(context.Background(), id)
+	if record["status"] != "draft" {
+		t.Fatalf("expected skipped schedule to leave the record alone, got %+v", record)
+	}
+	if again, _ := panel.ApplyContentSchedule(context.Background(), pending[0], nil); again.Status != ContentScheduleStatusScheduled {
+		t.Fatalf("expected finished schedule not to be claimed again, got %+v", again)
+	}
+}
+
+func TestPanelContentScheduleBlockedByTranslationPolicyNotifies(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := NewInMemoryContentScheduleStore()
+	sink := &recordingSink{}
+	policy := TranslationPolicyFunc(func(_ context.Context, input TranslationPolicyInput) error {
+		return MissingTranslationsError{EntityType: input.EntityType, EntityID: input.EntityID, Transition: input.Transition, MissingLocales: []string{"es"}}
+	})
+	panel := scheduledPagesPanel(t, repo, store, sink, policy)
+	notifications := NewInMemoryNotificationService()
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	publishAt := time.Now().UTC().Add(-time.Minute)
+	created, _ := repo.Create(context.Background(), map[string]any{"title": "Hola", "status": "approval", "locale": "en"})
+	id := toString(created["id"])
+	if _, err := panel.Update(ctx, id, map[string]any{"publish_at": publishAt.Format(time.RFC3339)}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	pending, _ := store.ListSchedules(context.Background(), ContentScheduleFilter{Panel: "pages"})
+
+	applied, err := panel.ApplyContentSchedule(context.Background(), pending[0], notifications)
+	if err != nil || applied.Status != ContentScheduleStatusBlocked {
+		t.Fatalf("expected schedule blocked, got %+v (%v)", applied, err)
+	}
+	record, _ := repo.Get(context.Background(), id)
+	if record["status"] != "approval" {
+		t.Fatalf("expected blocked schedule to keep the record in approval, got %+v", record)
+	}
+	inbox, _ := notifications.List(context.Background())
+	if len(inbox) != 1 || inbox[0].UserID != "editor-1" || inbox[0].Title != "Scheduled Publish Blocked" {
+		t.Fatalf("expected author notified of the blocker, got %+v", inbox)
+	}
+	last := sink.entries[len(sink.entries)-1]
+	if last.Action != "panel.schedule.blocked" || last.Metadata["translation_code"] != TextCodeTranslationMissing {
+		t.Fatalf("expected blocked schedule activity, got %+v", last)
+	}
+}
+
+type scheduleAuthorizer struct {
+	allowed map[string]bool
+	tenants []string
+}
+
+func (a *scheduleAuthorizer) CanApplyEvent(ctx context.Context, input WorkflowApplyEventRequest) bool {
+	a.tenants = append(a.tenants, tenantIDFromContext(ctx))
+	return a.allowed[userIDFromContext(ctx)+":"+input.Event]
+}
+
+func TestPanelContentScheduleChecksWorkflowAuthorizerOnSaveAndRun(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := NewInMemoryContentScheduleStore()
+	panel := scheduledPagesPanel(t, repo, store, nil, nil)
+	authz := &scheduleAuthorizer{allowed: map[string]bool{"editor-1:publish": true}}
+	panel.workflowAuth = authz
+	publishAt := time.Now().UTC().Add(time.Hour)
+
+	intern := AdminContext{Context: context.Background(), UserID: "intern-1"}
+	if _, err := panel.Create(intern, map[string]any{"title": "Sneaky", "status": "approval", "publish_at": publishAt.Format(time.RFC3339)}); err == nil {
+		t.Fatalf("expected scheduling a transition the author may not apply to be denied")
+	}
+	if _, total, _ := repo.List(context.Background(), ListOptions{}); total != 0 {
+		t.Fatalf("expected denied schedule to stop the write, got %d records", total)
+	}
+
+	editor := AdminContext{Context: context.Background(), UserID: "editor-1", TenantID: "tenant-1", OrgID: "org-1"}
+	created, err := panel.Create(editor, map[string]any{"title": "Launch", "status": "approval", "publish_at": publishAt.Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	pending, _ := store.ListSchedules(context.Background(), ContentScheduleFilter{Statuses: []string{ContentScheduleStatusScheduled}})
+	if len(pending) != 1 || pending[0].TenantID != "tenant-1" || pending[0].OrgID != "org-1" || pending[0].CreatedBy != "editor-1" {
+		t.Fatalf("expected schedule stored with author scope, got %+v", pending)
+	}
+
+	delete(authz.allowed, "editor-1:publish")
+	authz.tenants = nil
+	applied, err := panel.ApplyContentSchedule(context.Background(), pending[0], nil)
+	if err == nil || applied.Status != ContentScheduleStatusFailed {
+		t.Fatalf("expected revoked author to fail the schedule, got %+v (%v)", applied, err)
+	}
+	if len(authz.tenants) != 1 || authz.tenants[0] != "tenant-1" {
+		t.Fatalf("expected the run to check the author in the schedule tenant, got %v", authz.tenants)
+	}
+	record, _ := repo.Get(context.Background(), toString(created["id"]))
+	if record["status"] != "approval" {
+		t.Fatalf("expected denied schedule to leave the record alone, got %+v", record)
+	}
+}
+
+func TestContentScheduleCommandRecoversStaleRunningSchedules(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := NewInMemoryContentScheduleStore()
+	panel := scheduledPagesPanel(t, repo, store, nil, nil)
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	publishAt := time.Now().UTC().Add(time.Hour)
+	if _, err := panel.Create(ctx, map[string]any{"title": "Launch", "status": "approval", "publish_at": publishAt.Format(time.RFC3339)}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	pending, _ := store.ListSchedules(context.Background(), ContentScheduleFilter{})
+	if claimed, err := store.TransitionSchedule(context.Background(), pending[0].ID, ContentScheduleStatusScheduled, ContentSchedule{Status: ContentScheduleStatusRunning}); err != nil || !claimed {
+		t.Fatalf("claim: %v", err)
+	}
+
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("pages", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	now := publishAt.Add(time.Minute)
+	cmd := &ContentScheduleCommand{Registry: registry, Now: func() time.Time { return now }, RunningLease: 2 * time.Hour}
+	var result ContentScheduleResult
+	if err := cmd.Execute(context.Background(), ContentScheduleInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Recovered != 0 || result.Applied != 0 {
+		t.Fatalf("expected a schedule within its lease left alone, got %+v", result)
+	}
+
+	cmd.RunningLease = 0
+	if err := cmd.Execute(context.Background(), ContentScheduleInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Recovered != 1 || result.Applied != 1 {
+		t.Fatalf("expected stale running schedule recovered and applied, got %+v", result)
+	}
+}
+
+func TestPanelContentSchedulesBatchDecorationAndRollBackFailedSaves(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := &flakyContentScheduleStore{ContentScheduleStore: NewInMemoryContentScheduleStore()}
+	panel := scheduledPagesPanel(t, repo, store, nil, nil)
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	publishAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+
+	created, err := panel.Create(ctx, map[string]any{"title": "Launch", "status": "approval", "publish_at": publishAt.Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := toString(created["id"])
+	for _, title := range []string{"Second", "Third"} {
+		if _, err := repo.Create(ctx.Context, map[string]any{"title": title, "status": "draft"}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	store.lists = 0
+	if records, _, err := panel.List(ctx, ListOptions{}); err != nil || len(records) != 3 {
+		t.Fatalf("list: %+v (%v)", records, err)
+	}
+	if store.lists != 1 {
+		t.Fatalf("expected one schedule lookup for the page, got %d", store.lists)
+	}
+
+	if _, err := panel.Update(ctx, id, map[string]any{"unpublish_at": publishAt.Add(-time.Minute).Format(time.RFC3339)}); err == nil {
+		t.Fatalf("expected unpublish_at before the pending publish_at to be rejected")
+	}
+
+	store.saveErr = errors.New("schedule store down")
+	if _, err := panel.Create(ctx, map[string]any{"title": "Orphan", "status": "approval", "publish_at": publishAt.Format(time.RFC3339)}); !errors.Is(err, store.saveErr) {
+		t.Fatalf("expected create to fail with the schedule error, got %v", err)
+	}
+	if _, total, _ := repo.List(ctx.Context, ListOptions{}); total != 3 {
+		t.Fatalf("expected the created record rolled back, got %d records", total)
+	}
+	if _, err := panel.Update(ctx, id, map[string]any{"title": "Renamed", "publish_at": publishAt.Add(time.Hour).Format(time.RFC3339)}); !errors.Is(err, store.saveErr) {
+		t.Fatalf("expected update to fail with the schedule error, got %v", err)
+	}
+	if record, _ := repo.Get(ctx.Context, id); record["title"] != "Launch" {
+		t.Fatalf("expected the update rolled back, got %+v", record)
+	}
+	pending, _ := store.ListSchedules(ctx.Context, ContentScheduleFilter{RecordIDs: []string{id}, Statuses: []string{ContentScheduleStatusScheduled}})
+	if len(pending) != 1 || !pending[0].RunAt.Equal(publishAt) {
+		t.Fatalf("expected the original publish schedule kept, got %+v", pending)
+	}
+}
+
+type flakyContentScheduleStore struct {
+	ContentScheduleStore
+	lists   int
+	saveErr error
+}
+
+func (s *flakyContentScheduleStore) SaveSchedule(ctx context.Context, schedule ContentSchedule) (ContentSchedule, error) {
+	if s.saveErr != nil {
+		return ContentSchedule{}, s.saveErr
+	}
+	return s.ContentScheduleStore.SaveSchedule(ctx, schedule)
+}
+
+func (s *flakyContentScheduleStore) ListSchedules(ctx context.Context, filter ContentScheduleFilter) ([]ContentSchedule, error) {
+	s.lists++
+	return s.ContentScheduleStore.ListSchedules(ctx, filter)
+}
@@ -44,7 +44,12 @@ func (p *Panel) Get(ctx AdminContext, id string) (map[string]any, error) {
 	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.View, p.name); err != nil {
 		return nil, err
 	}
-	return p.repo.Get(ctx.Context, id)
+	record, err := p.repo.Get(ctx.Context, id)
+	if err != nil {
+		return nil, err
+	}
+	p.decorateContentSchedules(ctx.Context, record)
+	return record, nil
 }
 
 // List retrieves records with permissions enforced.
@@ -52,7 +57,40 @@ func (p *Panel) List(ctx AdminContext, opts ListOptions) ([]map[string]any, int,
 	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.View, p.name); err != nil {
 		return nil, 0, err
 	}
-	return p.repo.List(ctx.Context, opts)
+	records, total, err := p.repo.List(ctx.Context, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	p.decorateContentSchedules(ctx.Context, records...)
+	return records, total, nil
+}
+
+// ListPage lists records with a keyset cursor when the repository implements
+// CursorRepository. Other repositories page by offset and reject cursors.
+func (p *Panel) ListPage(ctx AdminContext, opts ListOptions) (ListPage, error) {
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.View, p.name); err != nil {
+		return ListPage{}, err
+	}
+	if repo, ok := p.repo.(CursorRepository); ok {
+		page, err := repo.ListPage(ctx.Context, opts)
+		if err != nil {
+			return ListPage{}, err
+		}
+		p.decorateContentSchedules(ctx.Context, page.Records...)
+		return page, nil
+	}
+	if strings.TrimSpace(opts.Cursor) != "" {
+		return ListPage{}, validationDomainError("cursor pagination is not supported", map[string]any{
+			"field": "cursor",
+			"panel": p.name,
+		})
+	}
+	records, total, err := p.repo.List(ctx.Context, opts)
+	if err != nil {
+		return ListPage{}, err
+	}
+	p.decorateContentSchedules(ctx.Context, records...)
+	return ListPage{Records: records, Total: total}, nil
 }
 
 // Create inserts a record with hooks and permissions.
@@ -60,6 +98,19 @@ func (p *Panel) Create(ctx AdminContext, record map[string]any) (map[string]any,
 	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.Create, p.name); err != nil {
 		return nil, err
 	}
+	return p.createRecord(ctx, record)
+}
+
+// createRecord runs the create pipeline for callers that already checked the
+// create permission.
+func (p *Panel) createRecord(ctx AdminContext, record map[string]any) (map[string]any, error) {
+	schedules, err := p.extractContentSchedules(record)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.authorizeContentSchedules(ctx, "", schedules); err != nil {
+		return nil, err
+	}
 	if p.hooks.BeforeCreate != nil {
 		if err := p.hooks.BeforeCreate(ctx, record); err != nil {
 			return nil, err
@@ -69,6 +120,12 @@ func (p *Panel) Create(ctx AdminContext, record map[string]any) (map[string]any,
 	if err != nil {
 		return nil, err
 	}
+	if err := p.saveContentSchedules(ctx, extractRecordID(res), schedules); err != nil {
+		return nil, p.rollbackContentScheduleWrite(extractRecordID(res), err, func() error {
+			return p.repo.Delete(ctx.Context, extractRecordID(res))
+		})
+	}
+	p.decorateContentSchedules(ctx.Context, res)
 	if p.hooks.AfterCreate != nil {
 		if err := p.hooks.AfterCreate(ctx, res); err != nil {
 			return nil, err
@@ -86,6 +143,22 @@ func (p *Panel) Update(ctx AdminContext, id string, record map[string]any) (map[
 	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.Edit, p.name); err != nil {
 		return nil, err
 	}
+	return p.updateRecord(ctx, id, record)
+}
+
+// updateRecord runs the update pipeline for callers that already checked the
+// edit permission.
+func (p *Panel) updateRecord(ctx AdminContext, id string, record map[string]any) (map[string]any, error) {
+	schedules, err := p.extractContentSchedules(record)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.authorizeContentSchedules(ctx, id, schedules); err != nil {
+		return nil, err
+	}
+	if err := p.validateContentSchedules(ctx.Context, id, schedules); err != nil {
+		return nil, err
+	}
 	if p.hooks.BeforeUpdateWithID != nil {
 		if err := p.hooks.BeforeUpdateWithID(ctx, id, record); err != nil {
 			p.recordBlockedTranslation(ctx, id, record, err)
@@ -98,19 +171,50 @@ func (p *Panel) Update(ctx AdminContext, id string, record map[string]any) (map[
 			return nil, err
 		}
 	}
-	res, err := p.repo.Update(ctx.Context, id, record)
+	updateCtx := p.revisionContext(ctx)
+	// The before snapshot feeds the revision diff and undoes the update when
+	// its schedules cannot be saved.
+	var before map[string]any
+	if p.capturesRevisions() || len(schedules) > 0 {
+		if before, err = p.repo.Get(ctx.Context, id); err != nil {
+			if p.revisionsRequired || len(schedules) > 0 {
+				return nil, err
+			}
+			before = nil // a missing snapshot records every field as added.
+		}
+	}
+	res, err := p.repo.Update(updateCtx, id, record)
 	if err != nil {
 		return nil, err
 	}
+	if err := p.saveContentSchedules(ctx, extractRecordID(res, id), schedules); err != nil {
+		return nil, p.rollbackContentScheduleWrite(id, err, func() error {
+			return p.restoreRecordSnapshot(ctx.Context, id, before)
+		})
+	}
+	var revisionErr error
+	if p.capturesRevisions() {
+		revisionErr = captureRevision(updateCtx, p.revisions, p.revisionResource(), extractRecordID(res, id), before, res)
+		if revisionErr != nil {
+			if err := p.handleRevisionCaptureFailure(ctx.Context, id, before, revisionErr); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p.decorateContentSchedules(ctx.Context, res)
 	if p.hooks.AfterUpdate != nil {
 		if err := p.hooks.AfterUpdate(ctx, res); err != nil {
 			return nil, err
 		}
 	}
-	p.recordActivity(ctx, "panel.update", map[string]any{
+	metadata := map[string]any{
 		"id":    extractRecordID(res, id),
 		"panel": p.name,
-	})
+	}
+	if revisionErr != nil {
+		metadata["revision_error"] = revisionErr.Error()
+	}
+	p.recordActivity(ctx, "panel.update", metadata)
 	return res, nil
 }
 
@@ -120,13 +224,29 @@ func (p *Panel) Delete(ctx AdminContext, id string) error {
 		captureActionExecutionFailureDiagnostic(ctx.Context, p.name, "delete", ActionScopeDetail, "permission", id, []string{id}, err)
 		return err
 	}
+	return p.deleteRecord(ctx, id)
+}
+
+// deleteRecord runs the delete pipeline for callers that already checked the
+// delete permission. Soft-delete panels move the record to the trash.
+func (p *Panel) deleteRecord(ctx AdminContext, id string) error {
 	if p.hooks.BeforeDelete != nil {
 		if err := p.hooks.BeforeDelete(ctx, id); err != nil {
 			captureActionExecutionFailureDiagnostic(ctx.Context, p.name, "delete", ActionScopeDetail, "before_delete_hook", id, []string{id}, err)
 			return err
 		}
 	}
-	if err := p.repo.Delete(ctx.Context, id); err != nil {
+	trash := p.trashRepository()
+	if trash != nil {
+		deletedBy := ctx.UserID
+		if deletedBy == "" {
+			deletedBy = actorFromContext(ctx.Context)
+		}
+		if err := trash.SoftDelete(ctx.Context, id, deletedBy); err != nil {
+			captureActionExecutionFailureDiagnostic(ctx.Context, p.name, "delete", ActionScopeDetail, "repository_soft_delete", id, []string{id}, err)
+			return err
+		}
+	} else if err := p.repo.Delete(ctx.Context, id); err != nil {
 		captureActionExecutionFailureDiagnostic(ctx.Context, p.name, "delete", ActionScopeDetail, "repository_delete", id, []string{id}, err)
 		return err
 	}
@@ -136,10 +256,14 @@ func (p *Panel) Delete(ctx AdminContext, id string) error {
 			return err
 		}
 	}
-	p.recordActivity(ctx, "panel.delete", map[string]any{
+	metadata := map[string]any{
 		"id":    id,
 		"panel": p.name,
-	})
+	}
+	if trash != nil {
+		metadata["soft_delete"] = true
+	}
+	p.recordActivity(ctx, "panel.delete", metadata)
 	return nil
 }
 
@@ -148,7 +272,9 @@ func (p *Panel) Subresources() []PanelSubresource {
 	if p == nil {
 		return nil
 	}
-	return clonePanelSubresources(normalizePanelSubresources(p.subresources))
+	declared := append(clonePanelSubresources(p.subresources), p.revisionSubresources()...)
+	declared = append(declared, p.scheduleSubresources()...)
+	return clonePanelSubresources(normalizePanelSubresources(declared))
 }
 
 // ServeSubresource resolves a panel subresource request.
@@ -170,6 +296,12 @@ func (p *Panel) ServeSubresource(ctx AdminContext, c router.Context, id, subreso
 	}
 	id = strings.TrimSpace(id)
 	value = strings.TrimSpace(value)
+	if handled, err := p.serveRevisionSubresource(ctx, c, id, spec.Name, value); handled {
+		return err
+	}
+	if handled, err := p.serveScheduleSubresource(ctx, c, id, spec.Name, value); handled {
+		return err
+	}
 	if responder, ok := p.repo.(PanelSubresourceResponder); ok && responder != nil {
 		return responder.ServePanelSubresource(ctx, c, id, spec.Name, value)
 	}
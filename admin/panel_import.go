@@ -0,0 +1,1139 @@
+package admin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	jsonschema "github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Panel import formats.
+const (
+	PanelImportFormatCSV    = "csv"
+	PanelImportFormatXLSX   = "xlsx"
+	PanelImportFormatNDJSON = "ndjson"
+)
+
+// Panel import row actions.
+const (
+	PanelImportActionCreate = "create"
+	PanelImportActionUpdate = "update"
+)
+
+// Panel import row statuses. Valid is only reported by dry runs; the other
+// statuses track a row through an import job.
+const (
+	PanelImportRowStatusValid      = "valid"
+	PanelImportRowStatusPending    = "pending"
+	PanelImportRowStatusProcessing = "processing"
+	PanelImportRowStatusCreated    = "created"
+	PanelImportRowStatusUpdated    = "updated"
+	PanelImportRowStatusFailed     = "failed"
+)
+
+const (
+	defaultPanelImportKeyField = "id"
+	defaultPanelImportMaxRows  = 10000
+	panelImportMaxFileBytes    = 32 << 20
+	panelImportMaxXLSXPart     = 128 << 20
+	panelImportMaxXLSXColumns  = 16384
+	panelImportSkipColumn      = "-"
+	panelImportSchemaResource  = "inmemory://admin/panel_import_schema.json"
+)
+
+// PanelImportConfig opts a panel into imports. KeyField names the field used
+// to match rows to existing records (default "id"): rows whose key matches a
+// record update it, the rest create records. MaxRows caps a single file
+// (default 10000). Import jobs are visible to the user who started them;
+// holders of ManagePermission see every job of their tenant and org.
+type PanelImportConfig struct {
+	Enabled          bool   `json:"enabled"`
+	KeyField         string `json:"key_field,omitempty"`
+	MaxRows          int    `json:"max_rows,omitempty"`
+	ManagePermission string `json:"manage_permission,omitempty"`
+}
+
+// Import enables CSV, XLSX, and NDJSON imports for the panel.
+func (b *PanelBuilder) Import(cfg PanelImportConfig) *PanelBuilder {
+	b.imports = cfg
+	return b
+}
+
+// ImportEnabled reports whether the panel accepts imports.
+func (p *Panel) ImportEnabled() bool {
+	return p != nil && p.imports.Enabled
+}
+
+// canManageImports reports whether the caller may see other users' import jobs.
+func (p *Panel) canManageImports(ctx context.Context) bool {
+	permission := strings.TrimSpace(p.imports.ManagePermission)
+	return permission != "" && requirePermissionWithAuthorizer(p.authorizer, ctx, permission, p.name) == nil
+}
+
+func (p *Panel) importKeyField() string {
+	if key := strings.TrimSpace(p.imports.KeyField); key != "" {
+		return key
+	}
+	return defaultPanelImportKeyField
+}
+
+func (p *Panel) importMaxRows() int {
+	if p.imports.MaxRows > 0 {
+		return p.imports.MaxRows
+	}
+	return defaultPanelImportMaxRows
+}
+
+func (p *Panel) requireImport(ctx AdminContext) error {
+	if !p.ImportEnabled() {
+		return notFoundDomainError("panel import not enabled", map[string]any{
+			"component": "panel",
+			"panel":     p.name,
+		})
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.Create, p.name); err != nil {
+		return err
+	}
+	return requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.Edit, p.name)
+}
+
+// PanelImportField is a panel field a source column can be mapped to.
+type PanelImportField struct {
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required,omitempty"`
+	Key      bool     `json:"key,omitempty"`
+	Options  []string `json:"options,omitempty"`
+}
+
+// ImportFields lists the import targets: the key field followed by the form
+// fields that are neither hidden nor read-only.
+func (p *Panel) ImportFields() []PanelImportField {
+	if p == nil {
+		return nil
+	}
+	key := p.importKeyField()
+	fields := []PanelImportField{}
+	keyed := false
+	for _, field := range p.formFields {
+		isKey := field.Name == key
+		if (field.Hidden || field.ReadOnly) && !isKey {
+			continue
+		}
+		target := PanelImportField{
+			Name:     field.Name,
+			Label:    field.Label,
+			Type:     field.Type,
+			Required: field.Required,
+			Key:      isKey,
+		}
+		for _, option := range field.Options {
+			target.Options = append(target.Options, toString(option.Value))
+		}
+		if isKey {
+			keyed = true
+			fields = append([]PanelImportField{target}, fields...)
+			continue
+		}
+		fields = append(fields, target)
+	}
+	if !keyed {
+		label := strings.ToUpper(key)
+		if key != defaultPanelImportKeyField {
+			label = key
+		}
+		fields = append([]PanelImportField{{Name: key, Label: label, Type: "text", Key: true}}, fields...)
+	}
+	return fields
+}
+
+// PanelImportTemplate is a downloadable file with one column per import field.
+type PanelImportTemplate struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"-"`
+}
+
+// ImportTemplate renders the panel's import template. CSV and XLSX templates
+// hold the header row; NDJSON holds one sample object.
+func (p *Panel) ImportTemplate(format string) (PanelImportTemplate, error) {
+	if !p.ImportEnabled() {
+		return PanelImportTemplate{}, notFoundDomainError("panel import not enabled", map[string]any{
+			"component": "panel",
+			"panel":     p.name,
+		})
+	}
+	fields := p.ImportFields()
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	format = normalizePanelImportFormat(format)
+	if format == "" {
+		format = PanelImportFormatCSV
+	}
+	template := PanelImportTemplate{FileName: p.name + "-import-template." + format}
+	var err error
+	switch format {
+	case PanelImportFormatCSV:
+		template.ContentType = "text/csv"
+		buf := &bytes.Buffer{}
+		writer := csv.NewWriter(buf)
+		if err = writer.Write(names); err == nil {
+			writer.Flush()
+			err = writer.Error()
+		}
+		template.Body = buf.Bytes()
+	case PanelImportFormatNDJSON:
+		template.ContentType = "application/x-ndjson"
+		sample := map[string]any{}
+		for _, field := range fields {
+			sample[field.Name] = panelImportSampleValue(field)
+		}
+		template.Body, err = json.Marshal(sample)
+		template.Body = append(template.Body, '\n')
+	case PanelImportFormatXLSX:
+		template.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		template.Body, err = writeXLSXHeader(names)
+	default:
+		return PanelImportTemplate{}, unsupportedPanelImportFormatError(format)
+	}
+	if err != nil {
+		return PanelImportTemplate{}, err
+	}
+	return template, nil
+}
+
+func panelImportSampleValue(field PanelImportField) any {
+	if len(field.Options) > 0 {
+		return field.Options[0]
+	}
+	if field.Key {
+		return ""
+	}
+	switch mapFieldType(field.Type) {
+	case "number":
+		return 0
+	case "boolean":
+		return false
+	case "array":
+		return []any{}
+	case "object":
+		return map[string]any{}
+	}
+	return ""
+}
+
+// PanelImportSource is a parsed import file: its columns in file order and one
+// map of column values per data row.
+type PanelImportSource struct {
+	Format  string           `json:"format"`
+	Columns []string         `json:"columns"`
+	Rows    []map[string]any `json:"rows"`
+}
+
+// ParsePanelImportSource reads a CSV, XLSX, or NDJSON file. Format wins over
+// the file name extension when both are given. CSV and XLSX files need a
+// header row; XLSX reads the first worksheet.
+func ParsePanelImportSource(format, fileName string, r io.Reader, maxRows int) (PanelImportSource, error) {
+	format = normalizePanelImportFormat(format)
+	if format == "" {
+		format = normalizePanelImportFormat(strings.TrimPrefix(filepath.Ext(strings.TrimSpace(fileName)), "."))
+	}
+	if r == nil {
+		return PanelImportSource{}, requiredFieldDomainError("file", map[string]any{"component": "panel_import"})
+	}
+	var (
+		source PanelImportSource
+		err    error
+	)
+	switch format {
+	case PanelImportFormatCSV:
+		source, err = parsePanelImportCSV(r)
+	case PanelImportFormatXLSX:
+		source, err = parsePanelImportXLSX(r, maxRows)
+	case PanelImportFormatNDJSON:
+		source, err = parsePanelImportNDJSON(r)
+	default:
+		return PanelImportSource{}, unsupportedPanelImportFormatError(format)
+	}
+	if err != nil {
+		return PanelImportSource{}, err
+	}
+	source.Format = format
+	if len(source.Rows) == 0 {
+		return PanelImportSource{}, validationDomainError("import file has no rows", map[string]any{
+			"component": "panel_import",
+			"format":    format,
+		})
+	}
+	if maxRows > 0 && len(source.Rows) > maxRows {
+		return PanelImportSource{}, panelImportTooManyRowsError(maxRows)
+	}
+	return source, nil
+}
+
+func panelImportTooManyRowsError(maxRows int) error {
+	return validationDomainError("import file has too many rows", map[string]any{
+		"component": "panel_import",
+		"max_rows":  maxRows,
+	})
+}
+
+func normalizePanelImportFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "":
+		return ""
+	case "csv", "text/csv":
+		return PanelImportFormatCSV
+	case "xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":
+		return PanelImportFormatXLSX
+	case "ndjson", "jsonl", "application/x-ndjson":
+		return PanelImportFormatNDJSON
+	}
+	return strings.ToLower(strings.TrimSpace(format))
+}
+
+func unsupportedPanelImportFormatError(format string) error {
+	return validationDomainError("unsupported import format", map[string]any{
+		"component": "panel_import",
+		"field":     "format",
+		"format":    format,
+		"supported": []string{PanelImportFormatCSV, PanelImportFormatXLSX, PanelImportFormatNDJSON},
+	})
+}
+
+func parsePanelImportCSV(r io.Reader) (PanelImportSource, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.LazyQuotes = true
+	grid, err := reader.ReadAll()
+	if err != nil {
+		return PanelImportSource{}, validationDomainError("invalid csv file", map[string]any{
+			"component": "panel_import",
+			"format":    PanelImportFormatCSV,
+			"error":     err.Error(),
+		})
+	}
+	return panelImportSourceFromGrid(grid), nil
+}
+
+// panelImportSourceFromGrid treats the first row as the header and skips
+// blank rows and unnamed or repeated columns.
+func panelImportSourceFromGrid(grid [][]string) PanelImportSource {
+	source := PanelImportSource{Columns: []string{}, Rows: []map[string]any{}}
+	if len(grid) == 0 {
+		return source
+	}
+	header := make([]string, len(grid[0]))
+	seen := map[string]bool{}
+	for i, cell := range grid[0] {
+		name := strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff"))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		header[i] = name
+		source.Columns = append(source.Columns, name)
+	}
+	for _, cells := range grid[1:] {
+		row := map[string]any{}
+		blank := true
+		for i, cell := range cells {
+			if i >= len(header) || header[i] == "" {
+				continue
+			}
+			row[header[i]] = cell
+			if strings.TrimSpace(cell) != "" {
+				blank = false
+			}
+		}
+		if !blank {
+			source.Rows = append(source.Rows, row)
+		}
+	}
+	return source
+}
+
+func parsePanelImportNDJSON(r io.Reader) (PanelImportSource, error) {
+	source := PanelImportSource{Columns: []string{}, Rows: []map[string]any{}}
+	seen := map[string]bool{}
+	decoder := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return PanelImportSource{}, validationDomainError("invalid ndjson file", map[string]any{
+				"component": "panel_import",
+				"format":    PanelImportFormatNDJSON,
+				"row":       line,
+				"error":     err.Error(),
+			})
+		}
+		row, ok := value.(map[string]any)
+		if !ok {
+			return PanelImportSource{}, validationDomainError("ndjson rows must be objects", map[string]any{
+				"component": "panel_import",
+				"format":    PanelImportFormatNDJSON,
+				"row":       line,
+			})
+		}
+		keys := make([]string, 0, len(row))
+		for key := range row {
+			if !seen[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			seen[key] = true
+			source.Columns = append(source.Columns, key)
+		}
+		source.Rows = append(source.Rows, row)
+	}
+	return source, nil
+}
+
+type xlsxRichText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+type xlsxRow struct {
+	Cells []struct {
+		Ref    string       `xml:"r,attr"`
+		Type   string       `xml:"t,attr"`
+		Value  string       `xml:"v"`
+		Inline xlsxRichText `xml:"is"`
+	} `xml:"c"`
+}
+
+// parsePanelImportXLSX reads cell text from the first worksheet. Numbers and
+// dates arrive as their stored text and are coerced per field afterwards.
+// Rows are decoded one at a time and reading stops past maxRows data rows.
+func parsePanelImportXLSX(r io.Reader, maxRows int) (PanelImportSource, error) {
+	invalid := func(err error) error {
+		return validationDomainError("invalid xlsx file", map[string]any{
+			"component": "panel_import",
+			"format":    PanelImportFormatXLSX,
+			"error":     err.Error(),
+		})
+	}
+	data, err := io.ReadAll(io.LimitReader(r, panelImportMaxFileBytes+1))
+	if err != nil {
+		return PanelImportSource{}, err
+	}
+	if len(data) > panelImportMaxFileBytes {
+		return PanelImportSource{}, validationDomainError("import file too large", map[string]any{
+			"component": "panel_import",
+			"max_bytes": panelImportMaxFileBytes,
+		})
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return PanelImportSource{}, invalid(err)
+	}
+	var shared xlsxSharedStrings
+	var sheet *zip.File
+	for _, file := range archive.File {
+		switch {
+		case file.Name == "xl/sharedStrings.xml":
+			if err := decodeXLSXPart(file, func(decoder *xml.Decoder) error { return decoder.Decode(&shared) }); err != nil {
+				return PanelImportSource{}, invalid(err)
+			}
+		case strings.HasPrefix(file.Name, "xl/worksheets/") && strings.HasSuffix(file.Name, ".xml"):
+			if sheet == nil || xlsxSheetLess(file.Name, sheet.Name) {
+				sheet = file
+			}
+		}
+	}
+	if sheet == nil {
+		return PanelImportSource{}, invalid(errors.New("workbook has no worksheet"))
+	}
+	grid := [][]string{}
+	tooMany := false
+	err = decodeXLSXPart(sheet, func(decoder *xml.Decoder) error {
+		for {
+			token, err := decoder.Token()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			start, ok := token.(xml.StartElement)
+			if !ok || start.Name.Local != "row" {
+				continue
+			}
+			var row xlsxRow
+			if err := decoder.DecodeElement(&row, &start); err != nil {
+				return err
+			}
+			cells := xlsxRowCells(row, shared)
+			if len(grid) > 0 && blankPanelImportCells(cells) {
+				continue
+			}
+			grid = append(grid, cells)
+			if maxRows > 0 && len(grid) > maxRows+1 {
+				tooMany = true
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return PanelImportSource{}, invalid(err)
+	}
+	if tooMany {
+		return PanelImportSource{}, panelImportTooManyRowsError(maxRows)
+	}
+	return panelImportSourceFromGrid(grid), nil
+}
+
+func xlsxRowCells(row xlsxRow, shared xlsxSharedStrings) []string {
+	cells := []string{}
+	for i, cell := range row.Cells {
+		col := xlsxColumnIndex(cell.Ref)
+		if col < 0 {
+			col = i
+		}
+		if col >= panelImportMaxXLSXColumns {
+			continue
+		}
+		for len(cells) <= col {
+			cells = append(cells, "")
+		}
+		switch cell.Type {
+		case "s":
+			if idx, err := strconv.Atoi(strings.TrimSpace(cell.Value)); err == nil && idx >= 0 && idx < len(shared.Items) {
+				cells[col] = shared.Items[idx].String()
+			}
+		case "inlineStr":
+			cells[col] = cell.Inline.String()
+		case "b":
+			cells[col] = strconv.FormatBool(strings.TrimSpace(cell.Value) == "1")
+		default:
+			cells[col] = cell.Value
+		}
+	}
+	return cells
+}
+
+func blankPanelImportCells(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeXLSXPart decodes one archive part. The inflated stream is capped so
+// a compressed bomb fails at the limit instead of exhausting memory.
+func decodeXLSXPart(file *zip.File, decode func(*xml.Decoder) error) error {
+	if file.UncompressedSize64 > panelImportMaxXLSXPart {
+		return fmt.Errorf("%s is larger than %d bytes uncompressed", file.Name, panelImportMaxXLSXPart)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+	limited := &io.LimitedReader{R: reader, N: panelImportMaxXLSXPart + 1}
+	if err := decode(xml.NewDecoder(limited)); err != nil {
+		if limited.N <= 0 {
+			return fmt.Errorf("%s is larger than %d bytes uncompressed", file.Name, panelImportMaxXLSXPart)
+		}
+		return err
+	}
+	return nil
+}
+
+// xlsxSheetLess orders sheet parts numerically so sheet2 sorts before sheet10.
+func xlsxSheetLess(a, b string) bool {
+	num := func(name string) int {
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "xl/worksheets/sheet"), ".xml")
+		n, err := strconv.Atoi(name)
+		if err != nil {
+			return int(^uint(0) >> 1)
+		}
+		return n
+	}
+	if na, nb := num(a), num(b); na != nb {
+		return na < nb
+	}
+	return a < b
+}
+
+// xlsxColumnIndex converts the letters of a cell reference such as "AB12" to
+// a zero-based column index.
+func xlsxColumnIndex(ref string) int {
+	col := 0
+	letters := 0
+	for _, r := range strings.ToUpper(ref) {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return -1
+	}
+	return col - 1
+}
+
+// writeXLSXHeader builds a single-sheet workbook whose first row holds names.
+func writeXLSXHeader(names []string) ([]byte, error) {
+	var cells strings.Builder
+	for i, name := range names {
+		ref := ""
+		for n := i + 1; n > 0; n = (n - 1) / 26 {
+			ref = string(rune('A'+(n-1)%26)) + ref
+		}
+		var text bytes.Buffer
+		if err := xml.EscapeText(&text, []byte(name)); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&cells, `<c r="%s1" t="inlineStr"><is><t>%s</t></is></c>`, ref, text.String())
+	}
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?><Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?><Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?><workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Import" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?><Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+		{"xl/worksheets/sheet1.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?><worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1">` + cells.String() + `</row></sheetData></worksheet>`},
+	}
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for _, part := range parts {
+		writer, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(writer, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// PanelImportMapping maps source columns to panel field names. Columns mapped
+// to "" or "-" are skipped.
+type PanelImportMapping map[string]string
+
+// SuggestImportMapping matches columns to import fields by name, then by
+// label, ignoring case, spaces, and dashes.
+func (p *Panel) SuggestImportMapping(columns []string) PanelImportMapping {
+	byName := map[string]string{}
+	byLabel := map[string]string{}
+	for _, field := range p.ImportFields() {
+		byName[normalizeImportColumn(field.Name)] = field.Name
+		if label := normalizeImportColumn(field.Label); label != "" {
+			if _, ok := byLabel[label]; !ok {
+				byLabel[label] = field.Name
+			}
+		}
+	}
+	mapping := PanelImportMapping{}
+	used := map[string]bool{}
+	for _, column := range columns {
+		key := normalizeImportColumn(column)
+		target, ok := byName[key]
+		if !ok {
+			target, ok = byLabel[key]
+		}
+		if ok && !used[target] {
+			mapping[column] = target
+			used[target] = true
+		}
+	}
+	return mapping
+}
+
+func normalizeImportColumn(raw string) string {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(raw)
+}
+
+// resolveImportMapping validates a requested mapping against the source
+// columns and import fields; nil falls back to the suggested mapping.
+func (p *Panel) resolveImportMapping(columns []string, requested PanelImportMapping) (PanelImportMapping, error) {
+	if requested == nil {
+		requested = p.SuggestImportMapping(columns)
+	}
+	known := map[string]bool{}
+	for _, column := range columns {
+		known[column] = true
+	}
+	targets := map[string]bool{}
+	for _, field := range p.ImportFields() {
+		targets[field.Name] = true
+	}
+	mapping := PanelImportMapping{}
+	used := map[string]string{}
+	for column, target := range requested {
+		target = strings.TrimSpace(target)
+		if target == "" || target == panelImportSkipColumn {
+			continue
+		}
+		meta := map[string]any{"component": "panel_import", "field": "mapping", "column": column, "target": target}
+		if !known[column] {
+			return nil, validationDomainError("mapping references an unknown column", meta)
+		}
+		if !targets[target] {
+			return nil, validationDomainError("mapping targets an unknown field", meta)
+		}
+		if other, ok := used[target]; ok {
+			meta["other_column"] = other
+			return nil, validationDomainError("mapping targets a field twice", meta)
+		}
+		used[target] = column
+		mapping[column] = target
+	}
+	if len(mapping) == 0 {
+		return nil, validationDomainError("no columns mapped to panel fields", map[string]any{
+			"component": "panel_import",
+			"field":     "mapping",
+			"columns":   columns,
+		})
+	}
+	return mapping, nil
+}
+
+// PanelImportRequest carries an uploaded file and its column mapping. A nil
+// Mapping uses SuggestImportMapping.
+type PanelImportRequest struct {
+	Format   string             `json:"format,omitempty"`
+	FileName string             `json:"file_name,omitempty"`
+	Reader   io.Reader          `json:"-"`
+	Mapping  PanelImportMapping `json:"mapping,omitempty"`
+}
+
+// PanelImportRow is one source row with its mapped values, the action it
+// resolves to, and its outcome. Index is the zero-based data row.
+type PanelImportRow struct {
+	Index    int               `json:"index"`
+	Action   string            `json:"action,omitempty"`
+	RecordID string            `json:"record_id,omitempty"`
+	Status   string            `json:"status"`
+	Error    string            `json:"error,omitempty"`
+	Fields   map[string]string `json:"fields,omitempty"`
+	Values   map[string]any    `json:"-"`
+}
+
+// PanelImportSummary counts row outcomes. Dry runs count planned creates and
+// updates; Succeeded counts valid rows there and applied rows in jobs.
+type PanelImportSummary struct {
+	Processed int `json:"processed"`
+	Succeeded int `json:"succeeded"`
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Failed    int `json:"failed"`
+}
+
+// PanelImportPreview is a dry-run report: the file's columns, the fields they
+// can map to, the mapping applied, and the validation result of every row.
+type PanelImportPreview struct {
+	Format   string             `json:"format"`
+	KeyField string             `json:"key_field"`
+	Columns  []string           `json:"columns"`
+	Fields   []PanelImportField `json:"fields"`
+	Mapping  PanelImportMapping `json:"mapping"`
+	Summary  PanelImportSummary `json:"summary"`
+	Rows     []PanelImportRow   `json:"rows"`
+}
+
+type panelImportPlan struct {
+	format  string
+	columns []string
+	mapping PanelImportMapping
+	rows    []PanelImportRow
+}
+
+// PreviewImport validates an import without writing: every row is mapped,
+// coerced to its field types, matched by key, and checked against the form
+// schema. Required fields are only enforced for rows that would create.
+func (p *Panel) PreviewImport(ctx AdminContext, req PanelImportRequest) (PanelImportPreview, error) {
+	if err := p.requireImport(ctx); err != nil {
+		return PanelImportPreview{}, err
+	}
+	plan, err := p.planImport(ctx.Context, req)
+	if err != nil {
+		return PanelImportPreview{}, err
+	}
+	preview := PanelImportPreview{
+		Format:   plan.format,
+		KeyField: p.importKeyField(),
+		Columns:  plan.columns,
+		Fields:   p.ImportFields(),
+		Mapping:  plan.mapping,
+		Rows:     plan.rows,
+	}
+	for _, row := range plan.rows {
+		preview.Summary.Processed++
+		if row.Status == PanelImportRowStatusFailed {
+			preview.Summary.Failed++
+			continue
+		}
+		preview.Summary.Succeeded++
+		if row.Action == PanelImportActionUpdate {
+			preview.Summary.Updated++
+		} else {
+			preview.Summary.Created++
+		}
+	}
+	return preview, nil
+}
+
+func (p *Panel) planImport(ctx context.Context, req PanelImportRequest) (panelImportPlan, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	source, err := ParsePanelImportSource(req.Format, req.FileName, req.Reader, p.importMaxRows())
+	if err != nil {
+		return panelImportPlan{}, err
+	}
+	mapping, err := p.resolveImportMapping(source.Columns, req.Mapping)
+	if err != nil {
+		return panelImportPlan{}, err
+	}
+	schema, err := p.importSchema()
+	if err != nil {
+		return panelImportPlan{}, err
+	}
+	fields := map[string]PanelImportField{}
+	for _, field := range p.ImportFields() {
+		fields[field.Name] = field
+	}
+	plan := panelImportPlan{format: source.Format, columns: source.Columns, mapping: mapping}
+	keys := map[string]int{}
+	for i, raw := range source.Rows {
+		row := p.planImportRow(ctx, i, raw, mapping, fields, schema)
+		if key := strings.TrimSpace(toString(row.Values[p.importKeyField()])); key != "" && row.Status != PanelImportRowStatusFailed {
+			if first, dup := keys[key]; dup {
+				failImportRow(&row, map[string]string{p.importKeyField(): fmt.Sprintf("duplicates row %d", first)})
+			} else {
+				keys[key] = i
+			}
+		}
+		plan.rows = append(plan.rows, row)
+	}
+	return plan, nil
+}
+
+func (p *Panel) planImportRow(ctx context.Context, index int, raw map[string]any, mapping PanelImportMapping, fields map[string]PanelImportField, schema *jsonschema.Schema) PanelImportRow {
+	row := PanelImportRow{Index: index, Status: PanelImportRowStatusValid, Values: map[string]any{}}
+	problems := map[string]string{}
+	for column, target := range mapping {
+		field := fields[target]
+		value, ok, err := coerceImportValue(field, raw[column])
+		if err != nil {
+			problems[target] = err.Error()
+			continue
+		}
+		if ok {
+			row.Values[target] = value
+		}
+	}
+	key := p.importKeyField()
+	recordID, err := p.findImportRecord(ctx, key, toString(row.Values[key]))
+	if err != nil {
+		problems[key] = err.Error()
+	}
+	row.RecordID = recordID
+	row.Action = PanelImportActionCreate
+	if recordID != "" {
+		row.Action = PanelImportActionUpdate
+	}
+	for name, field := range fields {
+		if _, ok := problems[name]; ok {
+			continue
+		}
+		value, present := row.Values[name]
+		if !present {
+			if field.Required && !field.Key && row.Action == PanelImportActionCreate {
+				problems[name] = "required"
+			}
+			continue
+		}
+		if msg := importOptionProblem(field, value); msg != "" {
+			problems[name] = msg
+		}
+	}
+	if schema != nil && len(problems) == 0 {
+		if err := schema.Validate(p.importSchemaInstance(row.Values)); err != nil {
+			collectImportSchemaProblems(err, problems)
+		}
+	}
+	if len(problems) > 0 {
+		failImportRow(&row, problems)
+	}
+	return row
+}
+
+func failImportRow(row *PanelImportRow, problems map[string]string) {
+	names := make([]string, 0, len(problems))
+	for name := range problems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+": "+problems[name])
+	}
+	row.Status = PanelImportRowStatusFailed
+	row.Fields = problems
+	row.Error = strings.Join(parts, "; ")
+}
+
+// findImportRecord returns the id of the record whose key field equals value,
+// or "" when none does.
+func (p *Panel) findImportRecord(ctx context.Context, key, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if key == defaultPanelImportKeyField {
+		record, err := p.repo.Get(ctx, value)
+		if errors.Is(err, ErrNotFound) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return extractRecordID(record, value), nil
+	}
+	records, _, err := p.repo.List(ctx, ListOptions{
+		Page:       1,
+		PerPage:    50,
+		Predicates: []ListPredicate{{Field: key, Operator: defaultListPredicateOperator, Values: []string{value}}},
+	})
+	if err != nil {
+		return "", err
+	}
+	ids := []string{}
+	for _, record := range records {
+		if strings.TrimSpace(toString(record[key])) == value {
+			ids = append(ids, extractRecordID(record))
+		}
+	}
+	if len(ids) > 1 {
+		return "", fmt.Errorf("matches %d records", len(ids))
+	}
+	if len(ids) == 1 {
+		return ids[0], nil
+	}
+	return "", nil
+}
+
+// coerceImportValue converts a cell to the field's JSON type. Blank cells
+// report ok=false so updates leave the field untouched.
+func coerceImportValue(field PanelImportField, raw any) (any, bool, error) {
+	if raw == nil {
+		return nil, false, nil
+	}
+	text, isText := raw.(string)
+	if isText {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, false, nil
+		}
+	}
+	if number, ok := raw.(json.Number); ok {
+		text, isText = number.String(), true
+	}
+	switch mapFieldType(field.Type) {
+	case "number":
+		if !isText {
+			switch raw.(type) {
+			case float64, float32, int, int32, int64:
+				return raw, true, nil
+			}
+			return nil, false, errors.New("must be a number")
+		}
+		if n, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return n, true, nil
+		}
+		if normalizeFieldTypeKey(field.Type) == "integer" {
+			return nil, false, errors.New("must be a whole number")
+		}
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, false, errors.New("must be a number")
+		}
+		return n, true, nil
+	case "boolean":
+		if b, ok := raw.(bool); ok {
+			return b, true, nil
+		}
+		switch strings.ToLower(text) {
+		case "true", "1", "yes", "y", "on":
+			return true, true, nil
+		case "false", "0", "no", "n", "off":
+			return false, true, nil
+		}
+		return nil, false, errors.New("must be true or false")
+	case "array":
+		if list, ok := raw.([]any); ok {
+			return list, true, nil
+		}
+		if !isText {
+			return nil, false, errors.New("must be a list")
+		}
+		if strings.HasPrefix(text, "[") {
+			var list []any
+			if err := json.Unmarshal([]byte(text), &list); err != nil {
+				return nil, false, errors.New("must be a JSON array")
+			}
+			return list, true, nil
+		}
+		list := []any{}
+		for part := range strings.SplitSeq(text, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				list = append(list, part)
+			}
+		}
+		return list, true, nil
+	case "object":
+		if object, ok := raw.(map[string]any); ok {
+			return object, true, nil
+		}
+		var object map[string]any
+		if !isText || json.Unmarshal([]byte(text), &object) != nil {
+			return nil, false, errors.New("must be a JSON object")
+		}
+		return object, true, nil
+	}
+	if isText {
+		return text, true, nil
+	}
+	switch typed := raw.(type) {
+	case bool, float64:
+		return toString(typed), true, nil
+	}
+	return nil, false, errors.New("must be text")
+}
+
+func importOptionProblem(field PanelImportField, value any) string {
+	if len(field.Options) == 0 {
+		return ""
+	}
+	allowed := map[string]bool{}
+	for _, option := range field.Options {
+		allowed[option] = true
+	}
+	values := []any{value}
+	if list, ok := value.([]any); ok {
+		values = list
+	}
+	for _, item := range values {
+		if !allowed[toString(item)] {
+			return "must be one of " + strings.Join(field.Options, ", ")
+		}
+	}
+	return ""
+}
+
+// importSchema compiles the panel form schema without its required list;
+// required fields are checked per action before schema validation runs.
+func (p *Panel) importSchema() (*jsonschema.Schema, error) {
+	schema := mergeFormSchemaWithFields(p.formSchema, p.formFields)
+	delete(schema, "required")
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(panelImportSchemaResource, bytes.NewReader(raw)); err != nil {
+		return nil, invalidPanelImportSchemaError(p.name, err)
+	}
+	compiled, err := compiler.Compile(panelImportSchemaResource)
+	if err != nil {
+		return nil, invalidPanelImportSchemaError(p.name, err)
+	}
+	return compiled, nil
+}
+
+func invalidPanelImportSchemaError(panel string, err error) error {
+	return validationDomainError("invalid panel form schema", map[string]any{
+		"component": "panel_import",
+		"panel":     panel,
+		"error":     strings.TrimSpace(err.Error()),
+	})
+}
+
+// importSchemaInstance drops a key field that is not part of the form so
+// schemas closed with additionalProperties still accept update rows.
+func (p *Panel) importSchemaInstance(values map[string]any) map[string]any {
+	out := primitives.CloneAnyMap(values)
+	key := p.importKeyField()
+	for _, field := range p.formFields {
+		if field.Name == key {
+			return out
+		}
+	}
+	delete(out, key)
+	return out
+}
+
+func collectImportSchemaProblems(err error, problems map[string]string) {
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		problems["record"] = err.Error()
+		return
+	}
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) > 0 {
+			for _, cause := range e.Causes {
+				walk(cause)
+			}
+			return
+		}
+		name, _, _ := strings.Cut(strings.TrimPrefix(e.InstanceLocation, "/"), "/")
+		if name == "" {
+			name = "record"
+		}
+		if _, ok := problems[name]; !ok {
+			problems[name] = e.Message
+		}
+	}
+	walk(verr)
+}
@@ -0,0 +1,637 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	"github.com/google/uuid"
+)
+
+// Panel import job statuses.
+const (
+	PanelImportJobStatusRunning   = "running"
+	PanelImportJobStatusCompleted = "completed"
+	PanelImportJobStatusFailed    = "failed"
+)
+
+const (
+	defaultPanelImportChunkSize = 200
+	defaultPanelImportLeaseTTL  = 2 * time.Minute
+)
+
+// PanelImportJob is a background import of one file into a panel. The actor
+// fields replay the starting user's scope when a job resumes after a restart.
+type PanelImportJob struct {
+	ID          string             `json:"id"`
+	Panel       string             `json:"panel"`
+	Status      string             `json:"status"`
+	Format      string             `json:"format"`
+	FileName    string             `json:"file_name,omitempty"`
+	KeyField    string             `json:"key_field"`
+	Mapping     PanelImportMapping `json:"mapping"`
+	Total       int                `json:"total"`
+	Processed   int                `json:"processed"`
+	Progress    float64            `json:"progress,omitempty"`
+	Summary     PanelImportSummary `json:"summary"`
+	CreatedBy   string             `json:"created_by,omitempty"`
+	TenantID    string             `json:"tenant_id,omitempty"`
+	OrgID       string             `json:"org_id,omitempty"`
+	Locale      string             `json:"locale,omitempty"`
+	StartedAt   time.Time          `json:"started_at"`
+	CompletedAt time.Time          `json:"completed_at"`
+}
+
+// PanelImportJobStore persists import jobs and their per-row progress.
+// ListJobRows returns rows in index order; empty statuses returns every row.
+type PanelImportJobStore interface {
+	CreateJob(ctx context.Context, job PanelImportJob, rows []PanelImportRow) error
+	GetJob(ctx context.Context, id string) (PanelImportJob, error)
+	ListJobs(ctx context.Context, panel string) ([]PanelImportJob, error)
+	ListActiveJobs(ctx context.Context) ([]PanelImportJob, error)
+	SaveJob(ctx context.Context, job PanelImportJob) error
+	ClaimJob(ctx context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error)
+	ReleaseJob(ctx context.Context, id, owner string) error
+	ListJobRows(ctx context.Context, jobID string, statuses []string, limit int) ([]PanelImportRow, error)
+	CountJobRows(ctx context.Context, jobID string, statuses []string) (int, error)
+	SaveJobRow(ctx context.Context, jobID string, row PanelImportRow) error
+}
+
+// PanelImportServiceOption configures a PanelImportService.
+type PanelImportServiceOption func(*PanelImportService)
+
+// WithPanelImportChunkSize sets how many rows are written between progress checkpoints.
+func WithPanelImportChunkSize(size int) PanelImportServiceOption {
+	return func(s *PanelImportService) {
+		if size > 0 {
+			s.chunkSize = size
+		}
+	}
+}
+
+// WithPanelImportLeaseTTL sets how long a worker owns a job without renewing its lease.
+func WithPanelImportLeaseTTL(ttl time.Duration) PanelImportServiceOption {
+	return func(s *PanelImportService) {
+		if ttl > 0 {
+			s.leaseTTL = ttl
+		}
+	}
+}
+
+// WithPanelImportLogger sets the logger used for background job failures.
+func WithPanelImportLogger(logger Logger) PanelImportServiceOption {
+	return func(s *PanelImportService) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
+
+// PanelImportService runs panel imports as background jobs. Start validates
+// the whole file like a dry run, persists every row, and upserts the valid
+// rows in chunks through the panel's create and update pipeline, so hooks,
+// revisions, schedules, search indexing, and activity apply per row. Call
+// Resume at startup to continue jobs interrupted by a restart.
+type PanelImportService struct {
+	store     PanelImportJobStore
+	registry  *Registry
+	logger    Logger
+	owner     string
+	chunkSize int
+	leaseTTL  time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	running map[string]struct{}
+	workers sync.WaitGroup
+}
+
+// NewPanelImportService builds an import service over the registry's panels.
+func NewPanelImportService(store PanelImportJobStore, registry *Registry, opts ...PanelImportServiceOption) (*PanelImportService, error) {
+	if store == nil {
+		return nil, serviceNotConfiguredDomainError("panel import job store", map[string]any{"component": "panel_import"})
+	}
+	if registry == nil {
+		return nil, serviceNotConfiguredDomainError("panel registry", map[string]any{"component": "panel_import"})
+	}
+	svc := &PanelImportService{
+		store:     store,
+		registry:  registry,
+		logger:    ensureLogger(nil),
+		owner:     "import_" + uuid.NewString(),
+		chunkSize: defaultPanelImportChunkSize,
+		leaseTTL:  defaultPanelImportLeaseTTL,
+		now:       time.Now,
+		running:   map[string]struct{}{},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(svc)
+		}
+	}
+	return svc, nil
+}
+
+// Start validates the file and queues its valid rows. Rows that fail
+// validation are stored as failed with their field errors.
+func (s *PanelImportService) Start(ctx AdminContext, panelName string, req PanelImportRequest) (PanelImportJob, error) {
+	if ctx.Context == nil {
+		ctx.Context = context.Background()
+	}
+	panel, err := s.panel(panelName)
+	if err != nil {
+		return PanelImportJob{}, err
+	}
+	if err := panel.requireImport(ctx); err != nil {
+		return PanelImportJob{}, err
+	}
+	plan, err := panel.planImport(ctx.Context, req)
+	if err != nil {
+		return PanelImportJob{}, err
+	}
+	job := PanelImportJob{
+		ID:        uuid.NewString(),
+		Panel:     panel.name,
+		Status:    PanelImportJobStatusRunning,
+		Format:    plan.format,
+		FileName:  strings.TrimSpace(req.FileName),
+		KeyField:  panel.importKeyField(),
+		Mapping:   plan.mapping,
+		Total:     len(plan.rows),
+		CreatedBy: ctx.UserID,
+		TenantID:  ctx.TenantID,
+		OrgID:     ctx.OrgID,
+		Locale:    ctx.Locale,
+		StartedAt: s.now(),
+	}
+	rows := make([]PanelImportRow, 0, len(plan.rows))
+	for _, row := range plan.rows {
+		if row.Status == PanelImportRowStatusValid {
+			row.Status = PanelImportRowStatusPending
+		} else {
+			job.Summary.Failed++
+		}
+		rows = append(rows, row)
+	}
+	job.Summary.Processed = job.Summary.Failed
+	job.Processed = job.Summary.Processed
+	if err := s.store.CreateJob(ctx.Context, job, rows); err != nil {
+		return PanelImportJob{}, err
+	}
+	ctx.Context = context.WithoutCancel(ctx.Context)
+	s.spawn(ctx, job.ID)
+	return withPanelImportProgress(job), nil
+}
+
+// Job returns a single persisted job. Jobs the caller may not see are
+// reported as not found.
+func (s *PanelImportService) Job(ctx AdminContext, id string) (PanelImportJob, error) {
+	job, err := s.visibleJob(ctx, id)
+	if err != nil {
+		return PanelImportJob{}, err
+	}
+	return withPanelImportProgress(job), nil
+}
+
+// Jobs returns the caller's visible jobs of a panel newest first; an empty
+// panel lists every panel.
+func (s *PanelImportService) Jobs(ctx AdminContext, panel string) ([]PanelImportJob, error) {
+	if ctx.Context == nil {
+		ctx.Context = context.Background()
+	}
+	jobs, err := s.store.ListJobs(ctx.Context, strings.TrimSpace(panel))
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]PanelImportJob, 0, len(jobs))
+	for _, job := range jobs {
+		if s.visible(ctx, job) {
+			visible = append(visible, withPanelImportProgress(job))
+		}
+	}
+	return visible, nil
+}
+
+// Rows returns a job's per-row results in file order, optionally filtered
+// by status.
+func (s *PanelImportService) Rows(ctx AdminContext, id string, statuses ...string) ([]PanelImportRow, error) {
+	if _, err := s.visibleJob(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.store.ListJobRows(ctx.Context, id, statuses, 0)
+}
+
+// Resume restarts processing for jobs left running by a previous process.
+// Jobs whose lease is still held elsewhere are skipped. Each job runs as the
+// user who started it, in the tenant and org it was started in.
+func (s *PanelImportService) Resume(ctx context.Context) error {
+	jobs, err := s.store.ListActiveJobs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		s.spawn(panelImportJobContext(ctx, job), job.ID)
+	}
+	return nil
+}
+
+// panelImportJobContext rebuilds the starting user's identity and scope so
+// a resumed job passes the same permission checks and repository scoping as
+// the request that started it.
+func panelImportJobContext(ctx context.Context, job PanelImportJob) AdminContext {
+	ctx = withAdminRouterIdentity(ctx, ensureAdminRouterActor(adminRouterIdentity{
+		userID:   job.CreatedBy,
+		tenantID: job.TenantID,
+		orgID:    job.OrgID,
+	}))
+	if job.Locale != "" {
+		ctx = WithLocale(ctx, job.Locale)
+	}
+	return AdminContext{
+		Context:  ctx,
+		UserID:   job.CreatedBy,
+		TenantID: job.TenantID,
+		OrgID:    job.OrgID,
+		Locale:   job.Locale,
+	}
+}
+
+func (s *PanelImportService) visibleJob(ctx AdminContext, id string) (PanelImportJob, error) {
+	if ctx.Context == nil {
+		ctx.Context = context.Background()
+	}
+	job, err := s.store.GetJob(ctx.Context, id)
+	if err != nil {
+		return PanelImportJob{}, err
+	}
+	if !s.visible(ctx, job) {
+		return PanelImportJob{}, ErrNotFound
+	}
+	return job, nil
+}
+
+// visible reports whether the caller may see a job: it must belong to the
+// caller's tenant and org, and be the caller's own unless the caller holds
+// the panel's import manage permission.
+func (s *PanelImportService) visible(ctx AdminContext, job PanelImportJob) bool {
+	if job.TenantID != ctx.TenantID || job.OrgID != ctx.OrgID {
+		return false
+	}
+	if ctx.UserID != "" && ctx.UserID == job.CreatedBy {
+		return true
+	}
+	panel, err := s.panel(job.Panel)
+	return err == nil && panel.canManageImports(ctx.Context)
+}
+
+// Wait blocks until background workers started by this service return.
+func (s *PanelImportService) Wait() {
+	s.workers.Wait()
+}
+
+func (s *PanelImportService) panel(name string) (*Panel, error) {
+	name = strings.TrimSpace(name)
+	panel, ok := s.registry.Panel(name)
+	if !ok || panel == nil {
+		return nil, notFoundDomainError("panel not found", map[string]any{"component": "panel_import", "panel": name})
+	}
+	return panel, nil
+}
+
+func (s *PanelImportService) spawn(ctx AdminContext, id string) {
+	s.mu.Lock()
+	if _, ok := s.running[id]; ok {
+		s.mu.Unlock()
+		return
+	}
+	s.running[id] = struct{}{}
+	s.mu.Unlock()
+
+	s.workers.Go(func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.running, id)
+			s.mu.Unlock()
+		}()
+		if err := s.process(ctx, id); err != nil {
+			s.logger.Error("panel import job processing failed", "job_id", id, "error", err)
+		}
+	})
+}
+
+func (s *PanelImportService) process(ctx AdminContext, id string) error {
+	claimed, err := s.store.ClaimJob(ctx.Context, id, s.owner, s.now(), s.leaseTTL)
+	if err != nil || !claimed {
+		return err
+	}
+	defer func() {
+		if err := s.store.ReleaseJob(context.WithoutCancel(ctx.Context), id, s.owner); err != nil {
+			s.logger.Warn("panel import job lease release failed", "job_id", id, "error", err)
+		}
+	}()
+
+	job, err := s.store.GetJob(ctx.Context, id)
+	if err != nil || job.Status != PanelImportJobStatusRunning {
+		return err
+	}
+	panel, err := s.panel(job.Panel)
+	if err == nil {
+		// The starter may have lost import access since Start, most often
+		// before a resume after restart.
+		err = panel.requireImport(ctx)
+	}
+	if err != nil {
+		job.Status = PanelImportJobStatusFailed
+		job.CompletedAt = s.now()
+		return errors.Join(err, s.store.SaveJob(ctx.Context, job))
+	}
+	return s.apply(ctx, panel, job)
+}
+
+func (s *PanelImportService) apply(ctx AdminContext, panel *Panel, job PanelImportJob) error {
+	for {
+		rows, err := s.store.ListJobRows(ctx.Context, job.ID, []string{PanelImportRowStatusPending, PanelImportRowStatusProcessing}, s.chunkSize)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, row := range rows {
+			if err := s.applyRow(ctx, panel, job, &row); err != nil {
+				return err
+			}
+		}
+		if err := s.checkpoint(ctx.Context, &job); err != nil {
+			return err
+		}
+	}
+
+	if err := s.summarize(ctx.Context, &job); err != nil {
+		return err
+	}
+	job.Status = PanelImportJobStatusCompleted
+	if job.Summary.Succeeded == 0 && job.Summary.Failed > 0 {
+		job.Status = PanelImportJobStatusFailed
+	}
+	job.CompletedAt = s.now()
+	if err := s.store.SaveJob(ctx.Context, job); err != nil {
+		return err
+	}
+	panel.recordActivity(ctx, "panel.import", map[string]any{
+		"panel":   panel.name,
+		"job_id":  job.ID,
+		"created": job.Summary.Created,
+		"updated": job.Summary.Updated,
+		"failed":  job.Summary.Failed,
+	})
+	return nil
+}
+
+// applyRow upserts one row through Create or Update, so each row is checked
+// against the permission its action needs. The key is looked up again at write
+// time so a record created since the dry run is updated rather than
+// duplicated. A row found processing was interrupted mid-write: keyed rows are
+// retried as an upsert, unkeyed rows fail because their create may already
+// have happened.
+func (s *PanelImportService) applyRow(ctx AdminContext, panel *Panel, job PanelImportJob, row *PanelImportRow) error {
+	key := strings.TrimSpace(toString(row.Values[job.KeyField]))
+	if row.Status == PanelImportRowStatusProcessing && key == "" {
+		failImportRow(row, map[string]string{"record": "import interrupted before the row completed; check for a duplicate record"})
+		return s.store.SaveJobRow(ctx.Context, job.ID, *row)
+	}
+	row.Status = PanelImportRowStatusProcessing
+	if err := s.store.SaveJobRow(ctx.Context, job.ID, *row); err != nil {
+		return err
+	}
+
+	values := primitives.CloneAnyMap(row.Values)
+	recordID, err := panel.findImportRecord(ctx.Context, job.KeyField, key)
+	var record map[string]any
+	if err == nil && recordID != "" {
+		if job.KeyField == defaultPanelImportKeyField {
+			delete(values, defaultPanelImportKeyField)
+		}
+		row.Action = PanelImportActionUpdate
+		record, err = panel.Update(ctx, recordID, values)
+	} else if err == nil {
+		row.Action = PanelImportActionCreate
+		record, err = panel.Create(ctx, values)
+	}
+	if err != nil {
+		failImportRow(row, map[string]string{"record": err.Error()})
+		return s.store.SaveJobRow(ctx.Context, job.ID, *row)
+	}
+	row.RecordID = extractRecordID(record, recordID)
+	row.Status = PanelImportRowStatusCreated
+	if row.Action == PanelImportActionUpdate {
+		row.Status = PanelImportRowStatusUpdated
+	}
+	row.Error, row.Fields = "", nil
+	return s.store.SaveJobRow(ctx.Context, job.ID, *row)
+}
+
+func (s *PanelImportService) checkpoint(ctx context.Context, job *PanelImportJob) error {
+	if err := s.summarize(ctx, job); err != nil {
+		return err
+	}
+	if err := s.store.SaveJob(ctx, *job); err != nil {
+		return err
+	}
+	claimed, err := s.store.ClaimJob(ctx, job.ID, s.owner, s.now(), s.leaseTTL)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return conflictDomainError("panel import job lease lost", map[string]any{"component": "panel_import", "id": job.ID})
+	}
+	return nil
+}
+
+// summarize recounts the row outcomes so progress survives restarts.
+func (s *PanelImportService) summarize(ctx context.Context, job *PanelImportJob) error {
+	counts := map[string]int{}
+	for _, status := range []string{PanelImportRowStatusCreated, PanelImportRowStatusUpdated, PanelImportRowStatusFailed} {
+		count, err := s.store.CountJobRows(ctx, job.ID, []string{status})
+		if err != nil {
+			return err
+		}
+		counts[status] = count
+	}
+	job.Summary = PanelImportSummary{
+		Created: counts[PanelImportRowStatusCreated],
+		Updated: counts[PanelImportRowStatusUpdated],
+		Failed:  counts[PanelImportRowStatusFailed],
+	}
+	job.Summary.Succeeded = job.Summary.Created + job.Summary.Updated
+	job.Summary.Processed = job.Summary.Succeeded + job.Summary.Failed
+	job.Processed = job.Summary.Processed
+	return nil
+}
+
+func withPanelImportProgress(job PanelImportJob) PanelImportJob {
+	if job.Total > 0 {
+		job.Progress = float64(job.Processed) / float64(job.Total)
+	}
+	return job
+}
+
+// InMemoryPanelImportJobStore is a process-local PanelImportJobStore for tests and demos.
+type InMemoryPanelImportJobStore struct {
+	mu     sync.Mutex
+	jobs   map[string]PanelImportJob
+	rows   map[string][]PanelImportRow
+	leases map[string]bulkJobLease
+}
+
+var _ PanelImportJobStore = (*InMemoryPanelImportJobStore)(nil)
+
+// NewInMemoryPanelImportJobStore builds an empty in-memory import job store.
+func NewInMemoryPanelImportJobStore() *InMemoryPanelImportJobStore {
+	return &InMemoryPanelImportJobStore{
+		jobs:   map[string]PanelImportJob{},
+		rows:   map[string][]PanelImportRow{},
+		leases: map[string]bulkJobLease{},
+	}
+}
+
+func (s *InMemoryPanelImportJobStore) CreateJob(_ context.Context, job PanelImportJob, rows []PanelImportRow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; ok {
+		return conflictDomainError("panel import job already exists", map[string]any{"component": "panel_import", "id": job.ID})
+	}
+	s.jobs[job.ID] = clonePanelImportJob(job)
+	stored := make([]PanelImportRow, 0, len(rows))
+	for _, row := range rows {
+		stored = append(stored, clonePanelImportRow(row))
+	}
+	s.rows[job.ID] = stored
+	return nil
+}
+
+func (s *InMemoryPanelImportJobStore) GetJob(_ context.Context, id string) (PanelImportJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[strings.TrimSpace(id)]
+	if !ok {
+		return PanelImportJob{}, ErrNotFound
+	}
+	return clonePanelImportJob(job), nil
+}
+
+func (s *InMemoryPanelImportJobStore) ListJobs(_ context.Context, panel string) ([]PanelImportJob, error) {
+	return s.listJobs(func(job PanelImportJob) bool { return panel == "" || job.Panel == panel }), nil
+}
+
+func (s *InMemoryPanelImportJobStore) ListActiveJobs(_ context.Context) ([]PanelImportJob, error) {
+	return s.listJobs(func(job PanelImportJob) bool { return job.Status == PanelImportJobStatusRunning }), nil
+}
+
+func (s *InMemoryPanelImportJobStore) listJobs(keep func(PanelImportJob) bool) []PanelImportJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := []PanelImportJob{}
+	for _, job := range s.jobs {
+		if keep(job) {
+			jobs = append(jobs, clonePanelImportJob(job))
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].StartedAt.Equal(jobs[j].StartedAt) {
+			return jobs[i].StartedAt.After(jobs[j].StartedAt)
+		}
+		return jobs[i].ID > jobs[j].ID
+	})
+	return jobs
+}
+
+func (s *InMemoryPanelImportJobStore) SaveJob(_ context.Context, job PanelImportJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; !ok {
+		return ErrNotFound
+	}
+	s.jobs[job.ID] = clonePanelImportJob(job)
+	return nil
+}
+
+func (s *InMemoryPanelImportJobStore) ClaimJob(_ context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[id]; !ok {
+		return false, ErrNotFound
+	}
+	if lease, ok := s.leases[id]; ok && lease.owner != owner && now.Before(lease.expiresAt) {
+		return false, nil
+	}
+	s.leases[id] = bulkJobLease{owner: owner, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+func (s *InMemoryPanelImportJobStore) ReleaseJob(_ context.Context, id, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lease, ok := s.leases[id]; ok && lease.owner == owner {
+		delete(s.leases, id)
+	}
+	return nil
+}
+
+func (s *InMemoryPanelImportJobStore) ListJobRows(_ context.Context, jobID string, statuses []string, limit int) ([]PanelImportRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []PanelImportRow{}
+	for _, row := range s.rows[jobID] {
+		if len(statuses) > 0 && !slices.Contains(statuses, row.Status) {
+			continue
+		}
+		out = append(out, clonePanelImportRow(row))
+		if limit > 0 && len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (s *InMemoryPanelImportJobStore) CountJobRows(_ context.Context, jobID string, statuses []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, row := range s.rows[jobID] {
+		if len(statuses) == 0 || slices.Contains(statuses, row.Status) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *InMemoryPanelImportJobStore) SaveJobRow(_ context.Context, jobID string, row PanelImportRow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rows := s.rows[jobID]
+	for i := range rows {
+		if rows[i].Index == row.Index {
+			rows[i] = clonePanelImportRow(row)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func clonePanelImportJob(job PanelImportJob) PanelImportJob {
+	job.Mapping = maps.Clone(job.Mapping)
+	return job
+}
+
+func clonePanelImportRow(row PanelImportRow) PanelImportRow {
+	row.Values = primitives.CloneAnyMap(row.Values)
+	row.Fields = maps.Clone(row.Fields)
+	return row
+}
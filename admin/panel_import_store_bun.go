@@ -0,0 +1,400 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// BunPanelImportJobStore persists import jobs in the panel_import_jobs and
+// panel_import_job_rows tables.
+type BunPanelImportJobStore struct {
+	db *bun.DB
+}
+
+var _ PanelImportJobStore = (*BunPanelImportJobStore)(nil)
+
+func NewBunPanelImportJobStore(db *bun.DB) *BunPanelImportJobStore {
+	if db == nil {
+		return nil
+	}
+	return &BunPanelImportJobStore{db: db}
+}
+
+type bunPanelImportJobRecord struct {
+	bun.BaseModel `bun:"table:panel_import_jobs,alias:pij"`
+
+	ID             string     `bun:"id,pk" json:"id"`
+	Panel          string     `bun:"panel" json:"panel"`
+	Status         string     `bun:"status" json:"status"`
+	Format         string     `bun:"format" json:"format"`
+	FileName       string     `bun:"file_name" json:"file_name"`
+	KeyField       string     `bun:"key_field" json:"key_field"`
+	MappingJSON    string     `bun:"mapping_json" json:"mapping_json"`
+	Total          int        `bun:"total" json:"total"`
+	Processed      int        `bun:"processed" json:"processed"`
+	SummaryJSON    string     `bun:"summary_json" json:"summary_json"`
+	CreatedBy      string     `bun:"created_by" json:"created_by"`
+	TenantID       string     `bun:"tenant_id" json:"tenant_id"`
+	OrgID          string     `bun:"org_id" json:"org_id"`
+	Locale         string     `bun:"locale" json:"locale"`
+	LeaseOwner     string     `bun:"lease_owner" json:"lease_owner"`
+	LeaseExpiresAt *time.Time `bun:"lease_expires_at" json:"lease_expires_at"`
+	StartedAt      time.Time  `bun:"started_at" json:"started_at"`
+	CompletedAt    *time.Time `bun:"completed_at" json:"completed_at"`
+	UpdatedAt      time.Time  `bun:"updated_at" json:"updated_at"`
+}
+
+type bunPanelImportJobRow struct {
+	bun.BaseModel `bun:"table:panel_import_job_rows,alias:pijr"`
+
+	JobID      string    `bun:"job_id,pk" json:"job_id"`
+	Position   int       `bun:"position,pk" json:"position"`
+	Action     string    `bun:"action" json:"action"`
+	RecordID   string    `bun:"record_id" json:"record_id"`
+	Status     string    `bun:"status" json:"status"`
+	ValuesJSON string    `bun:"values_json" json:"values_json"`
+	Error      string    `bun:"error" json:"error"`
+	FieldsJSON string    `bun:"fields_json" json:"fields_json"`
+	UpdatedAt  time.Time `bun:"updated_at" json:"updated_at"`
+}
+
+func (s *BunPanelImportJobStore) CreateJob(ctx context.Context, job PanelImportJob, rows []PanelImportRow) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	record, err := bunPanelImportJobRecordFromJob(job)
+	if err != nil {
+		return err
+	}
+	stored := make([]bunPanelImportJobRow, 0, len(rows))
+	for _, row := range rows {
+		item, err := bunPanelImportJobRowFromRow(job.ID, row)
+		if err != nil {
+			return err
+		}
+		stored = append(stored, item)
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(&record).Exec(ctx); err != nil {
+			return err
+		}
+		for start := 0; start < len(stored); start += bulkJobInsertBatch {
+			batch := stored[start:min(start+bulkJobInsertBatch, len(stored))]
+			if _, err := tx.NewInsert().Model(&batch).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BunPanelImportJobStore) GetJob(ctx context.Context, id string) (PanelImportJob, error) {
+	if err := s.ready(); err != nil {
+		return PanelImportJob{}, err
+	}
+	record := bunPanelImportJobRecord{}
+	err := s.db.NewSelect().
+		Model(&record).
+		Where("id = ?", strings.TrimSpace(id)).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return PanelImportJob{}, ErrNotFound
+	}
+	if err != nil {
+		return PanelImportJob{}, err
+	}
+	return panelImportJobFromBunRecord(record)
+}
+
+func (s *BunPanelImportJobStore) ListJobs(ctx context.Context, panel string) ([]PanelImportJob, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	records := []bunPanelImportJobRecord{}
+	query := s.db.NewSelect().Model(&records).OrderExpr("started_at DESC, id DESC")
+	if panel = strings.TrimSpace(panel); panel != "" {
+		query = query.Where("panel = ?", panel)
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return panelImportJobsFromBunRecords(records)
+}
+
+func (s *BunPanelImportJobStore) ListActiveJobs(ctx context.Context) ([]PanelImportJob, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	records := []bunPanelImportJobRecord{}
+	if err := s.db.NewSelect().
+		Model(&records).
+		Where("status = ?", PanelImportJobStatusRunning).
+		OrderExpr("started_at DESC, id DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return panelImportJobsFromBunRecords(records)
+}
+
+func (s *BunPanelImportJobStore) SaveJob(ctx context.Context, job PanelImportJob) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	record, err := bunPanelImportJobRecordFromJob(job)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.NewUpdate().
+		Model(&record).
+		Column("status", "total", "processed", "summary_json", "completed_at", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunPanelImportJobStore) ClaimJob(ctx context.Context, id, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	if err := s.ready(); err != nil {
+		return false, err
+	}
+	now = now.UTC()
+	result, err := s.db.NewUpdate().
+		Model((*bunPanelImportJobRecord)(nil)).
+		Set("lease_owner = ?", owner).
+		Set("lease_expires_at = ?", now.Add(ttl)).
+		Set("updated_at = ?", now).
+		Where("id = ?", strings.TrimSpace(id)).
+		Where("(lease_owner = '' OR lease_owner = ? OR lease_expires_at IS NULL OR lease_expires_at < ?)", owner, now).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (s *BunPanelImportJobStore) ReleaseJob(ctx context.Context, id, owner string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	_, err := s.db.NewUpdate().
+		Model((*bunPanelImportJobRecord)(nil)).
+		Set("lease_owner = ''").
+		Set("lease_expires_at = NULL").
+		Where("id = ?", strings.TrimSpace(id)).
+		Where("lease_owner = ?", owner).
+		Exec(ctx)
+	return err
+}
+
+func (s *BunPanelImportJobStore) ListJobRows(ctx context.Context, jobID string, statuses []string, limit int) ([]PanelImportRow, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	stored := []bunPanelImportJobRow{}
+	query := s.db.NewSelect().
+		Model(&stored).
+		Where("job_id = ?", strings.TrimSpace(jobID)).
+		OrderExpr("position ASC")
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(statuses))
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	rows := make([]PanelImportRow, 0, len(stored))
+	for _, item := range stored {
+		row := PanelImportRow{
+			Index:    item.Position,
+			Action:   item.Action,
+			RecordID: item.RecordID,
+			Status:   item.Status,
+			Error:    item.Error,
+		}
+		if err := json.Unmarshal([]byte(item.ValuesJSON), &row.Values); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(item.FieldsJSON), &row.Fields); err != nil {
+			return nil, err
+		}
+		if len(row.Fields) == 0 {
+			row.Fields = nil
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (s *BunPanelImportJobStore) CountJobRows(ctx context.Context, jobID string, statuses []string) (int, error) {
+	if err := s.ready(); err != nil {
+		return 0, err
+	}
+	query := s.db.NewSelect().
+		Model((*bunPanelImportJobRow)(nil)).
+		Where("job_id = ?", strings.TrimSpace(jobID))
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(statuses))
+	}
+	return query.Count(ctx)
+}
+
+func (s *BunPanelImportJobStore) SaveJobRow(ctx context.Context, jobID string, row PanelImportRow) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	item, err := bunPanelImportJobRowFromRow(jobID, row)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.NewUpdate().
+		Model(&item).
+		Column("action", "record_id", "status", "error", "fields_json", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunPanelImportJobStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("panel import job store", map[string]any{
+			"component": "panel_import_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunPanelImportJobRecordFromJob(job PanelImportJob) (bunPanelImportJobRecord, error) {
+	mapping := job.Mapping
+	if mapping == nil {
+		mapping = PanelImportMapping{}
+	}
+	mappingJSON, err := json.Marshal(mapping)
+	if err != nil {
+		return bunPanelImportJobRecord{}, err
+	}
+	summaryJSON, err := json.Marshal(job.Summary)
+	if err != nil {
+		return bunPanelImportJobRecord{}, err
+	}
+	record := bunPanelImportJobRecord{
+		ID:          strings.TrimSpace(job.ID),
+		Panel:       job.Panel,
+		Status:      job.Status,
+		Format:      job.Format,
+		FileName:    job.FileName,
+		KeyField:    job.KeyField,
+		MappingJSON: string(mappingJSON),
+		Total:       job.Total,
+		Processed:   job.Processed,
+		SummaryJSON: string(summaryJSON),
+		CreatedBy:   job.CreatedBy,
+		TenantID:    job.TenantID,
+		OrgID:       job.OrgID,
+		Locale:      job.Locale,
+		StartedAt:   job.StartedAt.UTC(),
+		UpdatedAt:   time.Now().UTC(),
+	}
+	if !job.CompletedAt.IsZero() {
+		completedAt := job.CompletedAt.UTC()
+		record.CompletedAt = &completedAt
+	}
+	return record, nil
+}
+
+func panelImportJobFromBunRecord(record bunPanelImportJobRecord) (PanelImportJob, error) {
+	job := PanelImportJob{
+		ID:        record.ID,
+		Panel:     record.Panel,
+		Status:    record.Status,
+		Format:    record.Format,
+		FileName:  record.FileName,
+		KeyField:  record.KeyField,
+		Total:     record.Total,
+		Processed: record.Processed,
+		CreatedBy: record.CreatedBy,
+		TenantID:  record.TenantID,
+		OrgID:     record.OrgID,
+		Locale:    record.Locale,
+		StartedAt: record.StartedAt,
+	}
+	if record.CompletedAt != nil {
+		job.CompletedAt = *record.CompletedAt
+	}
+	if err := json.Unmarshal([]byte(record.MappingJSON), &job.Mapping); err != nil {
+		return PanelImportJob{}, err
+	}
+	if err := json.Unmarshal([]byte(record.SummaryJSON), &job.Summary); err != nil {
+		return PanelImportJob{}, err
+	}
+	return job, nil
+}
+
+func panelImportJobsFromBunRecords(records []bunPanelImportJobRecord) ([]PanelImportJob, error) {
+	jobs := make([]PanelImportJob, 0, len(records))
+	for _, record := range records {
+		job, err := panelImportJobFromBunRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func bunPanelImportJobRowFromRow(jobID string, row PanelImportRow) (bunPanelImportJobRow, error) {
+	values := row.Values
+	if values == nil {
+		values = map[string]any{}
+	}
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return bunPanelImportJobRow{}, err
+	}
+	fields := row.Fields
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return bunPanelImportJobRow{}, err
+	}
+	return bunPanelImportJobRow{
+		JobID:      strings.TrimSpace(jobID),
+		Position:   row.Index,
+		Action:     row.Action,
+		RecordID:   row.RecordID,
+		Status:     row.Status,
+		ValuesJSON: string(valuesJSON),
+		Error:      row.Error,
+		FieldsJSON: string(fieldsJSON),
+		UpdatedAt:  time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunPanelImportJobStorePersistsJobsRowsAndLeases(t *testing.T) {
+	ctx := context.Background()
+	db := setupMigratedSQLite(t, admindata.PanelImportJobMigrations(), "0023_panel_import_jobs.up.sql")
+	store := NewBunPanelImportJobStore(db)
+	started := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	job := PanelImportJob{
+		ID:        "job-1",
+		Panel:     "posts",
+		Status:    PanelImportJobStatusRunning,
+		Format:    PanelImportFormatCSV,
+		KeyField:  "slug",
+		Mapping:   PanelImportMapping{"Title": "title"},
+		Total:     2,
+		CreatedBy: "editor-1",
+		TenantID:  "tenant-1",
+		OrgID:     "org-1",
+		StartedAt: started,
+	}
+	if err := store.CreateJob(ctx, job, []PanelImportRow{
+		{Index: 0, Status: PanelImportRowStatusPending, Values: map[string]any{"title": "First", "slug": "first"}},
+		{Index: 1, Status: PanelImportRowStatusFailed, Values: map[string]any{"slug": "bad"}, Fields: map[string]string{"title": "required"}},
+	}); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if err := store.CreateJob(ctx, PanelImportJob{ID: "job-2", Panel: "pages", Status: PanelImportJobStatusCompleted, StartedAt: started.Add(time.Hour)}, nil); err != nil {
+		t.Fatalf("create second job: %v", err)
+	}
+
+	loaded, err := store.GetJob(ctx, "job-1")
+	if err != nil || loaded.Mapping["Title"] != "title" || loaded.CreatedBy != "editor-1" || loaded.TenantID != "tenant-1" || loaded.OrgID != "org-1" {
+		t.Fatalf("expected job round-tripped, got %+v (%v)", loaded, err)
+	}
+	if _, err := store.GetJob(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if jobs, _ := store.ListJobs(ctx, "posts"); len(jobs) != 1 || jobs[0].ID != "job-1" {
+		t.Fatalf("expected jobs filtered by panel, got %+v", jobs)
+	}
+	if jobs, _ := store.ListJobs(ctx, ""); len(jobs) != 2 || jobs[0].ID != "job-2" {
+		t.Fatalf("expected every job newest first, got %+v", jobs)
+	}
+	if active, _ := store.ListActiveJobs(ctx); len(active) != 1 || active[0].ID != "job-1" {
+		t.Fatalf("expected only the running job active, got %+v", active)
+	}
+
+	if claimed, err := store.ClaimJob(ctx, "job-1", "worker-a", started, time.Minute); err != nil || !claimed {
+		t.Fatalf("expected claim, got %v (%v)", claimed, err)
+	}
+	if claimed, _ := store.ClaimJob(ctx, "job-1", "worker-b", started.Add(30*time.Second), time.Minute); claimed {
+		t.Fatalf("expected a live lease to block another worker")
+	}
+	if claimed, _ := store.ClaimJob(ctx, "job-1", "worker-b", started.Add(2*time.Minute), time.Minute); !claimed {
+		t.Fatalf("expected an expired lease to be taken over")
+	}
+	if err := store.ReleaseJob(ctx, "job-1", "worker-a"); err != nil {
+		t.Fatalf("release by stale owner: %v", err)
+	}
+	if claimed, _ := store.ClaimJob(ctx, "job-1", "worker-a", started.Add(150*time.Second), time.Minute); claimed {
+		t.Fatalf("expected a stale owner's release to leave the new lease in place")
+	}
+
+	pending, err := store.ListJobRows(ctx, "job-1", []string{PanelImportRowStatusPending}, 10)
+	if err != nil || len(pending) != 1 || pending[0].Values["slug"] != "first" || pending[0].Fields != nil {
+		t.Fatalf("expected pending row, got %+v (%v)", pending, err)
+	}
+	row := pending[0]
+	row.Status, row.Action, row.RecordID = PanelImportRowStatusCreated, PanelImportActionCreate, "rec-1"
+	if err := store.SaveJobRow(ctx, "job-1", row); err != nil {
+		t.Fatalf("save row: %v", err)
+	}
+	if err := store.SaveJobRow(ctx, "job-1", PanelImportRow{Index: 9}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown row, got %v", err)
+	}
+	if count, _ := store.CountJobRows(ctx, "job-1", []string{PanelImportRowStatusCreated, PanelImportRowStatusFailed}); count != 2 {
+		t.Fatalf("expected both rows settled, got %d", count)
+	}
+	rows, _ := store.ListJobRows(ctx, "job-1", nil, 0)
+	if len(rows) != 2 || rows[0].RecordID != "rec-1" || rows[1].Fields["title"] != "required" {
+		t.Fatalf("expected rows in file order with results, got %+v", rows)
+	}
+
+	loaded.Status = PanelImportJobStatusCompleted
+	loaded.Processed = 2
+	loaded.Summary = PanelImportSummary{Processed: 2, Succeeded: 1, Created: 1, Failed: 1}
+	loaded.CompletedAt = started.Add(time.Hour)
+	if err := store.SaveJob(ctx, loaded); err != nil {
+		t.Fatalf("save job: %v", err)
+	}
+	if saved, _ := store.GetJob(ctx, "job-1"); saved.Summary != loaded.Summary || saved.CompletedAt.IsZero() || saved.Status != PanelImportJobStatusCompleted {
+		t.Fatalf("expected job progress saved, got %+v", saved)
+	}
+	if err := store.SaveJob(ctx, PanelImportJob{ID: "missing"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown job, got %v", err)
+	}
+}
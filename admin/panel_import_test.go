@@ -0,0 +1,461 @@
+package admin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func buildImportPanel(t *testing.T, repo *MemoryRepository, cfg PanelImportConfig) *Panel {
+	t.Helper()
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(repo).
+		FormFields(
+			Field{Name: "title", Label: "Title", Type: "text", Required: true},
+			Field{Name: "slug", Label: "Slug", Type: "text"},
+			Field{Name: "status", Label: "Status", Type: "select", Options: []Option{{Value: "draft"}, {Value: "published"}}},
+			Field{Name: "views", Label: "Views", Type: "number"},
+			Field{Name: "featured", Label: "Featured", Type: "boolean"},
+			Field{Name: "tags", Label: "Tags", Type: "array"},
+			Field{Name: "created_by", Label: "Created by", Type: "text", ReadOnly: true},
+		).
+		FormSchema(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"title": map[string]any{"type": "string", "maxLength": 20},
+			},
+		}).
+		Import(cfg).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	return panel
+}
+
+func TestParsePanelImportSourceFormats(t *testing.T) {
+	csvSource, err := ParsePanelImportSource("", "posts.csv", strings.NewReader("\ufeffTitle, Views\nHello,3\n,\nBye,4\n"), 0)
+	if err != nil {
+		t.Fatalf("csv: %v", err)
+	}
+	if strings.Join(csvSource.Columns, "|") != "Title|Views" || len(csvSource.Rows) != 2 || csvSource.Rows[1]["Title"] != "Bye" {
+		t.Fatalf("expected header, trimmed BOM and blank rows skipped, got %+v", csvSource)
+	}
+
+	ndjson, err := ParsePanelImportSource(PanelImportFormatNDJSON, "", strings.NewReader(`{"title":"A","views":2}`+"\n"+`{"title":"B","tags":["x"]}`+"\n"), 0)
+	if err != nil {
+		t.Fatalf("ndjson: %v", err)
+	}
+	if strings.Join(ndjson.Columns, "|") != "title|views|tags" || ndjson.Rows[0]["views"] != float64(2) {
+		t.Fatalf("expected columns in first-seen order with typed values, got %+v", ndjson)
+	}
+
+	workbook := testXLSXWorkbook(t,
+		`<sst><si><t>Title</t></si><si><r><t>Hel</t></r><r><t>lo</t></r></si></sst>`,
+		`<worksheet><sheetData>`+
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="inlineStr"><is><t>Views</t></is></c></row>`+
+			`<row r="2"><c r="A2" t="s"><v>1</v></c><c r="C2"><v>7</v></c></row>`+
+			`</sheetData></worksheet>`)
+	xlsx, err := ParsePanelImportSource("", "posts.xlsx", bytes.NewReader(workbook), 0)
+	if err != nil {
+		t.Fatalf("xlsx: %v", err)
+	}
+	if strings.Join(xlsx.Columns, "|") != "Title|Views" || xlsx.Rows[0]["Title"] != "Hello" || xlsx.Rows[0]["Views"] != "7" {
+		t.Fatalf("expected shared, rich and sparse cells read, got %+v", xlsx)
+	}
+
+	if _, err := ParsePanelImportSource("", "posts.txt", strings.NewReader("a"), 0); err == nil {
+		t.Fatalf("expected unsupported format rejected")
+	}
+	if _, err := ParsePanelImportSource("", "posts.csv", strings.NewReader("title\na\nb\n"), 1); err == nil {
+		t.Fatalf("expected max rows enforced")
+	}
+}
+
+func TestParsePanelImportXLSXStopsAtMaxRowsAndCapsParts(t *testing.T) {
+	rows := strings.Builder{}
+	rows.WriteString(`<row><c t="inlineStr"><is><t>title</t></is></c></row><row/>`)
+	for range 3 {
+		rows.WriteString(`<row><c t="inlineStr"><is><t>x</t></is></c></row>`)
+	}
+	workbook := testXLSXWorkbook(t, `<sst/>`, `<worksheet><sheetData>`+rows.String()+`</sheetData></worksheet>`)
+	if source, err := parsePanelImportXLSX(bytes.NewReader(workbook), 3); err != nil || len(source.Rows) != 3 {
+		t.Fatalf("expected blank rows not counted against the limit, got %+v (%v)", source, err)
+	}
+	if _, err := parsePanelImportXLSX(bytes.NewReader(workbook), 2); err == nil {
+		t.Fatalf("expected max rows enforced while reading, got %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	part, err := archive.CreateRaw(&zip.FileHeader{Name: "xl/sharedStrings.xml", Method: zip.Store, CompressedSize64: 6, UncompressedSize64: panelImportMaxXLSXPart + 1})
+	if err != nil {
+		t.Fatalf("zip: %v", err)
+	}
+	if _, err := part.Write([]byte("<sst/>")); err != nil {
+		t.Fatalf("zip: %v", err)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("zip: %v", err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	err = decodeXLSXPart(reader.File[0], func(decoder *xml.Decoder) error { return decoder.Decode(&xlsxSharedStrings{}) })
+	if err == nil || !strings.Contains(err.Error(), "uncompressed") {
+		t.Fatalf("expected an oversized part rejected before inflating, got %v", err)
+	}
+}
+
+func TestPanelImportTemplatesFollowFields(t *testing.T) {
+	panel := buildImportPanel(t, NewMemoryRepository(), PanelImportConfig{Enabled: true})
+	csvTemplate, err := panel.ImportTemplate("csv")
+	if err != nil {
+		t.Fatalf("csv template: %v", err)
+	}
+	if got := strings.TrimSpace(string(csvTemplate.Body)); got != "id,title,slug,status,views,featured,tags" {
+		t.Fatalf("expected key then editable fields, got %q", got)
+	}
+	ndjson, err := panel.ImportTemplate("ndjson")
+	if err != nil || !strings.Contains(string(ndjson.Body), `"status":"draft"`) {
+		t.Fatalf("expected sample ndjson with first option, got %s (%v)", ndjson.Body, err)
+	}
+	xlsx, err := panel.ImportTemplate("xlsx")
+	if err != nil {
+		t.Fatalf("xlsx template: %v", err)
+	}
+	source, err := parsePanelImportXLSX(bytes.NewReader(xlsx.Body), 0)
+	if err != nil || len(source.Columns) != 7 || source.Columns[1] != "title" {
+		t.Fatalf("expected xlsx template header readable, got %+v (%v)", source, err)
+	}
+}
+
+func TestPanelPreviewImportValidatesEveryRow(t *testing.T) {
+	repo := NewMemoryRepository()
+	existing, _ := repo.Create(context.Background(), map[string]any{"title": "Existing"})
+	panel := buildImportPanel(t, repo, PanelImportConfig{Enabled: true})
+	file := strings.Join([]string{
+		"ID,Title,Status,Views,Featured,Tags,Notes",
+		toString(existing["id"]) + ",,published,5,yes,\"a, b\",ignored",
+		",New post,draft,3,no,,",
+		",,draft,,,,",
+		",Bad,archived,x,maybe,,",
+		",A title that is far too long,,,,,",
+		toString(existing["id"]) + ",Again,,,,,",
+	}, "\n")
+	preview, err := panel.PreviewImport(AdminContext{Context: context.Background()}, PanelImportRequest{FileName: "posts.csv", Reader: strings.NewReader(file)})
+	if err != nil {
+		t.Fatalf("preview: %v", err)
+	}
+	if preview.Mapping["ID"] != "id" || preview.Mapping["Title"] != "title" || preview.Mapping["Tags"] != "tags" {
+		t.Fatalf("expected mapping suggested from names and labels, got %+v", preview.Mapping)
+	}
+	if _, mapped := preview.Mapping["Notes"]; mapped {
+		t.Fatalf("expected unknown column left unmapped, got %+v", preview.Mapping)
+	}
+	rows := preview.Rows
+	if rows[0].Action != PanelImportActionUpdate || rows[0].Status != PanelImportRowStatusValid || rows[0].RecordID != toString(existing["id"]) {
+		t.Fatalf("expected update without required title, got %+v", rows[0])
+	}
+	if rows[0].Values["views"] != int64(5) || rows[0].Values["featured"] != true || len(rows[0].Values["tags"].([]any)) != 2 {
+		t.Fatalf("expected values coerced to field types, got %+v", rows[0].Values)
+	}
+	if rows[1].Action != PanelImportActionCreate || rows[1].Status != PanelImportRowStatusValid {
+		t.Fatalf("expected valid create, got %+v", rows[1])
+	}
+	if rows[2].Fields["title"] != "required" {
+		t.Fatalf("expected required title on create, got %+v", rows[2])
+	}
+	if rows[3].Fields["status"] == "" || rows[3].Fields["views"] == "" || rows[3].Fields["featured"] == "" {
+		t.Fatalf("expected option and type errors, got %+v", rows[3])
+	}
+	if rows[4].Fields["title"] == "" {
+		t.Fatalf("expected form schema maxLength enforced, got %+v", rows[4])
+	}
+	if !strings.Contains(rows[5].Fields["id"], "duplicates row 0") {
+		t.Fatalf("expected duplicate key rejected, got %+v", rows[5])
+	}
+	if preview.Summary != (PanelImportSummary{Processed: 6, Succeeded: 2, Created: 1, Updated: 1, Failed: 4}) {
+		t.Fatalf("unexpected summary %+v", preview.Summary)
+	}
+	if got, _ := repo.Get(context.Background(), toString(existing["id"])); got["title"] != "Existing" {
+		t.Fatalf("expected dry run to leave records untouched, got %+v", got)
+	}
+
+	_, err = panel.PreviewImport(AdminContext{Context: context.Background()}, PanelImportRequest{
+		FileName: "posts.csv",
+		Reader:   strings.NewReader("Heading\nx\n"),
+		Mapping:  PanelImportMapping{"Heading": "created_by"},
+	})
+	if err == nil {
+		t.Fatalf("expected read-only target rejected")
+	}
+}
+
+func TestPanelImportServiceUpsertsByKeyInBackground(t *testing.T) {
+	repo := NewMemoryRepository()
+	existing, _ := repo.Create(context.Background(), map[string]any{"title": "Old", "slug": "old"})
+	sink := &recordingSink{}
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(repo).
+		WithActivitySink(sink).
+		FormFields(Field{Name: "title", Label: "Title", Type: "text", Required: true}, Field{Name: "slug", Label: "Slug", Type: "text"}).
+		Import(PanelImportConfig{Enabled: true, KeyField: "slug"}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("posts", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	svc, err := NewPanelImportService(NewInMemoryPanelImportJobStore(), registry, WithPanelImportChunkSize(1))
+	if err != nil {
+		t.Fatalf("service: %v", err)
+	}
+	file := `{"slug":"old","title":"Renamed"}` + "\n" + `{"slug":"new","title":"Fresh"}` + "\n" + `{"slug":"bad"}` + "\n"
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	job, err := svc.Start(ctx, "posts", PanelImportRequest{FileName: "posts.ndjson", Reader: strings.NewReader(file)})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	svc.Wait()
+
+	job, err = svc.Job(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("job: %v", err)
+	}
+	if job.Status != PanelImportJobStatusCompleted || job.Summary != (PanelImportSummary{Processed: 3, Succeeded: 2, Created: 1, Updated: 1, Failed: 1}) || job.Progress != 1 {
+		t.Fatalf("unexpected job %+v", job)
+	}
+	rows, err := svc.Rows(ctx, job.ID)
+	if err != nil || len(rows) != 3 {
+		t.Fatalf("rows: %+v (%v)", rows, err)
+	}
+	if rows[0].Status != PanelImportRowStatusUpdated || rows[0].RecordID != toString(existing["id"]) {
+		t.Fatalf("expected keyed row updated in place, got %+v", rows[0])
+	}
+	if rows[1].Status != PanelImportRowStatusCreated || rows[1].RecordID == "" || rows[2].Fields["title"] != "required" {
+		t.Fatalf("expected create and validation failure, got %+v", rows[1:])
+	}
+	if got, _ := repo.Get(context.Background(), toString(existing["id"])); got["title"] != "Renamed" {
+		t.Fatalf("expected existing record updated, got %+v", got)
+	}
+	if _, total, _ := repo.List(context.Background(), ListOptions{}); total != 2 {
+		t.Fatalf("expected one record created, got %d records", total)
+	}
+	last := sink.entries[len(sink.entries)-1]
+	if last.Action != "panel.import" || last.Actor != "editor-1" || last.Metadata["created"] != 1 {
+		t.Fatalf("expected import activity, got %+v", last)
+	}
+}
+
+func TestPanelImportServiceResumesInterruptedJobs(t *testing.T) {
+	repo := NewMemoryRepository()
+	scopes := []string{}
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(repo).
+		FormFields(Field{Name: "title", Type: "text"}, Field{Name: "slug", Type: "text"}).
+		Import(PanelImportConfig{Enabled: true, KeyField: "slug"}).
+		Hooks(PanelHooks{AfterCreate: func(ctx AdminContext, _ map[string]any) error {
+			scopes = append(scopes, userIDFromContext(ctx.Context)+"@"+tenantIDFromContext(ctx.Context)+"/"+orgIDFromContext(ctx.Context))
+			return nil
+		}}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	_ = registry.RegisterPanel("posts", panel)
+	store := NewInMemoryPanelImportJobStore()
+	job := PanelImportJob{ID: "job-1", Panel: "posts", Status: PanelImportJobStatusRunning, KeyField: "slug", Total: 3, CreatedBy: "editor-1", TenantID: "tenant-1", OrgID: "org-1", StartedAt: time.Now()}
+	if err := store.CreateJob(context.Background(), job, []PanelImportRow{
+		{Index: 0, Status: PanelImportRowStatusCreated, RecordID: "9"},
+		{Index: 1, Status: PanelImportRowStatusProcessing, Values: map[string]any{"title": "Unkeyed"}},
+		{Index: 2, Status: PanelImportRowStatusProcessing, Values: map[string]any{"title": "Keyed", "slug": "keyed"}},
+	}); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if claimed, _ := store.ClaimJob(context.Background(), "job-1", "crashed-worker", time.Now().Add(-time.Hour), time.Minute); !claimed {
+		t.Fatalf("expected stale lease claimed")
+	}
+
+	svc, _ := NewPanelImportService(store, registry)
+	if err := svc.Resume(context.Background()); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	svc.Wait()
+
+	owner := AdminContext{Context: context.Background(), UserID: "editor-1", TenantID: "tenant-1", OrgID: "org-1"}
+	rows, _ := svc.Rows(owner, "job-1")
+	if rows[1].Status != PanelImportRowStatusFailed || !strings.Contains(rows[1].Error, "interrupted") {
+		t.Fatalf("expected unkeyed interrupted row failed, got %+v", rows[1])
+	}
+	if rows[2].Status != PanelImportRowStatusCreated {
+		t.Fatalf("expected keyed interrupted row retried as upsert, got %+v", rows[2])
+	}
+	job, _ = svc.Job(owner, "job-1")
+	if job.Status != PanelImportJobStatusCompleted || job.Summary.Created != 2 || job.Summary.Failed != 1 {
+		t.Fatalf("unexpected resumed job %+v", job)
+	}
+	if len(scopes) != 1 || scopes[0] != "editor-1@tenant-1/org-1" {
+		t.Fatalf("expected the resumed write to run as the job's user and scope, got %v", scopes)
+	}
+}
+
+func TestPanelImportServiceScopesJobsToOwnerTenantAndManagers(t *testing.T) {
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(NewMemoryRepository()).
+		WithAuthorizer(mapAuthorizer{allowed: map[string]bool{"posts.import.manage": false}}).
+		Import(PanelImportConfig{Enabled: true, ManagePermission: "posts.import.manage"}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	_ = registry.RegisterPanel("posts", panel)
+	store := NewInMemoryPanelImportJobStore()
+	started := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	for _, job := range []PanelImportJob{
+		{ID: "mine", Panel: "posts", Status: PanelImportJobStatusCompleted, CreatedBy: "editor-1", TenantID: "acme", StartedAt: started},
+		{ID: "colleague", Panel: "posts", Status: PanelImportJobStatusCompleted, CreatedBy: "editor-2", TenantID: "acme", StartedAt: started.Add(time.Minute)},
+		{ID: "other-tenant", Panel: "posts", Status: PanelImportJobStatusCompleted, CreatedBy: "editor-1", TenantID: "globex", StartedAt: started.Add(2 * time.Minute)},
+	} {
+		if err := store.CreateJob(context.Background(), job, nil); err != nil {
+			t.Fatalf("create %s: %v", job.ID, err)
+		}
+	}
+	svc, _ := NewPanelImportService(store, registry)
+	jobIDs := func(ctx AdminContext) string {
+		jobs, err := svc.Jobs(ctx, "posts")
+		if err != nil {
+			t.Fatalf("jobs: %v", err)
+		}
+		ids := []string{}
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	editor := AdminContext{Context: context.Background(), UserID: "editor-1", TenantID: "acme"}
+	if got := jobIDs(editor); got != "mine" {
+		t.Fatalf("expected only the caller's job in their tenant, got %q", got)
+	}
+	if _, err := svc.Job(editor, "colleague"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected another user's job hidden, got %v", err)
+	}
+	if _, err := svc.Rows(editor, "other-tenant"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected another tenant's rows hidden, got %v", err)
+	}
+
+	panel.authorizer = mapAuthorizer{allowed: map[string]bool{"posts.import.manage": true}}
+	if got := jobIDs(editor); got != "colleague,mine" {
+		t.Fatalf("expected managers to see every job of their tenant, got %q", got)
+	}
+	if got := jobIDs(AdminContext{Context: context.Background(), UserID: "editor-1"}); got != "" {
+		t.Fatalf("expected unscoped callers not to see scoped jobs, got %q", got)
+	}
+}
+
+func TestPanelImportRequiresCreateAndEditPermissions(t *testing.T) {
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(NewMemoryRepository()).
+		WithAuthorizer(mapAuthorizer{allowed: map[string]bool{"posts.create": true}}).
+		Permissions(PanelPermissions{Create: "posts.create", Edit: "posts.edit"}).
+		FormFields(Field{Name: "title", Type: "text"}).
+		Import(PanelImportConfig{Enabled: true}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	_, err = panel.PreviewImport(AdminContext{Context: context.Background()}, PanelImportRequest{FileName: "posts.csv", Reader: strings.NewReader("title\nx\n")})
+	if err == nil {
+		t.Fatalf("expected import denied without edit permission")
+	}
+	disabled, _ := (&PanelBuilder{name: "pages"}).WithRepository(NewMemoryRepository()).Build()
+	if _, err := disabled.ImportTemplate("csv"); err == nil {
+		t.Fatalf("expected template unavailable when import is off")
+	}
+}
+
+func TestPanelImportServiceRechecksPermissionsWhileProcessing(t *testing.T) {
+	repo := NewMemoryRepository()
+	authz := mapAuthorizer{allowed: map[string]bool{"posts.create": true}}
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(repo).
+		WithAuthorizer(authz).
+		Permissions(PanelPermissions{Create: "posts.create", Edit: "posts.edit"}).
+		FormFields(Field{Name: "title", Type: "text"}, Field{Name: "slug", Type: "text"}).
+		Import(PanelImportConfig{Enabled: true, KeyField: "slug"}).
+		Hooks(PanelHooks{AfterCreate: func(AdminContext, map[string]any) error {
+			authz.allowed["posts.create"] = false
+			return nil
+		}}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	_ = registry.RegisterPanel("posts", panel)
+	store := NewInMemoryPanelImportJobStore()
+	svc, _ := NewPanelImportService(store, registry)
+	owner := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	rows := []PanelImportRow{
+		{Index: 0, Status: PanelImportRowStatusPending, Values: map[string]any{"title": "First", "slug": "first"}},
+		{Index: 1, Status: PanelImportRowStatusPending, Values: map[string]any{"title": "Second", "slug": "second"}},
+	}
+	job := PanelImportJob{ID: "denied", Panel: "posts", Status: PanelImportJobStatusRunning, KeyField: "slug", Total: 2, CreatedBy: "editor-1", StartedAt: time.Now()}
+	if err := store.CreateJob(context.Background(), job, rows); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if err := svc.Resume(context.Background()); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	svc.Wait()
+	if job, _ = svc.Job(owner, "denied"); job.Status != PanelImportJobStatusFailed {
+		t.Fatalf("expected a job without import access failed, got %+v", job)
+	}
+	if _, total, _ := repo.List(context.Background(), ListOptions{}); total != 0 {
+		t.Fatalf("expected no rows applied without import access, got %d", total)
+	}
+
+	authz.allowed["posts.edit"] = true
+	job = PanelImportJob{ID: "revoked", Panel: "posts", Status: PanelImportJobStatusRunning, KeyField: "slug", Total: 2, CreatedBy: "editor-1", StartedAt: time.Now()}
+	if err := store.CreateJob(context.Background(), job, rows); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if err := svc.Resume(context.Background()); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	svc.Wait()
+	applied, _ := svc.Rows(owner, "revoked")
+	if applied[0].Status != PanelImportRowStatusCreated || applied[1].Status != PanelImportRowStatusFailed {
+		t.Fatalf("expected the create after the revoke denied, got %+v", applied)
+	}
+}
+
+func testXLSXWorkbook(t *testing.T, sharedStrings, sheet string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for name, body := range map[string]string{"xl/sharedStrings.xml": sharedStrings, "xl/worksheets/sheet1.xml": sheet} {
+		writer, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("zip: %v", err)
+		}
+		if _, err := writer.Write([]byte(body)); err != nil {
+			t.Fatalf("zip: %v", err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("zip: %v", err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,307 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	router "github.com/goliatone/go-router"
+)
+
+const (
+	// PanelSubresourceHistory lists a record's revisions (value "all") or
+	// returns one revision with its field diff (value = revision id).
+	PanelSubresourceHistory = "history"
+	// PanelSubresourceRevert reverts a record to the revision named by the value.
+	PanelSubresourceRevert = "revert"
+
+	panelHistoryListValue = "all"
+)
+
+// revisionRecordingRepository is implemented by repositories that capture
+// revisions in their own write path (CMS content). Panels then skip their own
+// capture and read history under the returned resource.
+type revisionRecordingRepository interface {
+	revisionResource() string
+}
+
+// revisionStoreBinder lets panels hand their revision store to repositories
+// that record revisions themselves.
+type revisionStoreBinder interface {
+	bindRevisionStore(store RevisionStore)
+}
+
+// revisionFieldsExcludedFromRevert are identity and bookkeeping fields that a
+// revert must not overwrite.
+var revisionFieldsExcludedFromRevert = []string{
+	"id",
+	"created_at",
+	"updated_at",
+	"updated_by",
+	SoftDeleteDeletedAtField,
+	SoftDeleteDeletedByField,
+	"transition",
+}
+
+// WithRevisionStore records a field-level revision for every panel update and
+// exposes the history and revert subresources.
+func (b *PanelBuilder) WithRevisionStore(store RevisionStore) *PanelBuilder {
+	b.revisions = store
+	return b
+}
+
+// RequireRevisions makes revision capture part of the update contract: an
+// update whose revision cannot be recorded is rolled back to the previous
+// snapshot and fails. By default the failure is logged and the update stands.
+func (b *PanelBuilder) RequireRevisions(required bool) *PanelBuilder {
+	b.revisionsRequired = required
+	return b
+}
+
+func bindPanelRevisionStore(b *PanelBuilder) {
+	if b == nil || b.revisions == nil {
+		return
+	}
+	if binder, ok := b.repo.(revisionStoreBinder); ok {
+		binder.bindRevisionStore(b.revisions)
+	}
+}
+
+// RevisionsEnabled reports whether the panel keeps revision history.
+func (p *Panel) RevisionsEnabled() bool {
+	return p != nil && p.revisions != nil
+}
+
+// revisionResource is the resource the panel's revisions are stored under.
+func (p *Panel) revisionResource() string {
+	if recorder, ok := p.repo.(revisionRecordingRepository); ok {
+		if resource := strings.TrimSpace(recorder.revisionResource()); resource != "" {
+			return resource
+		}
+	}
+	return p.name
+}
+
+// capturesRevisions reports whether Update must capture revisions itself.
+func (p *Panel) capturesRevisions() bool {
+	if !p.RevisionsEnabled() {
+		return false
+	}
+	if recorder, ok := p.repo.(revisionRecordingRepository); ok && strings.TrimSpace(recorder.revisionResource()) != "" {
+		return false
+	}
+	return true
+}
+
+// handleRevisionCaptureFailure logs a failed revision capture. Panels that
+// require revisions restore the before snapshot and return the capture error;
+// otherwise the update stands and the error is only logged.
+func (p *Panel) handleRevisionCaptureFailure(ctx context.Context, id string, before map[string]any, captureErr error) error {
+	ensureLogger(nil).Error("panel revision capture failed",
+		"panel", p.name,
+		"id", id,
+		"required", p.revisionsRequired,
+		"error", captureErr,
+	)
+	if !p.revisionsRequired {
+		return nil
+	}
+	if err := p.restoreRecordSnapshot(ctx, id, before); err != nil {
+		return errors.Join(captureErr, err)
+	}
+	return captureErr
+}
+
+// restoreRecordSnapshot writes a pre-update snapshot back to undo an update
+// whose follow-up bookkeeping failed. Identity and bookkeeping fields are left
+// as the repository set them.
+func (p *Panel) restoreRecordSnapshot(ctx context.Context, id string, before map[string]any) error {
+	payload := normalizeRevisionSnapshot(before)
+	for _, field := range revisionFieldsExcludedFromRevert {
+		delete(payload, field)
+	}
+	if _, err := p.repo.Update(ctx, id, payload); err != nil {
+		ensureLogger(nil).Error("panel update rollback failed",
+			"panel", p.name,
+			"id", id,
+			"error", err,
+		)
+		return err
+	}
+	return nil
+}
+
+// revisionContext carries the panel actor to whichever layer captures the
+// revision, keeping an actor already set by a revert.
+func (p *Panel) revisionContext(ctx AdminContext) context.Context {
+	if !p.RevisionsEnabled() || ctx.UserID == "" {
+		return ctx.Context
+	}
+	intent := revisionIntent{}
+	if ctx.Context != nil {
+		intent, _ = ctx.Context.Value(revisionIntentContextKey{}).(revisionIntent)
+	}
+	if intent.ActorID != "" {
+		return ctx.Context
+	}
+	intent.ActorID = ctx.UserID
+	return withRevisionIntent(ctx.Context, intent)
+}
+
+func (p *Panel) revisionSubresources() []PanelSubresource {
+	if !p.RevisionsEnabled() {
+		return nil
+	}
+	return []PanelSubresource{
+		{Name: PanelSubresourceHistory, Label: "History", Method: "GET", Permission: p.permissions.View},
+		{Name: PanelSubresourceRevert, Label: "Revert", Method: "POST", Permission: p.revertPermission()},
+	}
+}
+
+func (p *Panel) revertPermission() string {
+	if permission := strings.TrimSpace(p.permissions.Revert); permission != "" {
+		return permission
+	}
+	return p.permissions.Edit
+}
+
+// History lists the revisions of a record, newest first. The record must be
+// visible through the panel repository.
+func (p *Panel) History(ctx AdminContext, id string, opts ListOptions) ([]Revision, int, error) {
+	if err := p.requireRevisions(); err != nil {
+		return nil, 0, err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.View, p.name); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.repo.Get(ctx.Context, strings.TrimSpace(id)); err != nil {
+		return nil, 0, err
+	}
+	return p.revisions.ListRevisions(ctx.Context, RevisionFilter{
+		Resource: p.revisionResource(),
+		RecordID: strings.TrimSpace(id),
+		Page:     opts.Page,
+		PerPage:  opts.PerPage,
+	})
+}
+
+// Revision returns one revision of a record.
+func (p *Panel) Revision(ctx AdminContext, id, revisionID string) (Revision, error) {
+	if err := p.requireRevisions(); err != nil {
+		return Revision{}, err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.View, p.name); err != nil {
+		return Revision{}, err
+	}
+	return p.recordRevision(ctx, id, revisionID)
+}
+
+// RevertRevision restores the field values a record had after the given
+// revision. The revert runs through Update, so edit permissions, hooks and
+// workflow guards apply and the revert is itself recorded as a revision. On
+// workflow panels the workflow state is kept: reverting content never moves a
+// record between workflow states.
+func (p *Panel) RevertRevision(ctx AdminContext, id, revisionID string) (map[string]any, error) {
+	if err := p.requireRevisions(); err != nil {
+		return nil, err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.revertPermission(), p.name); err != nil {
+		return nil, err
+	}
+	revision, err := p.recordRevision(ctx, id, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	payload := normalizeRevisionSnapshot(revision.After)
+	for _, field := range revisionFieldsExcludedFromRevert {
+		delete(payload, field)
+	}
+	if p.workflow != nil {
+		delete(payload, "status")
+	}
+	actor := ctx.UserID
+	if actor == "" {
+		actor = actorFromContext(ctx.Context)
+	}
+	revertCtx := ctx
+	revertCtx.Context = withRevisionIntent(ctx.Context, revisionIntent{
+		Action:       RevisionActionRevert,
+		ActorID:      actor,
+		RevertedFrom: revision.ID,
+	})
+	record, err := p.Update(revertCtx, revision.RecordID, payload)
+	if err != nil {
+		return nil, err
+	}
+	p.recordActivity(ctx, "panel.revert", map[string]any{
+		"id":          revision.RecordID,
+		"panel":       p.name,
+		"revision_id": revision.ID,
+		"version":     revision.Version,
+	})
+	return record, nil
+}
+
+func (p *Panel) requireRevisions() error {
+	if p.RevisionsEnabled() {
+		return nil
+	}
+	return notFoundDomainError("panel revision history not enabled", map[string]any{
+		"component": "panel",
+		"panel":     p.name,
+	})
+}
+
+// recordRevision loads a revision and checks it belongs to the record. The
+// record is read through the panel repository first, so its scope criteria
+// hide the history of records the caller cannot see.
+func (p *Panel) recordRevision(ctx AdminContext, id, revisionID string) (Revision, error) {
+	if _, err := p.repo.Get(ctx.Context, strings.TrimSpace(id)); err != nil {
+		return Revision{}, err
+	}
+	revision, err := p.revisions.GetRevision(ctx.Context, strings.TrimSpace(revisionID))
+	if err != nil {
+		return Revision{}, err
+	}
+	if revision.Resource != p.revisionResource() || revision.RecordID != strings.TrimSpace(id) {
+		return Revision{}, notFoundDomainError("revision not found for record", map[string]any{
+			"panel":       p.name,
+			"id":          strings.TrimSpace(id),
+			"revision_id": strings.TrimSpace(revisionID),
+		})
+	}
+	return revision, nil
+}
+
+// serveRevisionSubresource answers the built-in history and revert
+// subresources. Permissions were checked against the subresource spec.
+func (p *Panel) serveRevisionSubresource(ctx AdminContext, c router.Context, id, subresource, value string) (bool, error) {
+	if !p.RevisionsEnabled() {
+		return false, nil
+	}
+	switch strings.ToLower(subresource) {
+	case PanelSubresourceHistory:
+		if strings.EqualFold(value, panelHistoryListValue) {
+			revisions, total, err := p.History(ctx, id, ListOptions{
+				Page:    atoiDefault(c.Query("page"), 1),
+				PerPage: atoiDefault(c.Query("per_page"), revisionDefaultPerPage),
+			})
+			if err != nil {
+				return true, err
+			}
+			return true, c.JSON(200, map[string]any{"data": revisions, "total": total})
+		}
+		revision, err := p.Revision(ctx, id, value)
+		if err != nil {
+			return true, err
+		}
+		return true, c.JSON(200, map[string]any{"data": revision})
+	case PanelSubresourceRevert:
+		record, err := p.RevertRevision(ctx, id, value)
+		if err != nil {
+			return true, err
+		}
+		return true, c.JSON(200, map[string]any{"data": record})
+	}
+	return false, nil
+}
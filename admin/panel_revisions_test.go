@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPanelRevisionHistoryAndRevert(t *testing.T) {
+	repo := NewMemoryRepository()
+	sink := &recordingSink{}
+	store := NewInMemoryRevisionStore()
+	authz := mapAuthorizer{allowed: map[string]bool{"products.view": true, "products.edit": true}}
+	panel, err := (&PanelBuilder{name: "products"}).
+		WithRepository(repo).
+		WithAuthorizer(authz).
+		WithActivitySink(sink).
+		WithRevisionStore(store).
+		Permissions(PanelPermissions{
+			View:   "products.view",
+			Edit:   "products.edit",
+			Revert: "products.revert",
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	created, _ := repo.Create(ctx.Context, map[string]any{"title": "Lamp", "price": 10, "meta": map[string]any{"sku": "L-1"}})
+	id := toString(created["id"])
+
+	if _, err := panel.Update(ctx, id, map[string]any{"title": "Lamp", "price": 12, "meta": map[string]any{"sku": "L-1"}}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	ctx.UserID = "editor-2"
+	if _, err := panel.Update(ctx, id, map[string]any{"title": "Desk Lamp", "price": 15, "meta": map[string]any{"sku": "L-2"}}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	revisions, total, err := panel.History(ctx, id, ListOptions{})
+	if err != nil || total != 2 {
+		t.Fatalf("expected two revisions, got %d (%v)", total, err)
+	}
+	first := revisions[1]
+	if first.Version != 1 || first.ActorID != "editor-1" || len(first.Changes) != 1 {
+		t.Fatalf("expected first revision to record only the price change by editor-1, got %+v", first)
+	}
+	if change := first.Changes[0]; change.Field != "price" || change.Before != float64(10) || change.After != float64(12) {
+		t.Fatalf("expected price 10 -> 12, got %+v", change)
+	}
+	fields := []string{}
+	for _, change := range revisions[0].Changes {
+		fields = append(fields, change.Field)
+	}
+	if revisions[0].Version != 2 || len(fields) != 3 || fields[0] != "meta.sku" || fields[1] != "price" || fields[2] != "title" {
+		t.Fatalf("expected nested diff fields on second revision, got %+v", revisions[0])
+	}
+
+	if _, err := panel.RevertRevision(ctx, id, first.ID); err == nil {
+		t.Fatalf("expected revert to require the revert permission")
+	}
+	authz.allowed["products.revert"] = true
+	reverted, err := panel.RevertRevision(ctx, id, first.ID)
+	if err != nil {
+		t.Fatalf("revert: %v", err)
+	}
+	if reverted["title"] != "Lamp" || toString(reverted["price"]) != "12" {
+		t.Fatalf("expected record restored to revision 1, got %+v", reverted)
+	}
+	revisions, _, _ = panel.History(ctx, id, ListOptions{})
+	latest := revisions[0]
+	if latest.Version != 3 || latest.Action != RevisionActionRevert || latest.RevertedFrom != first.ID || latest.ActorID != "editor-2" {
+		t.Fatalf("expected revert recorded as revision 3, got %+v", latest)
+	}
+	if last := sink.entries[len(sink.entries)-1]; last.Action != "panel.revert" || last.Metadata["revision_id"] != first.ID {
+		t.Fatalf("expected panel.revert activity, got %+v", last)
+	}
+
+	other, _ := repo.Create(ctx.Context, map[string]any{"title": "Chair"})
+	if _, err := panel.RevertRevision(ctx, toString(other["id"]), first.ID); err == nil {
+		t.Fatalf("expected revision of another record to be rejected")
+	}
+	if err := repo.Delete(ctx.Context, id); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, _, err := panel.History(ctx, id, ListOptions{}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected history of a record the repository no longer returns to be hidden, got %v", err)
+	}
+	if _, err := panel.Revision(ctx, id, first.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected revision of a record the repository no longer returns to be hidden, got %v", err)
+	}
+	names := []string{}
+	for _, subresource := range panel.Subresources() {
+		names = append(names, subresource.Name+":"+subresource.Method+":"+subresource.Permission)
+	}
+	if len(names) != 2 || names[0] != "history:GET:products.view" || names[1] != "revert:POST:products.revert" {
+		t.Fatalf("expected history and revert subresources, got %v", names)
+	}
+}
+
+func TestPanelRevertKeepsWorkflowState(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := NewInMemoryRevisionStore()
+	panel, err := (&PanelBuilder{name: "pages"}).
+		WithRepository(repo).
+		WithWorkflow(workflowEngineWithPagesAndPosts()).
+		WithRevisionStore(store).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	created, _ := repo.Create(ctx.Context, map[string]any{"title": "Draft", "status": "draft"})
+	id := toString(created["id"])
+	if _, err := panel.Update(ctx, id, map[string]any{"title": "Reviewed", "status": "draft"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := panel.Update(ctx, id, map[string]any{"title": "Submitted", "status": "approval"}); err != nil {
+		t.Fatalf("transition: %v", err)
+	}
+	revisions, _, _ := panel.History(ctx, id, ListOptions{})
+	if len(revisions) != 2 || revisions[0].State != "approval" || revisions[1].State != "draft" {
+		t.Fatalf("expected revisions to carry workflow state, got %+v", revisions)
+	}
+
+	reverted, err := panel.RevertRevision(ctx, id, revisions[1].ID)
+	if err != nil {
+		t.Fatalf("revert: %v", err)
+	}
+	if reverted["title"] != "Reviewed" || reverted["status"] != "approval" {
+		t.Fatalf("expected content reverted and workflow state kept, got %+v", reverted)
+	}
+}
+
+func TestCMSContentRepositoryRecordsRevisions(t *testing.T) {
+	store := NewInMemoryRevisionStore()
+	repo := NewCMSContentRepository(NewInMemoryContentService())
+	panel, err := (&PanelBuilder{name: "articles"}).
+		WithRepository(repo).
+		WithRevisionStore(store).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	created, err := repo.Create(ctx.Context, map[string]any{
+		"title":        "Hello",
+		"slug":         "hello",
+		"locale":       "en",
+		"status":       "draft",
+		"content_type": "article",
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := toString(created["id"])
+	if _, err := panel.Update(ctx, id, map[string]any{"title": "Hello World"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	revisions, total, err := store.ListRevisions(context.Background(), RevisionFilter{Resource: RevisionResourceContent, RecordID: id})
+	if err != nil || total != 1 {
+		t.Fatalf("expected write service to record one content revision, got %d (%v)", total, err)
+	}
+	changed := false
+	for _, change := range revisions[0].Changes {
+		if change.Field == "title" && change.Before == "Hello" && change.After == "Hello World" {
+			changed = true
+		}
+	}
+	if !changed || revisions[0].ActorID != "editor-1" {
+		t.Fatalf("expected title change by editor-1, got %+v", revisions[0])
+	}
+	history, _, err := panel.History(ctx, id, ListOptions{})
+	if err != nil || len(history) != 1 || history[0].ID != revisions[0].ID {
+		t.Fatalf("expected panel history to read content revisions once, got %+v (%v)", history, err)
+	}
+}
+
+func TestPanelRequiredRevisionsRollBackUpdateWhenCaptureFails(t *testing.T) {
+	repo := NewMemoryRepository()
+	store := failingRevisionStore{RevisionStore: NewInMemoryRevisionStore(), err: errors.New("revision store down")}
+	build := func(required bool) *Panel {
+		panel, err := (&PanelBuilder{name: "products"}).
+			WithRepository(repo).
+			WithRevisionStore(store).
+			RequireRevisions(required).
+			Build()
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+		return panel
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	created, _ := repo.Create(ctx.Context, map[string]any{"title": "Lamp"})
+	id := toString(created["id"])
+
+	if _, err := build(true).Update(ctx, id, map[string]any{"title": "Desk Lamp"}); !errors.Is(err, store.err) {
+		t.Fatalf("expected the capture error from a required revision panel, got %v", err)
+	}
+	if record, _ := repo.Get(ctx.Context, id); record["title"] != "Lamp" {
+		t.Fatalf("expected the update rolled back, got %+v", record)
+	}
+
+	if updated, err := build(false).Update(ctx, id, map[string]any{"title": "Desk Lamp"}); err != nil || updated["title"] != "Desk Lamp" {
+		t.Fatalf("expected the update to stand when revisions are optional, got %+v (%v)", updated, err)
+	}
+}
+
+type failingRevisionStore struct {
+	RevisionStore
+	err error
+}
+
+func (s failingRevisionStore) AppendRevision(context.Context, Revision) (Revision, error) {
+	return Revision{}, s.err
+}
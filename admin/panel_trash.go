@@ -0,0 +1,181 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+const panelTrashRetentionPerPage = 200
+
+// PanelSoftDeleteConfig opts a panel into soft delete. Retention is how long a
+// record stays in the trash before the retention job purges it; zero keeps
+// trashed records until purged by hand.
+type PanelSoftDeleteConfig struct {
+	Enabled   bool          `json:"enabled"`
+	Retention time.Duration `json:"retention"`
+}
+
+// TrashConfig surfaces the trash endpoint of soft-delete panels to UI consumers.
+type TrashConfig struct {
+	Endpoint  string `json:"endpoint"`
+	Retention string `json:"retention,omitempty"`
+}
+
+func validatePanelSoftDeleteContract(b *PanelBuilder) error {
+	if b == nil || !b.softDelete.Enabled {
+		return nil
+	}
+	if _, ok := b.repo.(SoftDeleteRepository); ok {
+		return nil
+	}
+	return validationDomainError("panel soft delete requires a SoftDeleteRepository", map[string]any{
+		"component": "panel_builder",
+		"panel":     strings.TrimSpace(b.name),
+		"field":     "soft_delete",
+		"hint":      "use MemoryRepository, a Bun adapter built WithBunSoftDelete, or implement SoftDeleteRepository",
+	})
+}
+
+// SoftDeleteEnabled reports whether deletes move records to the trash.
+func (p *Panel) SoftDeleteEnabled() bool {
+	return p.trashRepository() != nil
+}
+
+func (p *Panel) trashRepository() SoftDeleteRepository {
+	if p == nil || !p.softDelete.Enabled {
+		return nil
+	}
+	repo, _ := p.repo.(SoftDeleteRepository)
+	return repo
+}
+
+func (p *Panel) requireTrash() (SoftDeleteRepository, error) {
+	repo := p.trashRepository()
+	if repo == nil {
+		return nil, notFoundDomainError("panel trash not enabled", map[string]any{
+			"component": "panel",
+			"panel":     p.name,
+		})
+	}
+	return repo, nil
+}
+
+// restorePermission falls back to the delete permission when the panel does
+// not name a dedicated one: undoing a delete needs no more than the delete.
+func (p *Panel) restorePermission() string {
+	if permission := strings.TrimSpace(p.permissions.Restore); permission != "" {
+		return permission
+	}
+	return p.permissions.Delete
+}
+
+// requirePurge checks the purge permission. A panel that guards deletes but
+// names no purge permission denies purges by hand, since a purge cannot be
+// undone; the retention job runs as the system actor and is unaffected.
+func (p *Panel) requirePurge(ctx context.Context) error {
+	permission := strings.TrimSpace(p.permissions.Purge)
+	if permission == "" && strings.TrimSpace(p.permissions.Delete) != "" {
+		return permissionDenied("purge", p.name)
+	}
+	return requirePermissionWithAuthorizer(p.authorizer, ctx, permission, p.name)
+}
+
+// Trash lists trashed records. Anyone allowed to delete may see what was deleted.
+func (p *Panel) Trash(ctx AdminContext, opts ListOptions) ([]map[string]any, int, error) {
+	repo, err := p.requireTrash()
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.permissions.Delete, p.name); err != nil {
+		return nil, 0, err
+	}
+	return repo.ListTrash(ctx.Context, opts)
+}
+
+// Restore moves a trashed record back into the panel.
+func (p *Panel) Restore(ctx AdminContext, id string) (map[string]any, error) {
+	repo, err := p.requireTrash()
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePermissionWithAuthorizer(p.authorizer, ctx.Context, p.restorePermission(), p.name); err != nil {
+		return nil, err
+	}
+	return p.restoreTrashedRecord(ctx, repo, id)
+}
+
+// restoreTrashedRecord runs the restore pipeline for callers that already
+// checked the restore permission.
+func (p *Panel) restoreTrashedRecord(ctx AdminContext, repo SoftDeleteRepository, id string) (map[string]any, error) {
+	record, err := repo.Restore(ctx.Context, id)
+	if err != nil {
+		return nil, err
+	}
+	p.recordActivity(ctx, "panel.restore", map[string]any{
+		"id":    id,
+		"panel": p.name,
+	})
+	return record, nil
+}
+
+// Purge permanently deletes a trashed record.
+func (p *Panel) Purge(ctx AdminContext, id string) error {
+	repo, err := p.requireTrash()
+	if err != nil {
+		return err
+	}
+	if err := p.requirePurge(ctx.Context); err != nil {
+		return err
+	}
+	if err := repo.Purge(ctx.Context, id); err != nil {
+		return err
+	}
+	p.recordActivity(ctx, "panel.purge", map[string]any{
+		"id":    id,
+		"panel": p.name,
+	})
+	return nil
+}
+
+// PurgeExpiredTrash purges records trashed longer than the retention window and
+// returns how many were removed. It runs as the system actor.
+func (p *Panel) PurgeExpiredTrash(ctx context.Context, now time.Time) (int, error) {
+	repo := p.trashRepository()
+	if repo == nil || p.softDelete.Retention <= 0 {
+		return 0, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cutoff := now.Add(-p.softDelete.Retention)
+	systemCtx := AdminContext{Context: ctx, UserID: ActivityActorTypeSystem}
+	purged := 0
+	for {
+		expired, err := repo.ListExpiredTrash(ctx, cutoff, panelTrashRetentionPerPage)
+		if err != nil {
+			return purged, err
+		}
+		removed := 0
+		for _, id := range expired {
+			if err := repo.Purge(ctx, id); err != nil {
+				if errors.Is(err, ErrNotFound) {
+					continue
+				}
+				return purged, err
+			}
+			removed++
+			p.recordActivity(systemCtx, "panel.purge", tagActivityActorType(map[string]any{
+				"id":        id,
+				"panel":     p.name,
+				"reason":    "retention",
+				"retention": p.softDelete.Retention.String(),
+			}, ActivityActorTypeSystem))
+		}
+		purged += removed
+		if len(expired) < panelTrashRetentionPerPage || removed == 0 {
+			return purged, nil
+		}
+	}
+}
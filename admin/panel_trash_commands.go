@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-command"
+	"github.com/goliatone/go-command/dispatcher"
+)
+
+const (
+	panelTrashRetentionCommandName     = "jobs.panels.trash.purge"
+	panelTrashRetentionDefaultSchedule = "0 3 * * *"
+)
+
+// PanelTrashRetentionResult reports purged records per panel.
+type PanelTrashRetentionResult struct {
+	Purged map[string]int `json:"purged"`
+}
+
+// PanelTrashRetentionInput triggers one trash retention run.
+type PanelTrashRetentionInput struct {
+	Result *PanelTrashRetentionResult `json:"-"`
+}
+
+func (PanelTrashRetentionInput) Type() string { return panelTrashRetentionCommandName }
+
+func (PanelTrashRetentionInput) Validate() error { return nil }
+
+// PanelTrashRetentionCommand purges trashed records past each soft-delete
+// panel's retention window, on demand or on its cron schedule.
+type PanelTrashRetentionCommand struct {
+	Schedule string           `json:"schedule"`
+	Registry *Registry        `json:"registry"`
+	Now      func() time.Time `json:"-"`
+}
+
+var _ command.Commander[PanelTrashRetentionInput] = (*PanelTrashRetentionCommand)(nil)
+var _ command.CronCommand = (*PanelTrashRetentionCommand)(nil)
+
+func (c *PanelTrashRetentionCommand) Execute(ctx context.Context, msg PanelTrashRetentionInput) error {
+	if c == nil || c.Registry == nil {
+		return serviceNotConfiguredDomainError("panel registry", map[string]any{
+			"command": panelTrashRetentionCommandName,
+		})
+	}
+	now := time.Now().UTC()
+	if c.Now != nil {
+		now = c.Now()
+	}
+	panels := c.Registry.Panels()
+	names := make([]string, 0, len(panels))
+	for name := range panels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := PanelTrashRetentionResult{Purged: map[string]int{}}
+	for _, name := range names {
+		purged, err := panels[name].PurgeExpiredTrash(ctx, now)
+		if purged > 0 {
+			result.Purged[name] = purged
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if msg.Result != nil {
+		*msg.Result = result
+	}
+	return nil
+}
+
+func (c *PanelTrashRetentionCommand) CronHandler() func() error {
+	return func() error {
+		return dispatcher.Dispatch(context.Background(), PanelTrashRetentionInput{})
+	}
+}
+
+func (c *PanelTrashRetentionCommand) CronOptions() command.HandlerConfig {
+	if c == nil {
+		return command.HandlerConfig{}
+	}
+	schedule := strings.TrimSpace(c.Schedule)
+	if schedule == "" {
+		schedule = panelTrashRetentionDefaultSchedule
+	}
+	return command.HandlerConfig{Expression: schedule}
+}
+
+// RegisterPanelTrashCommands registers the scheduled trash retention job.
+// An empty schedule runs daily at 03:00.
+func RegisterPanelTrashCommands(bus *CommandBus, registry *Registry, schedule string) error {
+	_, err := RegisterCommand(bus, &PanelTrashRetentionCommand{Schedule: schedule, Registry: registry})
+	return err
+}
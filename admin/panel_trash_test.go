@@ -0,0 +1,225 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPanelSoftDeleteTrashRestoreAndPurge(t *testing.T) {
+	repo := NewMemoryRepository()
+	sink := &recordingSink{}
+	authz := mapAuthorizer{allowed: map[string]bool{"articles.view": true, "articles.delete": true, "articles.restore": true}}
+	panel, err := (&PanelBuilder{name: "articles"}).
+		WithRepository(repo).
+		WithAuthorizer(authz).
+		WithActivitySink(sink).
+		Permissions(PanelPermissions{
+			View:    "articles.view",
+			Delete:  "articles.delete",
+			Restore: "articles.restore",
+			Purge:   "articles.purge",
+		}).
+		SoftDelete(PanelSoftDeleteConfig{Enabled: true, Retention: 30 * 24 * time.Hour}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	first, _ := repo.Create(ctx.Context, map[string]any{"title": "First"})
+	second, _ := repo.Create(ctx.Context, map[string]any{"title": "Second"})
+	firstID, secondID := toString(first["id"]), toString(second["id"])
+
+	if err := panel.Delete(ctx, firstID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if records, total, _ := panel.List(ctx, ListOptions{}); total != 1 || toString(records[0]["id"]) != secondID {
+		t.Fatalf("expected trashed record hidden from list, got %d %+v", total, records)
+	}
+	if _, err := panel.Get(ctx, firstID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected trashed record hidden from get, got %v", err)
+	}
+	trashed, total, err := panel.Trash(ctx, ListOptions{})
+	if err != nil || total != 1 || trashed[0][SoftDeleteDeletedByField] != "editor-1" {
+		t.Fatalf("expected trashed record with deleted_by, got %+v (%v)", trashed, err)
+	}
+	if _, ok := trashedAt(trashed[0]); !ok {
+		t.Fatalf("expected deleted_at on trashed record, got %+v", trashed[0])
+	}
+
+	restored, err := panel.Restore(ctx, firstID)
+	if err != nil || restored["title"] != "First" || restored[SoftDeleteDeletedAtField] != nil {
+		t.Fatalf("expected restored record without trash fields, got %+v (%v)", restored, err)
+	}
+	if _, total, _ := panel.List(ctx, ListOptions{}); total != 2 {
+		t.Fatalf("expected restored record listed, got %d", total)
+	}
+
+	if err := panel.Delete(ctx, secondID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := panel.Purge(ctx, secondID); err == nil {
+		t.Fatalf("expected purge to require its own permission")
+	}
+	authz.allowed["articles.purge"] = true
+	if err := panel.Purge(ctx, secondID); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if _, total, _ := panel.Trash(ctx, ListOptions{}); total != 0 {
+		t.Fatalf("expected purged record gone from trash, got %d", total)
+	}
+
+	actions := []string{}
+	for _, entry := range sink.entries {
+		actions = append(actions, entry.Action)
+	}
+	want := []string{"panel.delete", "panel.restore", "panel.delete", "panel.purge"}
+	if len(actions) != len(want) {
+		t.Fatalf("expected activity %v, got %v", want, actions)
+	}
+	for i := range want {
+		if actions[i] != want[i] {
+			t.Fatalf("expected activity %v, got %v", want, actions)
+		}
+	}
+	if sink.entries[0].Metadata["soft_delete"] != true || sink.entries[0].Actor != "editor-1" {
+		t.Fatalf("expected soft delete activity by editor-1, got %+v", sink.entries[0])
+	}
+}
+
+func TestPanelTrashRetentionCommandPurgesExpiredRecords(t *testing.T) {
+	repo := NewMemoryRepository()
+	sink := &recordingSink{}
+	panel, err := (&PanelBuilder{name: "articles"}).
+		WithRepository(repo).
+		WithActivitySink(sink).
+		SoftDelete(PanelSoftDeleteConfig{Enabled: true, Retention: 24 * time.Hour}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("articles", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	record, _ := repo.Create(ctx.Context, map[string]any{"title": "Old"})
+	if err := panel.Delete(ctx, toString(record["id"])); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	cmd := &PanelTrashRetentionCommand{Registry: registry, Now: func() time.Time { return time.Now().Add(time.Hour) }}
+	var result PanelTrashRetentionResult
+	if err := cmd.Execute(context.Background(), PanelTrashRetentionInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Purged["articles"] != 0 {
+		t.Fatalf("expected record within retention kept, got %+v", result)
+	}
+
+	cmd.Now = func() time.Time { return time.Now().Add(48 * time.Hour) }
+	if err := cmd.Execute(context.Background(), PanelTrashRetentionInput{Result: &result}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if result.Purged["articles"] != 1 {
+		t.Fatalf("expected expired record purged, got %+v", result)
+	}
+	last := sink.entries[len(sink.entries)-1]
+	if last.Action != "panel.purge" || last.Actor != ActivityActorTypeSystem || last.Metadata["reason"] != "retention" {
+		t.Fatalf("expected system retention purge activity, got %+v", last)
+	}
+
+	if _, err := (&PanelBuilder{name: "plain"}).
+		WithRepository(plainRepository{}).
+		SoftDelete(PanelSoftDeleteConfig{Enabled: true}).
+		Build(); err == nil {
+		t.Fatalf("expected soft delete to require a SoftDeleteRepository")
+	}
+}
+
+type plainRepository struct{ Repository }
+
+func TestPanelTrashRestoreFallsBackToDeleteAndBulkDeletesRecordActivity(t *testing.T) {
+	repo := NewMemoryRepository()
+	sink := &recordingSink{}
+	panel, err := (&PanelBuilder{name: "articles"}).
+		WithRepository(repo).
+		WithAuthorizer(mapAuthorizer{allowed: map[string]bool{"articles.delete": true}}).
+		WithActivitySink(sink).
+		Permissions(PanelPermissions{Delete: "articles.delete"}).
+		SoftDelete(PanelSoftDeleteConfig{Enabled: true}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("articles", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	ids := []string{}
+	for _, title := range []string{"First", "Second", "Third"} {
+		record, _ := repo.Create(ctx.Context, map[string]any{"title": title})
+		ids = append(ids, toString(record["id"]))
+	}
+
+	bulkRepo, err := PanelBulkRepositoryResolver(registry)(ctx.Context, "articles")
+	if err != nil {
+		t.Fatalf("resolve bulk repository: %v", err)
+	}
+	for _, id := range ids {
+		if err := bulkRepo.Delete(ctx.Context, id); err != nil {
+			t.Fatalf("bulk delete %s: %v", id, err)
+		}
+	}
+	if len(sink.entries) != len(ids) {
+		t.Fatalf("expected one delete activity per record, got %+v", sink.entries)
+	}
+	for i, entry := range sink.entries {
+		if entry.Action != "panel.delete" || entry.Metadata["id"] != ids[i] {
+			t.Fatalf("expected panel.delete for %s, got %+v", ids[i], entry)
+		}
+	}
+
+	if _, err := panel.Restore(ctx, ids[0]); err != nil {
+		t.Fatalf("expected restore to fall back to the delete permission: %v", err)
+	}
+	if err := panel.Purge(ctx, ids[1]); err == nil {
+		t.Fatalf("expected purge without a purge permission to be denied")
+	}
+	denied := AdminContext{Context: context.Background(), UserID: "viewer-1"}
+	panel.authorizer = mapAuthorizer{allowed: map[string]bool{}}
+	if _, err := panel.Restore(denied, ids[2]); err == nil {
+		t.Fatalf("expected restore without the delete permission to be denied")
+	}
+}
+
+func TestMemoryRepositoryListExpiredTrashReturnsOldestFirstWithinLimit(t *testing.T) {
+	repo := NewMemoryRepository()
+	ctx := context.Background()
+	ids := []string{}
+	for _, title := range []string{"First", "Second", "Third"} {
+		record, _ := repo.Create(ctx, map[string]any{"title": title})
+		ids = append(ids, toString(record["id"]))
+	}
+	for _, id := range ids {
+		if err := repo.SoftDelete(ctx, id, "editor-1"); err != nil {
+			t.Fatalf("soft delete %s: %v", id, err)
+		}
+	}
+	now := time.Now().UTC()
+	repo.trash[0][SoftDeleteDeletedAtField] = now.Add(-48 * time.Hour)
+	repo.trash[1][SoftDeleteDeletedAtField] = now.Add(-72 * time.Hour)
+
+	expired, err := repo.ListExpiredTrash(ctx, now.Add(-24*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("list expired trash: %v", err)
+	}
+	if len(expired) != 2 || expired[0] != ids[1] || expired[1] != ids[0] {
+		t.Fatalf("expected expired records oldest first, got %v", expired)
+	}
+	if expired, _ := repo.ListExpiredTrash(ctx, now.Add(-24*time.Hour), 1); len(expired) != 1 || expired[0] != ids[1] {
+		t.Fatalf("expected limit to keep the oldest record, got %v", expired)
+	}
+}
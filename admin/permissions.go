@@ -51,6 +51,8 @@ const (
 
 	PermAdminSearchView = "admin.search.view"
 
+	PermAdminExportsSchedule = "admin.exports.schedule"
+
 	PermAdminPreferencesView         = "admin.preferences.view"
 	PermAdminPreferencesEdit         = "admin.preferences.edit"
 	PermAdminPreferencesManageTenant = "admin.preferences.manage_tenant"
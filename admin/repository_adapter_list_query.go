@@ -11,6 +11,7 @@ type normalizedRepositoryAdapterListQuery struct {
 	SortDesc   bool
 	Search     string
 	Predicates []ListPredicate
+	Cursor     string
 }
 
 func normalizeRepositoryAdapterListQuery(opts ListOptions) normalizedRepositoryAdapterListQuery {
@@ -20,6 +21,11 @@ func normalizeRepositoryAdapterListQuery(opts ListOptions) normalizedRepositoryA
 	}
 
 	page := max(opts.Page, 1)
+	cursor := strings.TrimSpace(opts.Cursor)
+	if cursor != "" {
+		// Keyset pages start right after the cursor, never at an offset.
+		page = 1
+	}
 	predicates := NormalizeListPredicates(opts)
 	search := strings.TrimSpace(opts.Search)
 	if search == "" {
@@ -33,6 +39,7 @@ func normalizeRepositoryAdapterListQuery(opts ListOptions) normalizedRepositoryA
 		SortDesc:   opts.SortDesc,
 		Search:     search,
 		Predicates: predicates,
+		Cursor:     cursor,
 	}
 }
 
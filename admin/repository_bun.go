@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	querybun "github.com/goliatone/go-crud/pkg/go-query-bun"
 	goerrors "github.com/goliatone/go-errors"
@@ -28,6 +29,8 @@ type BunRepositoryAdapter[T any] struct {
 	filterBuilders  map[string]func(any) repository.SelectCriteria
 	patchOptions    []repository.MapPatchOption
 	recordConverter BunRecordMapper[T]
+	columnMapper    func(T) (map[string]any, error)
+	softDelete      bool
 }
 
 // BunRepositoryOption configures the BunRepositoryAdapter.
@@ -79,6 +82,14 @@ func WithBunFilterMapping[T any](builders map[string]func(any) repository.Select
 	}
 }
 
+// WithBunSoftDelete enables SoftDeleteRepository support. The model must expose
+// nullable deleted_at and deleted_by columns; List and Get hide trashed rows.
+func WithBunSoftDelete[T any]() BunRepositoryOption[T] {
+	return func(a *BunRepositoryAdapter[T]) {
+		a.softDelete = true
+	}
+}
+
 // WithBunRecordMapper overrides the default map-native mapper.
 func WithBunRecordMapper[T any](mapper BunRecordMapper[T]) BunRepositoryOption[T] {
 	return func(a *BunRepositoryAdapter[T]) {
@@ -118,6 +129,9 @@ func NewBunRepositoryAdapter[T any](repo repository.Repository[T], opts ...BunRe
 			ToRecord: mapMapper.ToRecord,
 			ToMap:    mapMapper.ToMap,
 		},
+		// Keyset cursors read sort values by column, whatever keys a custom
+		// record mapper emits.
+		columnMapper: mapMapper.ToMap,
 	}
 	for _, opt := range opts {
 		opt(adapter)
@@ -131,29 +145,77 @@ func (a *BunRepositoryAdapter[T]) ensureRepo() error {
 
 // List delegates to the underlying repository with translated pagination/sort/filter/search.
 func (a *BunRepositoryAdapter[T]) List(ctx context.Context, opts ListOptions) ([]map[string]any, int, error) {
+	return a.list(ctx, opts, a.selectCriteria(), false)
+}
+
+// ListPage lists in keyset mode and returns the cursor for the next page.
+func (a *BunRepositoryAdapter[T]) ListPage(ctx context.Context, opts ListOptions) (ListPage, error) {
+	records, total, err := a.listRecords(ctx, opts, a.selectCriteria(), true)
+	if err != nil {
+		return ListPage{}, err
+	}
+	mapped, err := a.mapRecords(records)
+	if err != nil {
+		return ListPage{}, err
+	}
+	var last map[string]any
+	if len(records) > 0 {
+		if last, err = a.columnMapper(records[len(records)-1]); err != nil {
+			return ListPage{}, err
+		}
+	}
+	return ListPage{
+		Records:    mapped,
+		Total:      total,
+		NextCursor: nextListCursor(opts, last, len(records), normalizeRepositoryAdapterListQuery(opts).PerPage),
+	}, nil
+}
+
+func (a *BunRepositoryAdapter[T]) list(ctx context.Context, opts ListOptions, scope []repository.SelectCriteria, keyset bool) ([]map[string]any, int, error) {
+	records, total, err := a.listRecords(ctx, opts, scope, keyset)
+	if err != nil {
+		return nil, 0, err
+	}
+	mapped, err := a.mapRecords(records)
+	if err != nil {
+		return nil, 0, err
+	}
+	return mapped, total, nil
+}
+
+func (a *BunRepositoryAdapter[T]) listRecords(ctx context.Context, opts ListOptions, scope []repository.SelectCriteria, keyset bool) ([]T, int, error) {
 	if err := a.ensureRepo(); err != nil {
 		return nil, 0, err
 	}
 	query := normalizeRepositoryAdapterListQuery(opts)
-	criteria := append([]repository.SelectCriteria{}, a.baseCriteria...)
+	criteria := append([]repository.SelectCriteria{}, scope...)
 	standardPredicates, customCriteria := a.splitPredicateCriteria(query.FilterPredicates())
 
-	plan, err := buildRepositoryAdapterQueryPlan(query, standardPredicates, a.searchColumns)
+	cursor, err := resolveBunListCursor(query)
+	if err != nil {
+		return nil, 0, err
+	}
+	keyset = keyset || cursor != nil
+	planQuery := query
+	if keyset {
+		// The keyset criteria own the ORDER BY so NULL placement matches the cursor predicate.
+		planQuery.SortBy, planQuery.SortDesc = "", false
+	}
+	plan, err := buildRepositoryAdapterQueryPlan(planQuery, standardPredicates, a.searchColumns)
 	if err != nil {
 		return nil, 0, err
 	}
 	criteria = append(criteria, repositoryAdapterQueryBunCriteria(plan.ListCriteria())...)
 	criteria = append(criteria, customCriteria...)
+	if keyset {
+		criteria = append(criteria, bunListCursorCriteria(cursor, query.SortBy, query.SortDesc))
+	}
 
 	records, total, err := a.repo.List(ctx, criteria...)
 	if err != nil {
 		return nil, 0, mapBunError(err)
 	}
-	mapped, err := a.mapRecords(records)
-	if err != nil {
-		return nil, 0, err
-	}
-	return mapped, total, nil
+	return records, total, nil
 }
 
 // Get retrieves a single record by id.
@@ -161,8 +223,7 @@ func (a *BunRepositoryAdapter[T]) Get(ctx context.Context, id string) (map[strin
 	if err := a.ensureRepo(); err != nil {
 		return nil, err
 	}
-	criteria := append([]repository.SelectCriteria{}, a.baseCriteria...)
-	record, err := a.repo.GetByID(ctx, id, criteria...)
+	record, err := a.repo.GetByID(ctx, id, a.selectCriteria()...)
 	if err != nil {
 		return nil, mapBunError(err)
 	}
@@ -191,8 +252,8 @@ func (a *BunRepositoryAdapter[T]) Update(ctx context.Context, id string, record
 		return nil, err
 	}
 
-	if len(a.baseCriteria) > 0 {
-		if _, err := a.repo.GetByID(ctx, id, a.baseCriteria...); err != nil {
+	if scope := a.selectCriteria(); len(scope) > 0 {
+		if _, err := a.repo.GetByID(ctx, id, scope...); err != nil {
 			return nil, mapBunError(err)
 		}
 	}
@@ -226,6 +287,134 @@ func (a *BunRepositoryAdapter[T]) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// SoftDelete stamps deleted_at/deleted_by on a live row.
+func (a *BunRepositoryAdapter[T]) SoftDelete(ctx context.Context, id, deletedBy string) error {
+	if err := a.ensureSoftDelete(); err != nil {
+		return err
+	}
+	if _, err := a.repo.GetByID(ctx, id, a.selectCriteria()...); err != nil {
+		return mapBunError(err)
+	}
+	_, err := a.patchTrashFields(ctx, id, map[string]any{
+		SoftDeleteDeletedAtField: time.Now().UTC(),
+		SoftDeleteDeletedByField: deletedBy,
+	})
+	return err
+}
+
+// ListTrash lists trashed rows with the same query translation as List.
+func (a *BunRepositoryAdapter[T]) ListTrash(ctx context.Context, opts ListOptions) ([]map[string]any, int, error) {
+	if err := a.ensureSoftDelete(); err != nil {
+		return nil, 0, err
+	}
+	return a.list(ctx, opts, a.trashCriteria(), false)
+}
+
+// ListExpiredTrash returns the IDs of rows trashed before the cutoff, oldest first.
+func (a *BunRepositoryAdapter[T]) ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	if err := a.ensureSoftDelete(); err != nil {
+		return nil, err
+	}
+	criteria := append(a.trashCriteria(), repository.SelectRawProcessor(func(q *bun.SelectQuery) *bun.SelectQuery {
+		q = q.Where("?TableAlias."+SoftDeleteDeletedAtField+" < ?", before.UTC()).
+			OrderExpr("?TableAlias." + SoftDeleteDeletedAtField + " ASC")
+		if limit > 0 {
+			q = q.Limit(limit)
+		}
+		return q
+	}))
+	records, _, err := a.repo.List(ctx, criteria...)
+	if err != nil {
+		return nil, mapBunError(err)
+	}
+	mapped, err := a.mapRecords(records)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(mapped))
+	for _, record := range mapped {
+		ids = append(ids, toString(record["id"]))
+	}
+	return ids, nil
+}
+
+// Restore clears deleted_at/deleted_by on a trashed row.
+func (a *BunRepositoryAdapter[T]) Restore(ctx context.Context, id string) (map[string]any, error) {
+	if err := a.ensureSoftDelete(); err != nil {
+		return nil, err
+	}
+	if _, err := a.repo.GetByID(ctx, id, a.trashCriteria()...); err != nil {
+		return nil, mapBunError(err)
+	}
+	return a.patchTrashFields(ctx, id, map[string]any{
+		SoftDeleteDeletedAtField: nil,
+		SoftDeleteDeletedByField: nil,
+	})
+}
+
+// Purge permanently deletes a trashed row.
+func (a *BunRepositoryAdapter[T]) Purge(ctx context.Context, id string) error {
+	if err := a.ensureSoftDelete(); err != nil {
+		return err
+	}
+	if _, err := a.repo.GetByID(ctx, id, a.trashCriteria()...); err != nil {
+		return mapBunError(err)
+	}
+	return a.Delete(ctx, id)
+}
+
+func (a *BunRepositoryAdapter[T]) ensureSoftDelete() error {
+	if err := a.ensureRepo(); err != nil {
+		return err
+	}
+	if !a.softDelete {
+		return serviceNotConfiguredDomainError("bun soft delete", map[string]any{
+			"component": "bun_repository_adapter",
+			"hint":      "construct the adapter with WithBunSoftDelete",
+		})
+	}
+	return nil
+}
+
+// selectCriteria scopes reads to live rows.
+func (a *BunRepositoryAdapter[T]) selectCriteria() []repository.SelectCriteria {
+	criteria := append([]repository.SelectCriteria{}, a.baseCriteria...)
+	if a.softDelete {
+		criteria = append(criteria, bunTrashCriteria(false))
+	}
+	return criteria
+}
+
+func (a *BunRepositoryAdapter[T]) trashCriteria() []repository.SelectCriteria {
+	return append(append([]repository.SelectCriteria{}, a.baseCriteria...), bunTrashCriteria(true))
+}
+
+// patchTrashFields bypasses any patch allowlist, which only guards user payloads.
+func (a *BunRepositoryAdapter[T]) patchTrashFields(ctx context.Context, id string, patch map[string]any) (map[string]any, error) {
+	updated, err := repository.UpdateByIDWithMapPatch(
+		ctx,
+		a.repo,
+		id,
+		patch,
+		append([]repository.UpdateCriteria{}, a.updateCriteria...),
+		repository.WithPatchKeyMode(repository.MapKeyBun),
+	)
+	if err != nil {
+		return nil, mapBunError(err)
+	}
+	return a.recordConverter.ToMap(updated)
+}
+
+func bunTrashCriteria(trashed bool) repository.SelectCriteria {
+	condition := "?TableAlias." + SoftDeleteDeletedAtField + " IS NULL"
+	if trashed {
+		condition = "?TableAlias." + SoftDeleteDeletedAtField + " IS NOT NULL"
+	}
+	return repository.SelectRawProcessor(func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where(condition)
+	})
+}
+
 func (a *BunRepositoryAdapter[T]) mapRecords(records []T) ([]map[string]any, error) {
 	out := make([]map[string]any, 0, len(records))
 	for _, rec := range records {
@@ -16,6 +16,7 @@ type CMSContentRepository struct {
 	write                     AdminContentWriteService
 	entryNavigationOptions    EntryNavigationOptions
 	entryNavigationOptionsSet bool
+	revisions                 RevisionStore
 }
 
 // NewCMSContentRepository builds a content repository.
@@ -81,6 +82,7 @@ type CMSContentTypeEntryRepository struct {
 	write                     AdminContentWriteService
 	entryNavigationOptions    EntryNavigationOptions
 	entryNavigationOptionsSet bool
+	revisions                 RevisionStore
 }
 
 type cmsContentListOptionsService interface {
@@ -669,9 +671,33 @@ func (r *CMSContentRepository) newReadService() AdminContentReadService {
 
 func (r *CMSContentRepository) newWriteService() AdminContentWriteService {
 	if r.entryNavigationOptionsSet {
-		return newAdminContentWriteServiceWithEntryNavigationOptions(r.content, r.entryNavigationOptions, r.contentTypes)
+		return newAdminContentWriteServiceWithEntryNavigationOptions(r.content, r.entryNavigationOptions, r.contentTypes).withRevisionStore(r.revisions)
 	}
-	return newAdminContentWriteService(r.content, r.contentTypes)
+	return newAdminContentWriteService(r.content, r.contentTypes).withRevisionStore(r.revisions)
+}
+
+// WithRevisionStore records a field-level revision for every content update
+// made through the repository.
+func (r *CMSContentRepository) WithRevisionStore(store RevisionStore) *CMSContentRepository {
+	if r == nil {
+		return r
+	}
+	r.revisions = store
+	r.write = r.newWriteService()
+	return r
+}
+
+func (r *CMSContentRepository) bindRevisionStore(store RevisionStore) {
+	if r != nil && r.revisions == nil {
+		r.WithRevisionStore(store)
+	}
+}
+
+func (r *CMSContentRepository) revisionResource() string {
+	if r == nil || r.revisions == nil {
+		return ""
+	}
+	return RevisionResourceContent
 }
 
 func (r *CMSContentTypeEntryRepository) readService() AdminContentReadService {
@@ -703,9 +729,33 @@ func (r *CMSContentTypeEntryRepository) newReadService() AdminContentReadService
 
 func (r *CMSContentTypeEntryRepository) newWriteService() AdminContentWriteService {
 	if r.entryNavigationOptionsSet {
-		return newAdminContentWriteServiceWithEntryNavigationOptions(r.content, r.entryNavigationOptions)
+		return newAdminContentWriteServiceWithEntryNavigationOptions(r.content, r.entryNavigationOptions).withRevisionStore(r.revisions)
+	}
+	return newAdminContentWriteService(r.content).withRevisionStore(r.revisions)
+}
+
+// WithRevisionStore records a field-level revision for every content update
+// made through the repository.
+func (r *CMSContentTypeEntryRepository) WithRevisionStore(store RevisionStore) *CMSContentTypeEntryRepository {
+	if r == nil {
+		return r
+	}
+	r.revisions = store
+	r.write = r.newWriteService()
+	return r
+}
+
+func (r *CMSContentTypeEntryRepository) bindRevisionStore(store RevisionStore) {
+	if r != nil && r.revisions == nil {
+		r.WithRevisionStore(store)
+	}
+}
+
+func (r *CMSContentTypeEntryRepository) revisionResource() string {
+	if r == nil || r.revisions == nil {
+		return ""
 	}
-	return newAdminContentWriteService(r.content)
+	return RevisionResourceContent
 }
 
 func resolveContentLocaleFromService(ctx context.Context, content CMSContentService, id string) string {
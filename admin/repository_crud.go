@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/goliatone/go-admin/admin/internal/adminkeys"
 	crud "github.com/goliatone/go-crud"
 )
 
@@ -30,20 +31,60 @@ func (r *CRUDRepositoryAdapter) ensureService() error {
 
 // List delegates to the go-crud service using translated list options.
 func (r *CRUDRepositoryAdapter) List(ctx context.Context, opts ListOptions) ([]map[string]any, int, error) {
+	return r.list(ctx, opts, false)
+}
+
+// ListPage lists in keyset mode and returns the cursor for the next page.
+func (r *CRUDRepositoryAdapter) ListPage(ctx context.Context, opts ListOptions) (ListPage, error) {
+	records, total, err := r.list(ctx, opts, true)
+	if err != nil {
+		return ListPage{}, err
+	}
+	// go-crud services return records keyed by column, so the last record
+	// doubles as the cursor source.
+	var last map[string]any
+	if len(records) > 0 {
+		last = records[len(records)-1]
+	}
+	return ListPage{
+		Records:    records,
+		Total:      total,
+		NextCursor: nextListCursor(opts, last, len(records), normalizeRepositoryAdapterListQuery(opts).PerPage),
+	}, nil
+}
+
+func (r *CRUDRepositoryAdapter) list(ctx context.Context, opts ListOptions, keyset bool) ([]map[string]any, int, error) {
 	if err := r.ensureService(); err != nil {
 		return nil, 0, err
 	}
 
-	queryOpts := crudListQueryOptions(normalizeRepositoryAdapterListQuery(opts))
-	criteria, _, err := crud.BuildListCriteriaFromOptions[map[string]any](queryOpts)
+	query := normalizeRepositoryAdapterListQuery(opts)
+	queryOpts := crudListQueryOptions(query)
+	cursor, err := resolveBunListCursor(query)
 	if err != nil {
 		return nil, 0, err
 	}
+	keyset = keyset || cursor != nil
+	criteriaOpts := queryOpts
+	if keyset {
+		// The keyset criteria own the ORDER BY so NULL placement matches the cursor predicate.
+		criteriaOpts.SortBy, criteriaOpts.SortDesc = "", false
+	}
+	criteria, _, err := crud.BuildListCriteriaFromOptions[map[string]any](criteriaOpts)
+	if err != nil {
+		return nil, 0, err
+	}
+	if keyset {
+		criteria = append(criteria, bunListCursorCriteria(cursor, query.SortBy, query.SortDesc))
+	}
 
 	c := newCrudAdapterContext(ctx)
 
 	// Preserve existing service behavior for adapters that still inspect query values.
 	applyListQueryOptionsToContext(c, queryOpts)
+	if query.Cursor != "" {
+		c.setQuery(adminkeys.QueryCursor, query.Cursor)
+	}
 
 	return r.service.Index(c, criteria)
 }
@@ -112,6 +112,7 @@ func (s *stubCRUDService) Index(ctx crud.Context, criteria []repository.SelectCr
 	filtered := s.applyFilters(ctx)
 	filtered = s.applySearch(ctx, filtered)
 	filtered = s.applyOrder(ctx, filtered)
+	filtered = s.applyCursor(ctx, filtered)
 	filtered, total := s.applyPagination(ctx, filtered)
 	return filtered, total, nil
 }
@@ -186,6 +187,30 @@ func (s *stubCRUDService) applyOrder(ctx crud.Context, records []map[string]any)
 	return out
 }
 
+// applyCursor mimics the keyset criteria by resuming after the cursor record.
+func (s *stubCRUDService) applyCursor(ctx crud.Context, records []map[string]any) []map[string]any {
+	token := ctx.Query("cursor")
+	if token == "" {
+		return records
+	}
+	parts := strings.Fields(ctx.Query("order"))
+	sortBy, desc := "", false
+	if len(parts) > 0 {
+		sortBy = parts[0]
+		desc = len(parts) > 1 && strings.EqualFold(parts[1], "desc")
+	}
+	cursor, err := decodeListCursor(token, sortBy, desc)
+	if err != nil {
+		return nil
+	}
+	for idx, rec := range records {
+		if fmt.Sprint(rec["id"]) == cursor.ID {
+			return records[idx+1:]
+		}
+	}
+	return nil
+}
+
 func (s *stubCRUDService) applyPagination(ctx crud.Context, records []map[string]any) ([]map[string]any, int) {
 	limit := ctx.QueryInt("limit", len(records))
 	offset := ctx.QueryInt("offset", 0)
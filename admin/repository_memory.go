@@ -3,8 +3,10 @@ package admin
 import (
 	"context"
 	"maps"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/goliatone/go-admin/internal/primitives"
 )
@@ -13,6 +15,7 @@ import (
 type MemoryRepository struct {
 	mu     sync.Mutex
 	data   []map[string]any
+	trash  []map[string]any
 	nextID int
 }
 
@@ -85,6 +88,88 @@ func (r *MemoryRepository) Delete(_ context.Context, id string) error {
 	return ErrNotFound
 }
 
+// SoftDelete moves a record to the trash, stamping deleted_at and deleted_by.
+func (r *MemoryRepository) SoftDelete(_ context.Context, id, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, rec := range r.data {
+		if rec["id"] == id {
+			r.data = append(r.data[:i], r.data[i+1:]...)
+			rec[SoftDeleteDeletedAtField] = time.Now().UTC()
+			rec[SoftDeleteDeletedByField] = deletedBy
+			r.trash = append(r.trash, rec)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// ListTrash returns trashed records with the same filtering and sorting as List.
+func (r *MemoryRepository) ListTrash(_ context.Context, opts ListOptions) ([]map[string]any, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	paged, total := applyListOptionsToRecordMaps(cloneSlice(r.trash), opts, listRecordOptions{})
+	return cloneSlice(paged), total, nil
+}
+
+// ListExpiredTrash returns the IDs of records trashed before the cutoff, oldest first.
+func (r *MemoryRepository) ListExpiredTrash(_ context.Context, before time.Time, limit int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type expiredRecord struct {
+		id        string
+		deletedAt time.Time
+	}
+	expired := []expiredRecord{}
+	for _, rec := range r.trash {
+		if deletedAt, ok := trashedAt(rec); ok && deletedAt.Before(before) {
+			expired = append(expired, expiredRecord{id: toString(rec["id"]), deletedAt: deletedAt})
+		}
+	}
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].deletedAt.Before(expired[j].deletedAt)
+	})
+	if limit > 0 && len(expired) > limit {
+		expired = expired[:limit]
+	}
+	ids := make([]string, 0, len(expired))
+	for _, rec := range expired {
+		ids = append(ids, rec.id)
+	}
+	return ids, nil
+}
+
+// Restore moves a trashed record back, clearing deleted_at and deleted_by.
+func (r *MemoryRepository) Restore(_ context.Context, id string) (map[string]any, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, rec := range r.trash {
+		if rec["id"] == id {
+			r.trash = append(r.trash[:i], r.trash[i+1:]...)
+			delete(rec, SoftDeleteDeletedAtField)
+			delete(rec, SoftDeleteDeletedByField)
+			r.data = append(r.data, rec)
+			return cloneMap(rec), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Purge permanently removes a trashed record.
+func (r *MemoryRepository) Purge(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, rec := range r.trash {
+		if rec["id"] == id {
+			r.trash = append(r.trash[:i], r.trash[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
 var cloneMap = primitives.CloneAnyMapEmptyOnEmpty
 
 func cloneSlice(in []map[string]any) []map[string]any {
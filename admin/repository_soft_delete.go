@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const (
+	// SoftDeleteDeletedAtField stamps when a record moved to the trash.
+	SoftDeleteDeletedAtField = "deleted_at"
+	// SoftDeleteDeletedByField records the actor that moved a record to the trash.
+	SoftDeleteDeletedByField = "deleted_by"
+)
+
+// SoftDeleteRepository is implemented by repositories that can move records to a
+// trash instead of removing them. Trashed records carry deleted_at/deleted_by and
+// are hidden from List and Get until restored; Delete stays a permanent delete.
+// ListExpiredTrash returns up to limit IDs of records trashed before the cutoff,
+// oldest first, so retention never loads the whole trash.
+type SoftDeleteRepository interface {
+	Repository
+	SoftDelete(ctx context.Context, id, deletedBy string) error
+	ListTrash(ctx context.Context, opts ListOptions) ([]map[string]any, int, error)
+	ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]string, error)
+	Restore(ctx context.Context, id string) (map[string]any, error)
+	Purge(ctx context.Context, id string) error
+}
+
+// trashedAt reads deleted_at from a trashed record.
+func trashedAt(record map[string]any) (time.Time, bool) {
+	switch value := record[SoftDeleteDeletedAtField].(type) {
+	case time.Time:
+		return value, !value.IsZero()
+	case *time.Time:
+		if value == nil || value.IsZero() {
+			return time.Time{}, false
+		}
+		return *value, true
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(value))
+		if err != nil {
+			return time.Time{}, false
+		}
+		return parsed, true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,273 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RevisionActionUpdate marks a revision captured from a regular update.
+	RevisionActionUpdate = "update"
+	// RevisionActionRevert marks a revision captured from reverting to an earlier revision.
+	RevisionActionRevert = "revert"
+	// RevisionResourceContent is the resource CMS content write services record revisions under.
+	RevisionResourceContent = "content"
+
+	revisionDefaultPerPage = 50
+)
+
+// revisionIgnoredFields are bumped on every write and carry no editorial change.
+var revisionIgnoredFields = map[string]struct{}{
+	"updated_at": {},
+	"updated_by": {},
+}
+
+// RevisionFieldChange is one changed field between two record snapshots. Field
+// is a dotted path for nested objects; Before or After is nil when the field
+// was added or removed.
+type RevisionFieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// Revision is a before/after snapshot of one record write.
+type Revision struct {
+	ID       string `json:"id"`
+	Resource string `json:"resource"`
+	RecordID string `json:"record_id"`
+	// Version counts revisions per record, starting at 1.
+	Version int    `json:"version"`
+	Action  string `json:"action"`
+	ActorID string `json:"actor_id,omitempty"`
+	// State is the workflow state of the record after the write.
+	State        string                `json:"state,omitempty"`
+	RevertedFrom string                `json:"reverted_from,omitempty"`
+	Before       map[string]any        `json:"before,omitempty"`
+	After        map[string]any        `json:"after,omitempty"`
+	Changes      []RevisionFieldChange `json:"changes"`
+	CreatedAt    time.Time             `json:"created_at"`
+}
+
+// RevisionFilter narrows revision listings to one record.
+type RevisionFilter struct {
+	Resource string `json:"resource"`
+	RecordID string `json:"record_id"`
+	Page     int    `json:"page"`
+	PerPage  int    `json:"per_page"`
+}
+
+// RevisionStore persists record revisions. AppendRevision assigns ID, Version
+// and CreatedAt; ListRevisions returns the newest revision first.
+type RevisionStore interface {
+	AppendRevision(ctx context.Context, revision Revision) (Revision, error)
+	ListRevisions(ctx context.Context, filter RevisionFilter) ([]Revision, int, error)
+	GetRevision(ctx context.Context, id string) (Revision, error)
+}
+
+// DiffRevisionRecords computes the field-level changes between two record
+// snapshots. Values are compared in their JSON form so typed and decoded
+// records diff the same way; nested objects are walked, arrays compare whole.
+func DiffRevisionRecords(before, after map[string]any) []RevisionFieldChange {
+	changes := []RevisionFieldChange{}
+	diffRevisionMaps("", normalizeRevisionSnapshot(before), normalizeRevisionSnapshot(after), &changes)
+	return changes
+}
+
+func diffRevisionMaps(prefix string, before, after map[string]any, changes *[]RevisionFieldChange) {
+	keys := map[string]struct{}{}
+	for key := range before {
+		keys[key] = struct{}{}
+	}
+	for key := range after {
+		keys[key] = struct{}{}
+	}
+	ordered := make([]string, 0, len(keys))
+	for key := range keys {
+		if prefix == "" {
+			if _, ignored := revisionIgnoredFields[key]; ignored {
+				continue
+			}
+		}
+		ordered = append(ordered, key)
+	}
+	sort.Strings(ordered)
+	for _, key := range ordered {
+		field := key
+		if prefix != "" {
+			field = prefix + "." + key
+		}
+		prev, hadPrev := before[key]
+		next, hasNext := after[key]
+		prevMap, prevIsMap := prev.(map[string]any)
+		nextMap, nextIsMap := next.(map[string]any)
+		if prevIsMap && nextIsMap {
+			diffRevisionMaps(field, prevMap, nextMap, changes)
+			continue
+		}
+		if hadPrev == hasNext && reflect.DeepEqual(prev, next) {
+			continue
+		}
+		*changes = append(*changes, RevisionFieldChange{Field: field, Before: prev, After: next})
+	}
+}
+
+// normalizeRevisionSnapshot round-trips a record through JSON so snapshots hold
+// plain values regardless of the repository's native types.
+func normalizeRevisionSnapshot(record map[string]any) map[string]any {
+	if len(record) == 0 {
+		return map[string]any{}
+	}
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return map[string]any{}
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return map[string]any{}
+	}
+	return out
+}
+
+type revisionIntentContextKey struct{}
+
+// revisionIntent describes why a write happens so the layer that captures the
+// revision (panel or CMS write service) records it correctly.
+type revisionIntent struct {
+	Action       string
+	ActorID      string
+	RevertedFrom string
+}
+
+func withRevisionIntent(ctx context.Context, intent revisionIntent) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, revisionIntentContextKey{}, intent)
+}
+
+func revisionIntentFromContext(ctx context.Context) revisionIntent {
+	intent := revisionIntent{}
+	if ctx != nil {
+		intent, _ = ctx.Value(revisionIntentContextKey{}).(revisionIntent)
+	}
+	if strings.TrimSpace(intent.Action) == "" {
+		intent.Action = RevisionActionUpdate
+	}
+	if strings.TrimSpace(intent.ActorID) == "" {
+		intent.ActorID = actorFromContext(ctx)
+	}
+	return intent
+}
+
+// captureRevision records a write when it changed at least one field. Capture is
+// best-effort: the write already happened and must not be reported as failed,
+// so callers surface the returned error in their activity entry instead.
+func captureRevision(ctx context.Context, store RevisionStore, resource, recordID string, before, after map[string]any) error {
+	if store == nil || strings.TrimSpace(recordID) == "" {
+		return nil
+	}
+	changes := DiffRevisionRecords(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+	intent := revisionIntentFromContext(ctx)
+	snapshot := normalizeRevisionSnapshot(after)
+	_, err := store.AppendRevision(ctx, Revision{
+		Resource:     resource,
+		RecordID:     strings.TrimSpace(recordID),
+		Action:       intent.Action,
+		ActorID:      intent.ActorID,
+		State:        strings.TrimSpace(toString(snapshot["status"])),
+		RevertedFrom: intent.RevertedFrom,
+		Before:       normalizeRevisionSnapshot(before),
+		After:        snapshot,
+		Changes:      changes,
+	})
+	return err
+}
+
+func normalizeRevisionFilter(filter RevisionFilter) RevisionFilter {
+	filter.Resource = strings.TrimSpace(filter.Resource)
+	filter.RecordID = strings.TrimSpace(filter.RecordID)
+	if filter.PerPage <= 0 {
+		filter.PerPage = revisionDefaultPerPage
+	}
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	return filter
+}
+
+// InMemoryRevisionStore keeps revisions in process memory.
+type InMemoryRevisionStore struct {
+	mu        sync.RWMutex
+	revisions []Revision
+}
+
+var _ RevisionStore = (*InMemoryRevisionStore)(nil)
+
+// NewInMemoryRevisionStore builds an empty revision store.
+func NewInMemoryRevisionStore() *InMemoryRevisionStore {
+	return &InMemoryRevisionStore{}
+}
+
+func (s *InMemoryRevisionStore) AppendRevision(_ context.Context, revision Revision) (Revision, error) {
+	if s == nil {
+		return Revision{}, serviceNotConfiguredDomainError("revision store", map[string]any{"component": "revision_store"})
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	version := 0
+	for _, existing := range s.revisions {
+		if existing.Resource == revision.Resource && existing.RecordID == revision.RecordID {
+			version = max(version, existing.Version)
+		}
+	}
+	revision.ID = uuid.NewString()
+	revision.Version = version + 1
+	revision.CreatedAt = time.Now().UTC()
+	s.revisions = append(s.revisions, revision)
+	return revision, nil
+}
+
+func (s *InMemoryRevisionStore) ListRevisions(_ context.Context, filter RevisionFilter) ([]Revision, int, error) {
+	if s == nil {
+		return nil, 0, serviceNotConfiguredDomainError("revision store", map[string]any{"component": "revision_store"})
+	}
+	filter = normalizeRevisionFilter(filter)
+	s.mu.RLock()
+	items := []Revision{}
+	for i := len(s.revisions) - 1; i >= 0; i-- {
+		revision := s.revisions[i]
+		if revision.Resource != filter.Resource || revision.RecordID != filter.RecordID {
+			continue
+		}
+		items = append(items, revision)
+	}
+	s.mu.RUnlock()
+	page, total := paginateInMemory(items, ListOptions{Page: filter.Page, PerPage: filter.PerPage}, revisionDefaultPerPage)
+	return append([]Revision{}, page...), total, nil
+}
+
+func (s *InMemoryRevisionStore) GetRevision(_ context.Context, id string) (Revision, error) {
+	if s == nil {
+		return Revision{}, serviceNotConfiguredDomainError("revision store", map[string]any{"component": "revision_store"})
+	}
+	id = strings.TrimSpace(id)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, revision := range s.revisions {
+		if revision.ID == id {
+			return revision, nil
+		}
+	}
+	return Revision{}, ErrNotFound
+}
@@ -0,0 +1,204 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// BunRevisionStore persists revisions in the record_revisions table.
+type BunRevisionStore struct {
+	db *bun.DB
+}
+
+var _ RevisionStore = (*BunRevisionStore)(nil)
+
+func NewBunRevisionStore(db *bun.DB) *BunRevisionStore {
+	if db == nil {
+		return nil
+	}
+	return &BunRevisionStore{db: db}
+}
+
+type bunRevisionRecord struct {
+	bun.BaseModel `bun:"table:record_revisions,alias:rr"`
+
+	ID           string    `bun:"id,pk" json:"id"`
+	Resource     string    `bun:"resource" json:"resource"`
+	RecordID     string    `bun:"record_id" json:"record_id"`
+	Version      int       `bun:"version" json:"version"`
+	Action       string    `bun:"action" json:"action"`
+	ActorID      string    `bun:"actor_id" json:"actor_id"`
+	State        string    `bun:"state" json:"state"`
+	RevertedFrom string    `bun:"reverted_from" json:"reverted_from"`
+	BeforeJSON   string    `bun:"before_json" json:"before_json"`
+	AfterJSON    string    `bun:"after_json" json:"after_json"`
+	ChangesJSON  string    `bun:"changes_json" json:"changes_json"`
+	CreatedAt    time.Time `bun:"created_at" json:"created_at"`
+}
+
+// revisionAppendAttempts bounds how often AppendRevision retries when a
+// concurrent writer claimed the same version first.
+const revisionAppendAttempts = 5
+
+// AppendRevision assigns the next version for the record. Concurrent appends
+// can read the same MAX(version); the unique (resource, record_id, version)
+// index rejects the loser, which then retries with a fresh version.
+func (s *BunRevisionStore) AppendRevision(ctx context.Context, revision Revision) (Revision, error) {
+	if err := s.ready(); err != nil {
+		return Revision{}, err
+	}
+	revision.ID = uuid.NewString()
+	revision.CreatedAt = time.Now().UTC()
+	var err error
+	for range revisionAppendAttempts {
+		err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			var version int
+			err := tx.NewSelect().
+				Model((*bunRevisionRecord)(nil)).
+				ColumnExpr("COALESCE(MAX(version), 0)").
+				Where("resource = ?", revision.Resource).
+				Where("record_id = ?", revision.RecordID).
+				Scan(ctx, &version)
+			if err != nil {
+				return err
+			}
+			revision.Version = version + 1
+			record, err := bunRevisionRecordFromRevision(revision)
+			if err != nil {
+				return err
+			}
+			_, err = tx.NewInsert().Model(&record).Exec(ctx)
+			return err
+		})
+		if err == nil {
+			return revision, nil
+		}
+		if !workflowRepoUniqueConflict(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	return Revision{}, err
+}
+
+func (s *BunRevisionStore) ListRevisions(ctx context.Context, filter RevisionFilter) ([]Revision, int, error) {
+	if err := s.ready(); err != nil {
+		return nil, 0, err
+	}
+	filter = normalizeRevisionFilter(filter)
+	records := []bunRevisionRecord{}
+	total, err := s.db.NewSelect().
+		Model(&records).
+		Where("resource = ?", filter.Resource).
+		Where("record_id = ?", filter.RecordID).
+		OrderExpr("version DESC").
+		Limit(filter.PerPage).
+		Offset((filter.Page - 1) * filter.PerPage).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]Revision, 0, len(records))
+	for _, record := range records {
+		revision, err := revisionFromBunRecord(record)
+		if err != nil {
+			return nil, 0, err
+		}
+		out = append(out, revision)
+	}
+	return out, total, nil
+}
+
+func (s *BunRevisionStore) GetRevision(ctx context.Context, id string) (Revision, error) {
+	if err := s.ready(); err != nil {
+		return Revision{}, err
+	}
+	record := bunRevisionRecord{}
+	err := s.db.NewSelect().
+		Model(&record).
+		Where("id = ?", strings.TrimSpace(id)).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Revision{}, ErrNotFound
+	}
+	if err != nil {
+		return Revision{}, err
+	}
+	return revisionFromBunRecord(record)
+}
+
+func (s *BunRevisionStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("revision store", map[string]any{
+			"component": "revision_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunRevisionRecordFromRevision(revision Revision) (bunRevisionRecord, error) {
+	before, err := json.Marshal(normalizeRevisionSnapshot(revision.Before))
+	if err != nil {
+		return bunRevisionRecord{}, err
+	}
+	after, err := json.Marshal(normalizeRevisionSnapshot(revision.After))
+	if err != nil {
+		return bunRevisionRecord{}, err
+	}
+	changes := revision.Changes
+	if changes == nil {
+		changes = []RevisionFieldChange{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return bunRevisionRecord{}, err
+	}
+	return bunRevisionRecord{
+		ID:           revision.ID,
+		Resource:     revision.Resource,
+		RecordID:     revision.RecordID,
+		Version:      revision.Version,
+		Action:       revision.Action,
+		ActorID:      revision.ActorID,
+		State:        revision.State,
+		RevertedFrom: revision.RevertedFrom,
+		BeforeJSON:   string(before),
+		AfterJSON:    string(after),
+		ChangesJSON:  string(changesJSON),
+		CreatedAt:    revision.CreatedAt.UTC(),
+	}, nil
+}
+
+func revisionFromBunRecord(record bunRevisionRecord) (Revision, error) {
+	revision := Revision{
+		ID:           record.ID,
+		Resource:     record.Resource,
+		RecordID:     record.RecordID,
+		Version:      record.Version,
+		Action:       record.Action,
+		ActorID:      record.ActorID,
+		State:        record.State,
+		RevertedFrom: record.RevertedFrom,
+		Before:       map[string]any{},
+		After:        map[string]any{},
+		Changes:      []RevisionFieldChange{},
+		CreatedAt:    record.CreatedAt,
+	}
+	if err := json.Unmarshal([]byte(record.BeforeJSON), &revision.Before); err != nil {
+		return Revision{}, err
+	}
+	if err := json.Unmarshal([]byte(record.AfterJSON), &revision.After); err != nil {
+		return Revision{}, err
+	}
+	if err := json.Unmarshal([]byte(record.ChangesJSON), &revision.Changes); err != nil {
+		return Revision{}, err
+	}
+	return revision, nil
+}
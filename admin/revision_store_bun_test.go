@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunRevisionStoreAssignsDistinctVersionsUnderConcurrency(t *testing.T) {
+	ctx := context.Background()
+	db := setupMigratedSQLite(t, admindata.RecordRevisionMigrations(), "0020_record_revisions.up.sql")
+	db.SetMaxOpenConns(1)
+	store := NewBunRevisionStore(db)
+
+	const writers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for i := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := store.AppendRevision(ctx, Revision{
+				Resource: "products",
+				RecordID: "rec-1",
+				ActorID:  "editor-1",
+				After:    map[string]any{"price": i},
+				Changes:  []RevisionFieldChange{{Field: "price", After: i}},
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+
+	revisions, total, err := store.ListRevisions(ctx, RevisionFilter{Resource: "products", RecordID: "rec-1", PerPage: writers})
+	if err != nil || total != writers {
+		t.Fatalf("expected %d revisions, got %d (%v)", writers, total, err)
+	}
+	for i, revision := range revisions {
+		if revision.Version != writers-i {
+			t.Fatalf("expected versions %d..1 newest first, got %+v", writers, revisions)
+		}
+	}
+
+	loaded, err := store.GetRevision(ctx, revisions[0].ID)
+	if err != nil || loaded.RecordID != "rec-1" || loaded.ActorID != "editor-1" || len(loaded.Changes) != 1 {
+		t.Fatalf("expected revision round-trip, got %+v (%v)", loaded, err)
+	}
+	if _, err := store.GetRevision(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	other, err := store.AppendRevision(ctx, Revision{Resource: "products", RecordID: "rec-2", Changes: []RevisionFieldChange{{Field: "title"}}})
+	if err != nil || other.Version != 1 {
+		t.Fatalf("expected versions to count per record, got %+v (%v)", other, err)
+	}
+}
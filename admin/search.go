@@ -2,8 +2,19 @@ package admin
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	goerrors "github.com/goliatone/go-errors"
 )
 
+// DefaultSearchAdapterTimeout bounds each adapter call during a global search.
+const DefaultSearchAdapterTimeout = 2 * time.Second
+
 // SearchResult represents a single search hit.
 type SearchResult struct {
 	Type        string `json:"type"`
@@ -13,6 +24,45 @@ type SearchResult struct {
 	URL         string `json:"url,omitempty"`
 	Icon        string `json:"icon,omitempty"`
 	Thumbnail   string `json:"thumbnail,omitempty"`
+	// Score ranks the hit between 0 and 1. Adapters may set it; otherwise the
+	// engine scores the hit by how closely the title matches the query.
+	Score float64 `json:"score"`
+}
+
+// SearchResultGroup collects the hits of one type for the command palette.
+type SearchResultGroup struct {
+	Type    string         `json:"type"`
+	Score   float64        `json:"score"`
+	Results []SearchResult `json:"results"`
+}
+
+// Search adapter error codes reported to clients. The underlying adapter error
+// is only logged, never returned.
+const (
+	SearchAdapterErrorTimeout     = "timeout"
+	SearchAdapterErrorUnavailable = "unavailable"
+	SearchAdapterErrorFailed      = "error"
+)
+
+// SearchAdapterReport describes how one adapter answered a global search. A
+// failed adapter carries one of the SearchAdapterError codes and a generic
+// message.
+type SearchAdapterReport struct {
+	Key       string `json:"key"`
+	Results   int    `json:"results"`
+	LatencyMS int64  `json:"latency_ms"`
+	ErrorCode string `json:"error_code,omitempty"`
+	Error     string `json:"error,omitempty"`
+	TimedOut  bool   `json:"timed_out,omitempty"`
+}
+
+// SearchResponse is a ranked global search result with a per-adapter report.
+// Partial is set when at least one adapter failed or timed out.
+type SearchResponse struct {
+	Results  []SearchResult        `json:"results"`
+	Groups   []SearchResultGroup   `json:"groups"`
+	Adapters []SearchAdapterReport `json:"adapters"`
+	Partial  bool                  `json:"partial"`
 }
 
 // SearchAdapter performs a search for a specific entity type.
@@ -21,17 +71,41 @@ type SearchAdapter interface {
 	Permission() string
 }
 
+// SearchAdapterTimeout lets an adapter override the engine's per-adapter timeout.
+type SearchAdapterTimeout interface {
+	SearchTimeout() time.Duration
+}
+
 // SearchEngine aggregates adapters and executes queries across them.
 type SearchEngine struct {
 	adapters   map[string]SearchAdapter
 	primary    SearchAdapter
 	authorizer Authorizer
 	enabled    bool
+	timeout    time.Duration
+	logger     Logger
 }
 
 // NewSearchEngine constructs a search engine.
 func NewSearchEngine(authorizer Authorizer) *SearchEngine {
-	return &SearchEngine{adapters: make(map[string]SearchAdapter), authorizer: authorizer, enabled: true}
+	return &SearchEngine{
+		adapters:   make(map[string]SearchAdapter),
+		authorizer: authorizer,
+		enabled:    true,
+		timeout:    DefaultSearchAdapterTimeout,
+		logger:     ensureLogger(nil),
+	}
+}
+
+// SetAdapterTimeout bounds each adapter call. Non-positive values restore the default.
+func (s *SearchEngine) SetAdapterTimeout(timeout time.Duration) {
+	if s == nil {
+		return
+	}
+	if timeout <= 0 {
+		timeout = DefaultSearchAdapterTimeout
+	}
+	s.timeout = timeout
 }
 
 // Enable toggles whether search is available.
@@ -58,6 +132,15 @@ func (s *SearchEngine) Unregister(key string) {
 	delete(s.adapters, key)
 }
 
+// WithLogger sets the logger that records adapter failures.
+func (s *SearchEngine) WithLogger(logger Logger) *SearchEngine {
+	if s == nil {
+		return nil
+	}
+	s.logger = ensureLogger(logger)
+	return s
+}
+
 // SetPrimary installs the canonical search adapter. When configured, Query
 // routes all requests through the primary adapter instead of fanout across the
 // legacy registry.
@@ -68,39 +151,232 @@ func (s *SearchEngine) SetPrimary(adapter SearchAdapter) {
 	s.primary = adapter
 }
 
-// Query searches all adapters respecting permissions.
+// Query searches all adapters respecting permissions and returns the ranked
+// results. Adapter failures only drop that adapter's hits; Query fails when
+// every queried adapter failed.
 func (s *SearchEngine) Query(ctx AdminContext, query string, limit int) ([]SearchResult, error) {
+	response, err := s.QueryReport(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
+// QueryReport searches the permitted adapters concurrently, each bounded by its
+// timeout, and merges their hits by score, then adapter key, then adapter
+// order. When a primary adapter is configured only the primary is queried.
+func (s *SearchEngine) QueryReport(ctx AdminContext, query string, limit int) (SearchResponse, error) {
 	if s == nil || !s.enabled {
-		return nil, FeatureDisabledError{Feature: string(FeatureSearch)}
+		return SearchResponse{}, FeatureDisabledError{Feature: string(FeatureSearch)}
 	}
 	if limit <= 0 {
 		limit = 10
 	}
+	if ctx.Context == nil {
+		ctx.Context = context.Background()
+	}
+	targets := map[string]SearchAdapter{}
 	if s.primary != nil {
-		if !permissionAllowed(s.authorizer, ctx.Context, s.primary.Permission(), "search") {
-			return []SearchResult{}, nil
+		targets["primary"] = s.primary
+	} else {
+		for key, adapter := range s.adapters {
+			targets[key] = adapter
+		}
+	}
+	keys := make([]string, 0, len(targets))
+	for key, adapter := range targets {
+		if permissionAllowed(s.authorizer, ctx.Context, adapter.Permission(), "search") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	outcomes := make([]searchAdapterOutcome, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			outcomes[i] = s.runAdapter(ctx.Context, key, targets[key], query, limit)
+		}(i, key)
+	}
+	wg.Wait()
+
+	response := SearchResponse{Results: []SearchResult{}, Groups: []SearchResultGroup{}, Adapters: []SearchAdapterReport{}}
+	ranked := []rankedSearchResult{}
+	var firstErr error
+	for i, outcome := range outcomes {
+		report := SearchAdapterReport{
+			Key:       keys[i],
+			Results:   len(outcome.hits),
+			LatencyMS: outcome.latency.Milliseconds(),
+			TimedOut:  outcome.timedOut,
 		}
-		hits, err := s.primary.Search(ctx.Context, query, limit)
-		if err != nil {
-			return nil, err
+		if outcome.err != nil {
+			report.ErrorCode, report.Error = searchAdapterErrorReport(outcome)
+			s.logger.Warn("search adapter failed",
+				"adapter", keys[i],
+				"error_code", report.ErrorCode,
+				"latency_ms", report.LatencyMS,
+				"error", outcome.err,
+			)
+			response.Partial = true
+			if firstErr == nil {
+				firstErr = searchUnavailableError(report.ErrorCode)
+			}
+		}
+		response.Adapters = append(response.Adapters, report)
+		for position, hit := range outcome.hits {
+			if hit.Type == "" && s.primary == nil {
+				hit.Type = keys[i]
+			}
+			hit.Score = scoreSearchResult(query, hit)
+			ranked = append(ranked, rankedSearchResult{result: hit, key: keys[i], position: position})
 		}
-		return hits, nil
 	}
-	results := []SearchResult{}
-	for key, adapter := range s.adapters {
-		if !permissionAllowed(s.authorizer, ctx.Context, adapter.Permission(), "search") {
-			continue
+	if len(keys) > 0 && allSearchAdaptersFailed(outcomes) {
+		return response, firstErr
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].result.Score != ranked[j].result.Score {
+			return ranked[i].result.Score > ranked[j].result.Score
 		}
-		hits, err := adapter.Search(ctx.Context, query, limit)
-		if err != nil {
-			return nil, err
+		if ranked[i].key != ranked[j].key {
+			return ranked[i].key < ranked[j].key
 		}
-		for _, h := range hits {
-			if h.Type == "" {
-				h.Type = key
+		return ranked[i].position < ranked[j].position
+	})
+	for _, item := range ranked {
+		response.Results = append(response.Results, item.result)
+	}
+	response.Groups = groupSearchResults(response.Results)
+	return response, nil
+}
+
+type searchAdapterOutcome struct {
+	hits     []SearchResult
+	err      error
+	latency  time.Duration
+	timedOut bool
+}
+
+type rankedSearchResult struct {
+	result   SearchResult
+	key      string
+	position int
+}
+
+// runAdapter calls one adapter under its timeout. An adapter that ignores
+// context cancellation is abandoned when the timeout elapses; a panicking
+// adapter is reported as failed.
+func (s *SearchEngine) runAdapter(ctx context.Context, key string, adapter SearchAdapter, query string, limit int) searchAdapterOutcome {
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = DefaultSearchAdapterTimeout
+	}
+	if custom, ok := adapter.(SearchAdapterTimeout); ok && custom.SearchTimeout() > 0 {
+		timeout = custom.SearchTimeout()
+	}
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	started := time.Now()
+	done := make(chan searchAdapterOutcome, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				done <- searchAdapterOutcome{err: fmt.Errorf("search adapter %q panicked: %v", key, recovered)}
 			}
-			results = append(results, h)
+		}()
+		hits, err := adapter.Search(callCtx, query, limit)
+		done <- searchAdapterOutcome{hits: hits, err: err}
+	}()
+
+	var outcome searchAdapterOutcome
+	select {
+	case outcome = <-done:
+	case <-callCtx.Done():
+		outcome = searchAdapterOutcome{
+			err: serviceUnavailableDomainError("search adapter timed out", map[string]any{
+				"adapter": key,
+				"timeout": timeout.String(),
+			}),
+			timedOut: true,
+		}
+	}
+	outcome.latency = time.Since(started)
+	if outcome.err != nil {
+		outcome.hits = nil
+	}
+	return outcome
+}
+
+// searchAdapterErrorReport maps an adapter failure to a stable code and a
+// generic message that is safe to return to clients.
+func searchAdapterErrorReport(outcome searchAdapterOutcome) (string, string) {
+	if outcome.timedOut || errors.Is(outcome.err, context.DeadlineExceeded) {
+		return SearchAdapterErrorTimeout, "search adapter timed out"
+	}
+	var typed *goerrors.Error
+	if errors.As(outcome.err, &typed) && typed.TextCode == TextCodeServiceUnavailable {
+		return SearchAdapterErrorUnavailable, "search adapter unavailable"
+	}
+	return SearchAdapterErrorFailed, "search adapter failed"
+}
+
+// searchUnavailableError is returned when every adapter failed; it carries the
+// first failure code instead of the adapter error.
+func searchUnavailableError(code string) error {
+	return serviceUnavailableDomainError("search unavailable", map[string]any{
+		"error_code": code,
+	})
+}
+
+func allSearchAdaptersFailed(outcomes []searchAdapterOutcome) bool {
+	for _, outcome := range outcomes {
+		if outcome.err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// scoreSearchResult keeps an adapter score (clamped to 0..1) and otherwise
+// ranks exact, prefix, and substring title matches above description matches.
+func scoreSearchResult(query string, result SearchResult) float64 {
+	if result.Score > 0 {
+		return min(result.Score, 1)
+	}
+	q := strings.ToLower(strings.TrimSpace(query))
+	title := strings.ToLower(strings.TrimSpace(result.Title))
+	switch {
+	case q == "":
+		return 0.1
+	case title == q:
+		return 1
+	case strings.HasPrefix(title, q):
+		return 0.8
+	case strings.Contains(title, q):
+		return 0.6
+	case strings.Contains(strings.ToLower(result.Description), q):
+		return 0.4
+	}
+	return 0.1
+}
+
+// groupSearchResults groups ranked results by type, keeping each group's
+// results in rank order and ordering groups by their best score.
+func groupSearchResults(results []SearchResult) []SearchResultGroup {
+	groups := []SearchResultGroup{}
+	index := map[string]int{}
+	for _, result := range results {
+		i, ok := index[result.Type]
+		if !ok {
+			i = len(groups)
+			index[result.Type] = i
+			groups = append(groups, SearchResultGroup{Type: result.Type, Score: result.Score})
 		}
+		groups[i].Results = append(groups[i].Results, result)
 	}
-	return results, nil
+	return groups
 }
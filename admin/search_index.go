@@ -0,0 +1,307 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+const searchIndexReindexPerPage = 200
+
+// SearchDocument is one indexed record. Fields holds the allowlisted scalar
+// values for filtering; Body holds the allowlisted text. TenantID and OrgID
+// scope the document for admin search.
+type SearchDocument struct {
+	Type        string         `json:"type"`
+	RecordID    string         `json:"record_id"`
+	Title       string         `json:"title"`
+	Summary     string         `json:"summary,omitempty"`
+	Body        string         `json:"body,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	Locale      string         `json:"locale,omitempty"`
+	Status      string         `json:"status,omitempty"`
+	PublishedAt *time.Time     `json:"published_at,omitempty"`
+	TenantID    string         `json:"tenant_id,omitempty"`
+	OrgID       string         `json:"org_id,omitempty"`
+	Fields      map[string]any `json:"fields,omitempty"`
+}
+
+// SearchDocumentMapper turns a panel record into a search document. Returning
+// ok=false keeps the record out of the index.
+type SearchDocumentMapper func(panel string, record map[string]any) (SearchDocument, bool)
+
+// PanelSearchIndexer keeps a search index in sync with panel writes.
+// ResetPanel drops every document of a panel before a reindex.
+type PanelSearchIndexer interface {
+	IndexRecord(ctx context.Context, panel string, record map[string]any) error
+	RemoveRecord(ctx context.Context, panel, id string) error
+	ResetPanel(ctx context.Context, panel string) error
+}
+
+// SearchDocumentFields is the allowlist of record fields a mapper reads besides
+// the title, summary, and metadata fields. Body fields are indexed as text,
+// including nested objects and lists; Filters are kept as filterable fields
+// when they hold scalar values. Other record fields never reach the index.
+type SearchDocumentFields struct {
+	Body    []string `json:"body,omitempty"`
+	Filters []string `json:"filters,omitempty"`
+}
+
+var (
+	searchDocumentTitleFields   = []string{"title", "name", "label", "slug"}
+	searchDocumentSummaryFields = []string{"summary", "excerpt", "description"}
+	defaultSearchDocumentFields = SearchDocumentFields{Body: []string{"body", "content", "blocks"}}
+)
+
+// SearchDocumentFromRecord is the default mapper. It takes the title from
+// title/name/label/slug, the summary from summary/excerpt/description, and
+// indexes body/content/blocks as the body. It keeps no filter fields.
+func SearchDocumentFromRecord(panel string, record map[string]any) (SearchDocument, bool) {
+	return mapSearchDocument(panel, record, defaultSearchDocumentFields)
+}
+
+// NewSearchDocumentMapper returns a mapper that reads the title, summary, and
+// metadata like SearchDocumentFromRecord, and only the given body and filter
+// fields besides them.
+func NewSearchDocumentMapper(fields SearchDocumentFields) SearchDocumentMapper {
+	fields = SearchDocumentFields{
+		Body:    append([]string(nil), fields.Body...),
+		Filters: append([]string(nil), fields.Filters...),
+	}
+	return func(panel string, record map[string]any) (SearchDocument, bool) {
+		return mapSearchDocument(panel, record, fields)
+	}
+}
+
+func mapSearchDocument(panel string, record map[string]any, fields SearchDocumentFields) (SearchDocument, bool) {
+	id := extractRecordID(record)
+	if id == "" {
+		return SearchDocument{}, false
+	}
+	doc := SearchDocument{
+		Type:     panel,
+		RecordID: id,
+		Title:    firstRecordText(record, searchDocumentTitleFields),
+		Summary:  firstRecordText(record, searchDocumentSummaryFields),
+		URL:      firstRecordText(record, []string{"url", "path"}),
+		Locale:   strings.TrimSpace(toString(record["locale"])),
+		Status:   strings.TrimSpace(toString(record["status"])),
+		TenantID: strings.TrimSpace(toString(record["tenant_id"])),
+		OrgID:    strings.TrimSpace(toString(record["org_id"])),
+		Fields:   map[string]any{},
+	}
+	if publishedAt, ok := recordTime(record["published_at"]); ok {
+		doc.PublishedAt = &publishedAt
+	}
+	if doc.Title == "" {
+		doc.Title = id
+	}
+	body := []string{}
+	for _, key := range fields.Body {
+		body = appendRecordText(body, record[key], 0)
+	}
+	doc.Body = strings.Join(body, "\n")
+	for _, key := range fields.Filters {
+		switch value := record[key].(type) {
+		case string, bool, int, int32, int64, float32, float64:
+			doc.Fields[key] = value
+		}
+	}
+	return doc, true
+}
+
+func firstRecordText(record map[string]any, fields []string) string {
+	for _, field := range fields {
+		if value, ok := record[field].(string); ok && strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+func appendRecordText(out []string, value any, depth int) []string {
+	if depth > 4 {
+		return out
+	}
+	switch typed := value.(type) {
+	case string:
+		if text := strings.TrimSpace(typed); text != "" {
+			out = append(out, text)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			out = appendRecordText(out, typed[key], depth+1)
+		}
+	case []any:
+		for _, item := range typed {
+			out = appendRecordText(out, item, depth+1)
+		}
+	case []string:
+		for _, item := range typed {
+			out = appendRecordText(out, item, depth+1)
+		}
+	}
+	return out
+}
+
+func recordTime(value any) (time.Time, bool) {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.UTC(), !typed.IsZero()
+	case *time.Time:
+		if typed == nil || typed.IsZero() {
+			return time.Time{}, false
+		}
+		return typed.UTC(), true
+	case string:
+		parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(typed))
+		if err != nil {
+			return time.Time{}, false
+		}
+		return parsed.UTC(), true
+	}
+	return time.Time{}, false
+}
+
+// Searchable opts the panel into the admin search index set with
+// Admin.WithSearchIndex. Panels are not indexed unless they opt in.
+func (b *PanelBuilder) Searchable(enabled bool) *PanelBuilder {
+	b.searchable = enabled
+	return b
+}
+
+// WithSearchIndex keeps the panel's records in a search index. Creates and
+// updates are indexed from the after hooks, deletes remove the document, and
+// restore, purge, and scheduled transitions are picked up from the panel's
+// activity events. Index failures never fail the write.
+func (b *PanelBuilder) WithSearchIndex(index PanelSearchIndexer) *PanelBuilder {
+	b.searchIndex = index
+	return b
+}
+
+func bindPanelSearchIndex(b *PanelBuilder) {
+	if b == nil || b.searchIndex == nil {
+		return
+	}
+	index, name := b.searchIndex, b.name
+	indexRecord := func(ctx AdminContext, record map[string]any) error {
+		_ = index.IndexRecord(ctx.Context, name, record) //nolint:errcheck // indexing is best-effort; ReindexSearch repairs drift.
+		return nil
+	}
+	b.hooks.AfterCreate = chainAfterRecordHook(b.hooks.AfterCreate, indexRecord)
+	b.hooks.AfterUpdate = chainAfterRecordHook(b.hooks.AfterUpdate, indexRecord)
+	b.hooks.AfterDelete = chainAfterDeleteHook(b.hooks.AfterDelete, func(ctx AdminContext, id string) error {
+		_ = index.RemoveRecord(ctx.Context, name, id) //nolint:errcheck // indexing is best-effort; ReindexSearch repairs drift.
+		return nil
+	})
+	if _, wrapped := b.activity.(searchIndexActivitySink); !wrapped {
+		b.activity = searchIndexActivitySink{next: b.activity, index: index, panel: name, repo: b.repo}
+	}
+}
+
+func chainAfterRecordHook(existing, next func(AdminContext, map[string]any) error) func(AdminContext, map[string]any) error {
+	if existing == nil {
+		return next
+	}
+	return func(ctx AdminContext, record map[string]any) error {
+		if err := existing(ctx, record); err != nil {
+			return err
+		}
+		return next(ctx, record)
+	}
+}
+
+func chainAfterDeleteHook(existing, next func(AdminContext, string) error) func(AdminContext, string) error {
+	if existing == nil {
+		return next
+	}
+	return func(ctx AdminContext, id string) error {
+		if err := existing(ctx, id); err != nil {
+			return err
+		}
+		return next(ctx, id)
+	}
+}
+
+// searchIndexActivitySink forwards panel activity and re-syncs the index for
+// writes that bypass the panel hooks.
+type searchIndexActivitySink struct {
+	next  ActivitySink
+	index PanelSearchIndexer
+	panel string
+	repo  Repository
+}
+
+func (s searchIndexActivitySink) Record(ctx context.Context, entry ActivityEntry) error {
+	var err error
+	if s.next != nil {
+		err = s.next.Record(ctx, entry)
+	}
+	id := strings.TrimSpace(toString(entry.Metadata["id"]))
+	if id == "" {
+		return err
+	}
+	switch entry.Action {
+	case "panel.purge":
+		_ = s.index.RemoveRecord(ctx, s.panel, id) //nolint:errcheck // indexing is best-effort; ReindexSearch repairs drift.
+	case "panel.restore", "panel.schedule.applied":
+		record, getErr := s.repo.Get(ctx, id)
+		switch {
+		case getErr == nil:
+			_ = s.index.IndexRecord(ctx, s.panel, record) //nolint:errcheck // indexing is best-effort; ReindexSearch repairs drift.
+		case errors.Is(getErr, ErrNotFound):
+			_ = s.index.RemoveRecord(ctx, s.panel, id) //nolint:errcheck // indexing is best-effort; ReindexSearch repairs drift.
+		}
+	}
+	return err
+}
+
+func (s searchIndexActivitySink) List(ctx context.Context, limit int, filters ...ActivityFilter) ([]ActivityEntry, error) {
+	if s.next == nil {
+		return nil, nil
+	}
+	return s.next.List(ctx, limit, filters...)
+}
+
+// SearchIndexEnabled reports whether the panel keeps a search index.
+func (p *Panel) SearchIndexEnabled() bool {
+	return p != nil && p.searchIndex != nil
+}
+
+// ReindexSearch rebuilds the panel's documents from its repository and returns
+// how many records were indexed. Trashed records stay out of the index.
+func (p *Panel) ReindexSearch(ctx context.Context) (int, error) {
+	if !p.SearchIndexEnabled() {
+		return 0, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := p.searchIndex.ResetPanel(ctx, p.name); err != nil {
+		return 0, err
+	}
+	indexed := 0
+	for page := 1; ; page++ {
+		records, total, err := p.repo.List(ctx, ListOptions{Page: page, PerPage: searchIndexReindexPerPage})
+		if err != nil {
+			return indexed, err
+		}
+		for _, record := range records {
+			if err := p.searchIndex.IndexRecord(ctx, p.name, record); err != nil {
+				return indexed, err
+			}
+			indexed++
+		}
+		if len(records) < searchIndexReindexPerPage || page*searchIndexReindexPerPage >= total {
+			break
+		}
+	}
+	return indexed, nil
+}
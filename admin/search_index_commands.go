@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-command"
+)
+
+const searchReindexCommandName = "jobs.search.reindex"
+
+// SearchReindexResult reports how many records were indexed per panel.
+type SearchReindexResult struct {
+	Indexed map[string]int `json:"indexed"`
+}
+
+// SearchReindexInput rebuilds the search index. Panels limits the run to the
+// given panels; empty reindexes every panel with a search index.
+type SearchReindexInput struct {
+	Panels []string             `json:"panels,omitempty"`
+	Result *SearchReindexResult `json:"-"`
+}
+
+func (SearchReindexInput) Type() string { return searchReindexCommandName }
+
+func (SearchReindexInput) Validate() error { return nil }
+
+// SearchReindexCommand rebuilds the documents of search indexed panels from
+// their repositories, for first setup or to repair drift after failed writes.
+type SearchReindexCommand struct {
+	Registry *Registry `json:"registry"`
+}
+
+var _ command.Commander[SearchReindexInput] = (*SearchReindexCommand)(nil)
+
+func (c *SearchReindexCommand) Execute(ctx context.Context, msg SearchReindexInput) error {
+	if c == nil || c.Registry == nil {
+		return serviceNotConfiguredDomainError("panel registry", map[string]any{
+			"command": searchReindexCommandName,
+		})
+	}
+	panels := c.Registry.Panels()
+	wanted := map[string]bool{}
+	for _, name := range msg.Panels {
+		if name = strings.TrimSpace(name); name != "" {
+			wanted[name] = true
+		}
+	}
+	names := make([]string, 0, len(panels))
+	for name, panel := range panels {
+		if panel.SearchIndexEnabled() && (len(wanted) == 0 || wanted[name]) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	result := SearchReindexResult{Indexed: map[string]int{}}
+	for _, name := range names {
+		indexed, err := panels[name].ReindexSearch(ctx)
+		result.Indexed[name] = indexed
+		if err != nil {
+			return err
+		}
+	}
+	if msg.Result != nil {
+		*msg.Result = result
+	}
+	return nil
+}
+
+// RegisterSearchIndexCommands registers the search reindex command.
+func RegisterSearchIndexCommands(bus *CommandBus, registry *Registry) error {
+	_, err := RegisterCommand(bus, &SearchReindexCommand{Registry: registry})
+	return err
+}
@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+)
+
+type memorySearchIndexer struct {
+	docs map[string]map[string]SearchDocument
+}
+
+func newMemorySearchIndexer() *memorySearchIndexer {
+	return &memorySearchIndexer{docs: map[string]map[string]SearchDocument{}}
+}
+
+func (m *memorySearchIndexer) IndexRecord(_ context.Context, panel string, record map[string]any) error {
+	doc, ok := SearchDocumentFromRecord(panel, record)
+	if !ok {
+		return nil
+	}
+	if m.docs[panel] == nil {
+		m.docs[panel] = map[string]SearchDocument{}
+	}
+	m.docs[panel][doc.RecordID] = doc
+	return nil
+}
+
+func (m *memorySearchIndexer) RemoveRecord(_ context.Context, panel, id string) error {
+	delete(m.docs[panel], id)
+	return nil
+}
+
+func (m *memorySearchIndexer) ResetPanel(_ context.Context, panel string) error {
+	delete(m.docs, panel)
+	return nil
+}
+
+func (m *memorySearchIndexer) titles(panel string) []string {
+	out := []string{}
+	for _, doc := range m.docs[panel] {
+		out = append(out, doc.Title)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestSearchDocumentFromRecord(t *testing.T) {
+	publishedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	doc, ok := SearchDocumentFromRecord("posts", map[string]any{
+		"id":           "post-1",
+		"title":        "Hello World",
+		"excerpt":      "A first post",
+		"locale":       "en",
+		"status":       "published",
+		"path":         "/posts/hello",
+		"published_at": publishedAt.Format(time.RFC3339),
+		"category":     "news",
+		"views":        3,
+		"tenant_id":    "tenant-1",
+		"notes":        "internal reviewer notes",
+		"blocks":       []any{map[string]any{"type": "text", "body": "Deep content"}},
+	})
+	if !ok {
+		t.Fatalf("expected record mapped")
+	}
+	if doc.Type != "posts" || doc.RecordID != "post-1" || doc.Title != "Hello World" || doc.Summary != "A first post" {
+		t.Fatalf("unexpected document identity: %+v", doc)
+	}
+	if doc.URL != "/posts/hello" || doc.Locale != "en" || doc.Status != "published" || doc.PublishedAt == nil || !doc.PublishedAt.Equal(publishedAt) {
+		t.Fatalf("unexpected document metadata: %+v", doc)
+	}
+	if doc.Body != "Deep content\ntext" || doc.TenantID != "tenant-1" {
+		t.Fatalf("expected only allowlisted text in body and the scope kept, got %+v", doc)
+	}
+	if len(doc.Fields) != 0 {
+		t.Fatalf("expected no filter fields by default, got %+v", doc.Fields)
+	}
+	doc, _ = NewSearchDocumentMapper(SearchDocumentFields{Body: []string{"notes"}, Filters: []string{"category", "views", "blocks"}})("posts", map[string]any{
+		"id":       "post-1",
+		"notes":    "Listed notes",
+		"body":     "Unlisted body",
+		"category": "news",
+		"views":    3,
+		"blocks":   []any{"nested"},
+	})
+	if doc.Body != "Listed notes" || len(doc.Fields) != 2 || doc.Fields["category"] != "news" || doc.Fields["views"] != 3 {
+		t.Fatalf("expected the mapper to read only its allowlist, got %+v", doc)
+	}
+	if _, ok := SearchDocumentFromRecord("posts", map[string]any{"title": "No id"}); ok {
+		t.Fatalf("expected record without id skipped")
+	}
+}
+
+func TestPanelSearchIndexFollowsWritesTrashAndReindex(t *testing.T) {
+	repo := NewMemoryRepository()
+	index := newMemorySearchIndexer()
+	sink := &recordingSink{}
+	panel, err := (&PanelBuilder{name: "posts"}).
+		WithRepository(repo).
+		WithActivitySink(sink).
+		WithSearchIndex(index).
+		SoftDelete(PanelSoftDeleteConfig{Enabled: true}).
+		Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "editor-1"}
+	first, err := panel.Create(ctx, map[string]any{"title": "First"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	second, _ := panel.Create(ctx, map[string]any{"title": "Second"})
+	firstID, secondID := toString(first["id"]), toString(second["id"])
+	if _, err := panel.Update(ctx, firstID, map[string]any{"title": "First edited"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if got := index.titles("posts"); len(got) != 2 || got[0] != "First edited" {
+		t.Fatalf("expected writes indexed, got %v", got)
+	}
+
+	if err := panel.Delete(ctx, firstID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got := index.titles("posts"); len(got) != 1 || got[0] != "Second" {
+		t.Fatalf("expected trashed record removed, got %v", got)
+	}
+	if _, err := panel.Restore(ctx, firstID); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if got := index.titles("posts"); len(got) != 2 {
+		t.Fatalf("expected restored record indexed again, got %v", got)
+	}
+	if len(sink.entries) == 0 || sink.entries[len(sink.entries)-1].Action != "panel.restore" {
+		t.Fatalf("expected activity still forwarded to the panel sink, got %+v", sink.entries)
+	}
+
+	if _, err := repo.Update(context.Background(), secondID, map[string]any{"title": "Second edited"}); err != nil {
+		t.Fatalf("repo update: %v", err)
+	}
+	registry := NewRegistry()
+	if err := registry.RegisterPanel("posts", panel); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	var result SearchReindexResult
+	if err := (&SearchReindexCommand{Registry: registry}).Execute(context.Background(), SearchReindexInput{Result: &result}); err != nil {
+		t.Fatalf("reindex: %v", err)
+	}
+	if result.Indexed["posts"] != 2 {
+		t.Fatalf("expected two records reindexed, got %+v", result)
+	}
+	if got := index.titles("posts"); len(got) != 2 || got[1] != "Second edited" {
+		t.Fatalf("expected reindex to repair drift, got %v", got)
+	}
+}
+
+func TestAdminSearchIndexAppliesOnlyToSearchablePanels(t *testing.T) {
+	adm := mustNewAdmin(t, Config{BasePath: "/admin", DefaultLocale: "en"}, Dependencies{})
+	index := newMemorySearchIndexer()
+	adm.WithSearchIndex(index)
+
+	posts, err := adm.RegisterPanel("posts", adm.Panel("posts").WithRepository(NewMemoryRepository()).Searchable(true))
+	if err != nil {
+		t.Fatalf("register posts: %v", err)
+	}
+	users, err := adm.RegisterPanel("users", adm.Panel("users").WithRepository(NewMemoryRepository()))
+	if err != nil {
+		t.Fatalf("register users: %v", err)
+	}
+	if !posts.SearchIndexEnabled() || users.SearchIndexEnabled() {
+		t.Fatalf("expected only the opted-in panel indexed, got posts=%v users=%v", posts.SearchIndexEnabled(), users.SearchIndexEnabled())
+	}
+	ctx := AdminContext{Context: context.Background(), UserID: "admin-1"}
+	if _, err := users.Create(ctx, map[string]any{"name": "Private user"}); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	if _, err := posts.Create(ctx, map[string]any{"title": "Public post"}); err != nil {
+		t.Fatalf("create post: %v", err)
+	}
+	if len(index.docs["users"]) != 0 || len(index.docs["posts"]) != 1 {
+		t.Fatalf("expected only posts indexed, got %+v", index.docs)
+	}
+}
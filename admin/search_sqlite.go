@@ -0,0 +1,541 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/uptrace/bun"
+)
+
+const (
+	sqliteSearchDefaultPerPage      = 10
+	sqliteSearchMaxPerPage          = 100
+	sqliteSearchDefaultSuggestLimit = 5
+	sqliteSearchHighlightOpen       = "<mark>"
+	sqliteSearchHighlightClose      = "</mark>"
+)
+
+var sqliteSearchFieldPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// sqliteSearchFacetFields are the document columns reported as facets.
+var sqliteSearchFacetFields = []string{"type", "locale"}
+
+// SQLiteSearchIndex is an embedded search backend on SQLite FTS5 for hosts
+// without a go-search deployment. It implements PanelSearchIndexer, so panels
+// keep it current through WithSearchIndex, and serves the admin global search
+// through GlobalAdapter and site search through SiteProvider. The tables come
+// from data.SearchIndexMigrations and need an SQLite build with FTS5.
+type SQLiteSearchIndex struct {
+	db     *bun.DB
+	mapper SearchDocumentMapper
+}
+
+var _ PanelSearchIndexer = (*SQLiteSearchIndex)(nil)
+
+func NewSQLiteSearchIndex(db *bun.DB) *SQLiteSearchIndex {
+	if db == nil {
+		return nil
+	}
+	return &SQLiteSearchIndex{db: db, mapper: SearchDocumentFromRecord}
+}
+
+// WithMapper overrides how panel records become search documents.
+func (s *SQLiteSearchIndex) WithMapper(mapper SearchDocumentMapper) *SQLiteSearchIndex {
+	if s != nil && mapper != nil {
+		s.mapper = mapper
+	}
+	return s
+}
+
+type bunSearchDocumentRecord struct {
+	bun.BaseModel `bun:"table:search_documents,alias:d"`
+
+	ID          string     `bun:"id,pk" json:"id"`
+	Type        string     `bun:"type" json:"type"`
+	RecordID    string     `bun:"record_id" json:"record_id"`
+	Title       string     `bun:"title" json:"title"`
+	Summary     string     `bun:"summary" json:"summary"`
+	Body        string     `bun:"body" json:"body"`
+	URL         string     `bun:"url" json:"url"`
+	Locale      string     `bun:"locale" json:"locale"`
+	Status      string     `bun:"status" json:"status"`
+	PublishedAt *time.Time `bun:"published_at,nullzero" json:"published_at"`
+	TenantID    string     `bun:"tenant_id" json:"tenant_id"`
+	OrgID       string     `bun:"org_id" json:"org_id"`
+	FieldsJSON  string     `bun:"fields_json" json:"fields_json"`
+	UpdatedAt   time.Time  `bun:"updated_at" json:"updated_at"`
+}
+
+type sqliteSearchHitRow struct {
+	bunSearchDocumentRecord
+	Rank        float64 `bun:"search_rank"`
+	Snippet     string  `bun:"snippet"`
+	Highlighted string  `bun:"highlighted"`
+}
+
+type sqliteSearchBucketRow struct {
+	Value string `bun:"value"`
+	Count int    `bun:"count"`
+}
+
+func (s *SQLiteSearchIndex) IndexRecord(ctx context.Context, panel string, record map[string]any) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	if _, trashed := trashedAt(record); trashed {
+		return s.RemoveRecord(ctx, panel, extractRecordID(record))
+	}
+	doc, ok := s.mapper(panel, record)
+	if !ok {
+		return s.RemoveRecord(ctx, panel, extractRecordID(record))
+	}
+	if strings.TrimSpace(doc.Type) == "" {
+		doc.Type = panel
+	}
+	if doc.TenantID == "" && doc.OrgID == "" {
+		doc.TenantID, doc.OrgID = tenantIDFromContext(ctx), orgIDFromContext(ctx)
+	}
+	return s.IndexDocument(ctx, doc)
+}
+
+// IndexDocument adds or replaces one document. Use it to index content that
+// is not served by a panel.
+func (s *SQLiteSearchIndex) IndexDocument(ctx context.Context, doc SearchDocument) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	doc.Type = strings.TrimSpace(doc.Type)
+	doc.RecordID = strings.TrimSpace(doc.RecordID)
+	if doc.Type == "" || doc.RecordID == "" {
+		return requiredFieldDomainError("search document type and record_id", map[string]any{
+			"component": "search_sqlite",
+		})
+	}
+	fields := doc.Fields
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	record := bunSearchDocumentRecord{
+		ID:          sqliteSearchDocumentID(doc.Type, doc.RecordID),
+		Type:        doc.Type,
+		RecordID:    doc.RecordID,
+		Title:       doc.Title,
+		Summary:     doc.Summary,
+		Body:        doc.Body,
+		URL:         doc.URL,
+		Locale:      doc.Locale,
+		Status:      doc.Status,
+		PublishedAt: doc.PublishedAt,
+		TenantID:    strings.TrimSpace(doc.TenantID),
+		OrgID:       strings.TrimSpace(doc.OrgID),
+		FieldsJSON:  string(fieldsJSON),
+		UpdatedAt:   time.Now().UTC(),
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := deleteSQLiteSearchDocuments(ctx, tx, "id = ?", record.ID); err != nil {
+			return err
+		}
+		if _, err := tx.NewInsert().Model(&record).Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO search_documents_fts (doc_id, title, summary, body) VALUES (?, ?, ?, ?)",
+			record.ID, record.Title, record.Summary, record.Body,
+		)
+		return err
+	})
+}
+
+func (s *SQLiteSearchIndex) RemoveRecord(ctx context.Context, panel, id string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return deleteSQLiteSearchDocuments(ctx, tx, "id = ?", sqliteSearchDocumentID(panel, id))
+	})
+}
+
+func (s *SQLiteSearchIndex) ResetPanel(ctx context.Context, panel string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return deleteSQLiteSearchDocuments(ctx, tx, "type = ?", strings.TrimSpace(panel))
+	})
+}
+
+func deleteSQLiteSearchDocuments(ctx context.Context, tx bun.Tx, where string, arg any) error {
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM search_documents_fts WHERE doc_id IN (SELECT id FROM search_documents WHERE "+where+")", arg,
+	); err != nil {
+		return err
+	}
+	_, err := tx.NewDelete().Model((*bunSearchDocumentRecord)(nil)).Where(where, arg).Exec(ctx)
+	return err
+}
+
+// GlobalAdapter returns an admin global search adapter over the index. Types
+// limits it to the given document types; drafts are included. Results are
+// limited to unscoped documents and those of the caller's tenant and org.
+func (s *SQLiteSearchIndex) GlobalAdapter(permission string, types ...string) *SQLiteSearchGlobalAdapter {
+	if s == nil {
+		return nil
+	}
+	return &SQLiteSearchGlobalAdapter{index: s, permission: permission, types: append([]string(nil), types...)}
+}
+
+// SiteProvider returns a site search provider over the published documents.
+func (s *SQLiteSearchIndex) SiteProvider() *SQLiteSearchSiteProvider {
+	if s == nil {
+		return nil
+	}
+	return &SQLiteSearchSiteProvider{index: s}
+}
+
+func (s *SQLiteSearchIndex) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("sqlite search index", map[string]any{
+			"component": "search_sqlite",
+		})
+	}
+	return nil
+}
+
+// SQLiteSearchGlobalAdapter serves the admin command palette from the index.
+type SQLiteSearchGlobalAdapter struct {
+	index      *SQLiteSearchIndex
+	permission string
+	types      []string
+}
+
+var _ SearchAdapter = (*SQLiteSearchGlobalAdapter)(nil)
+
+func (a *SQLiteSearchGlobalAdapter) Search(ctx context.Context, query string, limit int) ([]SearchResult, error) {
+	if a == nil {
+		return nil, nil
+	}
+	match := sqliteSearchMatchExpr(query)
+	if match == "" {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = sqliteSearchDefaultPerPage
+	}
+	where := sqliteSearchWhere{}
+	where.add("search_documents_fts MATCH ?", match)
+	if len(a.types) > 0 {
+		where.add("d.type IN (?)", bun.In(a.types))
+	}
+	where.add("d.tenant_id IN ('', ?)", tenantIDFromContext(ctx))
+	where.add("d.org_id IN ('', ?)", orgIDFromContext(ctx))
+	rows := []sqliteSearchHitRow{}
+	sql, args := where.sql()
+	if err := a.index.db.NewRaw(
+		"SELECT d.*, bm25(search_documents_fts, 0, 10.0, 4.0, 1.0) AS search_rank, '' AS snippet, '' AS highlighted"+
+			" FROM search_documents_fts JOIN search_documents AS d ON d.id = search_documents_fts.doc_id"+
+			sql+" ORDER BY search_rank ASC, d.id ASC LIMIT ?",
+		append(args, limit)...,
+	).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	out := make([]SearchResult, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, SearchResult{
+			Type:        row.Type,
+			ID:          row.RecordID,
+			Title:       row.Title,
+			Description: row.Summary,
+			URL:         row.URL,
+		})
+	}
+	return out, nil
+}
+
+func (a *SQLiteSearchGlobalAdapter) Permission() string {
+	if a == nil {
+		return ""
+	}
+	return a.permission
+}
+
+// SQLiteSearchSiteProvider serves site search from the documents of the index
+// whose status is published or public. Filters match the type, locale, and the
+// mapped record fields as text; facets cover type and locale.
+type SQLiteSearchSiteProvider struct {
+	index *SQLiteSearchIndex
+}
+
+var _ SearchProvider = (*SQLiteSearchSiteProvider)(nil)
+
+func (p *SQLiteSearchSiteProvider) Search(ctx context.Context, req SearchRequest) (SearchResultPage, error) {
+	if p == nil {
+		return SearchResultPage{}, nil
+	}
+	if err := p.index.ready(); err != nil {
+		return SearchResultPage{}, err
+	}
+	page, perPage := req.Page, req.PerPage
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = sqliteSearchDefaultPerPage
+	}
+	if perPage > sqliteSearchMaxPerPage {
+		perPage = sqliteSearchMaxPerPage
+	}
+	match := sqliteSearchMatchExpr(req.Query)
+	if match == "" && strings.TrimSpace(req.Query) != "" {
+		return SearchResultPage{Hits: []SearchHit{}, Page: page, PerPage: perPage, TotalAccuracy: SearchTotalAccuracyExact}, nil
+	}
+	from := " FROM search_documents AS d"
+	columns := "d.*, 0 AS search_rank, '' AS snippet, '' AS highlighted"
+	if match != "" {
+		from = " FROM search_documents_fts JOIN search_documents AS d ON d.id = search_documents_fts.doc_id"
+		columns = "d.*, bm25(search_documents_fts, 0, 10.0, 4.0, 1.0) AS search_rank" +
+			", snippet(search_documents_fts, -1, '', '', '...', 24) AS snippet" +
+			", snippet(search_documents_fts, -1, '" + sqliteSearchHighlightOpen + "', '" + sqliteSearchHighlightClose + "', '...', 24) AS highlighted"
+	}
+	where := p.where(req, match, "")
+	whereSQL, whereArgs := where.sql()
+
+	var total int
+	if err := p.index.db.NewRaw("SELECT COUNT(*)"+from+whereSQL, whereArgs...).Scan(ctx, &total); err != nil {
+		return SearchResultPage{}, err
+	}
+	rows := []sqliteSearchHitRow{}
+	args := append(append([]any{}, whereArgs...), perPage, (page-1)*perPage)
+	if err := p.index.db.NewRaw(
+		"SELECT "+columns+from+whereSQL+" ORDER BY "+sqliteSearchOrder(req.Sort, match != "")+" LIMIT ? OFFSET ?",
+		args...,
+	).Scan(ctx, &rows); err != nil {
+		return SearchResultPage{}, err
+	}
+	hits := make([]SearchHit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, sqliteSearchHit(row))
+	}
+	facets := make([]SearchFacet, 0, len(sqliteSearchFacetFields))
+	for _, field := range sqliteSearchFacetFields {
+		facet, err := p.facet(ctx, req, match, from, field)
+		if err != nil {
+			return SearchResultPage{}, err
+		}
+		facets = append(facets, facet)
+	}
+	return SearchResultPage{
+		Hits:          hits,
+		Facets:        facets,
+		Page:          page,
+		PerPage:       perPage,
+		Total:         total,
+		TotalAccuracy: SearchTotalAccuracyExact,
+	}, nil
+}
+
+// Suggest returns titles whose words start with the typed prefix, titles that
+// start with it first.
+func (p *SQLiteSearchSiteProvider) Suggest(ctx context.Context, req SuggestRequest) (SuggestResult, error) {
+	if p == nil {
+		return SuggestResult{}, nil
+	}
+	if err := p.index.ready(); err != nil {
+		return SuggestResult{}, err
+	}
+	match := sqliteSearchMatchExpr(req.Query)
+	if match == "" {
+		return SuggestResult{Suggestions: []string{}}, nil
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = sqliteSearchDefaultSuggestLimit
+	}
+	where := p.where(SearchRequest{Locale: req.Locale, Filters: req.Filters}, "title : ("+match+")", "")
+	whereSQL, whereArgs := where.sql()
+	prefix := escapeSQLiteLike(strings.TrimSpace(req.Query)) + "%"
+	rows := []struct {
+		Title string `bun:"title"`
+	}{}
+	if err := p.index.db.NewRaw(
+		"SELECT d.title AS title FROM search_documents_fts JOIN search_documents AS d ON d.id = search_documents_fts.doc_id"+
+			whereSQL+" GROUP BY d.title ORDER BY d.title LIKE ? ESCAPE '\\' DESC, d.title ASC LIMIT ?",
+		append(whereArgs, prefix, limit)...,
+	).Scan(ctx, &rows); err != nil {
+		return SuggestResult{}, err
+	}
+	suggestions := make([]string, 0, len(rows))
+	for _, row := range rows {
+		suggestions = append(suggestions, row.Title)
+	}
+	return SuggestResult{Suggestions: suggestions}, nil
+}
+
+// where builds the shared filter clause. skip leaves one filter out so a
+// facet counts the values the user could still switch to.
+func (p *SQLiteSearchSiteProvider) where(req SearchRequest, match, skip string) sqliteSearchWhere {
+	where := sqliteSearchWhere{}
+	if match != "" {
+		where.add("search_documents_fts MATCH ?", match)
+	}
+	where.add("d.status IN ('published', 'public')")
+	filters := map[string][]string{}
+	for key, values := range req.Filters {
+		filters[strings.TrimSpace(key)] = values
+	}
+	if locale := strings.TrimSpace(req.Locale); locale != "" {
+		filters["locale"] = []string{locale}
+	}
+	for key, values := range filters {
+		if key == skip || key == "status" || len(values) == 0 || !sqliteSearchFieldPattern.MatchString(key) {
+			continue
+		}
+		where.add(sqliteSearchColumn(key)+" IN (?)", bun.In(values))
+	}
+	for _, rng := range req.Ranges {
+		field := strings.TrimSpace(rng.Field)
+		if !sqliteSearchFieldPattern.MatchString(field) {
+			continue
+		}
+		column := sqliteSearchColumn(field)
+		if rng.GTE != nil {
+			where.add(column+" >= ?", sqliteSearchRangeValue(field, rng.GTE))
+		}
+		if rng.LTE != nil {
+			where.add(column+" <= ?", sqliteSearchRangeValue(field, rng.LTE))
+		}
+	}
+	return where
+}
+
+func (p *SQLiteSearchSiteProvider) facet(ctx context.Context, req SearchRequest, match, from, field string) (SearchFacet, error) {
+	where := p.where(req, match, field)
+	whereSQL, whereArgs := where.sql()
+	rows := []sqliteSearchBucketRow{}
+	if err := p.index.db.NewRaw(
+		"SELECT d."+field+" AS value, COUNT(*) AS count"+from+whereSQL+" AND d."+field+" <> ''"+
+			" GROUP BY d."+field+" ORDER BY count DESC, value ASC",
+		whereArgs...,
+	).Scan(ctx, &rows); err != nil {
+		return SearchFacet{}, err
+	}
+	selected := map[string]bool{}
+	for _, value := range req.Filters[field] {
+		selected[value] = true
+	}
+	if field == "locale" && strings.TrimSpace(req.Locale) != "" {
+		selected[strings.TrimSpace(req.Locale)] = true
+	}
+	facet := SearchFacet{Name: field, Kind: "terms", Disjunctive: true, Buckets: make([]SearchFacetTerm, 0, len(rows))}
+	for _, row := range rows {
+		facet.Buckets = append(facet.Buckets, SearchFacetTerm{Value: row.Value, Count: row.Count, Selected: selected[row.Value]})
+	}
+	return facet, nil
+}
+
+type sqliteSearchWhere struct {
+	clauses []string
+	args    []any
+}
+
+func (w *sqliteSearchWhere) add(clause string, args ...any) {
+	w.clauses = append(w.clauses, clause)
+	w.args = append(w.args, args...)
+}
+
+func (w sqliteSearchWhere) sql() (string, []any) {
+	if len(w.clauses) == 0 {
+		return " WHERE 1 = 1", nil
+	}
+	return " WHERE " + strings.Join(w.clauses, " AND "), append([]any{}, w.args...)
+}
+
+func sqliteSearchColumn(field string) string {
+	switch field {
+	case "type", "locale", "status", "published_at", "title":
+		return "d." + field
+	}
+	return "CAST(json_extract(d.fields_json, '$." + field + "') AS TEXT)"
+}
+
+func sqliteSearchRangeValue(field string, value any) any {
+	if field == "published_at" {
+		if parsed, ok := recordTime(value); ok {
+			return parsed
+		}
+	}
+	return value
+}
+
+func sqliteSearchOrder(sort string, ranked bool) string {
+	switch strings.TrimSpace(sort) {
+	case "published_at":
+		return "d.published_at ASC, d.id ASC"
+	case "-published_at":
+		return "d.published_at DESC, d.id ASC"
+	case "title":
+		return "d.title COLLATE NOCASE ASC, d.id ASC"
+	case "-title":
+		return "d.title COLLATE NOCASE DESC, d.id ASC"
+	}
+	if ranked {
+		return "search_rank ASC, d.id ASC"
+	}
+	return "d.published_at DESC, d.id ASC"
+}
+
+func sqliteSearchHit(row sqliteSearchHitRow) SearchHit {
+	fields := map[string]any{}
+	if row.FieldsJSON != "" {
+		_ = json.Unmarshal([]byte(row.FieldsJSON), &fields) //nolint:errcheck // fields are written by IndexDocument; a bad row still returns its hit.
+	}
+	return SearchHit{
+		ID:          row.RecordID,
+		Type:        row.Type,
+		Title:       row.Title,
+		Summary:     row.Summary,
+		URL:         row.URL,
+		Locale:      row.Locale,
+		PublishedAt: row.PublishedAt,
+		Score:       -row.Rank,
+		Fields:      fields,
+		Snippet:     row.Snippet,
+		Highlighted: row.Highlighted,
+	}
+}
+
+// sqliteSearchMatchExpr turns free text into an FTS5 query: every word must
+// match and the last one matches as a prefix, so results follow the typing.
+func sqliteSearchMatchExpr(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, `"`+strings.ReplaceAll(word, `"`, `""`)+`"`)
+	}
+	terms[len(terms)-1] += "*"
+	return strings.Join(terms, " ")
+}
+
+func escapeSQLiteLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+func sqliteSearchDocumentID(docType, recordID string) string {
+	return strings.TrimSpace(docType) + ":" + strings.TrimSpace(recordID)
+}
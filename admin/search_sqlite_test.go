@@ -0,0 +1,187 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	admindata "github.com/goliatone/go-admin/data"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/driver/sqliteshim"
+)
+
+func setupSQLiteSearchIndex(t *testing.T) *SQLiteSearchIndex {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "search.db") + "?cache=shared"
+	sqlDB, err := sql.Open(sqliteshim.ShimName, dsn)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	db := bun.NewDB(sqlDB, sqlitedialect.New())
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("close search database: %v", err)
+		}
+	})
+	migration, err := fs.ReadFile(admindata.SearchIndexMigrations(), "sqlite/0022_search_documents.up.sql")
+	if err != nil {
+		t.Fatalf("read migration: %v", err)
+	}
+	if _, err := db.ExecContext(context.Background(), string(migration)); err != nil {
+		if strings.Contains(err.Error(), "fts5") {
+			t.Skipf("sqlite build without fts5: %v", err)
+		}
+		t.Fatalf("apply migration: %v", err)
+	}
+	return NewSQLiteSearchIndex(db)
+}
+
+func TestSQLiteSearchIndexSiteProvider(t *testing.T) {
+	index := setupSQLiteSearchIndex(t).WithMapper(NewSearchDocumentMapper(SearchDocumentFields{Body: []string{"body"}, Filters: []string{"category"}}))
+	ctx := context.Background()
+	older := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(48 * time.Hour)
+	records := []struct {
+		panel  string
+		record map[string]any
+	}{
+		{"posts", map[string]any{"id": "1", "title": "Gardening basics", "excerpt": "Start a garden", "locale": "en", "status": "published", "category": "home", "published_at": older.Format(time.RFC3339)}},
+		{"posts", map[string]any{"id": "2", "title": "Garden tools", "body": "Rakes and spades for the garden", "locale": "en", "status": "published", "category": "tools", "published_at": newer.Format(time.RFC3339)}},
+		{"posts", map[string]any{"id": "3", "title": "Jardinería", "body": "garden en español", "locale": "es", "status": "published"}},
+		{"pages", map[string]any{"id": "4", "title": "Garden party", "locale": "en", "status": "draft"}},
+		{"pages", map[string]any{"id": "5", "title": "Garden shed", "locale": "en"}},
+		{"pages", map[string]any{"id": "6", "title": "Guía", "body": "garden guide", "locale": "es", "status": "public"}},
+	}
+	for _, item := range records {
+		if err := index.IndexRecord(ctx, item.panel, item.record); err != nil {
+			t.Fatalf("index %s: %v", item.record["id"], err)
+		}
+	}
+	provider := index.SiteProvider()
+
+	page, err := provider.Search(ctx, SearchRequest{Query: "gard", Locale: "en", Sort: "-published_at"})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if page.Total != 2 || len(page.Hits) != 2 || page.Hits[0].ID != "2" || page.Hits[1].ID != "1" {
+		t.Fatalf("expected published english prefix matches newest first without the unpublished pages, got %+v", page)
+	}
+	if page.Hits[0].Fields["category"] != "tools" || page.Hits[0].PublishedAt == nil {
+		t.Fatalf("expected hit fields and published_at, got %+v", page.Hits[0])
+	}
+	if !strings.Contains(page.Hits[0].Highlighted, sqliteSearchHighlightOpen) {
+		t.Fatalf("expected highlighted snippet, got %+v", page.Hits[0])
+	}
+	var locales SearchFacet
+	for _, facet := range page.Facets {
+		if facet.Name == "locale" {
+			locales = facet
+		}
+	}
+	if len(locales.Buckets) != 2 || locales.Buckets[0].Value != "en" || !locales.Buckets[0].Selected || locales.Buckets[1].Count != 2 {
+		t.Fatalf("expected disjunctive locale facet, got %+v", locales)
+	}
+
+	spanish, err := provider.Search(ctx, SearchRequest{Query: "garden", Locale: "es", Sort: "title"})
+	if err != nil || spanish.Total != 2 || spanish.Hits[0].ID != "6" || spanish.Hits[1].ID != "3" {
+		t.Fatalf("expected published and public documents only, got %+v (%v)", spanish, err)
+	}
+	filtered, err := provider.Search(ctx, SearchRequest{Query: "garden", Filters: map[string][]string{"category": {"home"}}})
+	if err != nil || filtered.Total != 1 || filtered.Hits[0].ID != "1" {
+		t.Fatalf("expected field filter applied, got %+v (%v)", filtered, err)
+	}
+	ranged, err := provider.Search(ctx, SearchRequest{Ranges: []SearchRange{{Field: "published_at", GTE: newer.Add(-time.Hour).Format(time.RFC3339)}}})
+	if err != nil || ranged.Total != 1 || ranged.Hits[0].ID != "2" {
+		t.Fatalf("expected published_at range applied, got %+v (%v)", ranged, err)
+	}
+
+	suggest, err := provider.Suggest(ctx, SuggestRequest{Query: "gar", Locale: "en"})
+	if err != nil {
+		t.Fatalf("suggest: %v", err)
+	}
+	if len(suggest.Suggestions) != 2 || suggest.Suggestions[0] != "Garden tools" {
+		t.Fatalf("expected published title suggestions, got %+v", suggest)
+	}
+}
+
+func TestSQLiteSearchIndexGlobalAdapterAndRemoval(t *testing.T) {
+	index := setupSQLiteSearchIndex(t)
+	ctx := context.Background()
+	for _, record := range []map[string]any{
+		{"id": "1", "title": "Quarterly report", "status": "draft"},
+		{"id": "2", "title": "Report archive", "status": "published"},
+	} {
+		if err := index.IndexRecord(ctx, "pages", record); err != nil {
+			t.Fatalf("index: %v", err)
+		}
+	}
+	if err := index.IndexRecord(ctx, "users", map[string]any{"id": "9", "name": "Reporter"}); err != nil {
+		t.Fatalf("index: %v", err)
+	}
+	adapter := index.GlobalAdapter("admin.pages.view", "pages")
+	results, err := adapter.Search(ctx, "report", 10)
+	if err != nil || len(results) != 2 {
+		t.Fatalf("expected drafts and published pages in admin search, got %+v (%v)", results, err)
+	}
+	if results[0].Type != "pages" || adapter.Permission() != "admin.pages.view" {
+		t.Fatalf("unexpected adapter result: %+v", results[0])
+	}
+
+	if err := index.IndexRecord(ctx, "pages", map[string]any{"id": "2", "title": "Report archive", SoftDeleteDeletedAtField: time.Now().UTC()}); err != nil {
+		t.Fatalf("index trashed: %v", err)
+	}
+	if results, _ := adapter.Search(ctx, "report", 10); len(results) != 1 || results[0].ID != "1" {
+		t.Fatalf("expected trashed record removed, got %+v", results)
+	}
+	if err := index.ResetPanel(ctx, "pages"); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if results, _ := index.GlobalAdapter("").Search(ctx, "report", 10); len(results) != 1 || results[0].Type != "users" {
+		t.Fatalf("expected only the other panel left after reset, got %+v", results)
+	}
+}
+
+func TestSQLiteSearchIndexGlobalAdapterScopesDocuments(t *testing.T) {
+	index := setupSQLiteSearchIndex(t)
+	acme := withAdminRouterIdentity(context.Background(), adminRouterIdentity{tenantID: "acme", orgID: "acme-org"})
+	globex := withAdminRouterIdentity(context.Background(), adminRouterIdentity{tenantID: "globex"})
+	if err := index.IndexRecord(acme, "pages", map[string]any{"id": "1", "title": "Acme report"}); err != nil {
+		t.Fatalf("index acme: %v", err)
+	}
+	if err := index.IndexRecord(context.Background(), "pages", map[string]any{"id": "2", "title": "Globex report", "tenant_id": "globex"}); err != nil {
+		t.Fatalf("index globex: %v", err)
+	}
+	if err := index.IndexRecord(context.Background(), "pages", map[string]any{"id": "3", "title": "Shared report"}); err != nil {
+		t.Fatalf("index shared: %v", err)
+	}
+	adapter := index.GlobalAdapter("", "pages")
+
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{name: "acme", ctx: acme, want: []string{"1", "3"}},
+		{name: "globex", ctx: globex, want: []string{"2", "3"}},
+		{name: "unscoped", ctx: context.Background(), want: []string{"3"}},
+	} {
+		results, err := adapter.Search(tc.ctx, "report", 10)
+		if err != nil {
+			t.Fatalf("%s search: %v", tc.name, err)
+		}
+		ids := []string{}
+		for _, result := range results {
+			ids = append(ids, result.ID)
+		}
+		sort.Strings(ids)
+		if strings.Join(ids, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, ids)
+		}
+	}
+}
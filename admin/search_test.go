@@ -6,7 +6,9 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	router "github.com/goliatone/go-router"
 )
@@ -148,6 +150,118 @@ func TestSearchPrimaryAdapterFailsClosedWithoutAuthorizer(t *testing.T) {
 	}
 }
 
+type blockingSearchAdapter struct {
+	release chan struct{}
+}
+
+func (s *blockingSearchAdapter) Search(context.Context, string, int) ([]SearchResult, error) {
+	<-s.release
+	return []SearchResult{{ID: "late", Title: "Late"}}, nil
+}
+
+func (s *blockingSearchAdapter) Permission() string { return "" }
+
+type failingSearchAdapter struct {
+	err   error
+	panic bool
+}
+
+func (s *failingSearchAdapter) Search(context.Context, string, int) ([]SearchResult, error) {
+	if s.panic {
+		panic("adapter exploded")
+	}
+	return nil, s.err
+}
+
+func (s *failingSearchAdapter) Permission() string { return "" }
+
+func TestSearchQueryReportReturnsPartialResultsWhenAdaptersFailOrHang(t *testing.T) {
+	engine := NewSearchEngine(allowAll{})
+	engine.SetAdapterTimeout(20 * time.Millisecond)
+	slow := &blockingSearchAdapter{release: make(chan struct{})}
+	defer close(slow.release)
+	engine.Register("users", &stubSearchAdapter{results: []SearchResult{{ID: "1", Title: "Alice"}}})
+	engine.Register("media", slow)
+	engine.Register("orders", &failingSearchAdapter{err: errors.New("orders index offline")})
+	engine.Register("tenants", &failingSearchAdapter{panic: true})
+
+	started := time.Now()
+	response, err := engine.QueryReport(AdminContext{Context: context.Background()}, "Alice", 10)
+	if err != nil {
+		t.Fatalf("query error: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("expected hanging adapter to be cut off by its timeout, took %s", elapsed)
+	}
+	if !response.Partial || len(response.Results) != 1 || response.Results[0].ID != "1" {
+		t.Fatalf("expected partial users results, got %+v", response)
+	}
+	reports := map[string]SearchAdapterReport{}
+	for _, report := range response.Adapters {
+		reports[report.Key] = report
+	}
+	if len(reports) != 4 || reports["users"].Results != 1 || reports["users"].Error != "" {
+		t.Fatalf("expected one report per adapter, got %+v", response.Adapters)
+	}
+	if !reports["media"].TimedOut || reports["media"].ErrorCode != SearchAdapterErrorTimeout ||
+		reports["orders"].ErrorCode != SearchAdapterErrorFailed || reports["tenants"].ErrorCode != SearchAdapterErrorFailed {
+		t.Fatalf("expected timeout, error, and panic reported, got %+v", response.Adapters)
+	}
+	for _, report := range response.Adapters {
+		if strings.Contains(report.Error, "orders index offline") || strings.Contains(report.Error, "panicked") {
+			t.Fatalf("expected generic adapter error messages, got %+v", report)
+		}
+	}
+
+	results, err := engine.Query(AdminContext{Context: context.Background()}, "Alice", 10)
+	if err != nil || len(results) != 1 {
+		t.Fatalf("expected Query to return partial results without error, got %+v (%v)", results, err)
+	}
+}
+
+func TestSearchQueryFailsWhenEveryAdapterFails(t *testing.T) {
+	engine := NewSearchEngine(allowAll{})
+	engine.Register("orders", &failingSearchAdapter{err: errors.New("orders index offline")})
+
+	_, err := engine.Query(AdminContext{Context: context.Background()}, "x", 5)
+	if err == nil {
+		t.Fatalf("expected error when every adapter failed")
+	}
+	if strings.Contains(err.Error(), "orders index offline") {
+		t.Fatalf("expected the adapter error kept out of the returned error, got %v", err)
+	}
+}
+
+func TestSearchQueryRanksAndGroupsResults(t *testing.T) {
+	engine := NewSearchEngine(allowAll{})
+	engine.Register("users", &stubSearchAdapter{results: []SearchResult{
+		{ID: "u1", Title: "Alice"},
+		{ID: "u2", Title: "Alice Cooper", Description: "Alice"},
+	}})
+	engine.Register("pages", &stubSearchAdapter{results: []SearchResult{
+		{ID: "p1", Title: "About Alice", Description: "Alice"},
+		{ID: "p2", Title: "Pinned", Description: "Alice", Score: 0.9},
+	}})
+
+	response, err := engine.QueryReport(AdminContext{Context: context.Background()}, "Alice", 10)
+	if err != nil {
+		t.Fatalf("query error: %v", err)
+	}
+	ids := []string{}
+	for _, result := range response.Results {
+		ids = append(ids, result.ID)
+	}
+	if len(ids) != 4 || ids[0] != "u1" || ids[1] != "p2" || ids[2] != "u2" || ids[3] != "p1" {
+		t.Fatalf("expected results ordered by score, got %v", ids)
+	}
+	if response.Results[0].Score != 1 || response.Results[2].Score != 0.8 || response.Results[3].Score != 0.6 {
+		t.Fatalf("expected exact, prefix, and substring scores, got %+v", response.Results)
+	}
+	if len(response.Groups) != 2 || response.Groups[0].Type != "users" || response.Groups[1].Type != "pages" || len(response.Groups[1].Results) != 2 {
+		t.Fatalf("expected results grouped by type in score order, got %+v", response.Groups)
+	}
+}
+
 type searchAuthorizer struct {
 	allowed map[string]bool
 }
@@ -0,0 +1,318 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	urlkit "github.com/goliatone/go-urlkit"
+)
+
+const (
+	translationAssigneeLoadPerPage = 200
+	// TranslationLocaleProficiencyMetadataKey is the user profile metadata key holding
+	// per-locale proficiency, e.g. {"fr": "native", "de": 3}.
+	TranslationLocaleProficiencyMetadataKey = "locale_proficiency"
+)
+
+// TranslationAssigneeSelectionInput describes an open-pool assignment awaiting an assignee.
+type TranslationAssigneeSelectionInput struct {
+	Assignment TranslationAssignment `json:"assignment"`
+	Candidates []string              `json:"candidates"`
+}
+
+// TranslationAssigneeSelector picks an assignee among candidates. An empty result
+// leaves the assignment in the open pool.
+type TranslationAssigneeSelector interface {
+	SelectAssignee(ctx context.Context, input TranslationAssigneeSelectionInput) (string, error)
+}
+
+// RoundRobinTranslationAssigneeSelector rotates through candidates per tenant,
+// org and target locale. The cursor lives in process memory: it restarts at
+// the first candidate after a restart and each instance rotates on its own,
+// so use LeastLoadedTranslationAssigneeSelector when the spread must hold
+// across instances.
+type RoundRobinTranslationAssigneeSelector struct {
+	mu     sync.Mutex
+	cursor map[string]int
+}
+
+// NewRoundRobinTranslationAssigneeSelector constructs a round-robin selector.
+func NewRoundRobinTranslationAssigneeSelector() *RoundRobinTranslationAssigneeSelector {
+	return &RoundRobinTranslationAssigneeSelector{cursor: map[string]int{}}
+}
+
+func (s *RoundRobinTranslationAssigneeSelector) SelectAssignee(_ context.Context, input TranslationAssigneeSelectionInput) (string, error) {
+	candidates := normalizeTranslationAssigneeCandidates(input.Candidates)
+	if s == nil || len(candidates) == 0 {
+		return "", nil
+	}
+	key := strings.Join([]string{
+		strings.TrimSpace(input.Assignment.TenantID),
+		strings.TrimSpace(input.Assignment.OrgID),
+		normalizeTranslationAssigneeLocale(input.Assignment.TargetLocale),
+	}, "|")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cursor == nil {
+		s.cursor = map[string]int{}
+	}
+	next := s.cursor[key] % len(candidates)
+	s.cursor[key] = next + 1
+	return candidates[next], nil
+}
+
+// LeastLoadedTranslationAssigneeSelector picks the candidate with the fewest
+// in-flight assignments for the target locale within the assignment's tenant
+// and org. Ties keep candidate order.
+type LeastLoadedTranslationAssigneeSelector struct {
+	Repository TranslationAssignmentRepository `json:"repository"`
+}
+
+func (s *LeastLoadedTranslationAssigneeSelector) SelectAssignee(ctx context.Context, input TranslationAssigneeSelectionInput) (string, error) {
+	candidates := normalizeTranslationAssigneeCandidates(input.Candidates)
+	if s == nil || len(candidates) == 0 {
+		return "", nil
+	}
+	if s.Repository == nil {
+		return candidates[0], nil
+	}
+	load, err := translationAssigneeInFlightLoad(ctx, s.Repository, input.Assignment)
+	if err != nil {
+		return "", err
+	}
+	best := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if load[candidate] < load[best] {
+			best = candidate
+		}
+	}
+	return best, nil
+}
+
+// SkillTranslationAssigneeSelector keeps candidates whose profile lists the target
+// locale at or above MinProficiency, then delegates to Next (first match when nil).
+// Proficiency is read from UserProfile.Metadata[TranslationLocaleProficiencyMetadataKey]
+// as a 1-5 number or one of basic, conversational, professional, fluent, native.
+type SkillTranslationAssigneeSelector struct {
+	Profiles       ProfileStore                `json:"profiles"`
+	MinProficiency int                         `json:"min_proficiency"`
+	Next           TranslationAssigneeSelector `json:"next"`
+}
+
+func (s *SkillTranslationAssigneeSelector) SelectAssignee(ctx context.Context, input TranslationAssigneeSelectionInput) (string, error) {
+	candidates := normalizeTranslationAssigneeCandidates(input.Candidates)
+	if s == nil || s.Profiles == nil || len(candidates) == 0 {
+		return "", nil
+	}
+	minimum := max(s.MinProficiency, 1)
+	skilled := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		profile, err := s.Profiles.Get(ctx, candidate)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return "", err
+		}
+		if translationLocaleProficiency(profile, input.Assignment.TargetLocale) >= minimum {
+			skilled = append(skilled, candidate)
+		}
+	}
+	if len(skilled) == 0 {
+		return "", nil
+	}
+	if s.Next == nil {
+		return skilled[0], nil
+	}
+	input.Candidates = skilled
+	return s.Next.SelectAssignee(ctx, input)
+}
+
+// TranslationQueueAutoAssigner applies a selector to open-pool assignments, writing
+// the choice with the assignment's current Version so concurrent claims win.
+// Successful assignments record the same activity and assignee notification
+// as a manual assign.
+type TranslationQueueAutoAssigner struct {
+	Repository        TranslationAssignmentRepository `json:"repository"`
+	Selector          TranslationAssigneeSelector     `json:"selector"`
+	Candidates        []string                        `json:"candidates"`
+	CandidateProvider TranslationActorOptionProvider  `json:"candidate_provider"`
+	Activity          ActivitySink                    `json:"activity"`
+	Notifications     NotificationService             `json:"notifications"`
+	URLs              urlkit.Resolver                 `json:"urls"`
+}
+
+// AutoAssign selects an assignee for an open-pool assignment. It reports false when
+// the assignment stays in the pool: not open, no eligible candidate, or claimed
+// concurrently (version conflict). Excluded IDs are never selected.
+func (a *TranslationQueueAutoAssigner) AutoAssign(ctx context.Context, assignment TranslationAssignment, exclude ...string) (TranslationAssignment, bool, error) {
+	if a == nil || a.Repository == nil || a.Selector == nil {
+		return assignment, false, nil
+	}
+	if assignment.Status != AssignmentStatusOpen || assignment.AssignmentType != AssignmentTypeOpenPool {
+		return assignment, false, nil
+	}
+	candidates, err := a.candidates(ctx, assignment, exclude)
+	if err != nil || len(candidates) == 0 {
+		return assignment, false, err
+	}
+	assigneeID, err := a.Selector.SelectAssignee(ctx, TranslationAssigneeSelectionInput{
+		Assignment: assignment,
+		Candidates: candidates,
+	})
+	assigneeID = strings.TrimSpace(assigneeID)
+	if err != nil || assigneeID == "" {
+		return assignment, false, err
+	}
+
+	next := assignment
+	now := time.Now().UTC()
+	next.AssignmentType = AssignmentTypeDirect
+	next.Status = AssignmentStatusAssigned
+	next.AssigneeID = assigneeID
+	next.AssignerID = ActivityActorTypeSystem
+	next.AssignedAt = &now
+	updated, err := a.Repository.Update(ctx, next, assignment.Version)
+	if err != nil {
+		if errors.Is(err, ErrTranslationAssignmentVersionConflict) {
+			return assignment, false, nil
+		}
+		return assignment, false, err
+	}
+	transitions := &DefaultTranslationQueueService{Activity: a.Activity, Notifications: a.Notifications, URLs: a.URLs}
+	transitions.recordTransition(ctx, "assigned", ActivityActorTypeSystem, updated)
+	return updated, true, nil
+}
+
+func (a *TranslationQueueAutoAssigner) candidates(ctx context.Context, assignment TranslationAssignment, exclude []string) ([]string, error) {
+	ids := append([]string{}, a.Candidates...)
+	if a.CandidateProvider != nil {
+		options, err := a.CandidateProvider.ListTranslationActorOptions(ctx, TranslationActorOptionQuery{
+			Purpose:        TranslationActorOptionPurposeAssignee,
+			EntityType:     assignment.EntityType,
+			SourceRecordID: assignment.SourceRecordID,
+			FamilyID:       assignment.FamilyID,
+			TargetLocale:   assignment.TargetLocale,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, option := range options {
+			ids = append(ids, option.Value)
+		}
+	}
+	skip := map[string]bool{}
+	for _, id := range exclude {
+		skip[strings.ToLower(strings.TrimSpace(id))] = true
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range normalizeTranslationAssigneeCandidates(ids) {
+		if !skip[strings.ToLower(id)] {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// translationAssigneeInFlightLoad counts active work per assignee in the
+// assignment's tenant, org and target locale.
+func translationAssigneeInFlightLoad(ctx context.Context, repo TranslationAssignmentRepository, assignment TranslationAssignment) (map[string]int, error) {
+	filters := map[string]any{
+		"status": strings.Join([]string{
+			string(AssignmentStatusAssigned),
+			string(AssignmentStatusInProgress),
+			string(AssignmentStatusChangesRequested),
+		}, ","),
+		ScopeTenantIDKey: strings.TrimSpace(assignment.TenantID),
+		ScopeOrgIDKey:    strings.TrimSpace(assignment.OrgID),
+	}
+	if locale := strings.TrimSpace(strings.ToLower(assignment.TargetLocale)); locale != "" {
+		filters["target_locale"] = locale
+	}
+	load := map[string]int{}
+	for page := 1; ; page++ {
+		batch, total, err := repo.List(ctx, ListOptions{
+			Page:    page,
+			PerPage: translationAssigneeLoadPerPage,
+			Filters: primitives.CloneAnyMap(filters),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, assignment := range batch {
+			if assigneeID := strings.TrimSpace(assignment.AssigneeID); assigneeID != "" {
+				load[assigneeID]++
+			}
+		}
+		if len(batch) < translationAssigneeLoadPerPage || (total > 0 && page*translationAssigneeLoadPerPage >= total) {
+			return load, nil
+		}
+	}
+}
+
+var translationProficiencyLevels = map[string]int{
+	"basic":          1,
+	"conversational": 2,
+	"professional":   3,
+	"fluent":         4,
+	"native":         5,
+}
+
+// translationLocaleProficiency returns the profile's proficiency for locale, falling
+// back to the base language (fr-ca -> fr). Zero means no recorded proficiency.
+func translationLocaleProficiency(profile UserProfile, locale string) int {
+	raw, ok := profile.Metadata[TranslationLocaleProficiencyMetadataKey].(map[string]any)
+	if !ok || len(raw) == 0 {
+		return 0
+	}
+	levels := make(map[string]any, len(raw))
+	for key, value := range raw {
+		levels[normalizeTranslationAssigneeLocale(key)] = value
+	}
+	locale = normalizeTranslationAssigneeLocale(locale)
+	value, ok := levels[locale]
+	if !ok {
+		base, _, _ := strings.Cut(locale, "-")
+		value = levels[base]
+	}
+	switch typed := value.(type) {
+	case int:
+		return typed
+	case int64:
+		return int(typed)
+	case float64:
+		return int(typed)
+	case string:
+		typed = strings.ToLower(strings.TrimSpace(typed))
+		if level, ok := translationProficiencyLevels[typed]; ok {
+			return level
+		}
+		level, _ := strconv.Atoi(typed)
+		return level
+	}
+	return 0
+}
+
+func normalizeTranslationAssigneeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+func normalizeTranslationAssigneeCandidates(candidates []string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		key := strings.ToLower(candidate)
+		if candidate == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, candidate)
+	}
+	return out
+}
@@ -0,0 +1,170 @@
+package admin
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTranslationAssigneeSelectorsPickByRotationLoadAndSkill(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryTranslationAssignmentRepository()
+	for i, assignee := range []string{"alice", "alice", "bob"} {
+		if _, err := repo.Create(ctx, TranslationAssignment{
+			FamilyID:       "tg_" + assignee + string(rune('a'+i)),
+			EntityType:     "pages",
+			SourceRecordID: "page_" + string(rune('a'+i)),
+			SourceLocale:   "en",
+			TargetLocale:   "fr",
+			AssignmentType: AssignmentTypeDirect,
+			Status:         AssignmentStatusInProgress,
+			AssigneeID:     assignee,
+			Priority:       PriorityNormal,
+		}); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+	input := TranslationAssigneeSelectionInput{
+		Assignment: TranslationAssignment{TargetLocale: "fr"},
+		Candidates: []string{"alice", "bob", "carol"},
+	}
+
+	roundRobin := NewRoundRobinTranslationAssigneeSelector()
+	picks := []string{}
+	for range 4 {
+		picked, _ := roundRobin.SelectAssignee(ctx, input)
+		picks = append(picks, picked)
+	}
+	if picks[0] != "alice" || picks[1] != "bob" || picks[2] != "carol" || picks[3] != "alice" {
+		t.Fatalf("expected round-robin rotation, got %v", picks)
+	}
+
+	leastLoaded := &LeastLoadedTranslationAssigneeSelector{Repository: repo}
+	if picked, err := leastLoaded.SelectAssignee(ctx, input); err != nil || picked != "carol" {
+		t.Fatalf("expected idle carol, got %q (%v)", picked, err)
+	}
+
+	profiles := NewInMemoryProfileStore()
+	profiles.Save(ctx, UserProfile{UserID: "alice", Metadata: map[string]any{TranslationLocaleProficiencyMetadataKey: map[string]any{"fr": "native"}}})
+	profiles.Save(ctx, UserProfile{UserID: "bob", Metadata: map[string]any{TranslationLocaleProficiencyMetadataKey: map[string]any{"FR": 3}}})
+	profiles.Save(ctx, UserProfile{UserID: "carol", Metadata: map[string]any{TranslationLocaleProficiencyMetadataKey: map[string]any{"de": "native"}}})
+	skill := &SkillTranslationAssigneeSelector{Profiles: profiles, MinProficiency: 3, Next: leastLoaded}
+	if picked, err := skill.SelectAssignee(ctx, input); err != nil || picked != "bob" {
+		t.Fatalf("expected least-loaded skilled bob, got %q (%v)", picked, err)
+	}
+	input.Assignment.TargetLocale = "fr_CA"
+	if picked, _ := (&SkillTranslationAssigneeSelector{Profiles: profiles, MinProficiency: 5}).SelectAssignee(ctx, input); picked != "alice" {
+		t.Fatalf("expected base-language proficiency match alice, got %q", picked)
+	}
+	input.Assignment.TargetLocale = "es"
+	if picked, _ := skill.SelectAssignee(ctx, input); picked != "" {
+		t.Fatalf("expected no skilled candidate for es, got %q", picked)
+	}
+}
+
+func TestTranslationQueueAutoAssignerRunsOnAutoCreateAndRelease(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryTranslationAssignmentRepository()
+	activity := NewActivityFeed()
+	notifications := NewInMemoryNotificationService()
+	assigner := &TranslationQueueAutoAssigner{
+		Repository:    repo,
+		Selector:      &LeastLoadedTranslationAssigneeSelector{Repository: repo},
+		Candidates:    []string{"alice", "bob"},
+		Activity:      activity,
+		Notifications: notifications,
+	}
+	hook := &DefaultTranslationQueueAutoCreateHook{Repository: repo, AutoAssigner: assigner}
+	result := hook.OnTranslationBlocker(ctx, TranslationQueueAutoCreateInput{
+		FamilyID:       "tg_1",
+		EntityType:     "pages",
+		EntityID:       "page_1",
+		SourceLocale:   "en",
+		MissingLocales: []string{"fr"},
+	})
+	if result.Created != 1 || len(result.Assignments) != 1 {
+		t.Fatalf("unexpected auto-create result %+v", result)
+	}
+	created := result.Assignments[0]
+	if created.Status != AssignmentStatusAssigned || created.AssignmentType != AssignmentTypeDirect || created.AssigneeID != "alice" || created.AssignerID != ActivityActorTypeSystem {
+		t.Fatalf("expected auto-created assignment assigned to alice, got %+v", created)
+	}
+
+	service := &DefaultTranslationQueueService{Repository: repo, AutoAssigner: assigner}
+	released, err := service.Release(ctx, TranslationQueueReleaseInput{
+		AssignmentID:    created.ID,
+		ActorID:         "alice",
+		ExpectedVersion: created.Version,
+	})
+	if err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if released.Status != AssignmentStatusOpen || released.AssigneeID != "" {
+		t.Fatalf("expected release to return the released state, got %+v", released)
+	}
+	handedOff, _ := repo.Get(ctx, created.ID)
+	if handedOff.Status != AssignmentStatusAssigned || handedOff.AssigneeID != "bob" {
+		t.Fatalf("expected released assignment handed to bob, got %+v", handedOff)
+	}
+	entries, _ := activity.List(ctx, 10)
+	assigned := 0
+	for _, entry := range entries {
+		if entry.Action == "translation.queue.assigned" && entry.Actor == ActivityActorTypeSystem {
+			assigned++
+		}
+	}
+	if assigned != 2 {
+		t.Fatalf("expected an assigned activity for the auto-create and release hand-offs, got %+v", entries)
+	}
+	inbox, _ := notifications.List(ctx)
+	notified := map[string]int{}
+	for _, notification := range inbox {
+		notified[notification.UserID]++
+	}
+	if notified["alice"] != 1 || notified["bob"] != 1 {
+		t.Fatalf("expected each auto-assignee notified once, got %+v", notified)
+	}
+
+	stale := handedOff
+	stale.Status = AssignmentStatusOpen
+	stale.AssignmentType = AssignmentTypeOpenPool
+	stale.Version--
+	if _, ok, err := assigner.AutoAssign(ctx, stale); ok || err != nil {
+		t.Fatalf("expected stale version to leave assignment untouched, got ok=%v err=%v", ok, err)
+	}
+	if current, _ := repo.Get(ctx, handedOff.ID); current.AssigneeID != "bob" || current.Version != handedOff.Version {
+		t.Fatalf("expected stale auto-assign to be rejected, got %+v", current)
+	}
+}
+
+func TestLeastLoadedTranslationAssigneeSelectorCountsLoadWithinScope(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryTranslationAssignmentRepository()
+	for i, seed := range []struct{ tenant, assignee string }{
+		{"acme", "alice"},
+		{"other", "bob"},
+		{"other", "bob"},
+	} {
+		if _, err := repo.Create(ctx, TranslationAssignment{
+			FamilyID:       "tg_scope_" + string(rune('a'+i)),
+			TenantID:       seed.tenant,
+			EntityType:     "pages",
+			SourceRecordID: "page_scope_" + string(rune('a'+i)),
+			SourceLocale:   "en",
+			TargetLocale:   "fr",
+			AssignmentType: AssignmentTypeDirect,
+			Status:         AssignmentStatusInProgress,
+			AssigneeID:     seed.assignee,
+			Priority:       PriorityNormal,
+		}); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+	selector := &LeastLoadedTranslationAssigneeSelector{Repository: repo}
+	picked, err := selector.SelectAssignee(ctx, TranslationAssigneeSelectionInput{
+		Assignment: TranslationAssignment{TenantID: "acme", TargetLocale: "fr"},
+		Candidates: []string{"alice", "bob"},
+	})
+	if err != nil || picked != "bob" {
+		t.Fatalf("expected another tenant's work not to count as load, got %q (%v)", picked, err)
+	}
+}
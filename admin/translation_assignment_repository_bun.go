@@ -1073,6 +1073,76 @@ func (r *BunTranslationAssignmentRepository) AssignmentDashboardSummary(ctx cont
 	return out, nil
 }
 
+type bunTranslationSLAGroupRow struct {
+	Locale     string `bun:"locale"`
+	EntityType string `bun:"entity_type"`
+}
+
+type bunTranslationSLACountRow struct {
+	Breached int `bun:"breached"`
+	AtRisk   int `bun:"at_risk"`
+}
+
+// TranslationSLACounts resolves the policy of each target locale and entity
+// type pair with due work, then counts the pair's breached and at-risk
+// assignments with one aggregate query.
+func (r *BunTranslationAssignmentRepository) TranslationSLACounts(ctx context.Context, input TranslationSLACountInput) (TranslationSLACounts, error) {
+	if r == nil || r.db == nil {
+		return TranslationSLACounts{}, serviceNotConfiguredDomainError("translation assignment repository", nil)
+	}
+	out := TranslationSLACounts{}
+	if len(input.Policies) == 0 {
+		return out, nil
+	}
+	now := normalizedBunAssignmentQueryNow(input.Now)
+	dueSQL := r.assignmentDueDateSQL(now)
+	filters := map[string]any{"status": translationDashboardActionableStatusFilter()}
+	if input.TenantID != "" {
+		filters[ScopeTenantIDKey] = input.TenantID
+	}
+	if input.OrgID != "" {
+		filters[ScopeOrgIDKey] = input.OrgID
+	}
+	groups := []bunTranslationSLAGroupRow{}
+	query := r.db.NewSelect().
+		Model((*bunTranslationAssignmentRecord)(nil)).
+		ColumnExpr("LOWER(TRIM(target_locale)) AS locale").
+		ColumnExpr("LOWER(TRIM(entity_type)) AS entity_type").
+		Where("due_date IS NOT NULL").
+		GroupExpr("LOWER(TRIM(target_locale)), LOWER(TRIM(entity_type))")
+	applyBunAssignmentListFilters(query, ListOptions{Filters: cloneAssignmentFilterMap(filters)}, dueSQL)
+	if err := query.Scan(ctx, &groups); err != nil {
+		return TranslationSLACounts{}, err
+	}
+	for _, group := range groups {
+		policy, ok := input.Policies.Resolve(group.Locale, group.EntityType)
+		if !ok {
+			continue
+		}
+		window := policy.AtRiskWindow
+		if window <= 0 {
+			window = translationSLADefaultAtRiskWindow
+		}
+		breachedBefore := bunAssignmentStorageDateValue(now.Add(-max(policy.BreachGrace, 0)))
+		atRiskUntil := bunAssignmentStorageDateValue(now.Add(window))
+		row := bunTranslationSLACountRow{}
+		count := r.db.NewSelect().
+			Model((*bunTranslationAssignmentRecord)(nil)).
+			ColumnExpr("COALESCE(SUM(CASE WHEN due_date < ? THEN 1 ELSE 0 END), 0) AS breached", breachedBefore).
+			ColumnExpr("COALESCE(SUM(CASE WHEN due_date >= ? AND due_date <= ? THEN 1 ELSE 0 END), 0) AS at_risk", breachedBefore, atRiskUntil).
+			Where("due_date IS NOT NULL").
+			Where("LOWER(TRIM(target_locale)) = ?", group.Locale).
+			Where("LOWER(TRIM(entity_type)) = ?", group.EntityType)
+		applyBunAssignmentListFilters(count, ListOptions{Filters: cloneAssignmentFilterMap(filters)}, dueSQL)
+		if err := count.Scan(ctx, &row); err != nil {
+			return TranslationSLACounts{}, err
+		}
+		out.Breached += row.Breached
+		out.AtRisk += row.AtRisk
+	}
+	return out, nil
+}
+
 func (r *BunTranslationAssignmentRepository) applyAssignmentDashboardActorSummary(ctx context.Context, out *TranslationAssignmentDashboardSummary, scope map[string]any, actorID string, now time.Time) error {
 	actorID = strings.TrimSpace(actorID)
 	if actorID == "" || out == nil {
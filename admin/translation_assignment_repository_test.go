@@ -1616,3 +1616,62 @@ func TestInMemoryTranslationAssignmentRepositoryApprovedAssignmentsDoNotOccupyAc
 		t.Fatalf("expected approved assignment not to block new active assignment, got %v", err)
 	}
 }
+
+func TestBunTranslationAssignmentRepositoryCountsSLAStatesInSQL(t *testing.T) {
+	db := newTranslationFamilyStoreSQLiteDB(t)
+	ctx := context.Background()
+	if err := NewBunTranslationFamilyStore(db).SaveFamily(ctx, translationservices.FamilyRecord{
+		ID:              "family-sla",
+		TenantID:        "tenant-1",
+		OrgID:           "org-1",
+		ContentType:     "pages",
+		SourceLocale:    "en",
+		SourceVariantID: "family-sla::en",
+		ReadinessState:  "ready",
+		Variants: []translationservices.FamilyVariant{
+			{ID: "family-sla::en", FamilyID: "family-sla", TenantID: "tenant-1", OrgID: "org-1", Locale: "en", Status: "published", IsSource: true, SourceRecordID: "page-1"},
+			{ID: "family-sla::es", FamilyID: "family-sla", TenantID: "tenant-1", OrgID: "org-1", Locale: "es", Status: "draft", SourceRecordID: "page-1"},
+			{ID: "family-sla::fr", FamilyID: "family-sla", TenantID: "tenant-1", OrgID: "org-1", Locale: "fr", Status: "draft", SourceRecordID: "page-1"},
+		},
+	}); err != nil {
+		t.Fatalf("seed family: %v", err)
+	}
+	repo := NewBunTranslationAssignmentRepository(db)
+	now := time.Now().UTC().Truncate(time.Second)
+	overdue := now.Add(-2 * time.Hour)
+	withinGrace := now.Add(-30 * time.Minute)
+	soon := now.Add(3 * time.Hour)
+	for _, assignment := range []TranslationAssignment{
+		{ID: "asg-sla-1", EntityType: "pages", SourceRecordID: "page-1", TargetLocale: "es", Status: AssignmentStatusInProgress, DueDate: &overdue},
+		{ID: "asg-sla-2", EntityType: "news", SourceRecordID: "news-1", TargetLocale: "es", Status: AssignmentStatusAssigned, DueDate: &withinGrace},
+		{ID: "asg-sla-3", EntityType: "pages", SourceRecordID: "page-2", TargetLocale: "fr", Status: AssignmentStatusAssigned, DueDate: &soon},
+		{ID: "asg-sla-4", EntityType: "pages", SourceRecordID: "page-3", TargetLocale: "fr", Status: AssignmentStatusApproved, DueDate: &overdue},
+	} {
+		assignment.FamilyID = "family-sla"
+		assignment.TenantID = "tenant-1"
+		assignment.OrgID = "org-1"
+		assignment.SourceLocale = "en"
+		assignment.AssignmentType = AssignmentTypeDirect
+		assignment.AssigneeID = "translator-1"
+		if _, err := repo.Create(ctx, assignment); err != nil {
+			t.Fatalf("create assignment %s: %v", assignment.ID, err)
+		}
+	}
+	policies := TranslationSLAPolicies{
+		{ContentType: "news", BreachGrace: time.Hour},
+		{Locale: "fr", AtRiskWindow: 4 * time.Hour},
+		{AtRiskWindow: time.Hour},
+	}
+
+	counts, err := repo.TranslationSLACounts(ctx, TranslationSLACountInput{TenantID: "tenant-1", OrgID: "org-1", Policies: policies, Now: now})
+	if err != nil {
+		t.Fatalf("count SLA states: %v", err)
+	}
+	all, _, _ := repo.List(ctx, ListOptions{PerPage: 10})
+	if expected := CountTranslationSLAStates(all, policies, now); counts != expected || counts.Breached != 1 || counts.AtRisk != 2 {
+		t.Fatalf("expected SQL counts to match in-memory classification %+v, got %+v", expected, counts)
+	}
+	if other, err := repo.TranslationSLACounts(ctx, TranslationSLACountInput{TenantID: "tenant-2", Policies: policies, Now: now}); err != nil || other != (TranslationSLACounts{}) {
+		t.Fatalf("expected no counts for another tenant, got %+v (%v)", other, err)
+	}
+}
@@ -350,6 +350,7 @@ func translationCapabilityRouteSpecs(adminGroup, adminAPIGroup string) []transla
 		"translations.families.variants",
 		"translations.families.assignments",
 		"translations.sync.resources.id",
+		"translations.sync.resources.events",
 		"translations.assignments",
 		"translations.assignments.family_assignments",
 		"translations.assignments.id",
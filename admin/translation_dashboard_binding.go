@@ -73,15 +73,94 @@ func (b *translationQueueBinding) translationDashboardNow() time.Time {
 }
 
 func (b *translationQueueBinding) translationDashboardPayload(adminCtx AdminContext, identity translationTransportIdentity, actorID, channel string, now time.Time, overdueLimit, blockedLimit int) (map[string]any, error) {
-	if payload, ok, err := b.translationDashboardOptimizedPayload(adminCtx, identity, actorID, channel, now, overdueLimit, blockedLimit); ok || err != nil {
-		return payload, err
-	}
-	assignments, families, runtime, degradedReasons, err := b.translationDashboardDataSources(adminCtx, identity, channel)
+	payload, ok, err := b.translationDashboardOptimizedPayload(adminCtx, identity, actorID, channel, now, overdueLimit, blockedLimit)
 	if err != nil {
 		return nil, err
 	}
-	data, meta := b.translationDashboardSections(assignments, families, runtime, degradedReasons, identity, actorID, channel, now, overdueLimit, blockedLimit)
-	return map[string]any{"data": data, "meta": meta}, nil
+	if !ok {
+		assignments, families, runtime, degradedReasons, err := b.translationDashboardDataSources(adminCtx, identity, channel)
+		if err != nil {
+			return nil, err
+		}
+		data, meta := b.translationDashboardSections(assignments, families, runtime, degradedReasons, identity, actorID, channel, now, overdueLimit, blockedLimit)
+		payload = map[string]any{"data": data, "meta": meta}
+	}
+	b.applyTranslationDashboardSLACounts(adminCtx, identity, now, payload)
+	return payload, nil
+}
+
+// applyTranslationDashboardSLACounts adds SLA breach counts to the summary
+// and the overdue card when SLA policies are configured. A failed count
+// marks the payload degraded instead of failing the dashboard.
+func (b *translationQueueBinding) applyTranslationDashboardSLACounts(adminCtx AdminContext, identity translationTransportIdentity, now time.Time, payload map[string]any) {
+	policies := b.admin.translationSLAPolicies
+	if len(policies) == 0 {
+		return
+	}
+	counts, err := b.translationDashboardSLACounts(adminCtx, identity, policies, now)
+	if err != nil {
+		markTranslationDashboardDegraded(payload, map[string]any{"component": "sla_counts", "message": err.Error()})
+		return
+	}
+	data, _ := payload["data"].(map[string]any)
+	if summary, ok := data["summary"].(map[string]any); ok {
+		summary["sla_breached"] = counts.Breached
+		summary["sla_at_risk"] = counts.AtRisk
+	}
+	cards, _ := data["cards"].([]map[string]any)
+	for _, card := range cards {
+		if card["id"] != translationDashboardCardOverdueTasks {
+			continue
+		}
+		if breakdown, ok := card["breakdown"].(map[string]any); ok {
+			breakdown["sla_breached"] = counts.Breached
+			breakdown["sla_at_risk"] = counts.AtRisk
+		}
+	}
+}
+
+// translationDashboardSLACounts prefers the repository aggregate and only
+// classifies listed assignments for repositories without one.
+func (b *translationQueueBinding) translationDashboardSLACounts(adminCtx AdminContext, identity translationTransportIdentity, policies TranslationSLAPolicies, now time.Time) (TranslationSLACounts, error) {
+	repo, err := b.assignmentRepository()
+	if err != nil {
+		return TranslationSLACounts{}, err
+	}
+	if store, ok := repo.(TranslationSLACountStore); ok && store != nil {
+		return store.TranslationSLACounts(adminCtx.Context, TranslationSLACountInput{
+			TenantID: identity.TenantID,
+			OrgID:    identity.OrgID,
+			Policies: policies,
+			Now:      now,
+		})
+	}
+	assignments, err := listTranslationSLAAssignments(adminCtx.Context, repo, map[string]any{
+		ScopeTenantIDKey: identity.TenantID,
+		ScopeOrgIDKey:    identity.OrgID,
+	})
+	if err != nil {
+		return TranslationSLACounts{}, err
+	}
+	return CountTranslationSLAStates(assignments, policies, now), nil
+}
+
+// markTranslationDashboardDegraded records a degraded reason on an already
+// built dashboard payload and raises the degraded alert once.
+func markTranslationDashboardDegraded(payload map[string]any, reason map[string]any) {
+	meta, _ := payload["meta"].(map[string]any)
+	if meta == nil {
+		return
+	}
+	reasons, _ := meta["degraded_reasons"].([]map[string]any)
+	meta["degraded_reasons"] = append(reasons, reason)
+	if degraded, _ := meta["degraded"].(bool); degraded {
+		return
+	}
+	meta["degraded"] = true
+	if data, ok := payload["data"].(map[string]any); ok {
+		alerts, _ := data["alerts"].([]map[string]any)
+		data["alerts"] = append([]map[string]any{translationDashboardDegradedAlert()}, alerts...)
+	}
 }
 
 func (b *translationQueueBinding) translationDashboardOptimizedPayload(adminCtx AdminContext, identity translationTransportIdentity, actorID, channel string, now time.Time, overdueLimit, blockedLimit int) (map[string]any, bool, error) {
@@ -206,45 +285,45 @@ func translationDashboardAssignmentSummaryFromAssignments(assignments []Translat
 func (b *translationQueueBinding) translationDashboardOptimizedSections(assignments TranslationAssignmentDashboardSummary, families TranslationDashboardFamilyMetrics, runtime *translationFamilyRuntime, degradedReasons []map[string]any, identity translationTransportIdentity, actorID, channel string, now time.Time, overdueLimit, blockedLimit int) (map[string]any, map[string]any) {
 	cards := b.translationDashboardOptimizedCards(channel, now, assignments, families)
 	return map[string]any{
-			"cards": cards,
-			"tables": map[string]any{
-				translationDashboardTableTopOverdueAssignments: map[string]any{
-					"id":    translationDashboardTableTopOverdueAssignments,
-					"label": "Top Overdue Assignments",
-					"total": assignments.OverdueTasks,
-					"limit": overdueLimit,
-					"rows":  translationDashboardTopOverdueRows(b.admin.URLs(), assignments.TopOverdue, overdueLimit, now, channel),
-				},
-				translationDashboardTableBlockedFamilies: map[string]any{
-					"id":    translationDashboardTableBlockedFamilies,
-					"label": "Blocked Families",
-					"total": families.BlockedFamilies,
-					"limit": blockedLimit,
-					"rows":  translationDashboardTopBlockedRows(b.admin.URLs(), families.TopBlocked, blockedLimit, channel),
-				},
+		"cards": cards,
+		"tables": map[string]any{
+			translationDashboardTableTopOverdueAssignments: map[string]any{
+				"id":    translationDashboardTableTopOverdueAssignments,
+				"label": "Top Overdue Assignments",
+				"total": assignments.OverdueTasks,
+				"limit": overdueLimit,
+				"rows":  translationDashboardTopOverdueRows(b.admin.URLs(), assignments.TopOverdue, overdueLimit, now, channel),
 			},
-			"alerts":    translationDashboardAlerts(cards, len(degradedReasons) > 0),
-			"runbooks":  translationDashboardRunbooks(b.admin.URLs()),
-			"generated": now,
-			"summary": map[string]any{
-				"my_tasks":                 assignments.MyTasks,
-				"needs_review":             assignments.NeedsReview,
-				"overdue_tasks":            assignments.OverdueTasks,
-				"blocked_families":         families.BlockedFamilies,
-				"missing_required_locales": families.MissingRequiredFamilies,
+			translationDashboardTableBlockedFamilies: map[string]any{
+				"id":    translationDashboardTableBlockedFamilies,
+				"label": "Blocked Families",
+				"total": families.BlockedFamilies,
+				"limit": blockedLimit,
+				"rows":  translationDashboardTopBlockedRows(b.admin.URLs(), families.TopBlocked, blockedLimit, channel),
 			},
-		}, mergeTranslationChannelContract(map[string]any{
-			"generated_at":        now,
-			"refresh_interval_ms": translationDashboardRefreshIntervalMS,
-			"latency_target_ms":   translationDashboardLatencyTargetMS,
-			"query_models":        TranslationDashboardQueryModels(),
-			"contracts":           TranslationDashboardContractPayload(),
-			"degraded":            len(degradedReasons) > 0,
-			"degraded_reasons":    degradedReasons,
-			"family_report":       translationDashboardFamilyReport(runtime),
-			"scope":               map[string]any{ScopeTenantIDKey: identity.TenantID, ScopeOrgIDKey: identity.OrgID, "actor_id": actorID},
-			"metrics":             translationDashboardMetricCatalog(),
-		}, channel)
+		},
+		"alerts":    translationDashboardAlerts(cards, len(degradedReasons) > 0),
+		"runbooks":  translationDashboardRunbooks(b.admin.URLs()),
+		"generated": now,
+		"summary": map[string]any{
+			"my_tasks":                 assignments.MyTasks,
+			"needs_review":             assignments.NeedsReview,
+			"overdue_tasks":            assignments.OverdueTasks,
+			"blocked_families":         families.BlockedFamilies,
+			"missing_required_locales": families.MissingRequiredFamilies,
+		},
+	}, mergeTranslationChannelContract(map[string]any{
+		"generated_at":        now,
+		"refresh_interval_ms": translationDashboardRefreshIntervalMS,
+		"latency_target_ms":   translationDashboardLatencyTargetMS,
+		"query_models":        TranslationDashboardQueryModels(),
+		"contracts":           TranslationDashboardContractPayload(),
+		"degraded":            len(degradedReasons) > 0,
+		"degraded_reasons":    degradedReasons,
+		"family_report":       translationDashboardFamilyReport(runtime),
+		"scope":               map[string]any{ScopeTenantIDKey: identity.TenantID, ScopeOrgIDKey: identity.OrgID, "actor_id": actorID},
+		"metrics":             translationDashboardMetricCatalog(),
+	}, channel)
 }
 
 func (b *translationQueueBinding) translationDashboardOptimizedCards(channel string, now time.Time, assignments TranslationAssignmentDashboardSummary, families TranslationDashboardFamilyMetrics) []map[string]any {
@@ -354,30 +433,30 @@ func (b *translationQueueBinding) translationDashboardSections(assignments []Tra
 	missingRequiredFamilies := translationDashboardMissingRequiredFamilies(scopedFamilies)
 	cards := b.translationDashboardCards(channel, now, myTasks, needsReview, overdueAssignments, blockedFamilies, missingRequiredFamilies)
 	return map[string]any{
-			"cards":     cards,
-			"tables":    translationDashboardTables(b.admin.URLs(), overdueAssignments, blockedFamilies, overdueLimit, blockedLimit, now, channel),
-			"alerts":    translationDashboardAlerts(cards, len(degradedReasons) > 0),
-			"runbooks":  translationDashboardRunbooks(b.admin.URLs()),
-			"generated": now,
-			"summary": map[string]any{
-				"my_tasks":                 len(myTasks),
-				"needs_review":             len(needsReview),
-				"overdue_tasks":            len(overdueAssignments),
-				"blocked_families":         len(blockedFamilies),
-				"missing_required_locales": len(missingRequiredFamilies),
-			},
-		}, mergeTranslationChannelContract(map[string]any{
-			"generated_at":        now,
-			"refresh_interval_ms": translationDashboardRefreshIntervalMS,
-			"latency_target_ms":   translationDashboardLatencyTargetMS,
-			"query_models":        TranslationDashboardQueryModels(),
-			"contracts":           TranslationDashboardContractPayload(),
-			"degraded":            len(degradedReasons) > 0,
-			"degraded_reasons":    degradedReasons,
-			"family_report":       translationDashboardFamilyReport(runtime),
-			"scope":               map[string]any{ScopeTenantIDKey: identity.TenantID, ScopeOrgIDKey: identity.OrgID, "actor_id": actorID},
-			"metrics":             translationDashboardMetricCatalog(),
-		}, channel)
+		"cards":     cards,
+		"tables":    translationDashboardTables(b.admin.URLs(), overdueAssignments, blockedFamilies, overdueLimit, blockedLimit, now, channel),
+		"alerts":    translationDashboardAlerts(cards, len(degradedReasons) > 0),
+		"runbooks":  translationDashboardRunbooks(b.admin.URLs()),
+		"generated": now,
+		"summary": map[string]any{
+			"my_tasks":                 len(myTasks),
+			"needs_review":             len(needsReview),
+			"overdue_tasks":            len(overdueAssignments),
+			"blocked_families":         len(blockedFamilies),
+			"missing_required_locales": len(missingRequiredFamilies),
+		},
+	}, mergeTranslationChannelContract(map[string]any{
+		"generated_at":        now,
+		"refresh_interval_ms": translationDashboardRefreshIntervalMS,
+		"latency_target_ms":   translationDashboardLatencyTargetMS,
+		"query_models":        TranslationDashboardQueryModels(),
+		"contracts":           TranslationDashboardContractPayload(),
+		"degraded":            len(degradedReasons) > 0,
+		"degraded_reasons":    degradedReasons,
+		"family_report":       translationDashboardFamilyReport(runtime),
+		"scope":               map[string]any{ScopeTenantIDKey: identity.TenantID, ScopeOrgIDKey: identity.OrgID, "actor_id": actorID},
+		"metrics":             translationDashboardMetricCatalog(),
+	}, channel)
 }
 
 func (b *translationQueueBinding) translationDashboardCards(channel string, now time.Time, myTasks, needsReview, overdueAssignments []TranslationAssignment, blockedFamilies, missingRequiredFamilies []translationservices.FamilyRecord) []map[string]any {
@@ -689,11 +768,7 @@ func translationDashboardCardAlert(count int, critical, warning bool) map[string
 func translationDashboardAlerts(cards []map[string]any, degraded bool) []map[string]any {
 	alerts := make([]map[string]any, 0, len(cards)+1)
 	if degraded {
-		alerts = append(alerts, map[string]any{
-			"state":   translationDashboardAlertStateDegraded,
-			"code":    "DEGRADED_DATA",
-			"message": "Dashboard data is partially degraded; some family aggregates could not be refreshed.",
-		})
+		alerts = append(alerts, translationDashboardDegradedAlert())
 	}
 	for _, card := range cards {
 		alert := extractMap(card["alert"])
@@ -712,6 +787,14 @@ func translationDashboardAlerts(cards []map[string]any, degraded bool) []map[str
 	return alerts
 }
 
+func translationDashboardDegradedAlert() map[string]any {
+	return map[string]any{
+		"state":   translationDashboardAlertStateDegraded,
+		"code":    "DEGRADED_DATA",
+		"message": "Dashboard data is partially degraded; some family aggregates could not be refreshed.",
+	}
+}
+
 func translationDashboardLink(urls urlkit.Resolver, group, route, resolverKey string, params, query map[string]string, metadata map[string]any) map[string]any {
 	link := map[string]any{
 		"group":        strings.TrimSpace(group),
@@ -1036,3 +1036,81 @@ func extractListMaps(value any) []map[string]any {
 		return nil
 	}
 }
+
+type dashboardFailingSLACountRepo struct {
+	*InMemoryTranslationAssignmentRepository
+}
+
+func (r *dashboardFailingSLACountRepo) TranslationSLACounts(context.Context, TranslationSLACountInput) (TranslationSLACounts, error) {
+	return TranslationSLACounts{}, errors.New("sla counts unavailable")
+}
+
+func TestTranslationQueueBindingDashboardDegradesWhenSLACountsFail(t *testing.T) {
+	now := time.Date(2026, 2, 17, 12, 0, 0, 0, time.UTC)
+	adm := mustNewAdmin(t, Config{BasePath: "/admin", DefaultLocale: "en"}, Dependencies{
+		FeatureGate: featureGateFromKeys(FeatureCMS, FeatureTranslationQueue),
+	})
+	adm.WithAuthorizer(translationPermissionAuthorizer{allowed: map[string]bool{PermAdminTranslationsView: true}})
+	adm.WithTranslationSLAPolicies(TranslationSLAPolicies{{AtRiskWindow: time.Hour}})
+	baseRepo := NewInMemoryTranslationAssignmentRepository()
+	overdue := now.Add(-time.Hour)
+	if _, err := baseRepo.Create(context.Background(), TranslationAssignment{
+		FamilyID:       "family-sla",
+		EntityType:     "pages",
+		TenantID:       "tenant-1",
+		OrgID:          "org-1",
+		SourceRecordID: "page-sla",
+		SourceLocale:   "en",
+		TargetLocale:   "es",
+		AssigneeID:     "manager-1",
+		AssignmentType: AssignmentTypeDirect,
+		Status:         AssignmentStatusInProgress,
+		Priority:       PriorityNormal,
+		DueDate:        &overdue,
+	}); err != nil {
+		t.Fatalf("seed assignment: %v", err)
+	}
+	if _, err := RegisterTranslationQueuePanel(adm, &dashboardFailingSLACountRepo{InMemoryTranslationAssignmentRepository: baseRepo}); err != nil {
+		t.Fatalf("register queue panel: %v", err)
+	}
+	familyStore := &dashboardMetricsOnlyFamilyStore{}
+	binding := newTranslationQueueBinding(adm)
+	binding.now = func() time.Time { return now }
+	binding.dashboardLoadRuntime = func(context.Context, string) (*translationFamilyRuntime, error) {
+		return &translationFamilyRuntime{service: &translationservices.FamilyService{Store: familyStore}}, nil
+	}
+	app := newTranslationQueueTestApp(t, binding)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/admin/api/translations/dashboard?tenant_id=tenant-1&org_id=org-1", nil)
+	req.Header.Set("X-User-ID", "manager-1")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("request error: %v", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // test response body cleanup is best-effort
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status=%d want=200", resp.StatusCode)
+	}
+
+	payload := map[string]any{}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	data := extractMap(payload["data"])
+	summary := extractMap(data["summary"])
+	if got := toInt(summary["overdue_tasks"]); got != 1 {
+		t.Fatalf("expected the rest of the dashboard to load, overdue_tasks=%d", got)
+	}
+	if _, ok := summary["sla_breached"]; ok {
+		t.Fatalf("expected SLA counts omitted after failure, got %+v", summary)
+	}
+	meta := extractMap(payload["meta"])
+	reasons := extractListMaps(meta["degraded_reasons"])
+	if !toBool(meta["degraded"]) || len(reasons) != 1 || toString(reasons[0]["component"]) != "sla_counts" {
+		t.Fatalf("expected sla_counts degraded reason, got degraded=%v reasons=%+v", meta["degraded"], reasons)
+	}
+	alerts := extractListMaps(data["alerts"])
+	if len(alerts) == 0 || toString(alerts[0]["code"]) != "DEGRADED_DATA" {
+		t.Fatalf("expected degraded alert, got %+v", alerts)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	synccore "github.com/goliatone/go-admin/pkg/go-sync/core"
 	syncservice "github.com/goliatone/go-admin/pkg/go-sync/service"
@@ -12,10 +13,14 @@ import (
 	router "github.com/goliatone/go-router"
 )
 
+// translationDraftSyncWatchPollInterval bounds how long watchers wait for
+// drafts saved outside the sync endpoint, such as the editor form.
+const translationDraftSyncWatchPollInterval = 5 * time.Second
+
 type translationDraftSyncRequestIdentityContextKey struct{}
 
 func (b *translationQueueBinding) ReadDraftSync(c router.Context) error {
-	transport, err := newTranslationDraftSyncHTTPTransport(b)
+	transport, err := b.draftSyncTransport()
 	if err != nil {
 		return err
 	}
@@ -23,13 +28,49 @@ func (b *translationQueueBinding) ReadDraftSync(c router.Context) error {
 }
 
 func (b *translationQueueBinding) MutateDraftSync(c router.Context) error {
-	transport, err := newTranslationDraftSyncHTTPTransport(b)
+	transport, err := b.draftSyncTransport()
 	if err != nil {
 		return err
 	}
 	return b.serveDraftSyncTransport(c, transport.HandleMutate)
 }
 
+// WatchDraftSync streams draft revisions as server-sent events. It blocks
+// until the client disconnects.
+func (b *translationQueueBinding) WatchDraftSync(c router.Context) error {
+	transport, err := b.draftSyncTransport()
+	if err != nil {
+		return err
+	}
+	return b.serveDraftSyncTransport(c, transport.HandleWatch)
+}
+
+func (b *translationQueueBinding) draftSyncTransport() (*httptransport.Handler, error) {
+	if b == nil || b.admin == nil {
+		return nil, serviceNotConfiguredDomainError("translation queue binding", map[string]any{
+			"component": "translation_draft_sync_transport",
+		})
+	}
+	return b.admin.translationDraftSyncTransport()
+}
+
+// translationDraftSyncTransport returns the draft sync transport, building it
+// on first use. One sync service is shared per Admin so watch subscribers see
+// mutations applied by other requests.
+func (a *Admin) translationDraftSyncTransport() (*httptransport.Handler, error) {
+	a.translationDraftSyncMu.Lock()
+	defer a.translationDraftSyncMu.Unlock()
+	if a.translationDraftSync != nil {
+		return a.translationDraftSync, nil
+	}
+	transport, err := newTranslationDraftSyncHTTPTransport(newTranslationQueueBinding(a))
+	if err != nil {
+		return nil, err
+	}
+	a.translationDraftSync = transport
+	return transport, nil
+}
+
 func newTranslationDraftSyncHTTPTransport(binding *translationQueueBinding) (*httptransport.Handler, error) {
 	store := newTranslationDraftSyncResourceStore(binding)
 	if store == nil {
@@ -37,7 +78,7 @@ func newTranslationDraftSyncHTTPTransport(binding *translationQueueBinding) (*ht
 			"component": "translation_draft_sync_transport",
 		})
 	}
-	svc, err := syncservice.NewSyncService(store, nil)
+	svc, err := syncservice.NewSyncService(store, nil, syncservice.WithWatchPollInterval(translationDraftSyncWatchPollInterval))
 	if err != nil {
 		return nil, err
 	}
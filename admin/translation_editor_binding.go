@@ -59,6 +59,8 @@ type translationEditorContext struct {
 	TargetRecordID       string                            `json:"target_record_id"`
 	TargetStatus         string                            `json:"target_status"`
 	ActivityEntries      []ActivityEntry                   `json:"activity_entries"`
+	GlossaryTerms        []TranslationGlossaryTerm         `json:"glossary_terms"`
+	FieldMaxLengths      map[string]int                    `json:"field_max_lengths"`
 	HasTarget            bool                              `json:"has_target"`
 }
 
@@ -256,6 +258,10 @@ func (b *translationQueueBinding) loadAssignmentEditorContext(ctx context.Contex
 	}
 	editorCtx.SourceVersion = sourceVersion
 	editorCtx.ActivityEntries = entries
+	if b != nil && b.admin != nil {
+		editorCtx.GlossaryTerms = translationEditorGlossaryTerms(ctx, b.admin.translationGlossaryStore, scope.TenantID, scope.OrgID, editorCtx)
+		editorCtx.FieldMaxLengths = b.translationEditorFieldMaxLengths(ctx, editorCtx.Family.ContentType)
+	}
 	return editorCtx, nil
 }
 
@@ -399,7 +405,7 @@ func (b *translationQueueBinding) assignmentDetailPayload(ctx context.Context, a
 		"attachment_summary":         translationEditorAttachmentSummary(attachments),
 		"review_feedback":            reviewFeedback,
 		"last_rejection_reason":      strings.TrimSpace(assignment.LastRejectionReason),
-		"qa_results":                 b.translationQAResults(editorCtx),
+		"qa_results":                 b.translationQAResults(ctx, editorCtx),
 		"assist":                     translationEditorAssistPayload(ctx, b, editorCtx),
 		"glossary_matches":           translationEditorGlossaryMatches(editorCtx),
 		"style_guide_summary":        translationEditorStyleGuideSummary(editorCtx),
@@ -1121,18 +1127,48 @@ func translationEditorAssistPayload(ctx context.Context, b *translationQueueBind
 }
 
 func translationEditorMemorySuggestions(ctx context.Context, b *translationQueueBinding, editorCtx translationEditorContext) []map[string]any {
+	matches := translationEditorMemoryMatches(ctx, b, editorCtx, translationEditorMemoryMatchLimit)
+	suggestions := make([]map[string]any, 0, len(matches))
+	for _, match := range matches {
+		sourceHash := translationEditorHashFields(match.SourceVariant.Fields)
+		suggestions = append(suggestions, translationEditorMemorySuggestionPayload(match.Family, match.SourceVariant, match.TargetVariant, match.FieldPath, match.SourceText, match.SuggestedText, sourceHash))
+	}
+	for _, match := range translationEditorFuzzyMemoryMatches(ctx, b, editorCtx) {
+		suggestions = append(suggestions, translationMemoryMatchPayload(match))
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		leftScore := float64FromAny(suggestions[i]["score"])
+		rightScore := float64FromAny(suggestions[j]["score"])
+		if leftScore == rightScore {
+			if toString(suggestions[i]["field_path"]) == toString(suggestions[j]["field_path"]) {
+				return toString(suggestions[i]["source_label"]) < toString(suggestions[j]["source_label"])
+			}
+			return toString(suggestions[i]["field_path"]) < toString(suggestions[j]["field_path"])
+		}
+		return leftScore > rightScore
+	})
+	return suggestions
+}
+
+// translationEditorMemoryMatchLimit bounds the exact memory matches loaded for
+// the editor assist panel and for suggestion prompts.
+const translationEditorMemoryMatchLimit = 12
+
+// translationEditorMemoryMatches loads translation memory matches for the
+// editor source fields. Missing stores and lookup failures yield no matches.
+func translationEditorMemoryMatches(ctx context.Context, b *translationQueueBinding, editorCtx translationEditorContext, limit int) []TranslationEditorMemorySuggestion {
 	if b == nil || b.admin == nil {
-		return []map[string]any{}
+		return nil
 	}
 	store, ok := b.admin.translationFamilyStore.(TranslationEditorMemorySuggestionStore)
 	if !ok || store == nil {
-		return []map[string]any{}
+		return nil
 	}
 	sourceLocale := strings.TrimSpace(strings.ToLower(editorCtx.SourceVariant.Locale))
 	targetLocale := strings.TrimSpace(strings.ToLower(editorCtx.TargetVariant.Locale))
 	contentType := strings.TrimSpace(strings.ToLower(editorCtx.Family.ContentType))
 	if sourceLocale == "" || targetLocale == "" || contentType == "" {
-		return []map[string]any{}
+		return nil
 	}
 	input := normalizeTranslationEditorMemoryInput(TranslationEditorMemorySuggestionInput{
 		TenantID:        strings.TrimSpace(editorCtx.Family.TenantID),
@@ -1142,7 +1178,7 @@ func translationEditorMemorySuggestions(ctx context.Context, b *translationQueue
 		TargetLocale:    targetLocale,
 		ExcludeFamilyID: strings.TrimSpace(editorCtx.Family.ID),
 		FieldSources:    editorCtx.SourceFields,
-		Limit:           12,
+		Limit:           limit,
 	})
 	if input.TenantID == "" {
 		input.TenantID = tenantIDFromContext(ctx)
@@ -1151,29 +1187,39 @@ func translationEditorMemorySuggestions(ctx context.Context, b *translationQueue
 		input.OrgID = orgIDFromContext(ctx)
 	}
 	if len(input.FieldSources) == 0 {
-		return []map[string]any{}
+		return nil
 	}
 	matches, err := store.TranslationEditorMemorySuggestions(ctx, input)
 	if err != nil {
-		return []map[string]any{}
+		return nil
 	}
-	suggestions := make([]map[string]any, 0, len(matches))
-	for _, match := range matches {
-		sourceHash := translationEditorHashFields(match.SourceVariant.Fields)
-		suggestions = append(suggestions, translationEditorMemorySuggestionPayload(match.Family, match.SourceVariant, match.TargetVariant, match.FieldPath, match.SourceText, match.SuggestedText, sourceHash))
+	return matches
+}
+
+// translationEditorFuzzyMemoryMatches looks up imported and stored memory units
+// for the editor source fields and their sentences. Lookup failures yield no matches.
+func translationEditorFuzzyMemoryMatches(ctx context.Context, b *translationQueueBinding, editorCtx translationEditorContext) []TranslationMemoryMatch {
+	if b == nil || b.admin == nil || b.admin.translationMemoryStore == nil {
+		return nil
 	}
-	sort.SliceStable(suggestions, func(i, j int) bool {
-		leftScore := float64FromAny(suggestions[i]["score"])
-		rightScore := float64FromAny(suggestions[j]["score"])
-		if leftScore == rightScore {
-			if toString(suggestions[i]["field_path"]) == toString(suggestions[j]["field_path"]) {
-				return toString(suggestions[i]["source_label"]) < toString(suggestions[j]["source_label"])
-			}
-			return toString(suggestions[i]["field_path"]) < toString(suggestions[j]["field_path"])
-		}
-		return leftScore > rightScore
-	})
-	return suggestions
+	input := TranslationMemoryLookupInput{
+		TenantID:     strings.TrimSpace(editorCtx.Family.TenantID),
+		OrgID:        strings.TrimSpace(editorCtx.Family.OrgID),
+		SourceLocale: editorCtx.SourceVariant.Locale,
+		TargetLocale: editorCtx.TargetVariant.Locale,
+		FieldSources: editorCtx.SourceFields,
+	}
+	if input.TenantID == "" {
+		input.TenantID = tenantIDFromContext(ctx)
+	}
+	if input.OrgID == "" {
+		input.OrgID = orgIDFromContext(ctx)
+	}
+	matches, err := LookupTranslationMemory(ctx, b.admin.translationMemoryStore, input)
+	if err != nil {
+		return nil
+	}
+	return matches
 }
 
 func float64FromAny(value any) float64 {
@@ -1239,30 +1285,34 @@ func translationEditorMemorySuggestionPayload(family translationservices.FamilyR
 }
 
 func translationEditorGlossaryMatches(editorCtx translationEditorContext) []map[string]any {
-	library := translationEditorGlossaryLibrary(strings.TrimSpace(strings.ToLower(editorCtx.TargetVariant.Locale)))
-	if len(library) == 0 {
+	if len(editorCtx.GlossaryTerms) == 0 {
 		return []map[string]any{}
 	}
 	matches := []map[string]any{}
 	seen := map[string]struct{}{}
 	for _, path := range translationEditorFieldPaths(editorCtx) {
-		sourceValue := strings.ToLower(strings.TrimSpace(editorCtx.SourceFields[path]))
+		sourceValue := strings.TrimSpace(editorCtx.SourceFields[path])
 		if sourceValue == "" {
 			continue
 		}
-		for _, candidate := range library {
-			term := strings.ToLower(strings.TrimSpace(toString(candidate["term"])))
-			if term == "" || !strings.Contains(sourceValue, term) {
+		for _, candidate := range editorCtx.GlossaryTerms {
+			term := strings.TrimSpace(candidate.Term)
+			if candidate.Forbidden || !translationGlossaryTextContains(sourceValue, term, candidate.CaseSensitive) {
 				continue
 			}
-			key := path + ":" + term
+			key := path + ":" + strings.ToLower(term)
 			if _, ok := seen[key]; ok {
 				continue
 			}
 			seen[key] = struct{}{}
-			match := cloneAnyMap(candidate)
-			match["field_paths"] = []string{path}
-			matches = append(matches, match)
+			matches = append(matches, map[string]any{
+				"id":                    candidate.ID,
+				"term":                  term,
+				"preferred_translation": candidate.PreferredTranslation,
+				"notes":                 candidate.Notes,
+				"case_sensitive":        candidate.CaseSensitive,
+				"field_paths":           []string{path},
+			})
 		}
 	}
 	return matches
@@ -1750,7 +1800,7 @@ func (b *translationQueueBinding) runSubmitReviewAction(adminCtx AdminContext, s
 			"field_completeness": translationEditorFieldCompleteness(editorCtx),
 		})
 	}
-	qaResults := b.translationQAResults(editorCtx)
+	qaResults := b.translationQAResults(adminCtx.Context, editorCtx)
 	recordTranslationQAOutcomeMetric(adminCtx.Context, translationQAOutcomeEvent{
 		Trigger:      "submit_review",
 		AssignmentID: strings.TrimSpace(assignment.ID),
@@ -2135,24 +2185,6 @@ func translationEditorValidationMessage(fieldPath string, missing bool) string {
 	return translationEditorFieldLabel(fieldPath) + " is required before submit"
 }
 
-func translationEditorGlossaryLibrary(locale string) []map[string]any {
-	switch strings.TrimSpace(strings.ToLower(locale)) {
-	case "fr":
-		return []map[string]any{
-			{"term": "home", "preferred_translation": "accueil", "notes": "Use the navigation label, not domicile."},
-			{"term": "publish", "preferred_translation": "publier", "notes": "Keep the action verb concise."},
-			{"term": "translation", "preferred_translation": "traduction", "notes": "Use the product noun consistently."},
-		}
-	case "es":
-		return []map[string]any{
-			{"term": "home", "preferred_translation": "inicio", "notes": "Prefer Inicio for primary nav labels."},
-			{"term": "publish", "preferred_translation": "publicar", "notes": "Use the imperative action label."},
-		}
-	default:
-		return nil
-	}
-}
-
 func translationEditorStyleGuideRules(locale, contentType string) []string {
 	rules := []string{
 		"Preserve placeholders, links, and HTML structure from the source.",
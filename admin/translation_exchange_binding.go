@@ -13,6 +13,7 @@ import (
 	"maps"
 	"mime/multipart"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -28,7 +29,7 @@ const (
 	translationExchangePermissionImportView     = PermAdminTranslationsImportView
 )
 
-var translationExchangeSupportedFormats = []string{"csv", "json"}
+var translationExchangeSupportedFormats = []string{"csv", "json", "xliff", "xliff2", "po"}
 
 const (
 	translationExchangeMaxUploadBytes = 25 * 1024 * 1024
@@ -50,6 +51,7 @@ var translationExchangeAllowedTopLevelKeys = map[string]map[string]struct{}{
 		"field_path":          {},
 		"include_source_hash": {},
 		"options":             {},
+		"format":              {},
 		"async":               {},
 	},
 	translationExchangeJobKindImportValidate: {
@@ -208,6 +210,10 @@ func (b *translationExchangeBinding) Export(c router.Context) (payload any, err
 	if err != nil {
 		return nil, err
 	}
+	resultPayload, err := translationExchangeExportResultPayload(result)
+	if err != nil {
+		return nil, err
+	}
 	b.admin.recordActivity(adminCtx.Context, adminCtx.UserID, "translation.exchange.export.created", "translation_exchange", map[string]any{
 		"row_count":      result.RowCount,
 		"resource_count": len(input.Filter.Resources),
@@ -215,7 +221,7 @@ func (b *translationExchangeBinding) Export(c router.Context) (payload any, err
 		"target_locales": append([]string{}, input.Filter.TargetLocales...),
 	})
 	recordTranslationExchangeJobMetrics(adminCtx.Context, translationExchangeJobKindExport, translationExchangeAsyncJobStatusCompleted, time.Since(startedAt), result.RowCount)
-	job := b.recordCompletedExchangeJob(adminCtx, translationExchangeJobKindExport, translationExchangePermissionExport, translationExchangeExportRequestPayload(input.Filter), nil, translationExchangeExportResultPayload(result), "")
+	job := b.recordCompletedExchangeJob(adminCtx, translationExchangeJobKindExport, translationExchangePermissionExport, translationExchangeExportRequestPayload(input.Filter), nil, resultPayload, "")
 	return mergeTranslationExchangeExportPayload(result, job), nil
 }
 
@@ -255,6 +261,18 @@ func (b *translationExchangeBinding) Template(c router.Context) error {
 				"notes":              "",
 			},
 		})
+	case "xliff", "xliff2":
+		raw, err := encodeTranslationExchangeXLIFF([]TranslationExchangeRow{translationExchangeTemplateRow()}, format)
+		if err != nil {
+			return err
+		}
+		c.SetHeader("Content-Type", "application/xliff+xml")
+		c.SetHeader("Content-Disposition", "attachment; filename=translation_exchange_template.xlf")
+		return c.SendString(string(raw))
+	case "po":
+		c.SetHeader("Content-Type", "text/x-gettext-translation")
+		c.SetHeader("Content-Disposition", "attachment; filename=translation_exchange_template.po")
+		return c.SendString(string(encodeTranslationExchangePO([]TranslationExchangeRow{translationExchangeTemplateRow()})))
 	default:
 		c.SetHeader("Content-Type", "text/csv")
 		c.SetHeader("Content-Disposition", "attachment; filename=translation_exchange_template.csv")
@@ -266,6 +284,24 @@ func (b *translationExchangeBinding) Template(c router.Context) error {
 	}
 }
 
+func translationExchangeTemplateRow() TranslationExchangeRow {
+	return TranslationExchangeRow{
+		Resource:       "content_items",
+		EntityID:       "item_123",
+		FamilyID:       "tg_123",
+		SourceLocale:   "en",
+		TargetLocale:   "es",
+		FieldPath:      "title",
+		SourceText:     "Hello world",
+		TranslatedText: "Hola mundo",
+		SourceHash:     "0123456789abcdef",
+		Path:           "/example",
+		RouteKey:       "content/example",
+		Title:          "Example",
+		Status:         "draft",
+	}
+}
+
 func (b *translationExchangeBinding) ImportValidate(c router.Context) (payload any, err error) {
 	startedAt := time.Now()
 	obsCtx := c.Context()
@@ -876,7 +912,10 @@ func mergeTranslationExchangeResultPayloadForKind(kind string, result Translatio
 func translationExchangeExportRequestPayload(filter TranslationExportFilter) map[string]any {
 	payload := map[string]any{
 		"resource_count": len(filter.Resources),
-		"file_name":      "translation_exchange_export.json",
+		"file_name":      translationExchangeExportFileName(filter.Format),
+	}
+	if format := strings.TrimSpace(filter.Format); format != "" {
+		payload["format"] = format
 	}
 	if len(filter.Resources) > 0 {
 		payload["resources"] = append([]string{}, filter.Resources...)
@@ -930,19 +969,23 @@ func translationExchangeApplyRequestPayload(input TranslationImportApplyInput, p
 	return payload
 }
 
-func translationExchangeExportResultPayload(result TranslationExportResult) map[string]any {
+func translationExchangeExportResultPayload(result TranslationExportResult) (map[string]any, error) {
 	payload := map[string]any{
 		"summary": map[string]any{
 			"row_count": result.RowCount,
 			"format":    strings.TrimSpace(result.Format),
 		},
 	}
-	if download := translationExchangeExportDownload(result); len(download) > 0 {
+	download, err := translationExchangeExportDownload(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(download) > 0 {
 		payload["downloads"] = map[string]any{
 			translationExchangeDownloadKindArtifact: download,
 		}
 	}
-	return payload
+	return payload, nil
 }
 
 func translationExchangeResultPayload(result TranslationExchangeResult) map[string]any {
@@ -1109,6 +1152,7 @@ func parseTranslationExportInput(c router.Context) (TranslationExportInput, map[
 		FieldPaths:        nonEmptyStrings(toStringSlice(filterPayload["field_paths"]), splitIDs(c.Query("field_paths")), splitIDs(c.Query("field_path"))),
 		IncludeSourceHash: toBool(filterPayload["include_source_hash"]) || toBool(c.Query("include_source_hash")),
 		Options:           extractMap(filterPayload["options"]),
+		Format:            normalizeTranslationExchangeFormat(primitives.FirstNonEmptyRaw(strings.TrimSpace(toString(filterPayload["format"])), strings.TrimSpace(toString(body["format"])), strings.TrimSpace(c.Query("format")))),
 	}
 	if len(filter.Resources) == 0 {
 		return TranslationExportInput{}, nil, TranslationExchangeInvalidPayloadError{
@@ -1117,6 +1161,12 @@ func parseTranslationExportInput(c router.Context) (TranslationExportInput, map[
 			Format:  "json",
 		}
 	}
+	if filter.Format != "" && !slices.Contains(translationExchangeSupportedFormats, filter.Format) {
+		return TranslationExportInput{}, nil, TranslationExchangeUnsupportedFormatError{
+			Format:    filter.Format,
+			Supported: translationExchangeSupportedFormats,
+		}
+	}
 	return TranslationExportInput{Filter: filter}, body, nil
 }
 
@@ -1208,6 +1258,18 @@ func parseTranslationImportFile(c router.Context, file *multipart.FileHeader, re
 		if parseErr != nil {
 			return nil, "", parseErr
 		}
+	case "xliff", "xliff2":
+		var parseErr error
+		rows, parseErr = parseTranslationImportXLIFF(fh, requireTranslatedText)
+		if parseErr != nil {
+			return nil, "", parseErr
+		}
+	case "po":
+		var parseErr error
+		rows, parseErr = parseTranslationImportPO(fh, requireTranslatedText)
+		if parseErr != nil {
+			return nil, "", parseErr
+		}
 	default:
 		return nil, "", TranslationExchangeUnsupportedFormatError{
 			Format:    format,
@@ -1473,13 +1535,18 @@ func detectTranslationExchangeFormat(c router.Context, filename, contentType str
 			format = "json"
 		case strings.Contains(lowerType, "csv"):
 			format = "csv"
+		case strings.Contains(lowerType, "xliff"), strings.Contains(lowerType, "xml"):
+			format = "xliff"
+		case strings.Contains(lowerType, "gettext"), strings.Contains(lowerType, "x-po"):
+			format = "po"
 		}
 	}
 	if format == "" {
 		format = "csv"
 	}
+	format = normalizeTranslationExchangeFormat(format)
 	switch format {
-	case "csv", "json":
+	case "csv", "json", "xliff", "xliff2", "po":
 		return format, nil
 	default:
 		return "", TranslationExchangeUnsupportedFormatError{
@@ -1505,10 +1572,12 @@ func validateTranslationExchangeUpload(file *multipart.FileHeader) error {
 	ext := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(filepath.Ext(file.Filename))), ".")
 	contentType := strings.ToLower(strings.TrimSpace(file.Header.Get("Content-Type")))
 	allowed := map[string][]string{
-		"csv":  {"text/csv", "application/csv", "application/vnd.ms-excel", "text/plain", "application/octet-stream"},
-		"json": {"application/json", "text/json", "application/octet-stream", "text/plain"},
+		"csv":   {"text/csv", "application/csv", "application/vnd.ms-excel", "text/plain", "application/octet-stream"},
+		"json":  {"application/json", "text/json", "application/octet-stream", "text/plain"},
+		"xliff": {"application/xliff+xml", "application/x-xliff+xml", "application/xml", "text/xml", "application/octet-stream", "text/plain"},
+		"po":    {"text/x-gettext-translation", "text/x-po", "application/x-po", "application/octet-stream", "text/plain"},
 	}
-	mimes, ok := allowed[ext]
+	mimes, ok := allowed[normalizeTranslationExchangeFormat(ext)]
 	if !ok {
 		return TranslationExchangeUnsupportedFormatError{
 			Format:    ext,
@@ -602,6 +602,66 @@ func TestTranslationExchangeBindingImportApplyParsesCSVWithTranslatedText(t *tes
 	}
 }
 
+func TestTranslationExchangeBindingImportApplyParsesXLIFFAndPOUploads(t *testing.T) {
+	rows := []TranslationExchangeRow{{
+		Resource:       "pages",
+		EntityID:       "page_123",
+		FamilyID:       "tg_123",
+		SourceLocale:   "en",
+		TargetLocale:   "es",
+		FieldPath:      "title",
+		SourceText:     "Hello world",
+		TranslatedText: "Hola mundo",
+		SourceHash:     "abc123",
+	}}
+	xliff, err := encodeTranslationExchangeXLIFF(rows, "xliff2")
+	if err != nil {
+		t.Fatalf("encode xliff: %v", err)
+	}
+	uploads := []struct {
+		name        string
+		contentType string
+		payload     []byte
+	}{
+		{name: "translations.xlf", contentType: "application/xliff+xml", payload: xliff},
+		{name: "translations.po", contentType: "text/x-gettext-translation", payload: encodeTranslationExchangePO(rows)},
+	}
+	for _, upload := range uploads {
+		t.Run(upload.name, func(t *testing.T) {
+			adm := mustNewAdmin(t, Config{BasePath: "/admin", DefaultLocale: "en"}, Dependencies{})
+			executor := &stubTranslationExchangeExecutor{
+				applyResult: TranslationExchangeResult{
+					Summary: TranslationExchangeSummary{Processed: 1, Succeeded: 1},
+				},
+			}
+			binding := newTranslationExchangeBinding(adm)
+			binding.executor = executor
+			app := newTranslationExchangeTestApp(t, binding)
+
+			body, contentType := buildMultipartFile(t, upload.name, upload.contentType, upload.payload)
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/admin/api/translations/exchange/import/apply", body)
+			req.Header.Set("Content-Type", contentType)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request error: %v", err)
+			}
+			defer mustClose(t, "response body", resp.Body)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status=%d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			applyCalled, applyInput := executor.applySnapshot()
+			if applyCalled != 1 || len(applyInput.Rows) != 1 {
+				t.Fatalf("expected one applied row, got called=%d rows=%+v", applyCalled, applyInput.Rows)
+			}
+			row := applyInput.Rows[0]
+			if row.EntityID != "page_123" || row.FieldPath != "title" || row.SourceHash != "abc123" || row.TranslatedText != "Hola mundo" {
+				t.Fatalf("expected linkage and translation to survive upload, got %+v", row)
+			}
+		})
+	}
+}
+
 func TestTranslationExchangeBindingExportDispatchesCommandAndReturnsResult(t *testing.T) {
 	adm := mustNewAdmin(t, Config{BasePath: "/admin", DefaultLocale: "en"}, Dependencies{})
 	executor := &stubTranslationExchangeExecutor{
@@ -1249,6 +1309,10 @@ func TestTranslationExchangeBindingHistoryListsActorJobsAndFixtureExamples(t *te
 	_ = createResp.Body.Close() //nolint:errcheck // test cleanup failure cannot change the already-asserted behavior.
 
 	otherJobID := defaultTranslationExchangeRuntimeJobID()
+	otherResult, err := translationExchangeExportResultPayload(executor.exportResult)
+	if err != nil {
+		t.Fatalf("export result payload: %v", err)
+	}
 	if _, err = binding.runtime.RecordCompletedJob(context.Background(), translationExchangeAsyncJob{
 		ID:           otherJobID,
 		Kind:         translationExchangeJobKindExport,
@@ -1257,7 +1321,7 @@ func TestTranslationExchangeBindingHistoryListsActorJobsAndFixtureExamples(t *te
 		CreatedBy:    "other-user",
 		Request:      translationExchangeExportRequestPayload(TranslationExportFilter{Resources: []string{"pages"}}),
 		PollEndpoint: binding.jobStatusEndpoint(otherJobID),
-	}, nil, translationExchangeExportResultPayload(executor.exportResult)); err != nil {
+	}, nil, otherResult); err != nil {
 		t.Fatalf("record other-user export job: %v", err)
 	}
 
@@ -0,0 +1,788 @@
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+)
+
+const (
+	translationExchangeXLIFF12Namespace = "urn:oasis:names:tc:xliff:document:1.2"
+	translationExchangeXLIFF20Namespace = "urn:oasis:names:tc:xliff:document:2.0"
+
+	// translationExchangeCodecMetadataGroup names the XLIFF context/meta group
+	// that carries row linkage so it survives a round-trip through vendor tools.
+	translationExchangeCodecMetadataGroup = "go-admin"
+
+	// translationExchangePOContextSeparator joins resource, entity ID and field
+	// path in msgctxt so entries with identical source text stay distinct.
+	translationExchangePOContextSeparator = "|"
+)
+
+// translationExchangeCodecMetadataKeys lists the row fields written to unit
+// metadata, in output order. Source/target text and notes travel in the
+// format's native elements instead.
+var translationExchangeCodecMetadataKeys = []string{
+	"resource",
+	"entity_id",
+	"family_id",
+	"source_locale",
+	"target_locale",
+	"field_path",
+	"source_hash",
+	"create_translation",
+	"path",
+	"route_key",
+	"title",
+	"status",
+}
+
+func translationExchangeRowMetadataValue(row TranslationExchangeRow, key string) string {
+	switch key {
+	case "resource":
+		return strings.TrimSpace(row.Resource)
+	case "entity_id":
+		return strings.TrimSpace(row.EntityID)
+	case "family_id":
+		return strings.TrimSpace(row.FamilyID)
+	case "source_locale":
+		return strings.TrimSpace(row.SourceLocale)
+	case "target_locale":
+		return strings.TrimSpace(row.TargetLocale)
+	case "field_path":
+		return strings.TrimSpace(row.FieldPath)
+	case "source_hash":
+		return strings.TrimSpace(row.SourceHash)
+	case "create_translation":
+		if row.CreateTranslation {
+			return "true"
+		}
+		return ""
+	case "path":
+		return strings.TrimSpace(row.Path)
+	case "route_key":
+		return strings.TrimSpace(row.RouteKey)
+	case "title":
+		return strings.TrimSpace(row.Title)
+	case "status":
+		return strings.TrimSpace(row.Status)
+	default:
+		return ""
+	}
+}
+
+func setTranslationExchangeRowMetadataValue(row *TranslationExchangeRow, key, value string) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(strings.TrimSpace(key)) {
+	case "resource":
+		row.Resource = value
+	case "entity_id":
+		row.EntityID = value
+	case "family_id":
+		row.FamilyID = value
+	case "source_locale":
+		row.SourceLocale = value
+	case "target_locale":
+		row.TargetLocale = value
+	case "field_path":
+		row.FieldPath = value
+	case "source_hash":
+		row.SourceHash = value
+	case "create_translation":
+		row.CreateTranslation = toBool(value)
+	case "path":
+		row.Path = value
+	case "route_key":
+		row.RouteKey = value
+	case "title":
+		row.Title = value
+	case "status":
+		row.Status = value
+	}
+}
+
+// normalizeTranslationExchangeFormat maps file extensions and aliases onto
+// the canonical format names used by the exchange codecs.
+func normalizeTranslationExchangeFormat(format string) string {
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case "xlf", "xliff", "xliff12", "xliff1.2":
+		return "xliff"
+	case "xliff2", "xliff20", "xliff2.0":
+		return "xliff2"
+	case "pot":
+		return "po"
+	default:
+		return format
+	}
+}
+
+func finalizeTranslationImportCodecRows(rows []TranslationExchangeRow, format string, requireTranslatedText bool) ([]TranslationExchangeRow, error) {
+	if len(rows) == 0 {
+		return nil, TranslationExchangeInvalidPayloadError{
+			Message: "rows required",
+			Field:   "rows",
+			Format:  format,
+		}
+	}
+	if err := validateTranslationExchangeRowLimit(len(rows)); err != nil {
+		return nil, err
+	}
+	if !requireTranslatedText {
+		return rows, nil
+	}
+	for _, row := range rows {
+		if strings.TrimSpace(row.TranslatedText) == "" {
+			return nil, TranslationExchangeInvalidPayloadError{
+				Message: "row translated_text required",
+				Field:   "translated_text",
+				Format:  format,
+				Metadata: map[string]any{
+					"row": row.Index,
+				},
+			}
+		}
+	}
+	return rows, nil
+}
+
+type xliffDocument struct {
+	XMLName   xml.Name    `xml:"xliff"`
+	Namespace string      `xml:"xmlns,attr,omitempty"`
+	Version   string      `xml:"version,attr"`
+	SrcLang   string      `xml:"srcLang,attr,omitempty"`
+	TrgLang   string      `xml:"trgLang,attr,omitempty"`
+	Files     []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	ID             string       `xml:"id,attr,omitempty"`
+	Original       string       `xml:"original,attr,omitempty"`
+	Datatype       string       `xml:"datatype,attr,omitempty"`
+	SourceLanguage string       `xml:"source-language,attr,omitempty"`
+	TargetLanguage string       `xml:"target-language,attr,omitempty"`
+	Body           *xliffBody   `xml:"body,omitempty"`
+	Units          []xliffUnit  `xml:"unit"`
+	Groups         []xliffGroup `xml:"group"`
+}
+
+type xliffBody struct {
+	TransUnits []xliffTransUnit `xml:"trans-unit"`
+	Groups     []xliffGroup     `xml:"group"`
+}
+
+// xliffGroup is only read on import; vendor tools often wrap units in groups.
+type xliffGroup struct {
+	TransUnits []xliffTransUnit `xml:"trans-unit"`
+	Units      []xliffUnit      `xml:"unit"`
+	Groups     []xliffGroup     `xml:"group"`
+}
+
+// xliffTransUnit is an XLIFF 1.2 trans-unit; linkage travels in a
+// context-group because it is the core mechanism tools preserve.
+type xliffTransUnit struct {
+	ID            string              `xml:"id,attr"`
+	ResName       string              `xml:"resname,attr,omitempty"`
+	Source        xliffText           `xml:"source"`
+	Target        *xliffText          `xml:"target,omitempty"`
+	ContextGroups []xliffContextGroup `xml:"context-group"`
+	Notes         []string            `xml:"note"`
+}
+
+type xliffContextGroup struct {
+	Name     string         `xml:"name,attr,omitempty"`
+	Purpose  string         `xml:"purpose,attr,omitempty"`
+	Contexts []xliffContext `xml:"context"`
+}
+
+type xliffContext struct {
+	Type  string `xml:"context-type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// xliffUnit is an XLIFF 2.0 unit; linkage travels in the metadata module.
+type xliffUnit struct {
+	ID       string         `xml:"id,attr"`
+	Name     string         `xml:"name,attr,omitempty"`
+	Metadata *xliffMetadata `xml:"urn:oasis:names:tc:xliff:metadata:2.0 metadata,omitempty"`
+	Notes    []string       `xml:"notes>note"`
+	Segments []xliffSegment `xml:"segment"`
+}
+
+type xliffMetadata struct {
+	Groups []xliffMetaGroup `xml:"metaGroup"`
+}
+
+type xliffMetaGroup struct {
+	Category string      `xml:"category,attr,omitempty"`
+	Meta     []xliffMeta `xml:"meta"`
+}
+
+type xliffMeta struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xliffSegment struct {
+	Source xliffText  `xml:"source"`
+	Target *xliffText `xml:"target,omitempty"`
+}
+
+// xliffText is the text of a source or target. Export writes plain text;
+// import flattens inline markup back into it: native codes (XLIFF 1.2 bpt,
+// ept, ph, it) keep their content, empty placeholders fall back to their
+// equiv-text/equiv attribute, XLIFF 2.0 <pc> is wrapped in equivStart and
+// equivEnd, <cp> becomes its code point and container elements (g, mrk, sub)
+// keep their text.
+type xliffText struct {
+	Text string `xml:",chardata"`
+}
+
+func (t *xliffText) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	type inlineElement struct {
+		mark  int
+		equiv string
+		end   string
+	}
+	var text strings.Builder
+	open := []inlineElement{}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch value := token.(type) {
+		case xml.StartElement:
+			element := inlineElement{mark: text.Len()}
+			switch value.Name.Local {
+			case "cp":
+				if code, err := strconv.ParseUint(xliffAttr(value, "hex"), 16, 32); err == nil {
+					text.WriteRune(rune(code))
+				}
+			case "pc":
+				text.WriteString(xliffAttr(value, "equivStart"))
+				element.mark = text.Len()
+				element.end = xliffAttr(value, "equivEnd")
+			default:
+				element.equiv = primitives.FirstNonEmptyRaw(xliffAttr(value, "equiv-text"), xliffAttr(value, "equiv"))
+			}
+			open = append(open, element)
+		case xml.EndElement:
+			if len(open) == 0 {
+				t.Text = text.String()
+				return nil
+			}
+			element := open[len(open)-1]
+			open = open[:len(open)-1]
+			if text.Len() == element.mark {
+				text.WriteString(element.equiv)
+			}
+			text.WriteString(element.end)
+		case xml.CharData:
+			text.Write(value)
+		}
+	}
+}
+
+func xliffAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// encodeTranslationExchangeXLIFF renders rows as XLIFF 1.2 ("xliff") or 2.0
+// ("xliff2"). XLIFF 1.2 groups rows into one file per locale pair; XLIFF 2.0
+// takes document languages from the first row and keeps per-row locales in
+// unit metadata.
+func encodeTranslationExchangeXLIFF(rows []TranslationExchangeRow, format string) ([]byte, error) {
+	var doc xliffDocument
+	if normalizeTranslationExchangeFormat(format) == "xliff2" {
+		doc = xliff20Document(rows)
+	} else {
+		doc = xliff12Document(rows)
+	}
+	raw, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), raw...), nil
+}
+
+func xliff12Document(rows []TranslationExchangeRow) xliffDocument {
+	doc := xliffDocument{
+		Namespace: translationExchangeXLIFF12Namespace,
+		Version:   "1.2",
+	}
+	fileIndex := map[string]int{}
+	for index, row := range rows {
+		sourceLocale := strings.TrimSpace(row.SourceLocale)
+		targetLocale := strings.TrimSpace(row.TargetLocale)
+		key := sourceLocale + "\x00" + targetLocale
+		position, ok := fileIndex[key]
+		if !ok {
+			position = len(doc.Files)
+			fileIndex[key] = position
+			doc.Files = append(doc.Files, xliffFile{
+				Original:       "translation_exchange",
+				Datatype:       "plaintext",
+				SourceLanguage: sourceLocale,
+				TargetLanguage: targetLocale,
+				Body:           &xliffBody{},
+			})
+		}
+		unit := xliffTransUnit{
+			ID:      strconv.Itoa(index + 1),
+			ResName: strings.TrimSpace(row.FieldPath),
+			Source:  xliffText{Text: row.SourceText},
+			Target:  xliffTarget(row.TranslatedText),
+			ContextGroups: []xliffContextGroup{{
+				Name:    translationExchangeCodecMetadataGroup,
+				Purpose: "information",
+			}},
+		}
+		for _, metaKey := range translationExchangeCodecMetadataKeys {
+			if value := translationExchangeRowMetadataValue(row, metaKey); value != "" {
+				unit.ContextGroups[0].Contexts = append(unit.ContextGroups[0].Contexts, xliffContext{
+					Type:  "x-" + metaKey,
+					Value: value,
+				})
+			}
+		}
+		if notes := strings.TrimSpace(row.Notes); notes != "" {
+			unit.Notes = []string{notes}
+		}
+		body := doc.Files[position].Body
+		body.TransUnits = append(body.TransUnits, unit)
+	}
+	return doc
+}
+
+func xliff20Document(rows []TranslationExchangeRow) xliffDocument {
+	doc := xliffDocument{
+		Namespace: translationExchangeXLIFF20Namespace,
+		Version:   "2.0",
+		SrcLang:   "und",
+	}
+	if len(rows) > 0 {
+		doc.SrcLang = primitives.FirstNonEmptyRaw(strings.TrimSpace(rows[0].SourceLocale), doc.SrcLang)
+		doc.TrgLang = strings.TrimSpace(rows[0].TargetLocale)
+	}
+	file := xliffFile{ID: "translation_exchange"}
+	for index, row := range rows {
+		group := xliffMetaGroup{Category: translationExchangeCodecMetadataGroup}
+		for _, metaKey := range translationExchangeCodecMetadataKeys {
+			if value := translationExchangeRowMetadataValue(row, metaKey); value != "" {
+				group.Meta = append(group.Meta, xliffMeta{Type: metaKey, Value: value})
+			}
+		}
+		unit := xliffUnit{
+			ID:       "u" + strconv.Itoa(index+1),
+			Name:     strings.TrimSpace(row.FieldPath),
+			Metadata: &xliffMetadata{Groups: []xliffMetaGroup{group}},
+			Segments: []xliffSegment{{
+				Source: xliffText{Text: row.SourceText},
+				Target: xliffTarget(row.TranslatedText),
+			}},
+		}
+		if notes := strings.TrimSpace(row.Notes); notes != "" {
+			unit.Notes = []string{notes}
+		}
+		file.Units = append(file.Units, unit)
+	}
+	doc.Files = []xliffFile{file}
+	return doc
+}
+
+func xliffTarget(text string) *xliffText {
+	if text == "" {
+		return nil
+	}
+	return &xliffText{Text: text}
+}
+
+// parseTranslationImportXLIFF reads XLIFF 1.2 trans-units and XLIFF 2.0
+// units into exchange rows. Linkage comes from the go-admin metadata group;
+// units without it still become rows so validation can report missing_linkage.
+func parseTranslationImportXLIFF(reader io.Reader, requireTranslatedText bool) ([]TranslationExchangeRow, error) {
+	doc := xliffDocument{}
+	if err := xml.NewDecoder(reader).Decode(&doc); err != nil {
+		message := "invalid xliff payload"
+		if errors.Is(err, io.EOF) {
+			message = "empty xliff payload"
+		}
+		return nil, TranslationExchangeInvalidPayloadError{
+			Message: message,
+			Field:   "rows",
+			Format:  "xliff",
+		}
+	}
+	rows := []TranslationExchangeRow{}
+	for _, file := range doc.Files {
+		root := xliffGroup{Units: file.Units, Groups: file.Groups}
+		if file.Body != nil {
+			root.TransUnits = file.Body.TransUnits
+			root.Groups = append(root.Groups, file.Body.Groups...)
+		}
+		rows = appendXLIFFGroupRows(rows, doc, file, root)
+	}
+	return finalizeTranslationImportCodecRows(rows, "xliff", requireTranslatedText)
+}
+
+func appendXLIFFGroupRows(rows []TranslationExchangeRow, doc xliffDocument, file xliffFile, group xliffGroup) []TranslationExchangeRow {
+	for _, unit := range group.TransUnits {
+		rows = append(rows, xliff12Row(len(rows), file, unit))
+	}
+	for _, unit := range group.Units {
+		rows = append(rows, xliff20Row(len(rows), doc, unit))
+	}
+	for _, nested := range group.Groups {
+		rows = appendXLIFFGroupRows(rows, doc, file, nested)
+	}
+	return rows
+}
+
+func xliff12Row(index int, file xliffFile, unit xliffTransUnit) TranslationExchangeRow {
+	row := TranslationExchangeRow{
+		Index:        index,
+		SourceLocale: strings.TrimSpace(file.SourceLanguage),
+		TargetLocale: strings.TrimSpace(file.TargetLanguage),
+		FieldPath:    strings.TrimSpace(unit.ResName),
+		SourceText:   unit.Source.Text,
+		Notes:        strings.TrimSpace(strings.Join(unit.Notes, "\n")),
+	}
+	if unit.Target != nil {
+		row.TranslatedText = unit.Target.Text
+	}
+	for _, group := range unit.ContextGroups {
+		if strings.TrimSpace(group.Name) != translationExchangeCodecMetadataGroup {
+			continue
+		}
+		for _, context := range group.Contexts {
+			key, ok := strings.CutPrefix(strings.TrimSpace(context.Type), "x-")
+			if ok {
+				setTranslationExchangeRowMetadataValue(&row, key, context.Value)
+			}
+		}
+	}
+	return row
+}
+
+func xliff20Row(index int, doc xliffDocument, unit xliffUnit) TranslationExchangeRow {
+	row := TranslationExchangeRow{
+		Index:        index,
+		SourceLocale: strings.TrimSpace(doc.SrcLang),
+		TargetLocale: strings.TrimSpace(doc.TrgLang),
+		FieldPath:    strings.TrimSpace(unit.Name),
+		Notes:        strings.TrimSpace(strings.Join(unit.Notes, "\n")),
+	}
+	var source, target strings.Builder
+	hasTarget := false
+	for _, segment := range unit.Segments {
+		source.WriteString(segment.Source.Text)
+		if segment.Target != nil {
+			hasTarget = true
+			target.WriteString(segment.Target.Text)
+		}
+	}
+	row.SourceText = source.String()
+	if hasTarget {
+		row.TranslatedText = target.String()
+	}
+	if unit.Metadata != nil {
+		for _, group := range unit.Metadata.Groups {
+			if strings.TrimSpace(group.Category) != translationExchangeCodecMetadataGroup {
+				continue
+			}
+			for _, meta := range group.Meta {
+				setTranslationExchangeRowMetadataValue(&row, meta.Type, meta.Value)
+			}
+		}
+	}
+	return row
+}
+
+// encodeTranslationExchangePO renders rows as a gettext catalog. msgctxt keeps
+// entries unique per resource/entity/field, and the remaining linkage is
+// written as "#. key: value" extracted comments.
+func encodeTranslationExchangePO(rows []TranslationExchangeRow) []byte {
+	buffer := &bytes.Buffer{}
+	header := []string{
+		"Content-Type: text/plain; charset=UTF-8\n",
+		"Content-Transfer-Encoding: 8bit\n",
+	}
+	if len(rows) > 0 {
+		if locale := strings.TrimSpace(rows[0].TargetLocale); locale != "" {
+			header = append(header, "Language: "+locale+"\n")
+		}
+		if locale := strings.TrimSpace(rows[0].SourceLocale); locale != "" {
+			header = append(header, "X-Source-Language: "+locale+"\n")
+		}
+	}
+	writePOString(buffer, "msgid", "")
+	writePOString(buffer, "msgstr", strings.Join(header, ""))
+	for _, row := range rows {
+		buffer.WriteString("\n")
+		if notes := strings.TrimSpace(row.Notes); notes != "" {
+			for line := range strings.SplitSeq(notes, "\n") {
+				buffer.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+			}
+		}
+		for _, key := range translationExchangeCodecMetadataKeys {
+			if value := translationExchangeRowMetadataValue(row, key); value != "" {
+				buffer.WriteString("#. " + key + ": " + strings.ReplaceAll(value, "\n", " ") + "\n")
+			}
+		}
+		writePOString(buffer, "msgctxt", strings.Join([]string{
+			strings.TrimSpace(row.Resource),
+			strings.TrimSpace(row.EntityID),
+			strings.TrimSpace(row.FieldPath),
+		}, translationExchangePOContextSeparator))
+		writePOString(buffer, "msgid", row.SourceText)
+		writePOString(buffer, "msgstr", row.TranslatedText)
+	}
+	return buffer.Bytes()
+}
+
+func writePOString(buffer *bytes.Buffer, keyword, value string) {
+	if !strings.Contains(value, "\n") || value == "\n" {
+		buffer.WriteString(keyword + " " + quotePOString(value) + "\n")
+		return
+	}
+	buffer.WriteString(keyword + " \"\"\n")
+	for line := range strings.SplitAfterSeq(value, "\n") {
+		if line == "" {
+			continue
+		}
+		buffer.WriteString(quotePOString(line) + "\n")
+	}
+}
+
+func quotePOString(value string) string {
+	var out strings.Builder
+	out.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '\\':
+			out.WriteString(`\\`)
+		case '"':
+			out.WriteString(`\"`)
+		case '\n':
+			out.WriteString(`\n`)
+		case '\r':
+			out.WriteString(`\r`)
+		case '\t':
+			out.WriteString(`\t`)
+		default:
+			out.WriteRune(r)
+		}
+	}
+	out.WriteByte('"')
+	return out.String()
+}
+
+type poEntry struct {
+	comments   []string
+	extracted  []string
+	flags      []string
+	context    *string
+	msgid      *string
+	msgstr     *string
+	obsolete   bool
+	lastTarget **string
+}
+
+// parseTranslationImportPO reads a gettext catalog into exchange rows. The
+// header entry and obsolete (#~) entries are skipped, and fuzzy translations
+// are treated as untranslated, matching gettext's own compile behavior.
+func parseTranslationImportPO(reader io.Reader, requireTranslatedText bool) ([]TranslationExchangeRow, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), translationExchangeMaxUploadBytes)
+	rows := []TranslationExchangeRow{}
+	headerLocales := map[string]string{}
+	entry := &poEntry{}
+	flush := func() {
+		defer func() { entry = &poEntry{} }()
+		if entry.msgid == nil || entry.obsolete {
+			return
+		}
+		if *entry.msgid == "" && entry.context == nil {
+			if entry.msgstr != nil {
+				headerLocales = parsePOHeaderLocales(*entry.msgstr)
+			}
+			return
+		}
+		rows = append(rows, poEntryRow(len(rows), entry, headerLocales))
+	}
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#~"):
+			entry.obsolete = true
+		case strings.HasPrefix(line, "#."):
+			entry.extracted = append(entry.extracted, strings.TrimSpace(line[2:]))
+		case strings.HasPrefix(line, "#,"):
+			for flag := range strings.SplitSeq(line[2:], ",") {
+				entry.flags = append(entry.flags, strings.TrimSpace(flag))
+			}
+		case strings.HasPrefix(line, "#:"), strings.HasPrefix(line, "#|"):
+		case strings.HasPrefix(line, "#"):
+			entry.comments = append(entry.comments, strings.TrimPrefix(strings.TrimPrefix(line, "#"), " "))
+		case strings.HasPrefix(line, `"`):
+			if entry.lastTarget == nil || *entry.lastTarget == nil {
+				return nil, translationExchangePOError(lineNumber)
+			}
+			value, err := unquotePOString(line)
+			if err != nil {
+				return nil, translationExchangePOError(lineNumber)
+			}
+			**entry.lastTarget += value
+		default:
+			keyword, rest, _ := strings.Cut(line, " ")
+			value, err := unquotePOString(strings.TrimSpace(rest))
+			if err != nil {
+				return nil, translationExchangePOError(lineNumber)
+			}
+			switch {
+			case keyword == "msgctxt":
+				if entry.msgid != nil {
+					flush()
+				}
+				entry.context = &value
+				entry.lastTarget = &entry.context
+			case keyword == "msgid":
+				if entry.msgid != nil {
+					flush()
+				}
+				entry.msgid = &value
+				entry.lastTarget = &entry.msgid
+			case keyword == "msgstr", keyword == "msgstr[0]":
+				entry.msgstr = &value
+				entry.lastTarget = &entry.msgstr
+			case keyword == "msgid_plural", strings.HasPrefix(keyword, "msgstr["):
+				// Plural forms beyond the first have no row equivalent.
+				entry.lastTarget = nil
+			default:
+				return nil, translationExchangePOError(lineNumber)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, TranslationExchangeInvalidPayloadError{
+			Message: "invalid po payload",
+			Field:   "rows",
+			Format:  "po",
+		}
+	}
+	flush()
+	return finalizeTranslationImportCodecRows(rows, "po", requireTranslatedText)
+}
+
+func poEntryRow(index int, entry *poEntry, headerLocales map[string]string) TranslationExchangeRow {
+	row := TranslationExchangeRow{
+		Index:        index,
+		SourceLocale: headerLocales["source_locale"],
+		TargetLocale: headerLocales["target_locale"],
+		SourceText:   *entry.msgid,
+		Notes:        strings.TrimSpace(strings.Join(entry.comments, "\n")),
+	}
+	if entry.context != nil {
+		parts := strings.SplitN(*entry.context, translationExchangePOContextSeparator, 3)
+		if len(parts) == 3 {
+			row.Resource = strings.TrimSpace(parts[0])
+			row.EntityID = strings.TrimSpace(parts[1])
+			row.FieldPath = strings.TrimSpace(parts[2])
+		}
+	}
+	for _, comment := range entry.extracted {
+		key, value, ok := strings.Cut(comment, ":")
+		if ok {
+			setTranslationExchangeRowMetadataValue(&row, key, value)
+		}
+	}
+	fuzzy := false
+	for _, flag := range entry.flags {
+		if flag == "fuzzy" {
+			fuzzy = true
+		}
+	}
+	if entry.msgstr != nil && !fuzzy {
+		row.TranslatedText = *entry.msgstr
+	}
+	return row
+}
+
+func parsePOHeaderLocales(header string) map[string]string {
+	locales := map[string]string{}
+	for line := range strings.SplitSeq(header, "\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "language":
+			locales["target_locale"] = strings.TrimSpace(value)
+		case "x-source-language":
+			locales["source_locale"] = strings.TrimSpace(value)
+		}
+	}
+	return locales
+}
+
+func unquotePOString(value string) (string, error) {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return "", errors.New("po string must be quoted")
+	}
+	var out strings.Builder
+	body := value[1 : len(value)-1]
+	for i := 0; i < len(body); i++ {
+		ch := body[i]
+		if ch != '\\' {
+			out.WriteByte(ch)
+			continue
+		}
+		i++
+		if i >= len(body) {
+			return "", errors.New("po string has dangling escape")
+		}
+		switch body[i] {
+		case 'n':
+			out.WriteByte('\n')
+		case 'r':
+			out.WriteByte('\r')
+		case 't':
+			out.WriteByte('\t')
+		case '\\', '"':
+			out.WriteByte(body[i])
+		default:
+			return "", errors.New("po string has unsupported escape")
+		}
+	}
+	return out.String(), nil
+}
+
+func translationExchangePOError(line int) error {
+	return TranslationExchangeInvalidPayloadError{
+		Message: "invalid po payload",
+		Field:   "rows",
+		Format:  "po",
+		Metadata: map[string]any{
+			"line": line,
+		},
+	}
+}
@@ -0,0 +1,262 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func translationExchangeCodecFixtureRows() []TranslationExchangeRow {
+	return []TranslationExchangeRow{
+		{
+			Resource:       "pages",
+			EntityID:       "1",
+			FamilyID:       "tg_1",
+			SourceLocale:   "en",
+			TargetLocale:   "es",
+			FieldPath:      "title",
+			SourceText:     `Say "hi" & <wave>`,
+			TranslatedText: "Di \"hola\"\ny saluda",
+			SourceHash:     "stale_hash",
+			Path:           "/home",
+			Status:         "draft",
+			Notes:          "keep it short",
+		},
+		{
+			Index:             1,
+			Resource:          "pages",
+			EntityID:          "2",
+			FamilyID:          "tg_2",
+			SourceLocale:      "en",
+			TargetLocale:      "fr",
+			FieldPath:         "body.0.text",
+			SourceText:        "Line one\nLine two\n",
+			SourceHash:        "abc",
+			CreateTranslation: true,
+		},
+	}
+}
+
+func TestTranslationExchangeXLIFFRoundTripsRowLinkage(t *testing.T) {
+	for _, format := range []string{"xliff", "xliff2"} {
+		t.Run(format, func(t *testing.T) {
+			rows := translationExchangeCodecFixtureRows()
+			raw, err := encodeTranslationExchangeXLIFF(rows, format)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			decoded, err := parseTranslationImportXLIFF(bytes.NewReader(raw), false)
+			if err != nil {
+				t.Fatalf("parse: %v\n%s", err, raw)
+			}
+			if !reflect.DeepEqual(decoded, rows) {
+				t.Fatalf("expected round-trip rows\nwant %+v\ngot  %+v\n%s", rows, decoded, raw)
+			}
+		})
+	}
+}
+
+func TestTranslationExchangeXLIFFEncodesVersionNamespaces(t *testing.T) {
+	rows := translationExchangeCodecFixtureRows()
+	raw12, err := encodeTranslationExchangeXLIFF(rows, "xliff")
+	if err != nil {
+		t.Fatalf("encode 1.2: %v", err)
+	}
+	if !bytes.Contains(raw12, []byte(`xmlns="`+translationExchangeXLIFF12Namespace+`"`)) || bytes.Count(raw12, []byte("<file ")) != 2 {
+		t.Fatalf("expected XLIFF 1.2 document with one file per locale pair, got %s", raw12)
+	}
+	raw20, err := encodeTranslationExchangeXLIFF(rows, "xliff2")
+	if err != nil {
+		t.Fatalf("encode 2.0: %v", err)
+	}
+	if !bytes.Contains(raw20, []byte(`xmlns="`+translationExchangeXLIFF20Namespace+`"`)) || !bytes.Contains(raw20, []byte(`srcLang="en" trgLang="es"`)) {
+		t.Fatalf("expected XLIFF 2.0 document languages, got %s", raw20)
+	}
+}
+
+func TestParseTranslationImportXLIFFKeepsUnitsWithoutLinkage(t *testing.T) {
+	payload := `<?xml version="1.0"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file original="vendor.txt" source-language="en" target-language="de" datatype="plaintext">
+    <body>
+      <group id="g1">
+        <trans-unit id="a" resname="title"><source>Hello</source><target>Hallo</target></trans-unit>
+      </group>
+    </body>
+  </file>
+</xliff>`
+	rows, err := parseTranslationImportXLIFF(strings.NewReader(payload), true)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(rows) != 1 || rows[0].TargetLocale != "de" || rows[0].FieldPath != "title" || rows[0].TranslatedText != "Hallo" {
+		t.Fatalf("unexpected rows %+v", rows)
+	}
+	if rows[0].Resource != "" || rows[0].EntityID != "" {
+		t.Fatalf("expected no linkage for vendor unit, got %+v", rows[0])
+	}
+}
+
+func TestParseTranslationImportXLIFFFlattensInlineMarkup(t *testing.T) {
+	payload12 := `<?xml version="1.0"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file original="vendor.txt" source-language="en" target-language="de" datatype="html">
+    <body>
+      <trans-unit id="a" resname="body">
+        <source>Hi <x id="1" equiv-text="{name}"/>, <bpt id="2">&lt;b&gt;</bpt>welcome<ept id="2">&lt;/b&gt;</ept> <g id="3">home</g></source>
+        <target>Hallo <x id="1" equiv-text="{name}"/>, <bpt id="2">&lt;b&gt;</bpt>willkommen<ept id="2">&lt;/b&gt;</ept> <g id="3"><mrk mtype="term">zu Hause</mrk></g></target>
+      </trans-unit>
+    </body>
+  </file>
+</xliff>`
+	rows, err := parseTranslationImportXLIFF(strings.NewReader(payload12), true)
+	if err != nil {
+		t.Fatalf("parse 1.2: %v", err)
+	}
+	if len(rows) != 1 || rows[0].SourceText != "Hi {name}, <b>welcome</b> home" || rows[0].TranslatedText != "Hallo {name}, <b>willkommen</b> zu Hause" {
+		t.Fatalf("expected XLIFF 1.2 inline markup flattened, got %+v", rows)
+	}
+
+	payload20 := `<?xml version="1.0"?>
+<xliff version="2.0" xmlns="urn:oasis:names:tc:xliff:document:2.0" srcLang="en" trgLang="de">
+  <file id="f1">
+    <unit id="u1" name="body">
+      <segment>
+        <source>Hi <ph id="1" equiv="{name}"/>, <pc id="2" equivStart="&lt;b&gt;" equivEnd="&lt;/b&gt;">welcome</pc><cp hex="0009"/>home</source>
+        <target>Hallo <ph id="1" equiv="{name}"/>, <pc id="2" equivStart="&lt;b&gt;" equivEnd="&lt;/b&gt;">willkommen</pc><cp hex="0009"/><mrk id="m1">zu Hause</mrk></target>
+      </segment>
+    </unit>
+  </file>
+</xliff>`
+	rows, err = parseTranslationImportXLIFF(strings.NewReader(payload20), true)
+	if err != nil {
+		t.Fatalf("parse 2.0: %v", err)
+	}
+	if len(rows) != 1 || rows[0].SourceText != "Hi {name}, <b>welcome</b>\thome" || rows[0].TranslatedText != "Hallo {name}, <b>willkommen</b>\tzu Hause" {
+		t.Fatalf("expected XLIFF 2.0 inline markup flattened, got %+v", rows)
+	}
+}
+
+func TestParseTranslationImportXLIFFRejectsMalformedPayload(t *testing.T) {
+	_, err := parseTranslationImportXLIFF(strings.NewReader("<xliff><file>"), false)
+	var payloadErr TranslationExchangeInvalidPayloadError
+	if !errors.As(err, &payloadErr) || payloadErr.Format != "xliff" {
+		t.Fatalf("expected xliff invalid payload error, got %v", err)
+	}
+}
+
+func TestTranslationExchangePORoundTripsRowLinkage(t *testing.T) {
+	rows := translationExchangeCodecFixtureRows()
+	raw := encodeTranslationExchangePO(rows)
+	decoded, err := parseTranslationImportPO(bytes.NewReader(raw), false)
+	if err != nil {
+		t.Fatalf("parse: %v\n%s", err, raw)
+	}
+	if !reflect.DeepEqual(decoded, rows) {
+		t.Fatalf("expected round-trip rows\nwant %+v\ngot  %+v\n%s", rows, decoded, raw)
+	}
+	if !bytes.Contains(raw, []byte(`msgctxt "pages|1|title"`)) || !bytes.Contains(raw, []byte(`"Language: es\n"`)) {
+		t.Fatalf("expected msgctxt linkage and language header, got %s", raw)
+	}
+}
+
+func TestParseTranslationImportPOHandlesLegacyCatalogs(t *testing.T) {
+	payload := `msgid ""
+msgstr ""
+"Language: pt\n"
+"X-Source-Language: en\n"
+
+# translator note
+#: templates/home.html:12
+#, fuzzy
+msgctxt "pages|9|title"
+msgid "Welcome"
+msgstr "Bem-vindo"
+
+msgctxt "pages|9|summary"
+msgid ""
+"Multi "
+"line"
+msgstr "Multi linha"
+
+#~ msgid "Old"
+#~ msgstr "Velho"
+`
+	rows, err := parseTranslationImportPO(strings.NewReader(payload), false)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected two live entries, got %+v", rows)
+	}
+	if rows[0].TranslatedText != "" || rows[0].Notes != "translator note" || rows[0].TargetLocale != "pt" || rows[0].SourceLocale != "en" {
+		t.Fatalf("expected fuzzy entry treated as untranslated with header locales, got %+v", rows[0])
+	}
+	if rows[1].EntityID != "9" || rows[1].FieldPath != "summary" || rows[1].SourceText != "Multi line" || rows[1].TranslatedText != "Multi linha" {
+		t.Fatalf("expected msgctxt linkage and joined continuation lines, got %+v", rows[1])
+	}
+	if _, err := parseTranslationImportPO(strings.NewReader(payload), true); err == nil {
+		t.Fatal("expected apply parse to require translated text for fuzzy entry")
+	}
+}
+
+func TestParseTranslationImportPORejectsMalformedPayload(t *testing.T) {
+	_, err := parseTranslationImportPO(strings.NewReader("msgid \"unterminated\nmsgstr \"\"\n"), false)
+	var payloadErr TranslationExchangeInvalidPayloadError
+	if !errors.As(err, &payloadErr) || payloadErr.Format != "po" || payloadErr.Metadata["line"] != 1 {
+		t.Fatalf("expected po invalid payload error at line 1, got %v", err)
+	}
+}
+
+func TestTranslationExchangeCodecRowsKeepValidateConflictDetection(t *testing.T) {
+	store := &stubTranslationExchangeStore{
+		resolve: map[string]TranslationExchangeLinkage{
+			"pages::1::tg_1::es::title": {
+				Key: TranslationExchangeLinkageKey{
+					Resource:     "pages",
+					EntityID:     "1",
+					FamilyID:     "tg_1",
+					TargetLocale: "es",
+					FieldPath:    "title",
+				},
+				SourceHash: "current_hash",
+			},
+		},
+	}
+	service := NewTranslationExchangeService(store)
+	rows := translationExchangeCodecFixtureRows()
+	xliff, err := encodeTranslationExchangeXLIFF(rows, "xliff")
+	if err != nil {
+		t.Fatalf("encode xliff: %v", err)
+	}
+	decoders := map[string]func() ([]TranslationExchangeRow, error){
+		"xliff": func() ([]TranslationExchangeRow, error) {
+			return parseTranslationImportXLIFF(bytes.NewReader(xliff), false)
+		},
+		"po": func() ([]TranslationExchangeRow, error) {
+			return parseTranslationImportPO(bytes.NewReader(encodeTranslationExchangePO(rows)), false)
+		},
+	}
+	for format, decode := range decoders {
+		decoded, err := decode()
+		if err != nil {
+			t.Fatalf("%s decode: %v", format, err)
+		}
+		result, err := service.ValidateImport(context.Background(), TranslationImportValidateInput{Rows: decoded})
+		if err != nil {
+			t.Fatalf("%s validate: %v", format, err)
+		}
+		if len(result.Results) != 2 {
+			t.Fatalf("%s expected two results, got %+v", format, result.Results)
+		}
+		if conflict := result.Results[0].Conflict; conflict == nil || conflict.Type != "stale_source_hash" {
+			t.Fatalf("%s expected stale_source_hash conflict, got %+v", format, conflict)
+		}
+		if conflict := result.Results[1].Conflict; conflict == nil || conflict.Type != "missing_linkage" {
+			t.Fatalf("%s expected missing_linkage conflict, got %+v", format, conflict)
+		}
+	}
+}
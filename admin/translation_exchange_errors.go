@@ -7,7 +7,7 @@ import (
 )
 
 var (
-	// ErrTranslationExchangeUnsupportedFormat indicates a format outside CSV/JSON v1 support.
+	// ErrTranslationExchangeUnsupportedFormat indicates a format outside CSV/JSON/XLIFF/PO support.
 	ErrTranslationExchangeUnsupportedFormat = errors.New("translation exchange unsupported format")
 	// ErrTranslationExchangeInvalidPayload indicates malformed exchange payloads.
 	ErrTranslationExchangeInvalidPayload = errors.New("translation exchange invalid payload")
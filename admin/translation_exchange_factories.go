@@ -60,6 +60,10 @@ func buildTranslationExportInput(payload map[string]any, _ []string) (Translatio
 			),
 			IncludeSourceHash: resolveBoolField(filterPayload, payload, "include_source_hash"),
 			Options:           extractMap(filterPayload["options"]),
+			Format: normalizeTranslationExchangeFormat(primitives.FirstNonEmptyRaw(
+				strings.TrimSpace(toString(filterPayload["format"])),
+				strings.TrimSpace(toString(payload["format"])),
+			)),
 		},
 	}
 	if len(input.Filter.Resources) == 0 {
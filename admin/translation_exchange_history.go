@@ -173,6 +173,7 @@ func translationExchangeHistoryValidateResult(validateRows []TranslationExchange
 }
 
 func translationExchangeHistoryExportJob(actorID string, now time.Time, exportResult TranslationExportResult) translationExchangeAsyncJob {
+	exportPayload, _ := translationExchangeExportResultPayload(exportResult) //nolint:errcheck // fixture rows always encode; a failure only drops the sample download.
 	return translationExchangeAsyncJob{
 		ID:           "txex_job_fixture_export_history",
 		Kind:         translationExchangeJobKindExport,
@@ -190,7 +191,7 @@ func translationExchangeHistoryExportJob(actorID string, now time.Time, exportRe
 			"file_name":           "translation_exchange_export_demo.json",
 		},
 		Progress:  map[string]any{"total": exportResult.RowCount, "processed": exportResult.RowCount, "succeeded": exportResult.RowCount, "failed": 0},
-		Result:    translationExchangeExportResultPayload(exportResult),
+		Result:    exportPayload,
 		CreatedAt: now.Add(-4 * time.Hour),
 		UpdatedAt: now.Add(-4*time.Hour + 3*time.Minute),
 	}
@@ -304,7 +305,23 @@ func translationExchangeJSONDownload(kind, label, filename, contentType string,
 	return translationExchangeRawDownload(kind, label, filename, contentType, raw)
 }
 
-func translationExchangeExportDownload(result TranslationExportResult) map[string]any {
+func translationExchangeExportFileName(format string) string {
+	switch normalizeTranslationExchangeFormat(format) {
+	case "csv":
+		return "translation_exchange_export.csv"
+	case "xliff", "xliff2":
+		return "translation_exchange_export.xlf"
+	case "po":
+		return "translation_exchange_export.po"
+	default:
+		return "translation_exchange_export.json"
+	}
+}
+
+// translationExchangeExportDownload renders the export artifact. Encoding
+// failures are returned so the export fails instead of completing without
+// a download.
+func translationExchangeExportDownload(result TranslationExportResult) (map[string]any, error) {
 	format := strings.ToLower(strings.TrimSpace(primitives.FirstNonEmptyRaw(result.Format, "json")))
 	switch format {
 	case "csv":
@@ -345,24 +362,48 @@ func translationExchangeExportDownload(result TranslationExportResult) map[strin
 			})
 		}
 		writer.Flush()
-		if writer.Error() != nil {
-			return nil
+		if err := writer.Error(); err != nil {
+			return nil, err
 		}
 		return translationExchangeRawDownload(
 			translationExchangeDownloadKindArtifact,
 			"Download export CSV",
-			"translation_exchange_export.csv",
+			translationExchangeExportFileName(format),
 			"text/csv",
 			buffer.Bytes(),
-		)
+		), nil
+	case "xliff", "xliff2":
+		raw, err := encodeTranslationExchangeXLIFF(result.Rows, format)
+		if err != nil {
+			return nil, err
+		}
+		return translationExchangeRawDownload(
+			translationExchangeDownloadKindArtifact,
+			"Download export XLIFF",
+			translationExchangeExportFileName(format),
+			"application/xliff+xml",
+			raw,
+		), nil
+	case "po":
+		return translationExchangeRawDownload(
+			translationExchangeDownloadKindArtifact,
+			"Download export PO",
+			translationExchangeExportFileName(format),
+			"text/x-gettext-translation",
+			encodeTranslationExchangePO(result.Rows),
+		), nil
 	default:
-		return translationExchangeJSONDownload(
+		raw, err := json.MarshalIndent(result.Rows, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return translationExchangeRawDownload(
 			translationExchangeDownloadKindArtifact,
 			"Download export JSON",
 			"translation_exchange_export.json",
 			"application/json",
-			result.Rows,
-		)
+			raw,
+		), nil
 	}
 }
 
@@ -296,7 +296,11 @@ func (r *TranslationExchangeRuntime) executeExportJob(ctx context.Context, job t
 		r.failJob(ctx, job.ID, err)
 		return
 	}
-	responsePayload := translationExchangeExportResultPayload(result)
+	responsePayload, err := translationExchangeExportResultPayload(result)
+	if err != nil {
+		r.failJob(ctx, job.ID, err)
+		return
+	}
 	progress := map[string]any{
 		"total":     result.RowCount,
 		"processed": result.RowCount,
@@ -504,6 +508,7 @@ func translationExchangeExportInputFromRequest(request map[string]any) Translati
 			TargetLocales:     toStringSlice(request["target_locales"]),
 			FieldPaths:        toStringSlice(request["field_paths"]),
 			IncludeSourceHash: toBool(request["include_source_hash"]),
+			Format:            normalizeTranslationExchangeFormat(toString(request["format"])),
 		},
 	}
 }
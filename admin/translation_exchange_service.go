@@ -126,6 +126,7 @@ func (s *TranslationExchangeService) Export(ctx context.Context, input Translati
 	}
 	return TranslationExportResult{
 		RowCount: len(rows),
+		Format:   strings.TrimSpace(input.Filter.Format),
 		Rows:     rows,
 	}, nil
 }
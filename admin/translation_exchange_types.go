@@ -50,6 +50,9 @@ type TranslationExportFilter struct {
 	FieldPaths        []string       `json:"field_paths,omitempty"`
 	IncludeSourceHash bool           `json:"include_source_hash,omitempty"`
 	Options           map[string]any `json:"options,omitempty"`
+	// Format selects the export artifact encoding: json (default), csv,
+	// xliff (1.2), xliff2 or po.
+	Format string `json:"format,omitempty"`
 }
 
 // TranslationExportResult captures exported rows and aggregate metadata.
@@ -0,0 +1,350 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// translationGlossaryEditorTermLimit bounds how many terms the editor loads per assignment.
+const translationGlossaryEditorTermLimit = 500
+
+// TranslationGlossaryTerm is a managed terminology entry for one locale pair.
+//
+// Regular entries map a source Term to its PreferredTranslation. Forbidden
+// entries name a target-locale Term that must not appear in translations;
+// PreferredTranslation then holds the suggested replacement, if any.
+// Empty scope, SourceLocale and ContentType values apply the term to every
+// tenant/org, source locale and content type respectively.
+type TranslationGlossaryTerm struct {
+	ID                   string    `json:"id"`
+	TenantID             string    `json:"tenant_id,omitempty"`
+	OrgID                string    `json:"org_id,omitempty"`
+	SourceLocale         string    `json:"source_locale,omitempty"`
+	TargetLocale         string    `json:"target_locale"`
+	ContentType          string    `json:"content_type,omitempty"`
+	Term                 string    `json:"term"`
+	PreferredTranslation string    `json:"preferred_translation,omitempty"`
+	Notes                string    `json:"notes,omitempty"`
+	CaseSensitive        bool      `json:"case_sensitive"`
+	Forbidden            bool      `json:"forbidden"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+// TranslationGlossaryFilter narrows glossary listings. Empty fields are unconstrained.
+// TenantID, OrgID, SourceLocale and ContentType also match shared terms that
+// leave the corresponding field empty.
+type TranslationGlossaryFilter struct {
+	TenantID     string `json:"tenant_id"`
+	OrgID        string `json:"org_id"`
+	SourceLocale string `json:"source_locale"`
+	TargetLocale string `json:"target_locale"`
+	ContentType  string `json:"content_type"`
+	Term         string `json:"term"`
+	Search       string `json:"search"`
+	Page         int    `json:"page"`
+	PerPage      int    `json:"per_page"`
+}
+
+// TranslationGlossaryStore persists glossary terms.
+type TranslationGlossaryStore interface {
+	ListTerms(context.Context, TranslationGlossaryFilter) ([]TranslationGlossaryTerm, int, error)
+	GetTerm(context.Context, string) (TranslationGlossaryTerm, error)
+	CreateTerm(context.Context, TranslationGlossaryTerm) (TranslationGlossaryTerm, error)
+	UpdateTerm(context.Context, TranslationGlossaryTerm) (TranslationGlossaryTerm, error)
+	DeleteTerm(context.Context, string) error
+}
+
+// InMemoryTranslationGlossaryStore keeps glossary terms in process memory.
+type InMemoryTranslationGlossaryStore struct {
+	mu    sync.RWMutex
+	terms map[string]TranslationGlossaryTerm
+}
+
+var _ TranslationGlossaryStore = (*InMemoryTranslationGlossaryStore)(nil)
+
+// NewInMemoryTranslationGlossaryStore builds a memory store seeded with the provided terms.
+func NewInMemoryTranslationGlossaryStore(seed ...TranslationGlossaryTerm) *InMemoryTranslationGlossaryStore {
+	store := &InMemoryTranslationGlossaryStore{terms: map[string]TranslationGlossaryTerm{}}
+	now := time.Now().UTC()
+	for _, term := range seed {
+		term = normalizeTranslationGlossaryTerm(term)
+		if term.ID == "" {
+			term.ID = uuid.NewString()
+		}
+		if term.CreatedAt.IsZero() {
+			term.CreatedAt = now
+		}
+		if term.UpdatedAt.IsZero() {
+			term.UpdatedAt = term.CreatedAt
+		}
+		store.terms[term.ID] = term
+	}
+	return store
+}
+
+// DefaultTranslationGlossaryTerms returns the starter glossary used when no store is configured.
+func DefaultTranslationGlossaryTerms() []TranslationGlossaryTerm {
+	return []TranslationGlossaryTerm{
+		{ID: "glossary_fr_home", TargetLocale: "fr", Term: "home", PreferredTranslation: "accueil", Notes: "Use the navigation label, not domicile."},
+		{ID: "glossary_fr_publish", TargetLocale: "fr", Term: "publish", PreferredTranslation: "publier", Notes: "Keep the action verb concise."},
+		{ID: "glossary_fr_translation", TargetLocale: "fr", Term: "translation", PreferredTranslation: "traduction", Notes: "Use the product noun consistently."},
+		{ID: "glossary_es_home", TargetLocale: "es", Term: "home", PreferredTranslation: "inicio", Notes: "Prefer Inicio for primary nav labels."},
+		{ID: "glossary_es_publish", TargetLocale: "es", Term: "publish", PreferredTranslation: "publicar", Notes: "Use the imperative action label."},
+	}
+}
+
+func resolveTranslationGlossaryStore(store TranslationGlossaryStore) TranslationGlossaryStore {
+	if store != nil {
+		return store
+	}
+	return NewInMemoryTranslationGlossaryStore(DefaultTranslationGlossaryTerms()...)
+}
+
+func (s *InMemoryTranslationGlossaryStore) ListTerms(_ context.Context, filter TranslationGlossaryFilter) ([]TranslationGlossaryTerm, int, error) {
+	if s == nil {
+		return nil, 0, serviceNotConfiguredDomainError("translation glossary store", map[string]any{"component": "translation_glossary"})
+	}
+	filter = normalizeTranslationGlossaryFilter(filter)
+	s.mu.RLock()
+	items := make([]TranslationGlossaryTerm, 0, len(s.terms))
+	for _, term := range s.terms {
+		if translationGlossaryTermMatchesFilter(term, filter) {
+			items = append(items, term)
+		}
+	}
+	s.mu.RUnlock()
+	sortTranslationGlossaryTerms(items)
+	page, total := paginateInMemory(items, ListOptions{Page: filter.Page, PerPage: filter.PerPage}, len(items))
+	return append([]TranslationGlossaryTerm{}, page...), total, nil
+}
+
+func (s *InMemoryTranslationGlossaryStore) GetTerm(_ context.Context, id string) (TranslationGlossaryTerm, error) {
+	if s == nil {
+		return TranslationGlossaryTerm{}, serviceNotConfiguredDomainError("translation glossary store", map[string]any{"component": "translation_glossary"})
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	term, ok := s.terms[strings.TrimSpace(id)]
+	if !ok {
+		return TranslationGlossaryTerm{}, ErrNotFound
+	}
+	return term, nil
+}
+
+func (s *InMemoryTranslationGlossaryStore) CreateTerm(_ context.Context, term TranslationGlossaryTerm) (TranslationGlossaryTerm, error) {
+	if s == nil {
+		return TranslationGlossaryTerm{}, serviceNotConfiguredDomainError("translation glossary store", map[string]any{"component": "translation_glossary"})
+	}
+	term = normalizeTranslationGlossaryTerm(term)
+	if err := validateTranslationGlossaryTerm(term); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	if term.ID == "" {
+		term.ID = uuid.NewString()
+	}
+	now := time.Now().UTC()
+	term.CreatedAt = now
+	term.UpdatedAt = now
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.terms[term.ID]; ok || s.hasKeyLocked(term) {
+		return TranslationGlossaryTerm{}, translationGlossaryDuplicateError(term)
+	}
+	s.terms[term.ID] = term
+	return term, nil
+}
+
+func (s *InMemoryTranslationGlossaryStore) UpdateTerm(_ context.Context, term TranslationGlossaryTerm) (TranslationGlossaryTerm, error) {
+	if s == nil {
+		return TranslationGlossaryTerm{}, serviceNotConfiguredDomainError("translation glossary store", map[string]any{"component": "translation_glossary"})
+	}
+	term = normalizeTranslationGlossaryTerm(term)
+	if err := validateTranslationGlossaryTerm(term); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.terms[term.ID]
+	if !ok {
+		return TranslationGlossaryTerm{}, ErrNotFound
+	}
+	if s.hasKeyLocked(term) {
+		return TranslationGlossaryTerm{}, translationGlossaryDuplicateError(term)
+	}
+	term.CreatedAt = current.CreatedAt
+	term.UpdatedAt = time.Now().UTC()
+	s.terms[term.ID] = term
+	return term, nil
+}
+
+func (s *InMemoryTranslationGlossaryStore) DeleteTerm(_ context.Context, id string) error {
+	if s == nil {
+		return serviceNotConfiguredDomainError("translation glossary store", map[string]any{"component": "translation_glossary"})
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id = strings.TrimSpace(id)
+	if _, ok := s.terms[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.terms, id)
+	return nil
+}
+
+func (s *InMemoryTranslationGlossaryStore) hasKeyLocked(term TranslationGlossaryTerm) bool {
+	key := translationGlossaryTermKey(term)
+	for id, existing := range s.terms {
+		if id != term.ID && translationGlossaryTermKey(existing) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeTranslationGlossaryTerm(term TranslationGlossaryTerm) TranslationGlossaryTerm {
+	term.ID = strings.TrimSpace(term.ID)
+	term.TenantID = strings.TrimSpace(term.TenantID)
+	term.OrgID = strings.TrimSpace(term.OrgID)
+	term.SourceLocale = strings.ToLower(strings.TrimSpace(term.SourceLocale))
+	term.TargetLocale = strings.ToLower(strings.TrimSpace(term.TargetLocale))
+	term.ContentType = strings.ToLower(strings.TrimSpace(term.ContentType))
+	term.Term = strings.TrimSpace(term.Term)
+	term.PreferredTranslation = strings.TrimSpace(term.PreferredTranslation)
+	term.Notes = strings.TrimSpace(term.Notes)
+	return term
+}
+
+func normalizeTranslationGlossaryFilter(filter TranslationGlossaryFilter) TranslationGlossaryFilter {
+	filter.TenantID = strings.TrimSpace(filter.TenantID)
+	filter.OrgID = strings.TrimSpace(filter.OrgID)
+	filter.SourceLocale = strings.ToLower(strings.TrimSpace(filter.SourceLocale))
+	filter.TargetLocale = strings.ToLower(strings.TrimSpace(filter.TargetLocale))
+	filter.ContentType = strings.ToLower(strings.TrimSpace(filter.ContentType))
+	filter.Term = strings.TrimSpace(filter.Term)
+	filter.Search = strings.ToLower(strings.TrimSpace(filter.Search))
+	return filter
+}
+
+func validateTranslationGlossaryTerm(term TranslationGlossaryTerm) error {
+	if term.Term == "" {
+		return requiredFieldDomainError("term", map[string]any{"component": "translation_glossary"})
+	}
+	if term.TargetLocale == "" {
+		return requiredFieldDomainError("target_locale", map[string]any{"component": "translation_glossary"})
+	}
+	if !term.Forbidden && term.PreferredTranslation == "" {
+		return requiredFieldDomainError("preferred_translation", map[string]any{"component": "translation_glossary"})
+	}
+	return nil
+}
+
+func translationGlossaryDuplicateError(term TranslationGlossaryTerm) error {
+	return conflictDomainError("translation glossary term already exists", map[string]any{
+		"component":     "translation_glossary",
+		"term":          term.Term,
+		"target_locale": term.TargetLocale,
+		"content_type":  term.ContentType,
+	})
+}
+
+// translationGlossaryTermKey identifies a term within its scope, locale pair and content type.
+func translationGlossaryTermKey(term TranslationGlossaryTerm) string {
+	return strings.Join([]string{
+		term.TenantID,
+		term.OrgID,
+		term.SourceLocale,
+		term.TargetLocale,
+		term.ContentType,
+		strings.ToLower(term.Term),
+	}, "|")
+}
+
+func translationGlossaryTermMatchesFilter(term TranslationGlossaryTerm, filter TranslationGlossaryFilter) bool {
+	if !translationGlossarySharedFieldMatches(term.TenantID, filter.TenantID) ||
+		!translationGlossarySharedFieldMatches(term.OrgID, filter.OrgID) ||
+		!translationGlossarySharedFieldMatches(term.SourceLocale, filter.SourceLocale) ||
+		!translationGlossarySharedFieldMatches(term.ContentType, filter.ContentType) {
+		return false
+	}
+	if filter.TargetLocale != "" && term.TargetLocale != filter.TargetLocale {
+		return false
+	}
+	if filter.Term != "" && !strings.EqualFold(term.Term, filter.Term) {
+		return false
+	}
+	if filter.Search != "" {
+		haystack := strings.ToLower(term.Term + " " + term.PreferredTranslation + " " + term.Notes)
+		if !strings.Contains(haystack, filter.Search) {
+			return false
+		}
+	}
+	return true
+}
+
+func translationGlossarySharedFieldMatches(value, filter string) bool {
+	return filter == "" || value == "" || value == filter
+}
+
+func sortTranslationGlossaryTerms(items []TranslationGlossaryTerm) {
+	sort.SliceStable(items, func(i, j int) bool {
+		left := strings.ToLower(items[i].Term)
+		right := strings.ToLower(items[j].Term)
+		if left != right {
+			return left < right
+		}
+		if items[i].TargetLocale != items[j].TargetLocale {
+			return items[i].TargetLocale < items[j].TargetLocale
+		}
+		return items[i].ID < items[j].ID
+	})
+}
+
+// translationGlossaryTextContains reports whether text contains term, honoring case sensitivity.
+func translationGlossaryTextContains(text, term string, caseSensitive bool) bool {
+	if term == "" || text == "" {
+		return false
+	}
+	if caseSensitive {
+		return strings.Contains(text, term)
+	}
+	return strings.Contains(strings.ToLower(text), strings.ToLower(term))
+}
+
+// translationEditorGlossaryTerms loads the glossary terms that apply to one editor context.
+// Without a store the starter glossary is used; lookup failures degrade to an
+// empty glossary so the editor stays usable.
+func translationEditorGlossaryTerms(ctx context.Context, store TranslationGlossaryStore, tenantID, orgID string, editorCtx translationEditorContext) []TranslationGlossaryTerm {
+	targetLocale := strings.TrimSpace(editorCtx.TargetVariant.Locale)
+	if targetLocale == "" {
+		return nil
+	}
+	filter := TranslationGlossaryFilter{
+		TenantID:     tenantID,
+		OrgID:        orgID,
+		SourceLocale: editorCtx.SourceVariant.Locale,
+		TargetLocale: targetLocale,
+		ContentType:  editorCtx.Family.ContentType,
+		PerPage:      translationGlossaryEditorTermLimit,
+	}
+	if store == nil {
+		filter = normalizeTranslationGlossaryFilter(filter)
+		terms := []TranslationGlossaryTerm{}
+		for _, term := range DefaultTranslationGlossaryTerms() {
+			if term = normalizeTranslationGlossaryTerm(term); translationGlossaryTermMatchesFilter(term, filter) {
+				terms = append(terms, term)
+			}
+		}
+		return terms
+	}
+	terms, _, err := store.ListTerms(ctx, filter)
+	if err != nil {
+		return nil
+	}
+	return terms
+}
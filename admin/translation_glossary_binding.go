@@ -0,0 +1,229 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	router "github.com/goliatone/go-router"
+)
+
+const translationGlossaryMaxUploadBytes = 5 * 1024 * 1024
+
+type translationGlossaryBinding struct {
+	admin *Admin
+}
+
+func newTranslationGlossaryBinding(a *Admin) *translationGlossaryBinding {
+	return &translationGlossaryBinding{admin: a}
+}
+
+// TranslationGlossaryImportResult summarizes a glossary import.
+type TranslationGlossaryImportResult struct {
+	Format  string `json:"format"`
+	Total   int    `json:"total"`
+	Created int    `json:"created"`
+	Updated int    `json:"updated"`
+}
+
+// Export downloads the glossary terms visible to the request scope as CSV or TBX.
+func (b *translationGlossaryBinding) Export(c router.Context) error {
+	store, err := b.store()
+	if err != nil {
+		return err
+	}
+	adminCtx := b.admin.adminContextFromRequest(c, b.admin.config.DefaultLocale)
+	if err := b.admin.requirePermission(adminCtx, PermAdminTranslationsView, "translations"); err != nil {
+		return err
+	}
+	format, err := detectTranslationGlossaryFormat(c, "", "")
+	if err != nil {
+		return err
+	}
+	terms, _, err := store.ListTerms(adminCtx.Context, TranslationGlossaryFilter{
+		TenantID:     adminCtx.TenantID,
+		OrgID:        adminCtx.OrgID,
+		SourceLocale: c.Query("source_locale"),
+		TargetLocale: c.Query("target_locale"),
+		ContentType:  c.Query("content_type"),
+	})
+	if err != nil {
+		return err
+	}
+	if format == "tbx" {
+		raw, err := encodeTranslationGlossaryTBX(terms)
+		if err != nil {
+			return err
+		}
+		c.SetHeader("Content-Type", "application/x-tbx+xml")
+		c.SetHeader("Content-Disposition", "attachment; filename=translation_glossary.tbx")
+		return c.SendString(string(raw))
+	}
+	raw, err := encodeTranslationGlossaryCSV(terms)
+	if err != nil {
+		return err
+	}
+	c.SetHeader("Content-Type", "text/csv")
+	c.SetHeader("Content-Disposition", "attachment; filename=translation_glossary.csv")
+	return c.SendString(string(raw))
+}
+
+// Import upserts glossary terms from an uploaded (or raw body) CSV or TBX file
+// into the request scope.
+func (b *translationGlossaryBinding) Import(c router.Context) (any, error) {
+	store, err := b.store()
+	if err != nil {
+		return nil, err
+	}
+	adminCtx := b.admin.adminContextFromRequest(c, b.admin.config.DefaultLocale)
+	if err := b.admin.requirePermission(adminCtx, PermAdminTranslationsManage, "translations"); err != nil {
+		return nil, err
+	}
+	raw, filename, contentType, err := readTranslationGlossaryUpload(c)
+	if err != nil {
+		return nil, err
+	}
+	format, err := detectTranslationGlossaryFormat(c, filename, contentType)
+	if err != nil {
+		return nil, err
+	}
+	var terms []TranslationGlossaryTerm
+	if format == "tbx" {
+		terms, err = parseTranslationGlossaryTBX(bytes.NewReader(raw))
+	} else {
+		terms, err = parseTranslationGlossaryCSV(bytes.NewReader(raw))
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := importTranslationGlossaryTerms(adminCtx.Context, store, adminCtx.TenantID, adminCtx.OrgID, terms)
+	if err != nil {
+		return nil, err
+	}
+	result.Format = format
+	return result, nil
+}
+
+func (b *translationGlossaryBinding) store() (TranslationGlossaryStore, error) {
+	if b == nil || b.admin == nil || b.admin.translationGlossaryStore == nil {
+		return nil, serviceNotConfiguredDomainError("translation glossary store", map[string]any{
+			"component": "translation_glossary_binding",
+		})
+	}
+	return b.admin.translationGlossaryStore, nil
+}
+
+// importTranslationGlossaryTerms scopes terms to the tenant/org and updates
+// entries that already exist for the same scope, locale pair, content type and term.
+func importTranslationGlossaryTerms(ctx context.Context, store TranslationGlossaryStore, tenantID, orgID string, terms []TranslationGlossaryTerm) (TranslationGlossaryImportResult, error) {
+	result := TranslationGlossaryImportResult{Total: len(terms)}
+	for _, term := range terms {
+		term.ID = ""
+		term.TenantID = tenantID
+		term.OrgID = orgID
+		term = normalizeTranslationGlossaryTerm(term)
+		existing, err := findTranslationGlossaryTerm(ctx, store, term)
+		if err != nil {
+			return result, err
+		}
+		if existing.ID == "" {
+			if _, err := store.CreateTerm(ctx, term); err != nil {
+				return result, err
+			}
+			result.Created++
+			continue
+		}
+		term.ID = existing.ID
+		if _, err := store.UpdateTerm(ctx, term); err != nil {
+			return result, err
+		}
+		result.Updated++
+	}
+	return result, nil
+}
+
+func findTranslationGlossaryTerm(ctx context.Context, store TranslationGlossaryStore, term TranslationGlossaryTerm) (TranslationGlossaryTerm, error) {
+	candidates, _, err := store.ListTerms(ctx, TranslationGlossaryFilter{
+		TenantID:     term.TenantID,
+		OrgID:        term.OrgID,
+		SourceLocale: term.SourceLocale,
+		TargetLocale: term.TargetLocale,
+		ContentType:  term.ContentType,
+		Term:         term.Term,
+	})
+	if err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	key := translationGlossaryTermKey(term)
+	for _, candidate := range candidates {
+		if translationGlossaryTermKey(candidate) == key {
+			return candidate, nil
+		}
+	}
+	return TranslationGlossaryTerm{}, nil
+}
+
+func readTranslationGlossaryUpload(c router.Context) ([]byte, string, string, error) {
+	return readTranslationUpload(c, "glossary", "translation_glossary_binding", translationGlossaryMaxUploadBytes)
+}
+
+// readTranslationUpload reads the multipart "file" field, or the raw request
+// body when no file is attached, rejecting payloads above limit bytes.
+func readTranslationUpload(c router.Context, subject, component string, limit int64) ([]byte, string, string, error) {
+	if file, err := c.FormFile("file"); err == nil && file != nil {
+		if file.Size > limit {
+			return nil, "", "", validationDomainError(subject+" upload exceeds file size limit", map[string]any{
+				"field":       "file",
+				"limit_bytes": limit,
+			})
+		}
+		handle, err := file.Open()
+		if err != nil {
+			return nil, "", "", validationDomainError("unable to read "+subject+" file", map[string]any{"field": "file"})
+		}
+		defer handle.Close()
+		raw, err := io.ReadAll(io.LimitReader(handle, limit+1))
+		if err != nil {
+			return nil, "", "", validationDomainError("unable to read "+subject+" file", map[string]any{"field": "file"})
+		}
+		return raw, file.Filename, file.Header.Get("Content-Type"), nil
+	}
+	raw := bytes.TrimSpace(c.Body())
+	if len(raw) == 0 {
+		return nil, "", "", requiredFieldDomainError("file", map[string]any{"component": component})
+	}
+	if int64(len(raw)) > limit {
+		return nil, "", "", validationDomainError(subject+" upload exceeds file size limit", map[string]any{
+			"limit_bytes": limit,
+		})
+	}
+	return raw, "", c.Header("Content-Type"), nil
+}
+
+func detectTranslationGlossaryFormat(c router.Context, filename, contentType string) (string, error) {
+	format := strings.TrimSpace(c.Query("format"))
+	if format == "" {
+		format = strings.TrimSpace(c.FormValue("format"))
+	}
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(strings.TrimSpace(filename)), ".")
+	}
+	if format == "" {
+		lowerType := strings.ToLower(strings.TrimSpace(contentType))
+		if strings.Contains(lowerType, "tbx") || strings.Contains(lowerType, "xml") {
+			format = "tbx"
+		}
+	}
+	format = normalizeTranslationGlossaryFormat(format)
+	switch format {
+	case "csv", "tbx":
+		return format, nil
+	default:
+		return "", validationDomainError("unsupported glossary format", map[string]any{
+			"format":    format,
+			"supported": translationGlossarySupportedFormats,
+		})
+	}
+}
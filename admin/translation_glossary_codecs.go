@@ -0,0 +1,371 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+)
+
+const (
+	translationGlossaryTBXNamespace = "urn:iso:std:iso:30042:ed-2"
+
+	translationGlossaryTBXPreferredStatus  = "preferredTerm-admn-sts"
+	translationGlossaryTBXDeprecatedStatus = "deprecatedTerm-admn-sts"
+	translationGlossaryTBXSupersededStatus = "supersededTerm-admn-sts"
+	translationGlossaryTBXCaseSensitive    = "x-caseSensitive"
+)
+
+var translationGlossarySupportedFormats = []string{"csv", "tbx"}
+
+var translationGlossaryCSVHeader = []string{
+	"term",
+	"preferred_translation",
+	"source_locale",
+	"target_locale",
+	"content_type",
+	"notes",
+	"case_sensitive",
+	"forbidden",
+}
+
+func normalizeTranslationGlossaryFormat(format string) string {
+	switch format = strings.ToLower(strings.TrimSpace(format)); format {
+	case "tbx", "tbxm", "xml":
+		return "tbx"
+	case "", "csv":
+		return "csv"
+	default:
+		return format
+	}
+}
+
+func encodeTranslationGlossaryCSV(terms []TranslationGlossaryTerm) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(translationGlossaryCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, term := range terms {
+		if err := writer.Write([]string{
+			term.Term,
+			term.PreferredTranslation,
+			term.SourceLocale,
+			term.TargetLocale,
+			term.ContentType,
+			term.Notes,
+			strconv.FormatBool(term.CaseSensitive),
+			strconv.FormatBool(term.Forbidden),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func parseTranslationGlossaryCSV(reader io.Reader) ([]TranslationGlossaryTerm, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, validationDomainError("glossary file is empty", map[string]any{"format": "csv"})
+	}
+	if err != nil {
+		return nil, translationGlossaryParseError("csv", err)
+	}
+	columns := map[string]int{}
+	for index, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = index
+	}
+	for _, required := range []string{"term", "target_locale"} {
+		if _, ok := columns[required]; !ok {
+			return nil, requiredFieldDomainError(required, map[string]any{"format": "csv", "row": 0})
+		}
+	}
+	terms := []TranslationGlossaryTerm{}
+	for row := 1; ; row++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, translationGlossaryParseError("csv", err)
+		}
+		value := func(name string) string {
+			index, ok := columns[name]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+		term := normalizeTranslationGlossaryTerm(TranslationGlossaryTerm{
+			Term:                 value("term"),
+			PreferredTranslation: value("preferred_translation"),
+			SourceLocale:         value("source_locale"),
+			TargetLocale:         value("target_locale"),
+			ContentType:          value("content_type"),
+			Notes:                value("notes"),
+			CaseSensitive:        toBool(value("case_sensitive")),
+			Forbidden:            toBool(value("forbidden")),
+		})
+		if term.Term == "" && term.PreferredTranslation == "" && term.TargetLocale == "" {
+			continue
+		}
+		if err := validateTranslationGlossaryTerm(term); err != nil {
+			return nil, translationGlossaryRowError(err, "csv", row)
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+// tbxDocument leaves XMLName untagged so decoding accepts both <tbx> and
+// legacy <martif> roots.
+type tbxDocument struct {
+	XMLName   xml.Name
+	Namespace string    `xml:"xmlns,attr,omitempty"`
+	Style     string    `xml:"style,attr,omitempty"`
+	Type      string    `xml:"type,attr,omitempty"`
+	Lang      string    `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Header    tbxHeader `xml:"tbxHeader"`
+	Text      tbxText   `xml:"text"`
+}
+
+type tbxHeader struct {
+	Description string `xml:"fileDesc>sourceDesc>p"`
+}
+
+type tbxText struct {
+	Body tbxBody `xml:"body"`
+}
+
+// tbxBody accepts TBX v3 conceptEntry elements and legacy TBX 2008
+// termEntry elements; encoding only emits conceptEntry.
+type tbxBody struct {
+	Concepts []tbxConcept       `xml:"conceptEntry"`
+	Legacy   []tbxLegacyConcept `xml:"termEntry"`
+}
+
+type tbxConcept struct {
+	ID      string         `xml:"id,attr,omitempty"`
+	Descrip []tbxTypedText `xml:"descrip"`
+	LangSec []tbxLangSec   `xml:"langSec"`
+}
+
+type tbxLangSec struct {
+	Lang    string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	TermSec []tbxTermSec `xml:"termSec"`
+}
+
+type tbxTermSec struct {
+	Term     string         `xml:"term"`
+	TermNote []tbxTypedText `xml:"termNote"`
+	Note     string         `xml:"note,omitempty"`
+}
+
+type tbxLegacyConcept struct {
+	ID      string         `xml:"id,attr"`
+	Descrip []tbxTypedText `xml:"descrip"`
+	LangSet []struct {
+		Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		Tig  []struct {
+			Term     string         `xml:"term"`
+			TermNote []tbxTypedText `xml:"termNote"`
+			Note     string         `xml:"note"`
+		} `xml:"tig"`
+	} `xml:"langSet"`
+}
+
+type tbxTypedText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func tbxTypedValue(values []tbxTypedText, kind string) string {
+	for _, value := range values {
+		if strings.EqualFold(strings.TrimSpace(value.Type), kind) {
+			return strings.TrimSpace(value.Value)
+		}
+	}
+	return ""
+}
+
+// encodeTranslationGlossaryTBX writes one concept per term. Regular terms carry
+// a source and a preferred target language section; forbidden terms carry a
+// deprecated target term plus the preferred replacement when one is set.
+// Content types travel as the concept subjectField.
+func encodeTranslationGlossaryTBX(terms []TranslationGlossaryTerm) ([]byte, error) {
+	doc := tbxDocument{
+		XMLName:   xml.Name{Local: "tbx"},
+		Namespace: translationGlossaryTBXNamespace,
+		Style:     "dca",
+		Type:      "TBX-Basic",
+		Header:    tbxHeader{Description: "go-admin translation glossary"},
+	}
+	for _, term := range terms {
+		if doc.Lang == "" {
+			doc.Lang = term.SourceLocale
+		}
+		concept := tbxConcept{ID: term.ID}
+		if term.ContentType != "" {
+			concept.Descrip = []tbxTypedText{{Type: "subjectField", Value: term.ContentType}}
+		}
+		caseNote := []tbxTypedText{}
+		if term.CaseSensitive {
+			caseNote = append(caseNote, tbxTypedText{Type: translationGlossaryTBXCaseSensitive, Value: "true"})
+		}
+		target := tbxLangSec{Lang: term.TargetLocale}
+		if term.Forbidden {
+			target.TermSec = append(target.TermSec, tbxTermSec{
+				Term:     term.Term,
+				TermNote: append([]tbxTypedText{{Type: "administrativeStatus", Value: translationGlossaryTBXDeprecatedStatus}}, caseNote...),
+				Note:     term.Notes,
+			})
+			if term.PreferredTranslation != "" {
+				target.TermSec = append(target.TermSec, tbxTermSec{
+					Term:     term.PreferredTranslation,
+					TermNote: []tbxTypedText{{Type: "administrativeStatus", Value: translationGlossaryTBXPreferredStatus}},
+				})
+			}
+			concept.LangSec = append(concept.LangSec, target)
+		} else {
+			concept.LangSec = append(concept.LangSec, tbxLangSec{
+				Lang:    primitives.FirstNonEmptyRaw(term.SourceLocale, doc.Lang),
+				TermSec: []tbxTermSec{{Term: term.Term, TermNote: caseNote}},
+			})
+			target.TermSec = append(target.TermSec, tbxTermSec{
+				Term:     term.PreferredTranslation,
+				TermNote: []tbxTypedText{{Type: "administrativeStatus", Value: translationGlossaryTBXPreferredStatus}},
+				Note:     term.Notes,
+			})
+			concept.LangSec = append(concept.LangSec, target)
+		}
+		doc.Text.Body.Concepts = append(doc.Text.Body.Concepts, concept)
+	}
+	raw, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(raw, '\n')...), nil
+}
+
+// parseTranslationGlossaryTBX reads TBX concepts. The document xml:lang (or
+// the first language section of each concept) is treated as the source
+// language. Every other language section yields a regular term for its
+// preferred entry and a forbidden term for each deprecated or superseded entry.
+func parseTranslationGlossaryTBX(reader io.Reader) ([]TranslationGlossaryTerm, error) {
+	doc := tbxDocument{}
+	decoder := xml.NewDecoder(reader)
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, translationGlossaryParseError("tbx", err)
+	}
+	concepts := append([]tbxConcept{}, doc.Text.Body.Concepts...)
+	for _, legacy := range doc.Text.Body.Legacy {
+		concept := tbxConcept{ID: legacy.ID, Descrip: legacy.Descrip}
+		for _, langSet := range legacy.LangSet {
+			section := tbxLangSec{Lang: langSet.Lang}
+			for _, tig := range langSet.Tig {
+				section.TermSec = append(section.TermSec, tbxTermSec{Term: tig.Term, TermNote: tig.TermNote, Note: tig.Note})
+			}
+			concept.LangSec = append(concept.LangSec, section)
+		}
+		concepts = append(concepts, concept)
+	}
+	documentLang := strings.ToLower(strings.TrimSpace(doc.Lang))
+	terms := []TranslationGlossaryTerm{}
+	for index, concept := range concepts {
+		conceptTerms, err := translationGlossaryTermsFromTBXConcept(concept, documentLang)
+		if err != nil {
+			return nil, translationGlossaryRowError(err, "tbx", index+1)
+		}
+		terms = append(terms, conceptTerms...)
+	}
+	return terms, nil
+}
+
+func translationGlossaryTermsFromTBXConcept(concept tbxConcept, documentLang string) ([]TranslationGlossaryTerm, error) {
+	contentType := tbxTypedValue(concept.Descrip, "subjectField")
+	sourceLang := documentLang
+	if sourceLang == "" && len(concept.LangSec) > 1 {
+		sourceLang = strings.ToLower(strings.TrimSpace(concept.LangSec[0].Lang))
+	}
+	var source *tbxTermSec
+	for i := range concept.LangSec {
+		section := concept.LangSec[i]
+		if strings.EqualFold(strings.TrimSpace(section.Lang), sourceLang) && len(section.TermSec) > 0 {
+			source = &section.TermSec[0]
+			break
+		}
+	}
+	terms := []TranslationGlossaryTerm{}
+	for _, section := range concept.LangSec {
+		lang := strings.ToLower(strings.TrimSpace(section.Lang))
+		if lang == "" || lang == sourceLang {
+			continue
+		}
+		preferred := tbxTermSec{}
+		forbidden := []tbxTermSec{}
+		for _, entry := range section.TermSec {
+			switch tbxTypedValue(entry.TermNote, "administrativeStatus") {
+			case translationGlossaryTBXDeprecatedStatus, translationGlossaryTBXSupersededStatus:
+				forbidden = append(forbidden, entry)
+			default:
+				if preferred.Term == "" {
+					preferred = entry
+				}
+			}
+		}
+		if source != nil && strings.TrimSpace(source.Term) != "" && strings.TrimSpace(preferred.Term) != "" {
+			terms = append(terms, normalizeTranslationGlossaryTerm(TranslationGlossaryTerm{
+				SourceLocale:         sourceLang,
+				TargetLocale:         lang,
+				ContentType:          contentType,
+				Term:                 source.Term,
+				PreferredTranslation: preferred.Term,
+				Notes:                primitives.FirstNonEmptyRaw(preferred.Note, source.Note),
+				CaseSensitive:        toBool(tbxTypedValue(source.TermNote, translationGlossaryTBXCaseSensitive)),
+			}))
+		}
+		for _, entry := range forbidden {
+			term := normalizeTranslationGlossaryTerm(TranslationGlossaryTerm{
+				SourceLocale:         sourceLang,
+				TargetLocale:         lang,
+				ContentType:          contentType,
+				Term:                 entry.Term,
+				PreferredTranslation: preferred.Term,
+				Notes:                entry.Note,
+				CaseSensitive:        toBool(tbxTypedValue(entry.TermNote, translationGlossaryTBXCaseSensitive)),
+				Forbidden:            true,
+			})
+			if err := validateTranslationGlossaryTerm(term); err != nil {
+				return nil, err
+			}
+			terms = append(terms, term)
+		}
+	}
+	return terms, nil
+}
+
+func translationGlossaryParseError(format string, err error) error {
+	return validationDomainError("invalid glossary file", map[string]any{
+		"format": format,
+		"error":  err.Error(),
+	})
+}
+
+func translationGlossaryRowError(err error, format string, row int) error {
+	return validationDomainError(err.Error(), map[string]any{
+		"format": format,
+		"row":    row,
+	})
+}
@@ -0,0 +1,232 @@
+package admin
+
+import (
+	"context"
+	"strings"
+)
+
+const translationGlossaryPanelID = "translation_glossary"
+
+// NewTranslationGlossaryPanel builds the glossary term management panel.
+func NewTranslationGlossaryPanel(repo Repository) *PanelBuilder {
+	return (&PanelBuilder{}).
+		WithRepository(repo).
+		ListFields(
+			Field{Name: "term", Label: "Term", Type: "text"},
+			Field{Name: "preferred_translation", Label: "Preferred Translation", Type: "text"},
+			Field{Name: "source_locale", Label: "From", Type: "text"},
+			Field{Name: "target_locale", Label: "To", Type: "text"},
+			Field{Name: "content_type", Label: "Content Type", Type: "text"},
+			Field{Name: "case_sensitive", Label: "Case Sensitive", Type: "boolean"},
+			Field{Name: "forbidden", Label: "Forbidden", Type: "boolean"},
+		).
+		FormFields(
+			Field{Name: "term", Label: "Term", Type: "text", Required: true},
+			Field{Name: "preferred_translation", Label: "Preferred Translation", Type: "text"},
+			Field{Name: "source_locale", Label: "Source Locale", Type: "text"},
+			Field{Name: "target_locale", Label: "Target Locale", Type: "text", Required: true},
+			Field{Name: "content_type", Label: "Content Type", Type: "text"},
+			Field{Name: "notes", Label: "Notes", Type: "textarea"},
+			Field{Name: "case_sensitive", Label: "Case Sensitive", Type: "boolean"},
+			Field{Name: "forbidden", Label: "Forbidden Term", Type: "boolean"},
+		).
+		DetailFields(
+			Field{Name: "id", Label: "ID", Type: "text", ReadOnly: true},
+			Field{Name: "term", Label: "Term", Type: "text", ReadOnly: true},
+			Field{Name: "preferred_translation", Label: "Preferred Translation", Type: "text", ReadOnly: true},
+			Field{Name: "source_locale", Label: "Source Locale", Type: "text", ReadOnly: true},
+			Field{Name: "target_locale", Label: "Target Locale", Type: "text", ReadOnly: true},
+			Field{Name: "content_type", Label: "Content Type", Type: "text", ReadOnly: true},
+			Field{Name: "notes", Label: "Notes", Type: "textarea", ReadOnly: true},
+			Field{Name: "case_sensitive", Label: "Case Sensitive", Type: "boolean", ReadOnly: true},
+			Field{Name: "forbidden", Label: "Forbidden Term", Type: "boolean", ReadOnly: true},
+			Field{Name: "updated_at", Label: "Updated", Type: "datetime", ReadOnly: true},
+		).
+		Filters(
+			Filter{Name: "source_locale", Type: "text", Label: "Source Locale"},
+			Filter{Name: "target_locale", Type: "text", Label: "Target Locale"},
+			Filter{Name: "content_type", Type: "text", Label: "Content Type"},
+		).
+		Permissions(PanelPermissions{
+			View:   PermAdminTranslationsView,
+			Create: PermAdminTranslationsManage,
+			Edit:   PermAdminTranslationsManage,
+			Delete: PermAdminTranslationsManage,
+		})
+}
+
+// RegisterTranslationGlossaryPanel registers the glossary panel. A nil store
+// falls back to the store configured on the admin.
+func RegisterTranslationGlossaryPanel(admin *Admin, store TranslationGlossaryStore) (*Panel, error) {
+	if admin == nil {
+		return nil, serviceNotConfiguredDomainError("admin", map[string]any{"component": "translation_glossary_panel"})
+	}
+	if store == nil {
+		store = admin.TranslationGlossaryStore()
+	}
+	if store == nil {
+		return nil, serviceNotConfiguredDomainError("translation glossary store", map[string]any{"component": "translation_glossary_panel"})
+	}
+	return admin.RegisterPanel(translationGlossaryPanelID, NewTranslationGlossaryPanel(NewTranslationGlossaryPanelRepository(store)))
+}
+
+// TranslationGlossaryPanelRepository adapts glossary storage to panel CRUD contracts.
+// Every term is scoped to the tenant/org carried by the request context: new
+// terms take that scope, and terms outside it are not found. Shared terms
+// (empty tenant/org) are visible to every scope but read-only for scoped
+// callers.
+type TranslationGlossaryPanelRepository struct {
+	store TranslationGlossaryStore
+}
+
+// NewTranslationGlossaryPanelRepository wraps a glossary store for panel usage.
+func NewTranslationGlossaryPanelRepository(store TranslationGlossaryStore) *TranslationGlossaryPanelRepository {
+	return &TranslationGlossaryPanelRepository{store: store}
+}
+
+func (r *TranslationGlossaryPanelRepository) List(ctx context.Context, opts ListOptions) ([]map[string]any, int, error) {
+	if r == nil || r.store == nil {
+		return nil, 0, serviceNotConfiguredDomainError("translation glossary store", nil)
+	}
+	items, total, err := r.store.ListTerms(ctx, TranslationGlossaryFilter{
+		TenantID:     tenantIDFromContext(ctx),
+		OrgID:        orgIDFromContext(ctx),
+		SourceLocale: toString(opts.Filters["source_locale"]),
+		TargetLocale: toString(opts.Filters["target_locale"]),
+		ContentType:  toString(opts.Filters["content_type"]),
+		Search:       inMemoryListSearchTerm(opts),
+		Page:         opts.Page,
+		PerPage:      opts.PerPage,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, translationGlossaryTermToMap(item))
+	}
+	return rows, total, nil
+}
+
+func (r *TranslationGlossaryPanelRepository) Get(ctx context.Context, id string) (map[string]any, error) {
+	if r == nil || r.store == nil {
+		return nil, serviceNotConfiguredDomainError("translation glossary store", nil)
+	}
+	term, err := r.visibleTerm(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return translationGlossaryTermToMap(term), nil
+}
+
+func (r *TranslationGlossaryPanelRepository) Create(ctx context.Context, record map[string]any) (map[string]any, error) {
+	if r == nil || r.store == nil {
+		return nil, serviceNotConfiguredDomainError("translation glossary store", nil)
+	}
+	term := translationGlossaryTermFromMap(TranslationGlossaryTerm{
+		TenantID: tenantIDFromContext(ctx),
+		OrgID:    orgIDFromContext(ctx),
+	}, record)
+	created, err := r.store.CreateTerm(ctx, term)
+	if err != nil {
+		return nil, err
+	}
+	return translationGlossaryTermToMap(created), nil
+}
+
+func (r *TranslationGlossaryPanelRepository) Update(ctx context.Context, id string, record map[string]any) (map[string]any, error) {
+	if r == nil || r.store == nil {
+		return nil, serviceNotConfiguredDomainError("translation glossary store", nil)
+	}
+	current, err := r.writableTerm(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.store.UpdateTerm(ctx, translationGlossaryTermFromMap(current, record))
+	if err != nil {
+		return nil, err
+	}
+	return translationGlossaryTermToMap(updated), nil
+}
+
+func (r *TranslationGlossaryPanelRepository) Delete(ctx context.Context, id string) error {
+	if r == nil || r.store == nil {
+		return serviceNotConfiguredDomainError("translation glossary store", nil)
+	}
+	current, err := r.writableTerm(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.store.DeleteTerm(ctx, current.ID)
+}
+
+// visibleTerm loads a term the caller's scope can read; terms owned by
+// another tenant or org are reported as not found.
+func (r *TranslationGlossaryPanelRepository) visibleTerm(ctx context.Context, id string) (TranslationGlossaryTerm, error) {
+	term, err := r.store.GetTerm(ctx, id)
+	if err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	if !translationGlossarySharedFieldMatches(term.TenantID, tenantIDFromContext(ctx)) ||
+		!translationGlossarySharedFieldMatches(term.OrgID, orgIDFromContext(ctx)) {
+		return TranslationGlossaryTerm{}, ErrNotFound
+	}
+	return term, nil
+}
+
+// writableTerm loads a term the caller's scope owns. Terms shared from a
+// wider scope can be read but not changed.
+func (r *TranslationGlossaryPanelRepository) writableTerm(ctx context.Context, id string) (TranslationGlossaryTerm, error) {
+	term, err := r.visibleTerm(ctx, id)
+	if err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	tenantID, orgID := tenantIDFromContext(ctx), orgIDFromContext(ctx)
+	if (tenantID != "" && term.TenantID != tenantID) || (orgID != "" && term.OrgID != orgID) {
+		return TranslationGlossaryTerm{}, ErrForbidden
+	}
+	return term, nil
+}
+
+// translationGlossaryTermFromMap overlays the editable keys present in record
+// onto base. The id and tenant/org scope always come from base.
+func translationGlossaryTermFromMap(base TranslationGlossaryTerm, record map[string]any) TranslationGlossaryTerm {
+	text := map[string]*string{
+		"source_locale":         &base.SourceLocale,
+		"target_locale":         &base.TargetLocale,
+		"content_type":          &base.ContentType,
+		"term":                  &base.Term,
+		"preferred_translation": &base.PreferredTranslation,
+		"notes":                 &base.Notes,
+	}
+	for key, target := range text {
+		if value, ok := record[key]; ok {
+			*target = strings.TrimSpace(toString(value))
+		}
+	}
+	if value, ok := record["case_sensitive"]; ok {
+		base.CaseSensitive = toBool(value)
+	}
+	if value, ok := record["forbidden"]; ok {
+		base.Forbidden = toBool(value)
+	}
+	return normalizeTranslationGlossaryTerm(base)
+}
+
+func translationGlossaryTermToMap(term TranslationGlossaryTerm) map[string]any {
+	return map[string]any{
+		"id":                    term.ID,
+		"tenant_id":             term.TenantID,
+		"org_id":                term.OrgID,
+		"source_locale":         term.SourceLocale,
+		"target_locale":         term.TargetLocale,
+		"content_type":          term.ContentType,
+		"term":                  term.Term,
+		"preferred_translation": term.PreferredTranslation,
+		"notes":                 term.Notes,
+		"case_sensitive":        term.CaseSensitive,
+		"forbidden":             term.Forbidden,
+		"created_at":            term.CreatedAt,
+		"updated_at":            term.UpdatedAt,
+	}
+}
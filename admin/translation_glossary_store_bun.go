@@ -0,0 +1,260 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// BunTranslationGlossaryStore persists glossary terms in the translation_glossary_terms table.
+type BunTranslationGlossaryStore struct {
+	db *bun.DB
+}
+
+var _ TranslationGlossaryStore = (*BunTranslationGlossaryStore)(nil)
+
+func NewBunTranslationGlossaryStore(db *bun.DB) *BunTranslationGlossaryStore {
+	if db == nil {
+		return nil
+	}
+	return &BunTranslationGlossaryStore{db: db}
+}
+
+type bunTranslationGlossaryTermRecord struct {
+	bun.BaseModel `bun:"table:translation_glossary_terms,alias:tgt"`
+
+	ID                   string    `bun:"id,pk" json:"id"`
+	TenantID             string    `bun:"tenant_id" json:"tenant_id"`
+	OrgID                string    `bun:"org_id" json:"org_id"`
+	SourceLocale         string    `bun:"source_locale" json:"source_locale"`
+	TargetLocale         string    `bun:"target_locale" json:"target_locale"`
+	ContentType          string    `bun:"content_type" json:"content_type"`
+	Term                 string    `bun:"term" json:"term"`
+	TermKey              string    `bun:"term_key" json:"term_key"`
+	PreferredTranslation string    `bun:"preferred_translation" json:"preferred_translation"`
+	Notes                string    `bun:"notes" json:"notes"`
+	CaseSensitive        bool      `bun:"case_sensitive" json:"case_sensitive"`
+	Forbidden            bool      `bun:"forbidden" json:"forbidden"`
+	CreatedAt            time.Time `bun:"created_at" json:"created_at"`
+	UpdatedAt            time.Time `bun:"updated_at" json:"updated_at"`
+}
+
+func (s *BunTranslationGlossaryStore) ListTerms(ctx context.Context, filter TranslationGlossaryFilter) ([]TranslationGlossaryTerm, int, error) {
+	if err := s.ready(); err != nil {
+		return nil, 0, err
+	}
+	filter = normalizeTranslationGlossaryFilter(filter)
+	records := []bunTranslationGlossaryTermRecord{}
+	query := s.db.NewSelect().Model(&records).OrderExpr("term_key ASC, target_locale ASC, id ASC")
+	for _, shared := range [][2]string{
+		{"tenant_id", filter.TenantID},
+		{"org_id", filter.OrgID},
+		{"source_locale", filter.SourceLocale},
+		{"content_type", filter.ContentType},
+	} {
+		if shared[1] != "" {
+			query.Where("(? = '' OR ? = ?)", bun.Ident(shared[0]), bun.Ident(shared[0]), shared[1])
+		}
+	}
+	if filter.TargetLocale != "" {
+		query.Where("target_locale = ?", filter.TargetLocale)
+	}
+	if filter.Term != "" {
+		query.Where("term_key = ?", strings.ToLower(filter.Term))
+	}
+	if filter.Search != "" {
+		like := "%" + filter.Search + "%"
+		query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.WhereOr("term_key LIKE ?", like).
+				WhereOr("LOWER(preferred_translation) LIKE ?", like).
+				WhereOr("LOWER(notes) LIKE ?", like)
+		})
+	}
+	if filter.PerPage > 0 {
+		page := max(filter.Page, 1)
+		query.Limit(filter.PerPage).Offset((page - 1) * filter.PerPage)
+	}
+	total, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	terms := make([]TranslationGlossaryTerm, 0, len(records))
+	for _, record := range records {
+		terms = append(terms, translationGlossaryTermFromBunRecord(record))
+	}
+	return terms, total, nil
+}
+
+func (s *BunTranslationGlossaryStore) GetTerm(ctx context.Context, id string) (TranslationGlossaryTerm, error) {
+	if err := s.ready(); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	record := bunTranslationGlossaryTermRecord{}
+	err := s.db.NewSelect().
+		Model(&record).
+		Where("id = ?", strings.TrimSpace(id)).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return TranslationGlossaryTerm{}, ErrNotFound
+	}
+	if err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	return translationGlossaryTermFromBunRecord(record), nil
+}
+
+func (s *BunTranslationGlossaryStore) CreateTerm(ctx context.Context, term TranslationGlossaryTerm) (TranslationGlossaryTerm, error) {
+	if err := s.ready(); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	term = normalizeTranslationGlossaryTerm(term)
+	if err := validateTranslationGlossaryTerm(term); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	if term.ID == "" {
+		term.ID = uuid.NewString()
+	}
+	now := time.Now().UTC()
+	term.CreatedAt = now
+	term.UpdatedAt = now
+	record := bunTranslationGlossaryTermRecordFromTerm(term)
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := s.ensureUniqueKey(ctx, tx, record); err != nil {
+			return err
+		}
+		_, err := tx.NewInsert().Model(&record).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	return term, nil
+}
+
+func (s *BunTranslationGlossaryStore) UpdateTerm(ctx context.Context, term TranslationGlossaryTerm) (TranslationGlossaryTerm, error) {
+	if err := s.ready(); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	term = normalizeTranslationGlossaryTerm(term)
+	if err := validateTranslationGlossaryTerm(term); err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	var updated TranslationGlossaryTerm
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		current := bunTranslationGlossaryTermRecord{}
+		err := tx.NewSelect().Model(&current).Where("id = ?", term.ID).Limit(1).Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		term.CreatedAt = current.CreatedAt
+		term.UpdatedAt = time.Now().UTC()
+		record := bunTranslationGlossaryTermRecordFromTerm(term)
+		if err := s.ensureUniqueKey(ctx, tx, record); err != nil {
+			return err
+		}
+		if _, err := tx.NewUpdate().Model(&record).ExcludeColumn("id", "created_at").WherePK().Exec(ctx); err != nil {
+			return err
+		}
+		updated = translationGlossaryTermFromBunRecord(record)
+		return nil
+	})
+	if err != nil {
+		return TranslationGlossaryTerm{}, err
+	}
+	return updated, nil
+}
+
+func (s *BunTranslationGlossaryStore) DeleteTerm(ctx context.Context, id string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	result, err := s.db.NewDelete().
+		Model((*bunTranslationGlossaryTermRecord)(nil)).
+		Where("id = ?", strings.TrimSpace(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ensureUniqueKey reports a domain conflict before the unique index rejects the write.
+func (s *BunTranslationGlossaryStore) ensureUniqueKey(ctx context.Context, tx bun.Tx, record bunTranslationGlossaryTermRecord) error {
+	exists, err := tx.NewSelect().
+		Model((*bunTranslationGlossaryTermRecord)(nil)).
+		Where("tenant_id = ?", record.TenantID).
+		Where("org_id = ?", record.OrgID).
+		Where("source_locale = ?", record.SourceLocale).
+		Where("target_locale = ?", record.TargetLocale).
+		Where("content_type = ?", record.ContentType).
+		Where("term_key = ?", record.TermKey).
+		Where("id <> ?", record.ID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return translationGlossaryDuplicateError(translationGlossaryTermFromBunRecord(record))
+	}
+	return nil
+}
+
+func (s *BunTranslationGlossaryStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("translation glossary store", map[string]any{
+			"component": "translation_glossary_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunTranslationGlossaryTermRecordFromTerm(term TranslationGlossaryTerm) bunTranslationGlossaryTermRecord {
+	return bunTranslationGlossaryTermRecord{
+		ID:                   term.ID,
+		TenantID:             term.TenantID,
+		OrgID:                term.OrgID,
+		SourceLocale:         term.SourceLocale,
+		TargetLocale:         term.TargetLocale,
+		ContentType:          term.ContentType,
+		Term:                 term.Term,
+		TermKey:              strings.ToLower(term.Term),
+		PreferredTranslation: term.PreferredTranslation,
+		Notes:                term.Notes,
+		CaseSensitive:        term.CaseSensitive,
+		Forbidden:            term.Forbidden,
+		CreatedAt:            term.CreatedAt,
+		UpdatedAt:            term.UpdatedAt,
+	}
+}
+
+func translationGlossaryTermFromBunRecord(record bunTranslationGlossaryTermRecord) TranslationGlossaryTerm {
+	return TranslationGlossaryTerm{
+		ID:                   record.ID,
+		TenantID:             record.TenantID,
+		OrgID:                record.OrgID,
+		SourceLocale:         record.SourceLocale,
+		TargetLocale:         record.TargetLocale,
+		ContentType:          record.ContentType,
+		Term:                 record.Term,
+		PreferredTranslation: record.PreferredTranslation,
+		Notes:                record.Notes,
+		CaseSensitive:        record.CaseSensitive,
+		Forbidden:            record.Forbidden,
+		CreatedAt:            record.CreatedAt.UTC(),
+		UpdatedAt:            record.UpdatedAt.UTC(),
+	}
+}
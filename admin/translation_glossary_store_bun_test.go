@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunTranslationGlossaryStoreScopesTermsAndRejectsDuplicates(t *testing.T) {
+	ctx := context.Background()
+	store := NewBunTranslationGlossaryStore(setupMigratedSQLite(t, admindata.TranslationGlossaryMigrations(), "0018_translation_glossary_terms.up.sql"))
+
+	shared, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TargetLocale: "FR", Term: "Home", PreferredTranslation: "accueil", CaseSensitive: true})
+	if err != nil {
+		t.Fatalf("create shared: %v", err)
+	}
+	if shared.ID == "" || shared.TargetLocale != "fr" || shared.CreatedAt.IsZero() {
+		t.Fatalf("expected normalized term with id and timestamps, got %+v", shared)
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TenantID: "acme", TargetLocale: "fr", ContentType: "posts", Term: "post", PreferredTranslation: "article"}); err != nil {
+		t.Fatalf("create scoped: %v", err)
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TenantID: "other", TargetLocale: "fr", Term: "post", PreferredTranslation: "billet"}); err != nil {
+		t.Fatalf("create other tenant: %v", err)
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TargetLocale: "fr", Term: "HOME", PreferredTranslation: "maison"}); err == nil {
+		t.Fatal("expected duplicate term conflict")
+	}
+
+	terms, total, err := store.ListTerms(ctx, TranslationGlossaryFilter{TenantID: "acme", TargetLocale: "fr", ContentType: "posts"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if total != 2 || terms[0].Term != "Home" || !terms[0].CaseSensitive || terms[1].PreferredTranslation != "article" {
+		t.Fatalf("expected shared and tenant terms only, got %d %+v", total, terms)
+	}
+	if terms, _, _ := store.ListTerms(ctx, TranslationGlossaryFilter{Search: "BILL"}); len(terms) != 1 || terms[0].TenantID != "other" {
+		t.Fatalf("expected case-insensitive search, got %+v", terms)
+	}
+	if terms, total, _ := store.ListTerms(ctx, TranslationGlossaryFilter{PerPage: 1, Page: 2}); total != 3 || len(terms) != 1 {
+		t.Fatalf("expected paginated listing with full total, got %d %+v", total, terms)
+	}
+
+	shared.PreferredTranslation = "page d'accueil"
+	updated, err := store.UpdateTerm(ctx, shared)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.PreferredTranslation != "page d'accueil" || updated.CreatedAt.Unix() != shared.CreatedAt.Unix() {
+		t.Fatalf("expected update to keep created_at, got %+v", updated)
+	}
+	if _, err := store.UpdateTerm(ctx, TranslationGlossaryTerm{ID: "missing", TargetLocale: "fr", Term: "x", PreferredTranslation: "y"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for unknown term, got %v", err)
+	}
+	if err := store.DeleteTerm(ctx, shared.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.GetTerm(ctx, shared.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+	if err := store.DeleteTerm(ctx, shared.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found deleting twice, got %v", err)
+	}
+}
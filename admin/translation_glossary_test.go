@@ -0,0 +1,284 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	translationservices "github.com/goliatone/go-admin/translations/services"
+)
+
+func translationGlossaryFixtureTerms() []TranslationGlossaryTerm {
+	return []TranslationGlossaryTerm{
+		{SourceLocale: "en", TargetLocale: "fr", ContentType: "pages", Term: "Home", PreferredTranslation: "Accueil", Notes: "Navigation label", CaseSensitive: true},
+		{SourceLocale: "en", TargetLocale: "fr", Term: "publish", PreferredTranslation: "publier"},
+		{SourceLocale: "en", TargetLocale: "fr", Term: "domicile", PreferredTranslation: "accueil", Notes: "Legacy wording", Forbidden: true},
+	}
+}
+
+func normalizedTranslationGlossaryFixtureTerms() []TranslationGlossaryTerm {
+	terms := translationGlossaryFixtureTerms()
+	for i := range terms {
+		terms[i] = normalizeTranslationGlossaryTerm(terms[i])
+	}
+	return terms
+}
+
+func TestInMemoryTranslationGlossaryStoreScopesTermsAndRejectsDuplicates(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryTranslationGlossaryStore()
+	shared, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TargetLocale: "FR", Term: "home", PreferredTranslation: "accueil"})
+	if err != nil {
+		t.Fatalf("create shared: %v", err)
+	}
+	if shared.TargetLocale != "fr" || shared.ID == "" {
+		t.Fatalf("expected normalized term with id, got %+v", shared)
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TenantID: "acme", TargetLocale: "fr", ContentType: "posts", Term: "post", PreferredTranslation: "article"}); err != nil {
+		t.Fatalf("create scoped: %v", err)
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TenantID: "other", TargetLocale: "fr", Term: "post", PreferredTranslation: "billet"}); err != nil {
+		t.Fatalf("create other tenant: %v", err)
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TargetLocale: "fr", Term: "HOME", PreferredTranslation: "maison"}); err == nil {
+		t.Fatal("expected duplicate term conflict")
+	}
+	if _, err := store.CreateTerm(ctx, TranslationGlossaryTerm{TargetLocale: "fr", Term: "draft"}); err == nil {
+		t.Fatal("expected preferred_translation to be required for regular terms")
+	}
+
+	terms, total, err := store.ListTerms(ctx, TranslationGlossaryFilter{TenantID: "acme", TargetLocale: "fr", ContentType: "posts"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if total != 2 || terms[0].Term != "home" || terms[1].PreferredTranslation != "article" {
+		t.Fatalf("expected shared and tenant terms only, got %d %+v", total, terms)
+	}
+	if terms, _, _ := store.ListTerms(ctx, TranslationGlossaryFilter{TenantID: "acme", ContentType: "pages"}); len(terms) != 1 {
+		t.Fatalf("expected content type filter to exclude posts terms, got %+v", terms)
+	}
+
+	shared.PreferredTranslation = "page d'accueil"
+	if _, err := store.UpdateTerm(ctx, shared); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := store.DeleteTerm(ctx, shared.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.GetTerm(ctx, shared.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestTranslationEditorGlossaryTermsFallsBackToStarterGlossary(t *testing.T) {
+	editorCtx := translationEditorContext{
+		SourceVariant: translationservices.FamilyVariant{Locale: "en"},
+		TargetVariant: translationservices.FamilyVariant{Locale: "fr"},
+	}
+	terms := translationEditorGlossaryTerms(context.Background(), nil, "", "", editorCtx)
+	if len(terms) != 3 {
+		t.Fatalf("expected starter fr glossary, got %+v", terms)
+	}
+	store := NewInMemoryTranslationGlossaryStore(translationGlossaryFixtureTerms()...)
+	editorCtx.Family.ContentType = "posts"
+	terms = translationEditorGlossaryTerms(context.Background(), store, "acme", "", editorCtx)
+	if len(terms) != 2 {
+		t.Fatalf("expected pages-only term to be excluded for posts, got %+v", terms)
+	}
+}
+
+func TestTranslationGlossaryQAFindingsRespectCaseAndForbiddenRules(t *testing.T) {
+	editorCtx := translationEditorContext{
+		SourceVariant: translationservices.FamilyVariant{Locale: "en"},
+		TargetVariant: translationservices.FamilyVariant{Locale: "fr"},
+		SourceFields: map[string]string{
+			"title":   "Home",
+			"summary": "home and publish",
+		},
+		TargetFields: map[string]string{
+			"title":   "accueil",
+			"summary": "retour au domicile et PUBLIER",
+		},
+		GlossaryTerms: normalizedTranslationGlossaryFixtureTerms(),
+	}
+
+	matches := translationEditorGlossaryMatches(editorCtx)
+	matchKeys := []string{}
+	for _, match := range matches {
+		matchKeys = append(matchKeys, toString(match["term"])+"@"+strings.Join(toStringSlice(match["field_paths"]), ","))
+	}
+	sort.Strings(matchKeys)
+	if !reflect.DeepEqual(matchKeys, []string{"Home@title", "publish@summary"}) {
+		t.Fatalf("expected case-sensitive Home to match title only, got %v", matchKeys)
+	}
+
+	findings := translationTerminologyQAFindings(editorCtx)
+	byID := map[string]map[string]any{}
+	for _, finding := range findings {
+		byID[toString(finding["id"])] = finding
+	}
+	if len(findings) != 2 {
+		t.Fatalf("expected case-sensitive warning and forbidden blocker, got %+v", findings)
+	}
+	if finding := byID["terminology:title:home"]; toString(finding["severity"]) != translationQASeverityWarning {
+		t.Fatalf("expected case-sensitive preferred translation warning, got %+v", findings)
+	}
+	forbidden := byID["terminology:summary:forbidden:domicile"]
+	if toString(forbidden["severity"]) != translationQASeverityBlocker || !toBool(forbidden["forbidden"]) {
+		t.Fatalf("expected forbidden term blocker, got %+v", findings)
+	}
+}
+
+func TestTranslationGlossaryCodecsRoundTripTerms(t *testing.T) {
+	terms := normalizedTranslationGlossaryFixtureTerms()
+	rawCSV, err := encodeTranslationGlossaryCSV(terms)
+	if err != nil {
+		t.Fatalf("encode csv: %v", err)
+	}
+	fromCSV, err := parseTranslationGlossaryCSV(bytes.NewReader(rawCSV))
+	if err != nil {
+		t.Fatalf("parse csv: %v\n%s", err, rawCSV)
+	}
+	if !reflect.DeepEqual(fromCSV, terms) {
+		t.Fatalf("expected csv round-trip\nwant %+v\ngot  %+v", terms, fromCSV)
+	}
+
+	rawTBX, err := encodeTranslationGlossaryTBX(terms)
+	if err != nil {
+		t.Fatalf("encode tbx: %v", err)
+	}
+	if !bytes.Contains(rawTBX, []byte(`<tbx xmlns="`+translationGlossaryTBXNamespace+`"`)) || !bytes.Contains(rawTBX, []byte(`xml:lang="fr"`)) {
+		t.Fatalf("expected TBX root and language sections, got %s", rawTBX)
+	}
+	fromTBX, err := parseTranslationGlossaryTBX(bytes.NewReader(rawTBX))
+	if err != nil {
+		t.Fatalf("parse tbx: %v\n%s", err, rawTBX)
+	}
+	if !reflect.DeepEqual(fromTBX, terms) {
+		t.Fatalf("expected tbx round-trip\nwant %+v\ngot  %+v\n%s", terms, fromTBX, rawTBX)
+	}
+}
+
+func TestParseTranslationGlossaryTBXReadsLegacyTermEntries(t *testing.T) {
+	payload := `<?xml version="1.0"?>
+<martif type="TBX" xml:lang="en">
+  <text><body>
+    <termEntry id="c1">
+      <descrip type="subjectField">pages</descrip>
+      <langSet xml:lang="en"><tig><term>sign in</term></tig></langSet>
+      <langSet xml:lang="de">
+        <tig><term>anmelden</term><termNote type="administrativeStatus">preferredTerm-admn-sts</termNote></tig>
+        <tig><term>einloggen</term><termNote type="administrativeStatus">deprecatedTerm-admn-sts</termNote></tig>
+      </langSet>
+    </termEntry>
+  </body></text>
+</martif>`
+	terms, err := parseTranslationGlossaryTBX(strings.NewReader(payload))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(terms) != 2 {
+		t.Fatalf("expected preferred and forbidden terms, got %+v", terms)
+	}
+	if terms[0].Term != "sign in" || terms[0].PreferredTranslation != "anmelden" || terms[0].ContentType != "pages" || terms[0].TargetLocale != "de" {
+		t.Fatalf("unexpected preferred term %+v", terms[0])
+	}
+	if !terms[1].Forbidden || terms[1].Term != "einloggen" || terms[1].PreferredTranslation != "anmelden" {
+		t.Fatalf("unexpected forbidden term %+v", terms[1])
+	}
+}
+
+func TestParseTranslationGlossaryCSVReportsInvalidRows(t *testing.T) {
+	_, err := parseTranslationGlossaryCSV(strings.NewReader("term,target_locale\nhome,\n"))
+	if err == nil || !strings.Contains(err.Error(), "target_locale") {
+		t.Fatalf("expected missing target_locale error, got %v", err)
+	}
+	if _, err := parseTranslationGlossaryCSV(strings.NewReader("preferred_translation\naccueil\n")); err == nil {
+		t.Fatal("expected missing term column error")
+	}
+}
+
+func TestImportTranslationGlossaryTermsUpsertsWithinScope(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryTranslationGlossaryStore(TranslationGlossaryTerm{TargetLocale: "fr", Term: "home", PreferredTranslation: "accueil"})
+	first, err := importTranslationGlossaryTerms(ctx, store, "acme", "", []TranslationGlossaryTerm{
+		{TargetLocale: "fr", Term: "home", PreferredTranslation: "page d'accueil"},
+		{TargetLocale: "fr", Term: "publish", PreferredTranslation: "publier"},
+	})
+	if err != nil {
+		t.Fatalf("first import: %v", err)
+	}
+	if first.Created != 2 || first.Updated != 0 {
+		t.Fatalf("expected tenant terms created alongside shared term, got %+v", first)
+	}
+	second, err := importTranslationGlossaryTerms(ctx, store, "acme", "", []TranslationGlossaryTerm{
+		{TargetLocale: "fr", Term: "Publish", PreferredTranslation: "mettre en ligne"},
+	})
+	if err != nil {
+		t.Fatalf("second import: %v", err)
+	}
+	if second.Created != 0 || second.Updated != 1 {
+		t.Fatalf("expected existing tenant term updated, got %+v", second)
+	}
+	terms, total, _ := store.ListTerms(ctx, TranslationGlossaryFilter{TenantID: "acme", Term: "publish"})
+	if total != 1 || terms[0].PreferredTranslation != "mettre en ligne" || terms[0].TenantID != "acme" {
+		t.Fatalf("expected updated tenant term, got %+v", terms)
+	}
+}
+
+func TestTranslationGlossaryPanelRepositoryEnforcesScope(t *testing.T) {
+	store := NewInMemoryTranslationGlossaryStore(
+		TranslationGlossaryTerm{ID: "shared", TargetLocale: "fr", Term: "home", PreferredTranslation: "accueil"},
+		TranslationGlossaryTerm{ID: "other", TenantID: "other", TargetLocale: "fr", Term: "post", PreferredTranslation: "billet"},
+	)
+	repo := NewTranslationGlossaryPanelRepository(store)
+	acme := withAdminRouterIdentity(context.Background(), adminRouterIdentity{tenantID: "acme"})
+
+	created, err := repo.Create(acme, map[string]any{
+		"tenant_id":             "other",
+		"org_id":                "other-org",
+		"target_locale":         "fr",
+		"term":                  "post",
+		"preferred_translation": "article",
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created["tenant_id"] != "acme" || created["org_id"] != "" {
+		t.Fatalf("expected scope taken from the context, got %+v", created)
+	}
+	id, _ := created["id"].(string)
+	if _, err := repo.Update(acme, id, map[string]any{"tenant_id": "other", "preferred_translation": "billet"}); err != nil {
+		t.Fatalf("update own term: %v", err)
+	}
+	if term, _ := store.GetTerm(context.Background(), id); term.TenantID != "acme" || term.PreferredTranslation != "billet" {
+		t.Fatalf("expected payload scope ignored on update, got %+v", term)
+	}
+
+	if _, err := repo.Get(acme, "other"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected another tenant's term hidden, got %v", err)
+	}
+	if _, err := repo.Update(acme, "other", map[string]any{"preferred_translation": "x"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected another tenant's term not updatable, got %v", err)
+	}
+	if err := repo.Delete(acme, "other"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected another tenant's term not deletable, got %v", err)
+	}
+
+	if _, err := repo.Get(acme, "shared"); err != nil {
+		t.Fatalf("expected shared term readable, got %v", err)
+	}
+	if _, err := repo.Update(acme, "shared", map[string]any{"preferred_translation": "maison"}); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected shared term read-only for tenant users, got %v", err)
+	}
+	if err := repo.Delete(acme, "shared"); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected shared term not deletable by tenant users, got %v", err)
+	}
+	if _, err := repo.Update(context.Background(), "shared", map[string]any{"preferred_translation": "page d'accueil"}); err != nil {
+		t.Fatalf("expected unscoped callers to manage shared terms, got %v", err)
+	}
+}
@@ -0,0 +1,565 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	"github.com/google/uuid"
+)
+
+const (
+	translationMemoryDefaultMinScore      = 0.7
+	translationMemoryDefaultLimit         = 5
+	translationMemoryCandidateLimit       = 500
+	translationMemoryMinSegmentRunes      = 8
+	translationMemoryOriginImport         = "tmx"
+	translationMemoryMatchTypeField       = "field"
+	translationMemoryMatchTypeSegment     = "segment"
+	translationMemorySuggestionSourceName = "translation_memory"
+)
+
+// TranslationMemoryUnit is one source/target segment pair for a locale pair.
+// Units are scoped exactly by TenantID and OrgID; empty values form their own
+// global scope rather than matching every tenant.
+type TranslationMemoryUnit struct {
+	ID           string    `json:"id"`
+	TenantID     string    `json:"tenant_id,omitempty"`
+	OrgID        string    `json:"org_id,omitempty"`
+	SourceLocale string    `json:"source_locale"`
+	TargetLocale string    `json:"target_locale"`
+	SourceText   string    `json:"source_text"`
+	TargetText   string    `json:"target_text"`
+	Origin       string    `json:"origin,omitempty"`
+	Note         string    `json:"note,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TranslationMemoryFilter narrows unit listings. Empty locale and search
+// fields are unconstrained; TenantID and OrgID always match exactly.
+type TranslationMemoryFilter struct {
+	TenantID     string `json:"tenant_id"`
+	OrgID        string `json:"org_id"`
+	SourceLocale string `json:"source_locale"`
+	TargetLocale string `json:"target_locale"`
+	Search       string `json:"search"`
+	Page         int    `json:"page"`
+	PerPage      int    `json:"per_page"`
+}
+
+// TranslationMemoryCandidateQuery selects units that may fuzzily match a
+// segment. Stores should return the most recently updated units first.
+type TranslationMemoryCandidateQuery struct {
+	TenantID     string
+	OrgID        string
+	SourceLocale string
+	TargetLocale string
+	// SourceHash, when set, restricts candidates to units whose normalized
+	// source text has this hash, so exact matches are found regardless of
+	// how many other units share the length window.
+	SourceHash string
+	// MinLength and MaxLength bound the normalized source length in runes.
+	MinLength int
+	MaxLength int
+	Limit     int
+}
+
+// TranslationMemoryImportResult summarizes a translation memory import.
+type TranslationMemoryImportResult struct {
+	Format  string `json:"format"`
+	Total   int    `json:"total"`
+	Created int    `json:"created"`
+	Updated int    `json:"updated"`
+	Skipped int    `json:"skipped"`
+}
+
+// TranslationMemoryStore persists translation memory units.
+type TranslationMemoryStore interface {
+	ListUnits(context.Context, TranslationMemoryFilter) ([]TranslationMemoryUnit, int, error)
+	CandidateUnits(context.Context, TranslationMemoryCandidateQuery) ([]TranslationMemoryUnit, error)
+	// UpsertUnits creates units and refreshes existing ones with the same
+	// scope, locale pair, source and target text.
+	UpsertUnits(context.Context, []TranslationMemoryUnit) (TranslationMemoryImportResult, error)
+	DeleteUnit(context.Context, string) error
+}
+
+// TranslationMemoryLookupInput requests fuzzy matches for editor fields.
+type TranslationMemoryLookupInput struct {
+	TenantID     string
+	OrgID        string
+	SourceLocale string
+	TargetLocale string
+	FieldSources map[string]string
+	// MinScore is the lowest similarity (0-1) returned. Zero uses 0.7.
+	MinScore float64
+	// Limit caps matches per field. Zero uses 5.
+	Limit int
+}
+
+// TranslationMemoryMatch is a fuzzy match for a whole field or one of its sentences.
+type TranslationMemoryMatch struct {
+	Unit      TranslationMemoryUnit `json:"unit"`
+	FieldPath string                `json:"field_path"`
+	// QueryText is the field text or sentence the unit was matched against.
+	QueryText    string  `json:"query_text"`
+	MatchType    string  `json:"match_type"`
+	SegmentIndex int     `json:"segment_index"`
+	Score        float64 `json:"score"`
+	MatchPercent int     `json:"match_percent"`
+}
+
+// LookupTranslationMemory fuzzily matches every field, and each sentence of
+// multi-sentence fields, against the memory for the input scope and locale pair.
+func LookupTranslationMemory(ctx context.Context, store TranslationMemoryStore, input TranslationMemoryLookupInput) ([]TranslationMemoryMatch, error) {
+	if store == nil {
+		return nil, nil
+	}
+	input = normalizeTranslationMemoryLookupInput(input)
+	if input.SourceLocale == "" || input.TargetLocale == "" || len(input.FieldSources) == 0 {
+		return nil, nil
+	}
+	paths := make([]string, 0, len(input.FieldSources))
+	for path := range input.FieldSources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	out := []TranslationMemoryMatch{}
+	for _, path := range paths {
+		fieldMatches := []TranslationMemoryMatch{}
+		text := input.FieldSources[path]
+		queries := []TranslationMemoryMatch{{FieldPath: path, QueryText: text, MatchType: translationMemoryMatchTypeField}}
+		if segments := segmentTranslationMemoryText(text); len(segments) > 1 {
+			for index, segment := range segments {
+				queries = append(queries, TranslationMemoryMatch{
+					FieldPath:    path,
+					QueryText:    segment,
+					MatchType:    translationMemoryMatchTypeSegment,
+					SegmentIndex: index,
+				})
+			}
+		}
+		for _, query := range queries {
+			matches, err := translationMemoryMatchesForQuery(ctx, store, input, query)
+			if err != nil {
+				return nil, err
+			}
+			fieldMatches = append(fieldMatches, matches...)
+		}
+		sort.SliceStable(fieldMatches, func(i, j int) bool {
+			if fieldMatches[i].Score != fieldMatches[j].Score {
+				return fieldMatches[i].Score > fieldMatches[j].Score
+			}
+			return fieldMatches[i].MatchType == translationMemoryMatchTypeField && fieldMatches[j].MatchType != translationMemoryMatchTypeField
+		})
+		if len(fieldMatches) > input.Limit {
+			fieldMatches = fieldMatches[:input.Limit]
+		}
+		out = append(out, fieldMatches...)
+	}
+	return out, nil
+}
+
+// translationMemoryMatchesForQuery looks up exact source matches by hash
+// first, then scores the most recent units in the length window. The fuzzy
+// pass is capped, so the exact lookup keeps 100% matches from being missed in
+// large memories.
+func translationMemoryMatchesForQuery(ctx context.Context, store TranslationMemoryStore, input TranslationMemoryLookupInput, query TranslationMemoryMatch) ([]TranslationMemoryMatch, error) {
+	normalized := normalizeTranslationMemoryText(query.QueryText)
+	length := utf8.RuneCountInString(normalized)
+	if length == 0 {
+		return nil, nil
+	}
+	scope := TranslationMemoryCandidateQuery{
+		TenantID:     input.TenantID,
+		OrgID:        input.OrgID,
+		SourceLocale: input.SourceLocale,
+		TargetLocale: input.TargetLocale,
+		Limit:        translationMemoryCandidateLimit,
+	}
+	exact := scope
+	exact.SourceHash = translationMemoryTextHash(query.QueryText)
+	exactCandidates, err := store.CandidateUnits(ctx, exact)
+	if err != nil {
+		return nil, err
+	}
+	fuzzy := scope
+	fuzzy.MinLength = int(math.Floor(float64(length) * input.MinScore))
+	fuzzy.MaxLength = int(math.Ceil(float64(length) / input.MinScore))
+	fuzzyCandidates, err := store.CandidateUnits(ctx, fuzzy)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	out := []TranslationMemoryMatch{}
+	for _, unit := range append(exactCandidates, fuzzyCandidates...) {
+		score := translationMemorySimilarity(normalized, normalizeTranslationMemoryText(unit.SourceText))
+		if score < input.MinScore {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(unit.TargetText))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		match := query
+		match.Unit = unit
+		match.Score = score
+		match.MatchPercent = int(math.Floor(score * 100))
+		out = append(out, match)
+	}
+	return out, nil
+}
+
+// translationMemorySimilarity returns 1 - levenshtein/maxLength over runes of
+// normalized text. A cheap trigram overlap check skips unrelated candidates.
+func translationMemorySimilarity(left, right string) float64 {
+	if left == right {
+		return 1
+	}
+	a := []rune(left)
+	b := []rune(right)
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 1
+	}
+	if len(a) >= 3 && len(b) >= 3 && translationMemoryTrigramDice(a, b) < 0.2 {
+		return 0
+	}
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return 1 - float64(previous[len(b)])/float64(longest)
+}
+
+func translationMemoryTrigramDice(a, b []rune) float64 {
+	grams := map[string]int{}
+	for i := 0; i+3 <= len(a); i++ {
+		grams[string(a[i:i+3])]++
+	}
+	shared := 0
+	for i := 0; i+3 <= len(b); i++ {
+		gram := string(b[i : i+3])
+		if grams[gram] > 0 {
+			grams[gram]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)-2+len(b)-2)
+}
+
+// segmentTranslationMemoryText splits text into sentences on terminal
+// punctuation followed by whitespace (or CJK full stops) and on line breaks.
+// Fragments shorter than a few characters are merged into the previous sentence.
+func segmentTranslationMemoryText(text string) []string {
+	segments := []string{}
+	var current strings.Builder
+	flush := func() {
+		segment := strings.TrimSpace(current.String())
+		current.Reset()
+		if segment == "" {
+			return
+		}
+		if len(segments) > 0 && utf8.RuneCountInString(segment) < translationMemoryMinSegmentRunes {
+			segments[len(segments)-1] += " " + segment
+			return
+		}
+		segments = append(segments, segment)
+	}
+	runes := []rune(text)
+	for i, r := range runes {
+		if r == '\n' {
+			flush()
+			continue
+		}
+		current.WriteRune(r)
+		switch r {
+		case '。', '！', '？':
+			flush()
+		case '.', '!', '?':
+			if i+1 == len(runes) || unicode.IsSpace(runes[i+1]) {
+				flush()
+			}
+		}
+	}
+	flush()
+	return segments
+}
+
+func normalizeTranslationMemoryText(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
+
+func translationMemoryTextHash(text string) string {
+	sum := sha256.Sum256([]byte(normalizeTranslationMemoryText(text)))
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeTranslationMemoryLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+func normalizeTranslationMemoryUnit(unit TranslationMemoryUnit) TranslationMemoryUnit {
+	unit.ID = strings.TrimSpace(unit.ID)
+	unit.TenantID = strings.TrimSpace(unit.TenantID)
+	unit.OrgID = strings.TrimSpace(unit.OrgID)
+	unit.SourceLocale = normalizeTranslationMemoryLocale(unit.SourceLocale)
+	unit.TargetLocale = normalizeTranslationMemoryLocale(unit.TargetLocale)
+	unit.SourceText = strings.TrimSpace(unit.SourceText)
+	unit.TargetText = strings.TrimSpace(unit.TargetText)
+	unit.Origin = strings.TrimSpace(unit.Origin)
+	unit.Note = strings.TrimSpace(unit.Note)
+	return unit
+}
+
+func normalizeTranslationMemoryFilter(filter TranslationMemoryFilter) TranslationMemoryFilter {
+	filter.TenantID = strings.TrimSpace(filter.TenantID)
+	filter.OrgID = strings.TrimSpace(filter.OrgID)
+	filter.SourceLocale = normalizeTranslationMemoryLocale(filter.SourceLocale)
+	filter.TargetLocale = normalizeTranslationMemoryLocale(filter.TargetLocale)
+	filter.Search = strings.ToLower(strings.TrimSpace(filter.Search))
+	return filter
+}
+
+func normalizeTranslationMemoryLookupInput(input TranslationMemoryLookupInput) TranslationMemoryLookupInput {
+	input.TenantID = strings.TrimSpace(input.TenantID)
+	input.OrgID = strings.TrimSpace(input.OrgID)
+	input.SourceLocale = normalizeTranslationMemoryLocale(input.SourceLocale)
+	input.TargetLocale = normalizeTranslationMemoryLocale(input.TargetLocale)
+	if input.MinScore <= 0 || input.MinScore > 1 {
+		input.MinScore = translationMemoryDefaultMinScore
+	}
+	if input.Limit <= 0 {
+		input.Limit = translationMemoryDefaultLimit
+	}
+	fields := map[string]string{}
+	for path, value := range input.FieldSources {
+		path = strings.TrimSpace(path)
+		value = strings.TrimSpace(value)
+		if path != "" && value != "" {
+			fields[path] = value
+		}
+	}
+	input.FieldSources = fields
+	return input
+}
+
+func validateTranslationMemoryUnit(unit TranslationMemoryUnit) error {
+	for field, value := range map[string]string{
+		"source_locale": unit.SourceLocale,
+		"target_locale": unit.TargetLocale,
+		"source_text":   unit.SourceText,
+		"target_text":   unit.TargetText,
+	} {
+		if value == "" {
+			return requiredFieldDomainError(field, map[string]any{"component": "translation_memory"})
+		}
+	}
+	return nil
+}
+
+// translationMemoryUnitKey identifies a unit within its scope and locale pair.
+func translationMemoryUnitKey(unit TranslationMemoryUnit) string {
+	return strings.Join([]string{
+		unit.TenantID,
+		unit.OrgID,
+		unit.SourceLocale,
+		unit.TargetLocale,
+		translationMemoryTextHash(unit.SourceText),
+		translationMemoryTextHash(unit.TargetText),
+	}, "|")
+}
+
+// InMemoryTranslationMemoryStore keeps translation memory units in process memory.
+type InMemoryTranslationMemoryStore struct {
+	mu    sync.RWMutex
+	units map[string]TranslationMemoryUnit
+	keys  map[string]string
+}
+
+var _ TranslationMemoryStore = (*InMemoryTranslationMemoryStore)(nil)
+
+// NewInMemoryTranslationMemoryStore builds an empty memory store.
+func NewInMemoryTranslationMemoryStore() *InMemoryTranslationMemoryStore {
+	return &InMemoryTranslationMemoryStore{
+		units: map[string]TranslationMemoryUnit{},
+		keys:  map[string]string{},
+	}
+}
+
+func resolveTranslationMemoryStore(store TranslationMemoryStore) TranslationMemoryStore {
+	if store != nil {
+		return store
+	}
+	return NewInMemoryTranslationMemoryStore()
+}
+
+func (s *InMemoryTranslationMemoryStore) ListUnits(_ context.Context, filter TranslationMemoryFilter) ([]TranslationMemoryUnit, int, error) {
+	if s == nil {
+		return nil, 0, serviceNotConfiguredDomainError("translation memory store", map[string]any{"component": "translation_memory"})
+	}
+	filter = normalizeTranslationMemoryFilter(filter)
+	s.mu.RLock()
+	items := []TranslationMemoryUnit{}
+	for _, unit := range s.units {
+		if unit.TenantID != filter.TenantID || unit.OrgID != filter.OrgID {
+			continue
+		}
+		if filter.SourceLocale != "" && unit.SourceLocale != filter.SourceLocale {
+			continue
+		}
+		if filter.TargetLocale != "" && unit.TargetLocale != filter.TargetLocale {
+			continue
+		}
+		if filter.Search != "" && !strings.Contains(strings.ToLower(unit.SourceText+" "+unit.TargetText), filter.Search) {
+			continue
+		}
+		items = append(items, unit)
+	}
+	s.mu.RUnlock()
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].SourceLocale != items[j].SourceLocale || items[i].TargetLocale != items[j].TargetLocale {
+			return items[i].SourceLocale+items[i].TargetLocale < items[j].SourceLocale+items[j].TargetLocale
+		}
+		return items[i].ID < items[j].ID
+	})
+	page, total := paginateInMemory(items, ListOptions{Page: filter.Page, PerPage: filter.PerPage}, len(items))
+	return append([]TranslationMemoryUnit{}, page...), total, nil
+}
+
+func (s *InMemoryTranslationMemoryStore) CandidateUnits(_ context.Context, query TranslationMemoryCandidateQuery) ([]TranslationMemoryUnit, error) {
+	if s == nil {
+		return nil, serviceNotConfiguredDomainError("translation memory store", map[string]any{"component": "translation_memory"})
+	}
+	sourceLocale := normalizeTranslationMemoryLocale(query.SourceLocale)
+	targetLocale := normalizeTranslationMemoryLocale(query.TargetLocale)
+	sourceHash := strings.TrimSpace(query.SourceHash)
+	s.mu.RLock()
+	items := []TranslationMemoryUnit{}
+	for _, unit := range s.units {
+		if unit.TenantID != strings.TrimSpace(query.TenantID) || unit.OrgID != strings.TrimSpace(query.OrgID) ||
+			unit.SourceLocale != sourceLocale || unit.TargetLocale != targetLocale {
+			continue
+		}
+		if sourceHash != "" && translationMemoryTextHash(unit.SourceText) != sourceHash {
+			continue
+		}
+		length := utf8.RuneCountInString(normalizeTranslationMemoryText(unit.SourceText))
+		if length < query.MinLength || (query.MaxLength > 0 && length > query.MaxLength) {
+			continue
+		}
+		items = append(items, unit)
+	}
+	s.mu.RUnlock()
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].UpdatedAt.Equal(items[j].UpdatedAt) {
+			return items[i].UpdatedAt.After(items[j].UpdatedAt)
+		}
+		return items[i].ID < items[j].ID
+	})
+	if query.Limit > 0 && len(items) > query.Limit {
+		items = items[:query.Limit]
+	}
+	return items, nil
+}
+
+func (s *InMemoryTranslationMemoryStore) UpsertUnits(_ context.Context, units []TranslationMemoryUnit) (TranslationMemoryImportResult, error) {
+	result := TranslationMemoryImportResult{Total: len(units)}
+	if s == nil {
+		return result, serviceNotConfiguredDomainError("translation memory store", map[string]any{"component": "translation_memory"})
+	}
+	now := time.Now().UTC()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, unit := range units {
+		unit = normalizeTranslationMemoryUnit(unit)
+		if validateTranslationMemoryUnit(unit) != nil {
+			result.Skipped++
+			continue
+		}
+		key := translationMemoryUnitKey(unit)
+		if id, ok := s.keys[key]; ok {
+			existing := s.units[id]
+			existing.Origin = unit.Origin
+			existing.Note = unit.Note
+			existing.UpdatedAt = now
+			s.units[id] = existing
+			result.Updated++
+			continue
+		}
+		if unit.ID == "" {
+			unit.ID = uuid.NewString()
+		}
+		if unit.CreatedAt.IsZero() {
+			unit.CreatedAt = now
+		}
+		unit.UpdatedAt = now
+		s.units[unit.ID] = unit
+		s.keys[key] = unit.ID
+		result.Created++
+	}
+	return result, nil
+}
+
+func (s *InMemoryTranslationMemoryStore) DeleteUnit(_ context.Context, id string) error {
+	if s == nil {
+		return serviceNotConfiguredDomainError("translation memory store", map[string]any{"component": "translation_memory"})
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	unit, ok := s.units[strings.TrimSpace(id)]
+	if !ok {
+		return ErrNotFound
+	}
+	delete(s.units, unit.ID)
+	delete(s.keys, translationMemoryUnitKey(unit))
+	return nil
+}
+
+// translationMemoryMatchPayload shapes a fuzzy match like the family-history
+// suggestions the editor assist panel already renders.
+func translationMemoryMatchPayload(match TranslationMemoryMatch) map[string]any {
+	unit := match.Unit
+	return map[string]any{
+		"id":             "tmx:" + unit.ID + ":" + match.FieldPath + ":" + match.MatchType + ":" + strconv.Itoa(match.SegmentIndex),
+		"score":          math.Floor(match.Score*100) / 100,
+		"match_percent":  match.MatchPercent,
+		"match_type":     match.MatchType,
+		"segment_index":  match.SegmentIndex,
+		"query_text":     match.QueryText,
+		"source":         translationMemorySuggestionSourceName,
+		"source_label":   primitives.FirstNonEmptyRaw(unit.Note, "Translation memory"),
+		"locale_pair":    unit.SourceLocale + ":" + unit.TargetLocale,
+		"source_locale":  unit.SourceLocale,
+		"target_locale":  unit.TargetLocale,
+		"field_path":     match.FieldPath,
+		"source_text":    unit.SourceText,
+		"suggested_text": unit.TargetText,
+		"stale_source":   false,
+		"source_state":   "memory",
+		"memory_unit_id": unit.ID,
+	}
+}
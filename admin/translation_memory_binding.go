@@ -0,0 +1,100 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+
+	router "github.com/goliatone/go-router"
+)
+
+const translationMemoryMaxUploadBytes = 50 * 1024 * 1024
+
+type translationMemoryBinding struct {
+	admin *Admin
+}
+
+func newTranslationMemoryBinding(a *Admin) *translationMemoryBinding {
+	return &translationMemoryBinding{admin: a}
+}
+
+// Export downloads the memory units visible to the request scope as TMX,
+// optionally narrowed by source_locale and target_locale.
+func (b *translationMemoryBinding) Export(c router.Context) error {
+	store, err := b.store()
+	if err != nil {
+		return err
+	}
+	adminCtx := b.admin.adminContextFromRequest(c, b.admin.config.DefaultLocale)
+	if err := b.admin.requirePermission(adminCtx, PermAdminTranslationsView, "translations"); err != nil {
+		return err
+	}
+	units, _, err := store.ListUnits(adminCtx.Context, TranslationMemoryFilter{
+		TenantID:     adminCtx.TenantID,
+		OrgID:        adminCtx.OrgID,
+		SourceLocale: c.Query("source_locale"),
+		TargetLocale: c.Query("target_locale"),
+	})
+	if err != nil {
+		return err
+	}
+	raw, err := encodeTranslationMemoryTMX(units)
+	if err != nil {
+		return err
+	}
+	c.SetHeader("Content-Type", "application/x-tmx+xml")
+	c.SetHeader("Content-Disposition", "attachment; filename=translation_memory.tmx")
+	return c.SendString(string(raw))
+}
+
+// Import upserts memory units from an uploaded (or raw body) TMX file into
+// the request scope.
+func (b *translationMemoryBinding) Import(c router.Context) (any, error) {
+	store, err := b.store()
+	if err != nil {
+		return nil, err
+	}
+	adminCtx := b.admin.adminContextFromRequest(c, b.admin.config.DefaultLocale)
+	if err := b.admin.requirePermission(adminCtx, PermAdminTranslationsManage, "translations"); err != nil {
+		return nil, err
+	}
+	raw, filename, _, err := readTranslationUpload(c, "translation memory", "translation_memory_binding", translationMemoryMaxUploadBytes)
+	if err != nil {
+		return nil, err
+	}
+	if ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(strings.TrimSpace(filename)), ".")); ext != "" && ext != "tmx" && ext != "xml" {
+		return nil, validationDomainError("unsupported translation memory format", map[string]any{
+			"format":    ext,
+			"supported": []string{"tmx"},
+		})
+	}
+	units, err := parseTranslationMemoryTMX(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	return importTranslationMemoryUnits(adminCtx.Context, store, adminCtx.TenantID, adminCtx.OrgID, units)
+}
+
+func (b *translationMemoryBinding) store() (TranslationMemoryStore, error) {
+	if b == nil || b.admin == nil || b.admin.translationMemoryStore == nil {
+		return nil, serviceNotConfiguredDomainError("translation memory store", map[string]any{
+			"component": "translation_memory_binding",
+		})
+	}
+	return b.admin.translationMemoryStore, nil
+}
+
+// importTranslationMemoryUnits scopes units to the tenant/org before upserting.
+func importTranslationMemoryUnits(ctx context.Context, store TranslationMemoryStore, tenantID, orgID string, units []TranslationMemoryUnit) (TranslationMemoryImportResult, error) {
+	scoped := make([]TranslationMemoryUnit, 0, len(units))
+	for _, unit := range units {
+		unit.ID = ""
+		unit.TenantID = tenantID
+		unit.OrgID = orgID
+		scoped = append(scoped, unit)
+	}
+	result, err := store.UpsertUnits(ctx, scoped)
+	result.Format = "tmx"
+	return result, err
+}
@@ -0,0 +1,229 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// BunTranslationMemoryStore persists memory units in the translation_memory_units table.
+type BunTranslationMemoryStore struct {
+	db *bun.DB
+}
+
+var _ TranslationMemoryStore = (*BunTranslationMemoryStore)(nil)
+
+func NewBunTranslationMemoryStore(db *bun.DB) *BunTranslationMemoryStore {
+	if db == nil {
+		return nil
+	}
+	return &BunTranslationMemoryStore{db: db}
+}
+
+type bunTranslationMemoryUnitRecord struct {
+	bun.BaseModel `bun:"table:translation_memory_units,alias:tmu"`
+
+	ID           string    `bun:"id,pk" json:"id"`
+	TenantID     string    `bun:"tenant_id" json:"tenant_id"`
+	OrgID        string    `bun:"org_id" json:"org_id"`
+	SourceLocale string    `bun:"source_locale" json:"source_locale"`
+	TargetLocale string    `bun:"target_locale" json:"target_locale"`
+	SourceText   string    `bun:"source_text" json:"source_text"`
+	TargetText   string    `bun:"target_text" json:"target_text"`
+	SourceHash   string    `bun:"source_hash" json:"source_hash"`
+	TargetHash   string    `bun:"target_hash" json:"target_hash"`
+	SourceLength int       `bun:"source_length" json:"source_length"`
+	Origin       string    `bun:"origin" json:"origin"`
+	Note         string    `bun:"note" json:"note"`
+	CreatedAt    time.Time `bun:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `bun:"updated_at" json:"updated_at"`
+}
+
+func (s *BunTranslationMemoryStore) ListUnits(ctx context.Context, filter TranslationMemoryFilter) ([]TranslationMemoryUnit, int, error) {
+	if err := s.ready(); err != nil {
+		return nil, 0, err
+	}
+	filter = normalizeTranslationMemoryFilter(filter)
+	records := []bunTranslationMemoryUnitRecord{}
+	query := s.db.NewSelect().
+		Model(&records).
+		Where("tenant_id = ?", filter.TenantID).
+		Where("org_id = ?", filter.OrgID).
+		OrderExpr("source_locale ASC, target_locale ASC, id ASC")
+	if filter.SourceLocale != "" {
+		query.Where("source_locale = ?", filter.SourceLocale)
+	}
+	if filter.TargetLocale != "" {
+		query.Where("target_locale = ?", filter.TargetLocale)
+	}
+	if filter.Search != "" {
+		like := "%" + filter.Search + "%"
+		query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.WhereOr("LOWER(source_text) LIKE ?", like).
+				WhereOr("LOWER(target_text) LIKE ?", like)
+		})
+	}
+	if filter.PerPage > 0 {
+		page := max(filter.Page, 1)
+		query.Limit(filter.PerPage).Offset((page - 1) * filter.PerPage)
+	}
+	total, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return translationMemoryUnitsFromBunRecords(records), total, nil
+}
+
+func (s *BunTranslationMemoryStore) CandidateUnits(ctx context.Context, candidate TranslationMemoryCandidateQuery) ([]TranslationMemoryUnit, error) {
+	if err := s.ready(); err != nil {
+		return nil, err
+	}
+	records := []bunTranslationMemoryUnitRecord{}
+	query := s.db.NewSelect().
+		Model(&records).
+		Where("tenant_id = ?", strings.TrimSpace(candidate.TenantID)).
+		Where("org_id = ?", strings.TrimSpace(candidate.OrgID)).
+		Where("source_locale = ?", normalizeTranslationMemoryLocale(candidate.SourceLocale)).
+		Where("target_locale = ?", normalizeTranslationMemoryLocale(candidate.TargetLocale)).
+		Where("source_length >= ?", candidate.MinLength).
+		OrderExpr("updated_at DESC, id ASC")
+	if sourceHash := strings.TrimSpace(candidate.SourceHash); sourceHash != "" {
+		query.Where("source_hash = ?", sourceHash)
+	}
+	if candidate.MaxLength > 0 {
+		query.Where("source_length <= ?", candidate.MaxLength)
+	}
+	if candidate.Limit > 0 {
+		query.Limit(candidate.Limit)
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return translationMemoryUnitsFromBunRecords(records), nil
+}
+
+func (s *BunTranslationMemoryStore) UpsertUnits(ctx context.Context, units []TranslationMemoryUnit) (TranslationMemoryImportResult, error) {
+	result := TranslationMemoryImportResult{Total: len(units)}
+	if err := s.ready(); err != nil {
+		return result, err
+	}
+	now := time.Now().UTC()
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, unit := range units {
+			unit = normalizeTranslationMemoryUnit(unit)
+			if validateTranslationMemoryUnit(unit) != nil {
+				result.Skipped++
+				continue
+			}
+			record := bunTranslationMemoryUnitRecordFromUnit(unit)
+			updated, err := tx.NewUpdate().
+				Model((*bunTranslationMemoryUnitRecord)(nil)).
+				Set("origin = ?", record.Origin).
+				Set("note = ?", record.Note).
+				Set("updated_at = ?", now).
+				Where("tenant_id = ?", record.TenantID).
+				Where("org_id = ?", record.OrgID).
+				Where("source_locale = ?", record.SourceLocale).
+				Where("target_locale = ?", record.TargetLocale).
+				Where("source_hash = ?", record.SourceHash).
+				Where("target_hash = ?", record.TargetHash).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			if affected, err := updated.RowsAffected(); err != nil {
+				return err
+			} else if affected > 0 {
+				result.Updated++
+				continue
+			}
+			if record.ID == "" {
+				record.ID = uuid.NewString()
+			}
+			if record.CreatedAt.IsZero() {
+				record.CreatedAt = now
+			}
+			record.UpdatedAt = now
+			if _, err := tx.NewInsert().Model(&record).Exec(ctx); err != nil {
+				return err
+			}
+			result.Created++
+		}
+		return nil
+	})
+	if err != nil {
+		return TranslationMemoryImportResult{Total: len(units)}, err
+	}
+	return result, nil
+}
+
+func (s *BunTranslationMemoryStore) DeleteUnit(ctx context.Context, id string) error {
+	if err := s.ready(); err != nil {
+		return err
+	}
+	result, err := s.db.NewDelete().
+		Model((*bunTranslationMemoryUnitRecord)(nil)).
+		Where("id = ?", strings.TrimSpace(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *BunTranslationMemoryStore) ready() error {
+	if s == nil || s.db == nil {
+		return serviceNotConfiguredDomainError("translation memory store", map[string]any{
+			"component": "translation_memory_store_bun",
+		})
+	}
+	return nil
+}
+
+func bunTranslationMemoryUnitRecordFromUnit(unit TranslationMemoryUnit) bunTranslationMemoryUnitRecord {
+	return bunTranslationMemoryUnitRecord{
+		ID:           unit.ID,
+		TenantID:     unit.TenantID,
+		OrgID:        unit.OrgID,
+		SourceLocale: unit.SourceLocale,
+		TargetLocale: unit.TargetLocale,
+		SourceText:   unit.SourceText,
+		TargetText:   unit.TargetText,
+		SourceHash:   translationMemoryTextHash(unit.SourceText),
+		TargetHash:   translationMemoryTextHash(unit.TargetText),
+		SourceLength: utf8.RuneCountInString(normalizeTranslationMemoryText(unit.SourceText)),
+		Origin:       unit.Origin,
+		Note:         unit.Note,
+		CreatedAt:    unit.CreatedAt,
+		UpdatedAt:    unit.UpdatedAt,
+	}
+}
+
+func translationMemoryUnitsFromBunRecords(records []bunTranslationMemoryUnitRecord) []TranslationMemoryUnit {
+	units := make([]TranslationMemoryUnit, 0, len(records))
+	for _, record := range records {
+		units = append(units, TranslationMemoryUnit{
+			ID:           record.ID,
+			TenantID:     record.TenantID,
+			OrgID:        record.OrgID,
+			SourceLocale: record.SourceLocale,
+			TargetLocale: record.TargetLocale,
+			SourceText:   record.SourceText,
+			TargetText:   record.TargetText,
+			Origin:       record.Origin,
+			Note:         record.Note,
+			CreatedAt:    record.CreatedAt.UTC(),
+			UpdatedAt:    record.UpdatedAt.UTC(),
+		})
+	}
+	return units
+}
@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunTranslationMemoryStoreUpsertsAndSelectsCandidates(t *testing.T) {
+	ctx := context.Background()
+	store := NewBunTranslationMemoryStore(setupMigratedSQLite(t, admindata.TranslationMemoryMigrations(), "0019_translation_memory_units.up.sql"))
+
+	result, err := store.UpsertUnits(ctx, []TranslationMemoryUnit{
+		{TenantID: "acme", SourceLocale: "EN", TargetLocale: "fr_FR", SourceText: "Save your changes before leaving.", TargetText: "Enregistrez vos modifications avant de partir."},
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr-fr", SourceText: "Contact support.", TargetText: "Contactez le support."},
+		{TenantID: "other", SourceLocale: "en", TargetLocale: "fr-fr", SourceText: "Save your changes before leaving.", TargetText: "Autre locataire."},
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr-fr", SourceText: "Missing target"},
+	})
+	if err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if result.Created != 3 || result.Skipped != 1 {
+		t.Fatalf("unexpected first upsert result %+v", result)
+	}
+	result, err = store.UpsertUnits(ctx, []TranslationMemoryUnit{
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr-fr", SourceText: "save your  changes before leaving.", TargetText: "Enregistrez vos modifications avant de partir.", Note: "reviewed"},
+	})
+	if err != nil || result.Updated != 1 || result.Created != 0 {
+		t.Fatalf("expected normalized source to update the existing unit, got %+v (%v)", result, err)
+	}
+
+	units, total, err := store.ListUnits(ctx, TranslationMemoryFilter{TenantID: "acme", Search: "SUPPORT"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if total != 1 || units[0].TargetText != "Contactez le support." {
+		t.Fatalf("expected scoped search match, got %d %+v", total, units)
+	}
+
+	scope := TranslationMemoryCandidateQuery{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr-fr"}
+	exact := scope
+	exact.SourceHash = translationMemoryTextHash("SAVE your changes before leaving.")
+	candidates, err := store.CandidateUnits(ctx, exact)
+	if err != nil {
+		t.Fatalf("exact candidates: %v", err)
+	}
+	if len(candidates) != 1 || candidates[0].Note != "reviewed" {
+		t.Fatalf("expected one exact candidate for acme, got %+v", candidates)
+	}
+	window := scope
+	window.MinLength, window.MaxLength = 10, 20
+	candidates, err = store.CandidateUnits(ctx, window)
+	if err != nil {
+		t.Fatalf("window candidates: %v", err)
+	}
+	if len(candidates) != 1 || candidates[0].SourceText != "Contact support." {
+		t.Fatalf("expected length window to select the short unit, got %+v", candidates)
+	}
+
+	matches, err := LookupTranslationMemory(ctx, store, TranslationMemoryLookupInput{
+		TenantID:     "acme",
+		SourceLocale: "en",
+		TargetLocale: "fr-FR",
+		FieldSources: map[string]string{"title": "Save your changes before leaving."},
+	})
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if len(matches) != 1 || matches[0].MatchPercent != 100 {
+		t.Fatalf("expected one exact match from the bun store, got %+v", matches)
+	}
+
+	if err := store.DeleteUnit(ctx, units[0].ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.DeleteUnit(ctx, units[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found deleting twice, got %v", err)
+	}
+}
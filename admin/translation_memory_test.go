@@ -0,0 +1,210 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTranslationMemoryTMXRoundTripsUnits(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryTranslationMemoryStore()
+	if _, err := store.UpsertUnits(ctx, []TranslationMemoryUnit{
+		{SourceLocale: "en", TargetLocale: "fr", SourceText: "Save your changes.", TargetText: "Enregistrez vos modifications.", Note: "Editor footer"},
+		{SourceLocale: "en", TargetLocale: "de_DE", SourceText: "Publish now", TargetText: "Jetzt veröffentlichen"},
+	}); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	units, _, err := store.ListUnits(ctx, TranslationMemoryFilter{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	raw, err := encodeTranslationMemoryTMX(units)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if !bytes.Contains(raw, []byte(`<tmx version="1.4">`)) || !bytes.Contains(raw, []byte(`srclang="en"`)) || !bytes.Contains(raw, []byte(`xml:lang="de-de"`)) {
+		t.Fatalf("expected TMX 1.4 header and variants, got %s", raw)
+	}
+	parsed, err := parseTranslationMemoryTMX(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(parsed) != 2 {
+		t.Fatalf("expected 2 parsed units, got %+v", parsed)
+	}
+	byTarget := map[string]TranslationMemoryUnit{}
+	for _, unit := range parsed {
+		byTarget[unit.TargetLocale] = unit
+	}
+	if fr := byTarget["fr"]; fr.SourceText != "Save your changes." || fr.TargetText != "Enregistrez vos modifications." || fr.Note != "Editor footer" || fr.Origin != translationMemoryOriginImport {
+		t.Fatalf("unexpected fr unit %+v", fr)
+	}
+	if de := byTarget["de-de"]; de.SourceLocale != "en" || de.TargetText != "Jetzt veröffentlichen" {
+		t.Fatalf("unexpected de unit %+v", de)
+	}
+}
+
+func TestParseTranslationMemoryTMXHandlesMultilingualUnitsAndInlineCodes(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<tmx version="1.4">
+  <header creationtool="CAT" creationtoolversion="2" segtype="sentence" o-tmf="cat" adminlang="en" srclang="*all*" datatype="html"/>
+  <body>
+    <tu tuid="1" srclang="en-US">
+      <tuv xml:lang="fr-FR"><seg>Cliquez <bpt i="1">&lt;b&gt;</bpt>ici<ept i="1">&lt;/b&gt;</ept>.</seg></tuv>
+      <tuv xml:lang="en-US"><seg>Click <bpt i="1">&lt;b&gt;</bpt>here<ept i="1">&lt;/b&gt;</ept>.</seg></tuv>
+      <tuv lang="ES"><seg>Haga clic <ph x="1">&lt;br/&gt;</ph>aquí.</seg></tuv>
+    </tu>
+    <tu>
+      <tuv xml:lang="it"><seg>Salva</seg></tuv>
+    </tu>
+  </body>
+</tmx>`
+	if _, err := parseTranslationMemoryTMX(strings.NewReader(doc)); err == nil {
+		t.Fatal("expected unit without a target variant to be rejected")
+	}
+	doc = strings.Replace(doc, `<tu>
+      <tuv xml:lang="it"><seg>Salva</seg></tuv>
+    </tu>`, "", 1)
+	units, err := parseTranslationMemoryTMX(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(units) != 2 {
+		t.Fatalf("expected fr and es units, got %+v", units)
+	}
+	if units[0].SourceLocale != "en-us" || units[0].TargetLocale != "fr-fr" || units[0].SourceText != "Click here." || units[0].TargetText != "Cliquez ici." {
+		t.Fatalf("unexpected fr unit %+v", units[0])
+	}
+	if units[1].TargetLocale != "es" || units[1].TargetText != "Haga clic aquí." {
+		t.Fatalf("unexpected es unit %+v", units[1])
+	}
+}
+
+func TestLookupTranslationMemoryReturnsFuzzyFieldAndSentenceMatches(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryTranslationMemoryStore()
+	if _, err := store.UpsertUnits(ctx, []TranslationMemoryUnit{
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr", SourceText: "Save your changes before leaving.", TargetText: "Enregistrez vos modifications avant de partir."},
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr", SourceText: "Contact support for help.", TargetText: "Contactez le support pour obtenir de l'aide."},
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "de", SourceText: "Save your changes before leaving.", TargetText: "Speichern Sie Ihre Änderungen."},
+		{TenantID: "other", SourceLocale: "en", TargetLocale: "fr", SourceText: "Save your changes before leaving!", TargetText: "Autre locataire."},
+	}); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	matches, err := LookupTranslationMemory(ctx, store, TranslationMemoryLookupInput{
+		TenantID:     "acme",
+		SourceLocale: "EN",
+		TargetLocale: "fr",
+		FieldSources: map[string]string{
+			"title": "Save your change before leaving.",
+			"body":  "Please save your changes before leaving. Contact support for help.",
+		},
+	})
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	byField := map[string][]TranslationMemoryMatch{}
+	for _, match := range matches {
+		if match.Unit.TenantID != "acme" || match.Unit.TargetLocale != "fr" {
+			t.Fatalf("expected matches scoped to acme en:fr, got %+v", match.Unit)
+		}
+		byField[match.FieldPath] = append(byField[match.FieldPath], match)
+	}
+	title := byField["title"]
+	if len(title) != 1 || title[0].MatchType != translationMemoryMatchTypeField || title[0].MatchPercent < 90 || title[0].MatchPercent == 100 {
+		t.Fatalf("expected one high fuzzy title match, got %+v", title)
+	}
+	body := byField["body"]
+	if len(body) != 2 {
+		t.Fatalf("expected one match per body sentence, got %+v", body)
+	}
+	if body[0].MatchType != translationMemoryMatchTypeSegment || body[0].SegmentIndex != 1 || body[0].MatchPercent != 100 {
+		t.Fatalf("expected exact second-sentence match first, got %+v", body[0])
+	}
+	if body[1].SegmentIndex != 0 || body[1].QueryText != "Please save your changes before leaving." || body[1].MatchPercent >= 100 {
+		t.Fatalf("expected fuzzy first-sentence match, got %+v", body[1])
+	}
+
+	payload := translationMemoryMatchPayload(title[0])
+	if payload["source"] != translationMemorySuggestionSourceName || payload["match_percent"] != title[0].MatchPercent || payload["suggested_text"] != "Enregistrez vos modifications avant de partir." {
+		t.Fatalf("unexpected editor payload %+v", payload)
+	}
+}
+
+func TestLookupTranslationMemoryFindsExactMatchBeyondCandidateLimit(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryTranslationMemoryStore()
+	if _, err := store.UpsertUnits(ctx, []TranslationMemoryUnit{
+		{TenantID: "acme", SourceLocale: "en", TargetLocale: "fr", SourceText: "Save your changes before leaving.", TargetText: "Enregistrez vos modifications avant de partir."},
+	}); err != nil {
+		t.Fatalf("seed exact: %v", err)
+	}
+	for id, unit := range store.units {
+		unit.UpdatedAt = unit.UpdatedAt.Add(-time.Hour)
+		store.units[id] = unit
+	}
+	filler := make([]TranslationMemoryUnit, 0, translationMemoryCandidateLimit+100)
+	for i := range translationMemoryCandidateLimit + 100 {
+		filler = append(filler, TranslationMemoryUnit{
+			TenantID:     "acme",
+			SourceLocale: "en",
+			TargetLocale: "fr",
+			SourceText:   fmt.Sprintf("Unrelated filler sentence %04d.", i),
+			TargetText:   fmt.Sprintf("Phrase de remplissage %04d.", i),
+		})
+	}
+	if _, err := store.UpsertUnits(ctx, filler); err != nil {
+		t.Fatalf("seed filler: %v", err)
+	}
+
+	matches, err := LookupTranslationMemory(ctx, store, TranslationMemoryLookupInput{
+		TenantID:     "acme",
+		SourceLocale: "en",
+		TargetLocale: "fr",
+		FieldSources: map[string]string{"title": "save your changes  before leaving."},
+	})
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if len(matches) != 1 || matches[0].MatchPercent != 100 || matches[0].Unit.TargetText != "Enregistrez vos modifications avant de partir." {
+		t.Fatalf("expected the older exact match to survive the candidate limit, got %+v", matches)
+	}
+}
+
+func TestImportTranslationMemoryUnitsUpsertsWithinScope(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryTranslationMemoryStore()
+	units := []TranslationMemoryUnit{
+		{ID: "ignored", TenantID: "spoofed", SourceLocale: "en", TargetLocale: "fr", SourceText: "Hello", TargetText: "Bonjour"},
+		{SourceLocale: "en", TargetLocale: "fr", SourceText: "Goodbye", TargetText: ""},
+	}
+	result, err := importTranslationMemoryUnits(ctx, store, "acme", "north", units)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Format != "tmx" || result.Total != 2 || result.Created != 1 || result.Skipped != 1 {
+		t.Fatalf("unexpected first import result %+v", result)
+	}
+	result, err = importTranslationMemoryUnits(ctx, store, "acme", "north", units[:1])
+	if err != nil {
+		t.Fatalf("reimport: %v", err)
+	}
+	if result.Created != 0 || result.Updated != 1 {
+		t.Fatalf("expected reimport to update, got %+v", result)
+	}
+	scoped, total, err := store.ListUnits(ctx, TranslationMemoryFilter{TenantID: "acme", OrgID: "north"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if total != 1 || scoped[0].ID == "ignored" || scoped[0].TenantID != "acme" {
+		t.Fatalf("expected one unit scoped to acme/north, got %+v", scoped)
+	}
+	if _, total, _ := store.ListUnits(ctx, TranslationMemoryFilter{TenantID: "spoofed"}); total != 0 {
+		t.Fatalf("expected no units for spoofed tenant, got %d", total)
+	}
+}
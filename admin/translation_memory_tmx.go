@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+)
+
+const (
+	translationMemoryTMXVersion    = "1.4"
+	translationMemoryTMXTimeLayout = "20060102T150405Z"
+	translationMemoryTMXAllSources = "*all*"
+)
+
+type tmxDocument struct {
+	XMLName xml.Name  `xml:"tmx"`
+	Version string    `xml:"version,attr"`
+	Header  tmxHeader `xml:"header"`
+	Body    tmxBody   `xml:"body"`
+}
+
+type tmxHeader struct {
+	CreationTool        string `xml:"creationtool,attr"`
+	CreationToolVersion string `xml:"creationtoolversion,attr"`
+	SegType             string `xml:"segtype,attr"`
+	OTMF                string `xml:"o-tmf,attr"`
+	AdminLang           string `xml:"adminlang,attr"`
+	SrcLang             string `xml:"srclang,attr"`
+	DataType            string `xml:"datatype,attr"`
+}
+
+type tmxBody struct {
+	Units []tmxUnit `xml:"tu"`
+}
+
+type tmxUnit struct {
+	TUID         string       `xml:"tuid,attr,omitempty"`
+	SrcLang      string       `xml:"srclang,attr,omitempty"`
+	CreationDate string       `xml:"creationdate,attr,omitempty"`
+	ChangeDate   string       `xml:"changedate,attr,omitempty"`
+	Notes        []string     `xml:"note"`
+	Variants     []tmxVariant `xml:"tuv"`
+}
+
+// tmxVariant reads xml:lang (TMX 1.4) and the plain lang attribute used by
+// TMX 1.1-1.3 exports.
+type tmxVariant struct {
+	Lang       string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	LegacyLang string     `xml:"lang,attr,omitempty"`
+	Segment    tmxSegment `xml:"seg"`
+}
+
+func (v tmxVariant) locale() string {
+	return normalizeTranslationMemoryLocale(primitives.FirstNonEmptyRaw(v.Lang, v.LegacyLang))
+}
+
+// tmxSegment keeps the readable text of a <seg>. Inline native codes
+// (bpt, ept, ph, it, ut) are dropped; hi and sub text is kept.
+type tmxSegment struct {
+	Text string `xml:",chardata"`
+}
+
+func (s *tmxSegment) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var text strings.Builder
+	skipDepth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch value := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+			switch value.Name.Local {
+			case "bpt", "ept", "ph", "it", "ut":
+				skipDepth = 1
+			}
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if value.Name == start.Name {
+				s.Text = text.String()
+				return nil
+			}
+		case xml.CharData:
+			if skipDepth == 0 {
+				text.Write(value)
+			}
+		}
+	}
+}
+
+// encodeTranslationMemoryTMX writes one translation unit per memory unit with
+// a source and a target variant. The header srclang is the shared source
+// locale, or *all* when the export mixes source locales.
+func encodeTranslationMemoryTMX(units []TranslationMemoryUnit) ([]byte, error) {
+	srcLang := ""
+	for _, unit := range units {
+		if srcLang == "" {
+			srcLang = unit.SourceLocale
+		} else if srcLang != unit.SourceLocale {
+			srcLang = translationMemoryTMXAllSources
+			break
+		}
+	}
+	doc := tmxDocument{
+		Version: translationMemoryTMXVersion,
+		Header: tmxHeader{
+			CreationTool:        "go-admin",
+			CreationToolVersion: "1",
+			SegType:             "sentence",
+			OTMF:                "go-admin",
+			AdminLang:           "en",
+			SrcLang:             primitives.FirstNonEmptyRaw(srcLang, translationMemoryTMXAllSources),
+			DataType:            "plaintext",
+		},
+	}
+	for _, unit := range units {
+		tu := tmxUnit{
+			TUID:         unit.ID,
+			CreationDate: formatTranslationMemoryTMXTime(unit.CreatedAt),
+			ChangeDate:   formatTranslationMemoryTMXTime(unit.UpdatedAt),
+			Variants: []tmxVariant{
+				{Lang: unit.SourceLocale, Segment: tmxSegment{Text: unit.SourceText}},
+				{Lang: unit.TargetLocale, Segment: tmxSegment{Text: unit.TargetText}},
+			},
+		}
+		if srcLang == translationMemoryTMXAllSources {
+			tu.SrcLang = unit.SourceLocale
+		}
+		if unit.Note != "" {
+			tu.Notes = []string{unit.Note}
+		}
+		doc.Body.Units = append(doc.Body.Units, tu)
+	}
+	raw, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(raw, '\n')...), nil
+}
+
+// parseTranslationMemoryTMX reads TMX 1.4b translation units. The unit
+// srclang, then the header srclang, selects the source variant; *all* (or a
+// missing source variant) falls back to the first variant. Every other
+// variant yields one memory unit for that locale pair.
+func parseTranslationMemoryTMX(reader io.Reader) ([]TranslationMemoryUnit, error) {
+	doc := tmxDocument{}
+	if err := xml.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, translationMemoryParseError(err)
+	}
+	headerLang := normalizeTranslationMemoryLocale(doc.Header.SrcLang)
+	units := []TranslationMemoryUnit{}
+	for index, tu := range doc.Body.Units {
+		if len(tu.Variants) < 2 {
+			return nil, validationDomainError("translation unit requires a source and a target variant", map[string]any{
+				"format": "tmx",
+				"row":    index + 1,
+			})
+		}
+		sourceLang := normalizeTranslationMemoryLocale(primitives.FirstNonEmptyRaw(tu.SrcLang, doc.Header.SrcLang))
+		if sourceLang == "" || sourceLang == translationMemoryTMXAllSources {
+			sourceLang = headerLang
+		}
+		sourceIndex := 0
+		for i, variant := range tu.Variants {
+			if variant.locale() == sourceLang {
+				sourceIndex = i
+				break
+			}
+		}
+		source := tu.Variants[sourceIndex]
+		created := parseTranslationMemoryTMXTime(tu.CreationDate)
+		for i, variant := range tu.Variants {
+			if i == sourceIndex || variant.locale() == "" || variant.locale() == source.locale() {
+				continue
+			}
+			units = append(units, normalizeTranslationMemoryUnit(TranslationMemoryUnit{
+				SourceLocale: source.locale(),
+				TargetLocale: variant.locale(),
+				SourceText:   source.Segment.Text,
+				TargetText:   variant.Segment.Text,
+				Origin:       translationMemoryOriginImport,
+				Note:         strings.Join(tu.Notes, "\n"),
+				CreatedAt:    created,
+			}))
+		}
+	}
+	return units, nil
+}
+
+func formatTranslationMemoryTMXTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(translationMemoryTMXTimeLayout)
+}
+
+func parseTranslationMemoryTMXTime(value string) time.Time {
+	parsed, err := time.Parse(translationMemoryTMXTimeLayout, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+func translationMemoryParseError(err error) error {
+	if errors.Is(err, io.EOF) {
+		err = errors.New("empty document")
+	}
+	return validationDomainError("invalid translation memory file", map[string]any{
+		"format": "tmx",
+		"error":  err.Error(),
+	})
+}
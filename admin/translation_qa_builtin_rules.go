@@ -0,0 +1,691 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Built-in translation QA rule IDs. Register a TranslationQARuleRegistration
+// with the same ID to override its severity or disable it for a scope.
+const (
+	TranslationQARuleTerminology = "terminology.glossary"
+	TranslationQARuleTokens      = "style.tokens"
+	TranslationQARuleICUMessage  = "style.icu_message"
+	TranslationQARuleNumbers     = "style.numbers"
+	TranslationQARuleLength      = "style.length"
+	TranslationQARuleMarkdown    = "style.markdown"
+	TranslationQARuleWhitespace  = "style.whitespace"
+)
+
+const (
+	translationQADefaultMaxLengthRatio  = 1.5
+	translationQALengthMinSourceLength  = 10
+	translationQALengthRatioSlackRunes  = 10
+	translationQACategoryTerminology    = "terminology"
+	translationQACategoryStyle          = "style"
+	translationQAICUOtherKey            = "other"
+	translationQAICUArgumentTypePlural  = "plural"
+	translationQAICUArgumentTypeSelect  = "select"
+	translationQAICUArgumentTypeOrdinal = "selectordinal"
+)
+
+var (
+	translationQAICUComplexArgPattern = regexp.MustCompile(`\{\s*[\p{L}\p{N}_.]+\s*,\s*(plural|select|selectordinal)\s*,`)
+	translationQAISODatePattern       = regexp.MustCompile(`\b(\d{4})-(\d{1,2})-(\d{1,2})\b`)
+	translationQANumericDatePattern   = regexp.MustCompile(`\b(\d{1,2})[./](\d{1,2})[./](\d{4})\b`)
+	translationQANumberPattern        = regexp.MustCompile(`\d{1,3}(?:[,.\x{00A0}\x{202F}']\d{3})+(?:[.,]\d+)?|\d+(?:[.,]\d+)?`)
+	translationQAICUExactKeyPattern   = regexp.MustCompile(`=\d+\s*\{`)
+	translationQAMarkdownHeading      = regexp.MustCompile(`(?m)^ {0,3}(#{1,6})[ \t]`)
+	translationQAMarkdownListItem     = regexp.MustCompile(`(?m)^[ \t]*(?:[-*+]|\d+[.)])[ \t]`)
+	translationQAMarkdownFence        = regexp.MustCompile("(?m)^ {0,3}(?:```|~~~)")
+	translationQAMarkdownLink         = regexp.MustCompile(`!?\[[^\]]*\]\([^)\s]+[^)]*\)`)
+	translationQAMarkdownCodeSpan     = regexp.MustCompile("`[^`\n]+`")
+)
+
+// DefaultTranslationQARules returns the built-in rules: glossary terminology,
+// placeholder/URL/HTML tokens, ICU plural/select branches, numbers and dates,
+// length, markdown structure and leading/trailing whitespace.
+func DefaultTranslationQARules() []TranslationQARule {
+	return []TranslationQARule{
+		TranslationQARuleFunc{RuleID: TranslationQARuleTerminology, RuleCategory: translationQACategoryTerminology, Fn: translationQATerminologyRuleFindings},
+		TranslationQARuleFunc{RuleID: TranslationQARuleTokens, RuleCategory: translationQACategoryStyle, Fn: translationQATokenRuleFindings},
+		NewTranslationQAICUMessageRule(),
+		NewTranslationQANumberRule(),
+		NewTranslationQALengthRule(translationQADefaultMaxLengthRatio),
+		NewTranslationQAMarkdownRule(),
+		NewTranslationQAWhitespaceRule(),
+	}
+}
+
+// NewTranslationQAICUMessageRule checks that ICU MessageFormat plural, select
+// and selectordinal arguments survive translation with the branches the
+// target locale needs.
+func NewTranslationQAICUMessageRule() TranslationQARule {
+	return TranslationQARuleFunc{RuleID: TranslationQARuleICUMessage, RuleCategory: translationQACategoryStyle, Fn: translationQAICUMessageFindings}
+}
+
+// NewTranslationQANumberRule checks that numbers and dates from the source are
+// kept in the target, ignoring locale-specific separators and date order.
+func NewTranslationQANumberRule() TranslationQARule {
+	return TranslationQARuleFunc{RuleID: TranslationQARuleNumbers, RuleCategory: translationQACategoryStyle, Fn: translationQANumberFindings}
+}
+
+// NewTranslationQALengthRule blocks targets longer than a schema maxLength and
+// warns when a target exceeds maxRatio times the source length.
+func NewTranslationQALengthRule(maxRatio float64) TranslationQARule {
+	if maxRatio <= 0 {
+		maxRatio = translationQADefaultMaxLengthRatio
+	}
+	return TranslationQARuleFunc{
+		RuleID:       TranslationQARuleLength,
+		RuleCategory: translationQACategoryStyle,
+		Fn: func(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+			return translationQALengthFindings(input, maxRatio)
+		},
+	}
+}
+
+// NewTranslationQAMarkdownRule compares headings, list items, code fences,
+// links and inline code between source and target markdown.
+func NewTranslationQAMarkdownRule() TranslationQARule {
+	return TranslationQARuleFunc{RuleID: TranslationQARuleMarkdown, RuleCategory: translationQACategoryStyle, Fn: translationQAMarkdownFindings}
+}
+
+// NewTranslationQAWhitespaceRule reports leading/trailing whitespace that
+// differs from the source.
+func NewTranslationQAWhitespaceRule() TranslationQARule {
+	return TranslationQARuleFunc{RuleID: TranslationQARuleWhitespace, RuleCategory: translationQACategoryStyle, Fn: translationQAWhitespaceFindings}
+}
+
+func translationQATerminologyRuleFindings(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	return translationQAFindingsFromPayloads(translationTerminologyQAFindings(input.editorContext()))
+}
+
+func translationQATokenRuleFindings(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	return translationQAFindingsFromPayloads(translationStyleQAFindings(input.editorContext()))
+}
+
+// translationQAFieldPairs yields the field paths where both source and target have content.
+func translationQAFieldPairs(input TranslationQARuleInput, fn func(fieldPath, source, target string)) {
+	for _, fieldPath := range input.fieldPaths() {
+		source := input.SourceFields[fieldPath]
+		target := input.TargetFields[fieldPath]
+		if strings.TrimSpace(source) == "" || strings.TrimSpace(target) == "" {
+			continue
+		}
+		fn(fieldPath, source, target)
+	}
+}
+
+func translationQAFieldFinding(fieldPath, kind, severity, message string, metadata map[string]any) TranslationQAFinding {
+	return TranslationQAFinding{
+		ID:        fmt.Sprintf("style:%s:%s", fieldPath, kind),
+		Category:  translationQACategoryStyle,
+		Severity:  severity,
+		FieldPath: fieldPath,
+		Message:   message,
+		Metadata:  metadata,
+	}
+}
+
+// ICU MessageFormat
+
+type translationICUArgument struct {
+	Name string
+	Type string
+	Keys []string
+}
+
+func (arg translationICUArgument) hasKey(key string) bool {
+	return slices.Contains(arg.Keys, key)
+}
+
+type translationPluralCategories struct {
+	Required []string
+	Optional []string
+}
+
+// translationQAPluralCategoriesByLanguage lists CLDR cardinal plural categories.
+// Optional categories only apply to large or fractional numbers and are
+// accepted but not required.
+var translationQAPluralCategoriesByLanguage = map[string]translationPluralCategories{
+	"ja": {Required: []string{"other"}},
+	"zh": {Required: []string{"other"}},
+	"ko": {Required: []string{"other"}},
+	"vi": {Required: []string{"other"}},
+	"th": {Required: []string{"other"}},
+	"id": {Required: []string{"other"}},
+	"ms": {Required: []string{"other"}},
+	"en": {Required: []string{"one", "other"}},
+	"de": {Required: []string{"one", "other"}},
+	"nl": {Required: []string{"one", "other"}},
+	"sv": {Required: []string{"one", "other"}},
+	"da": {Required: []string{"one", "other"}},
+	"nb": {Required: []string{"one", "other"}},
+	"no": {Required: []string{"one", "other"}},
+	"fi": {Required: []string{"one", "other"}},
+	"et": {Required: []string{"one", "other"}},
+	"el": {Required: []string{"one", "other"}},
+	"hu": {Required: []string{"one", "other"}},
+	"tr": {Required: []string{"one", "other"}},
+	"bg": {Required: []string{"one", "other"}},
+	"fr": {Required: []string{"one", "other"}, Optional: []string{"many"}},
+	"es": {Required: []string{"one", "other"}, Optional: []string{"many"}},
+	"it": {Required: []string{"one", "other"}, Optional: []string{"many"}},
+	"pt": {Required: []string{"one", "other"}, Optional: []string{"many"}},
+	"ca": {Required: []string{"one", "other"}, Optional: []string{"many"}},
+	"ru": {Required: []string{"one", "few", "many", "other"}},
+	"uk": {Required: []string{"one", "few", "many", "other"}},
+	"be": {Required: []string{"one", "few", "many", "other"}},
+	"pl": {Required: []string{"one", "few", "many", "other"}},
+	"lt": {Required: []string{"one", "few", "many", "other"}},
+	"cs": {Required: []string{"one", "few", "other"}, Optional: []string{"many"}},
+	"sk": {Required: []string{"one", "few", "other"}, Optional: []string{"many"}},
+	"hr": {Required: []string{"one", "few", "other"}},
+	"sr": {Required: []string{"one", "few", "other"}},
+	"bs": {Required: []string{"one", "few", "other"}},
+	"ro": {Required: []string{"one", "few", "other"}},
+	"lv": {Required: []string{"zero", "one", "other"}},
+	"sl": {Required: []string{"one", "two", "few", "other"}},
+	"he": {Required: []string{"one", "two", "other"}},
+	"ga": {Required: []string{"one", "two", "few", "many", "other"}},
+	"ar": {Required: []string{"zero", "one", "two", "few", "many", "other"}},
+	"cy": {Required: []string{"zero", "one", "two", "few", "many", "other"}},
+}
+
+func translationQAPluralCategoriesForLocale(locale string) (translationPluralCategories, bool) {
+	language := strings.ToLower(strings.TrimSpace(locale))
+	if idx := strings.IndexAny(language, "-_"); idx >= 0 {
+		language = language[:idx]
+	}
+	categories, ok := translationQAPluralCategoriesByLanguage[language]
+	return categories, ok
+}
+
+func translationQAICUMessageFindings(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	findings := []TranslationQAFinding{}
+	translationQAFieldPairs(input, func(fieldPath, source, target string) {
+		if !translationQAICUComplexArgPattern.MatchString(source) {
+			return
+		}
+		sourceArgs, err := parseTranslationICUArguments(source)
+		if err != nil {
+			return
+		}
+		targetArgs, err := parseTranslationICUArguments(target)
+		if err != nil {
+			findings = append(findings, translationQAFieldFinding(fieldPath, "icu_syntax", translationQASeverityBlocker,
+				fmt.Sprintf("Fix the ICU message syntax: %s.", err.Error()),
+				map[string]any{"error": err.Error()}))
+			return
+		}
+		findings = append(findings, translationQAICUArgumentFindings(fieldPath, input.TargetLocale, sourceArgs, targetArgs)...)
+	})
+	return findings
+}
+
+func translationQAICUArgumentFindings(fieldPath, targetLocale string, sourceArgs, targetArgs []translationICUArgument) []TranslationQAFinding {
+	targetByKey := map[string]translationICUArgument{}
+	for _, arg := range targetArgs {
+		targetByKey[arg.Name+"|"+arg.Type] = arg
+	}
+	findings := []TranslationQAFinding{}
+	for _, sourceArg := range sourceArgs {
+		if sourceArg.Type == "" {
+			continue
+		}
+		kind := "icu_" + sourceArg.Type + ":" + sourceArg.Name
+		targetArg, ok := targetByKey[sourceArg.Name+"|"+sourceArg.Type]
+		if !ok {
+			findings = append(findings, translationQAFieldFinding(fieldPath, kind, translationQASeverityBlocker,
+				fmt.Sprintf("Keep the ICU %s argument %q from the source.", sourceArg.Type, sourceArg.Name),
+				map[string]any{"argument": sourceArg.Name, "argument_type": sourceArg.Type}))
+			continue
+		}
+		missing := []string{}
+		unexpected := []string{}
+		switch sourceArg.Type {
+		case translationQAICUArgumentTypeSelect:
+			for _, key := range sourceArg.Keys {
+				if !targetArg.hasKey(key) {
+					missing = append(missing, key)
+				}
+			}
+		case translationQAICUArgumentTypePlural:
+			for _, key := range sourceArg.Keys {
+				if strings.HasPrefix(key, "=") && !targetArg.hasKey(key) {
+					missing = append(missing, key)
+				}
+			}
+			if categories, known := translationQAPluralCategoriesForLocale(targetLocale); known {
+				for _, key := range categories.Required {
+					if !targetArg.hasKey(key) {
+						missing = append(missing, key)
+					}
+				}
+				allowed := map[string]struct{}{}
+				for _, key := range append(append([]string{}, categories.Required...), categories.Optional...) {
+					allowed[key] = struct{}{}
+				}
+				for _, key := range targetArg.Keys {
+					if _, ok := allowed[key]; !ok && !strings.HasPrefix(key, "=") {
+						unexpected = append(unexpected, key)
+					}
+				}
+			}
+		}
+		if !targetArg.hasKey(translationQAICUOtherKey) && !slices.Contains(missing, translationQAICUOtherKey) {
+			missing = append(missing, translationQAICUOtherKey)
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			findings = append(findings, translationQAFieldFinding(fieldPath, kind, translationQASeverityBlocker,
+				fmt.Sprintf("Add the missing %s branches for %q: %s.", sourceArg.Type, sourceArg.Name, strings.Join(missing, ", ")),
+				map[string]any{"argument": sourceArg.Name, "argument_type": sourceArg.Type, "missing_branches": missing}))
+		}
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+			findings = append(findings, translationQAFieldFinding(fieldPath, kind+":unexpected", translationQASeverityWarning,
+				fmt.Sprintf("Remove plural branches %s does not use for %q: %s.", targetLocale, sourceArg.Name, strings.Join(unexpected, ", ")),
+				map[string]any{"argument": sourceArg.Name, "argument_type": sourceArg.Type, "unexpected_branches": unexpected}))
+		}
+	}
+	return findings
+}
+
+// parseTranslationICUArguments returns the arguments of an ICU message,
+// including those nested in plural/select branches. Simple arguments are
+// returned with an empty Type.
+func parseTranslationICUArguments(value string) ([]translationICUArgument, error) {
+	parser := &translationICUParser{input: []rune(value)}
+	if err := parser.parseMessage(false); err != nil {
+		return nil, err
+	}
+	return parser.args, nil
+}
+
+type translationICUParser struct {
+	input []rune
+	pos   int
+	args  []translationICUArgument
+}
+
+func (p *translationICUParser) parseMessage(nested bool) error {
+	for p.pos < len(p.input) {
+		switch ch := p.input[p.pos]; ch {
+		case '\'':
+			p.skipQuoted()
+		case '{':
+			if err := p.parseArgument(); err != nil {
+				return err
+			}
+		case '}':
+			if nested {
+				return nil
+			}
+			return fmt.Errorf("unexpected '}' at position %d", p.pos)
+		default:
+			p.pos++
+		}
+	}
+	if nested {
+		return fmt.Errorf("unclosed branch")
+	}
+	return nil
+}
+
+// skipQuoted follows ICU apostrophe rules: ” is a literal apostrophe and an
+// apostrophe before a brace quotes text up to the next apostrophe.
+func (p *translationICUParser) skipQuoted() {
+	p.pos++
+	if p.pos >= len(p.input) {
+		return
+	}
+	switch p.input[p.pos] {
+	case '\'':
+		p.pos++
+	case '{', '}':
+		for p.pos < len(p.input) && p.input[p.pos] != '\'' {
+			p.pos++
+		}
+		p.pos++
+	}
+}
+
+func (p *translationICUParser) parseArgument() error {
+	start := p.pos
+	p.pos++
+	name := p.readUntil(",}")
+	if name == "" || strings.ContainsAny(name, "{ \t\n") {
+		return p.skipBraces(start)
+	}
+	if p.pos >= len(p.input) {
+		return fmt.Errorf("unclosed argument %q", name)
+	}
+	if p.input[p.pos] == '}' {
+		p.pos++
+		p.args = append(p.args, translationICUArgument{Name: name})
+		return nil
+	}
+	p.pos++
+	argType := strings.ToLower(p.readUntil(",}"))
+	if p.pos >= len(p.input) {
+		return fmt.Errorf("unclosed argument %q", name)
+	}
+	switch argType {
+	case translationQAICUArgumentTypePlural, translationQAICUArgumentTypeSelect, translationQAICUArgumentTypeOrdinal:
+	default:
+		return p.skipBraces(start)
+	}
+	if p.input[p.pos] != ',' {
+		return fmt.Errorf("argument %q has no %s branches", name, argType)
+	}
+	p.pos++
+	arg := translationICUArgument{Name: name, Type: argType}
+	p.args = append(p.args, translationICUArgument{})
+	slot := len(p.args) - 1
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return fmt.Errorf("unclosed argument %q", name)
+		}
+		if p.input[p.pos] == '}' {
+			p.pos++
+			break
+		}
+		key := p.readKey()
+		if key == "" {
+			return fmt.Errorf("argument %q has an empty branch key", name)
+		}
+		if strings.HasPrefix(key, "offset:") {
+			continue
+		}
+		p.skipSpace()
+		if p.pos >= len(p.input) || p.input[p.pos] != '{' {
+			return fmt.Errorf("branch %q of %q has no message", key, name)
+		}
+		p.pos++
+		if err := p.parseMessage(true); err != nil {
+			return fmt.Errorf("branch %q of %q: %w", key, name, err)
+		}
+		p.pos++
+		arg.Keys = append(arg.Keys, key)
+	}
+	if len(arg.Keys) == 0 {
+		return fmt.Errorf("argument %q has no %s branches", name, argType)
+	}
+	p.args[slot] = arg
+	return nil
+}
+
+// skipBraces jumps over a non-ICU brace group such as {{placeholder}} or {n, number}.
+func (p *translationICUParser) skipBraces(start int) error {
+	depth := 0
+	for p.pos = start; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unclosed '{' at position %d", start)
+}
+
+func (p *translationICUParser) readUntil(stops string) string {
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(stops, p.input[p.pos]) {
+		p.pos++
+	}
+	return strings.TrimSpace(string(p.input[start:p.pos]))
+}
+
+func (p *translationICUParser) readKey() string {
+	start := p.pos
+	for p.pos < len(p.input) && !unicode.IsSpace(p.input[p.pos]) && p.input[p.pos] != '{' && p.input[p.pos] != '}' {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+func (p *translationICUParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// Numbers and dates
+
+func translationQANumberFindings(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	findings := []TranslationQAFinding{}
+	translationQAFieldPairs(input, func(fieldPath, source, target string) {
+		sourceDates, sourceNumbers := translationQANumbersAndDates(source)
+		if len(sourceDates) == 0 && len(sourceNumbers) == 0 {
+			return
+		}
+		targetDates, targetNumbers := translationQANumbersAndDates(target)
+		if missing := translationQAMissingValues(sourceDates, targetDates); len(missing) > 0 {
+			findings = append(findings, translationQAFieldFinding(fieldPath, "date", translationQASeverityWarning,
+				fmt.Sprintf("Check dates copied from the source: %s.", strings.Join(missing, ", ")),
+				map[string]any{"missing_values": missing}))
+		}
+		if missing := translationQAMissingValues(sourceNumbers, targetNumbers); len(missing) > 0 {
+			findings = append(findings, translationQAFieldFinding(fieldPath, "number", translationQASeverityWarning,
+				fmt.Sprintf("Check numbers copied from the source: %s.", strings.Join(missing, ", ")),
+				map[string]any{"missing_values": missing}))
+		}
+	})
+	return findings
+}
+
+// translationQANumbersAndDates returns normalized date and number keys mapped
+// to their first raw spelling. Placeholders, URLs, HTML tags and ICU branch
+// selectors are ignored.
+func translationQANumbersAndDates(value string) (map[string][]string, map[string][]string) {
+	value = translationQAPlaceholderPattern.ReplaceAllString(value, " ")
+	value = translationQAURLPattern.ReplaceAllString(value, " ")
+	value = translationQAHTMLTagPattern.ReplaceAllString(value, " ")
+	value = translationQAICUComplexArgPattern.ReplaceAllString(value, " ")
+	value = translationQAICUExactKeyPattern.ReplaceAllString(value, "{")
+
+	dates := map[string][]string{}
+	collectDate := func(pattern *regexp.Regexp, yearIndex int) {
+		value = pattern.ReplaceAllStringFunc(value, func(match string) string {
+			parts := pattern.FindStringSubmatch(match)
+			year := parts[yearIndex]
+			rest := []int{}
+			for i := 1; i <= 3; i++ {
+				if i == yearIndex {
+					continue
+				}
+				n, _ := strconv.Atoi(parts[i])
+				rest = append(rest, n)
+			}
+			sort.Ints(rest)
+			key := fmt.Sprintf("%s|%d|%d", year, rest[0], rest[1])
+			dates[key] = append(dates[key], match)
+			return " "
+		})
+	}
+	collectDate(translationQAISODatePattern, 1)
+	collectDate(translationQANumericDatePattern, 3)
+
+	numbers := map[string][]string{}
+	for _, match := range translationQANumberPattern.FindAllString(value, -1) {
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, match)
+		digits = strings.TrimLeft(digits, "0")
+		if digits == "" {
+			digits = "0"
+		}
+		numbers[digits] = append(numbers[digits], strings.TrimSpace(match))
+	}
+	return dates, numbers
+}
+
+func translationQAMissingValues(source, target map[string][]string) []string {
+	missing := []string{}
+	for key, raw := range source {
+		if len(target[key]) < len(raw) {
+			missing = append(missing, raw[0])
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// Length
+
+func translationQALengthFindings(input TranslationQARuleInput, maxRatio float64) []TranslationQAFinding {
+	findings := []TranslationQAFinding{}
+	for _, fieldPath := range input.fieldPaths() {
+		target := strings.TrimSpace(input.TargetFields[fieldPath])
+		if target == "" {
+			continue
+		}
+		targetLength := utf8.RuneCountInString(target)
+		if maxLength := input.FieldMaxLengths[fieldPath]; maxLength > 0 && targetLength > maxLength {
+			findings = append(findings, translationQAFieldFinding(fieldPath, "max_length", translationQASeverityBlocker,
+				fmt.Sprintf("Shorten the translation to %d characters or fewer (currently %d).", maxLength, targetLength),
+				map[string]any{"max_length": maxLength, "length": targetLength}))
+			continue
+		}
+		sourceLength := utf8.RuneCountInString(strings.TrimSpace(input.SourceFields[fieldPath]))
+		if sourceLength < translationQALengthMinSourceLength {
+			continue
+		}
+		limit := max(int(math.Ceil(float64(sourceLength)*maxRatio)), sourceLength+translationQALengthRatioSlackRunes)
+		if targetLength > limit {
+			findings = append(findings, translationQAFieldFinding(fieldPath, "length_ratio", translationQASeverityWarning,
+				fmt.Sprintf("The translation is %d characters, more than %.1fx the source length of %d.", targetLength, maxRatio, sourceLength),
+				map[string]any{"length": targetLength, "source_length": sourceLength, "max_ratio": maxRatio}))
+		}
+	}
+	return findings
+}
+
+// Markdown
+
+func translationQAMarkdownFindings(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	findings := []TranslationQAFinding{}
+	translationQAFieldPairs(input, func(fieldPath, source, target string) {
+		sourceShape := translationQAMarkdownShapeOf(source)
+		if sourceShape.empty() {
+			return
+		}
+		issues := sourceShape.diff(translationQAMarkdownShapeOf(target))
+		if len(issues) == 0 {
+			return
+		}
+		findings = append(findings, translationQAFieldFinding(fieldPath, "markdown", translationQASeverityWarning,
+			fmt.Sprintf("Keep the markdown structure of the source: %s.", strings.Join(issues, "; ")),
+			map[string]any{"issues": issues}))
+	})
+	return findings
+}
+
+type translationQAMarkdownShape struct {
+	Headings  map[int]int
+	ListItems int
+	Fences    int
+	Links     int
+	CodeSpans []string
+}
+
+func translationQAMarkdownShapeOf(value string) translationQAMarkdownShape {
+	shape := translationQAMarkdownShape{Headings: map[int]int{}}
+	for _, match := range translationQAMarkdownHeading.FindAllStringSubmatch(value, -1) {
+		shape.Headings[len(match[1])]++
+	}
+	shape.ListItems = len(translationQAMarkdownListItem.FindAllString(value, -1))
+	shape.Fences = len(translationQAMarkdownFence.FindAllString(value, -1))
+	shape.Links = len(translationQAMarkdownLink.FindAllString(value, -1))
+	shape.CodeSpans = translationQAMarkdownCodeSpan.FindAllString(value, -1)
+	sort.Strings(shape.CodeSpans)
+	return shape
+}
+
+func (s translationQAMarkdownShape) empty() bool {
+	return len(s.Headings) == 0 && s.ListItems == 0 && s.Fences == 0 && s.Links == 0 && len(s.CodeSpans) == 0
+}
+
+func (s translationQAMarkdownShape) diff(target translationQAMarkdownShape) []string {
+	issues := []string{}
+	for level := 1; level <= 6; level++ {
+		if s.Headings[level] != target.Headings[level] {
+			issues = append(issues, fmt.Sprintf("%d level-%d heading(s), found %d", s.Headings[level], level, target.Headings[level]))
+		}
+	}
+	if s.ListItems != target.ListItems {
+		issues = append(issues, fmt.Sprintf("%d list item(s), found %d", s.ListItems, target.ListItems))
+	}
+	if s.Fences != target.Fences {
+		issues = append(issues, fmt.Sprintf("%d code fence(s), found %d", s.Fences, target.Fences))
+	}
+	if s.Links != target.Links {
+		issues = append(issues, fmt.Sprintf("%d link(s), found %d", s.Links, target.Links))
+	}
+	targetSpans := map[string]int{}
+	for _, span := range target.CodeSpans {
+		targetSpans[span]++
+	}
+	for _, span := range s.CodeSpans {
+		if targetSpans[span] == 0 {
+			issues = append(issues, "inline code "+span+" changed")
+			continue
+		}
+		targetSpans[span]--
+	}
+	return issues
+}
+
+// Whitespace
+
+func translationQAWhitespaceFindings(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	findings := []TranslationQAFinding{}
+	translationQAFieldPairs(input, func(fieldPath, source, target string) {
+		sourceLeading, sourceTrailing := translationQAEdgeWhitespace(source)
+		targetLeading, targetTrailing := translationQAEdgeWhitespace(target)
+		if sourceLeading == targetLeading && sourceTrailing == targetTrailing {
+			return
+		}
+		edges := []string{}
+		if sourceLeading != targetLeading {
+			edges = append(edges, "leading")
+		}
+		if sourceTrailing != targetTrailing {
+			edges = append(edges, "trailing")
+		}
+		findings = append(findings, translationQAFieldFinding(fieldPath, "whitespace", translationQASeverityInfo,
+			fmt.Sprintf("Match the source %s whitespace.", strings.Join(edges, " and ")),
+			map[string]any{
+				"source_leading":  sourceLeading,
+				"source_trailing": sourceTrailing,
+				"target_leading":  targetLeading,
+				"target_trailing": targetTrailing,
+			}))
+	})
+	return findings
+}
+
+func translationQAEdgeWhitespace(value string) (string, string) {
+	trimmedLeft := strings.TrimLeftFunc(value, unicode.IsSpace)
+	trimmed := strings.TrimRightFunc(trimmedLeft, unicode.IsSpace)
+	return value[:len(value)-len(trimmedLeft)], trimmedLeft[len(trimmed):]
+}
@@ -0,0 +1,354 @@
+package admin
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+
+	translationservices "github.com/goliatone/go-admin/translations/services"
+)
+
+const translationQASeverityInfo = "info"
+
+// TranslationQAFinding is a single issue reported by a translation QA rule.
+type TranslationQAFinding struct {
+	ID        string         `json:"id"`
+	RuleID    string         `json:"rule_id"`
+	Category  string         `json:"category"`
+	Severity  string         `json:"severity"`
+	FieldPath string         `json:"field_path"`
+	Message   string         `json:"message"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+// TranslationQARuleInput carries the source/target fields a rule evaluates.
+type TranslationQARuleInput struct {
+	TenantID        string
+	OrgID           string
+	ContentType     string
+	SourceLocale    string
+	TargetLocale    string
+	FieldPaths      []string
+	SourceFields    map[string]string
+	TargetFields    map[string]string
+	FieldMaxLengths map[string]int
+	GlossaryTerms   []TranslationGlossaryTerm
+}
+
+// TranslationQARule checks a translation and reports findings. Rules should be
+// deterministic and side-effect free; they run on every editor load and save.
+type TranslationQARule interface {
+	ID() string
+	Category() string
+	Evaluate(ctx context.Context, input TranslationQARuleInput) []TranslationQAFinding
+}
+
+// TranslationQARuleFunc adapts a function into a TranslationQARule.
+type TranslationQARuleFunc struct {
+	RuleID       string
+	RuleCategory string
+	Fn           func(ctx context.Context, input TranslationQARuleInput) []TranslationQAFinding
+}
+
+func (r TranslationQARuleFunc) ID() string       { return r.RuleID }
+func (r TranslationQARuleFunc) Category() string { return r.RuleCategory }
+
+func (r TranslationQARuleFunc) Evaluate(ctx context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+	if r.Fn == nil {
+		return nil
+	}
+	return r.Fn(ctx, input)
+}
+
+// TranslationQARuleRegistration binds a rule to a scope. Empty TenantID, OrgID
+// and ContentType match any value; when several registrations share a rule ID
+// the most specific match wins, so hosts can override severities or disable a
+// built-in rule for one tenant or content type.
+type TranslationQARuleRegistration struct {
+	Rule        TranslationQARule
+	TenantID    string
+	OrgID       string
+	ContentType string
+	// Severity overrides the severity of every finding the rule reports.
+	Severity string
+	Disabled bool
+}
+
+// TranslationQARuleRegistry stores QA rules and their scoped overrides.
+type TranslationQARuleRegistry struct {
+	mu      sync.RWMutex
+	entries []TranslationQARuleRegistration
+}
+
+// NewTranslationQARuleRegistry constructs an empty registry.
+func NewTranslationQARuleRegistry() *TranslationQARuleRegistry {
+	return &TranslationQARuleRegistry{}
+}
+
+// NewDefaultTranslationQARuleRegistry constructs a registry seeded with DefaultTranslationQARules.
+func NewDefaultTranslationQARuleRegistry() *TranslationQARuleRegistry {
+	registry := NewTranslationQARuleRegistry()
+	for _, rule := range DefaultTranslationQARules() {
+		_ = registry.Register(TranslationQARuleRegistration{Rule: rule})
+	}
+	return registry
+}
+
+// Register adds a rule registration. Registering the same rule ID and scope
+// again replaces the previous registration.
+func (r *TranslationQARuleRegistry) Register(registration TranslationQARuleRegistration) error {
+	if r == nil {
+		return serviceNotConfiguredDomainError("translation qa rule registry", nil)
+	}
+	if registration.Rule == nil || strings.TrimSpace(registration.Rule.ID()) == "" {
+		return requiredFieldDomainError("rule", map[string]any{"component": "translation_qa_rules"})
+	}
+	registration.TenantID = strings.TrimSpace(registration.TenantID)
+	registration.OrgID = strings.TrimSpace(registration.OrgID)
+	registration.ContentType = strings.ToLower(strings.TrimSpace(registration.ContentType))
+	registration.Severity = strings.ToLower(strings.TrimSpace(registration.Severity))
+	if registration.Severity != "" && !translationQASeverityValid(registration.Severity) {
+		return validationDomainError("unsupported translation qa severity", map[string]any{
+			"rule_id":  registration.Rule.ID(),
+			"severity": registration.Severity,
+		})
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.entries {
+		if existing.Rule.ID() == registration.Rule.ID() &&
+			existing.TenantID == registration.TenantID &&
+			existing.OrgID == registration.OrgID &&
+			existing.ContentType == registration.ContentType {
+			r.entries[i] = registration
+			return nil
+		}
+	}
+	r.entries = append(r.entries, registration)
+	return nil
+}
+
+// Rules resolves the enabled registrations that apply to the input scope,
+// ordered by rule ID.
+func (r *TranslationQARuleRegistry) Rules(input TranslationQARuleInput) []TranslationQARuleRegistration {
+	if r == nil {
+		return nil
+	}
+	tenantID := strings.TrimSpace(input.TenantID)
+	orgID := strings.TrimSpace(input.OrgID)
+	contentType := strings.ToLower(strings.TrimSpace(input.ContentType))
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	resolved := map[string]TranslationQARuleRegistration{}
+	specificity := map[string]int{}
+	for _, entry := range r.entries {
+		if !translationQARuleScopeMatches(entry.TenantID, tenantID) ||
+			!translationQARuleScopeMatches(entry.OrgID, orgID) ||
+			!translationQARuleScopeMatches(entry.ContentType, contentType) {
+			continue
+		}
+		id := entry.Rule.ID()
+		score := translationQARuleSpecificity(entry)
+		if current, ok := specificity[id]; ok && current > score {
+			continue
+		}
+		resolved[id] = entry
+		specificity[id] = score
+	}
+	out := make([]TranslationQARuleRegistration, 0, len(resolved))
+	for _, entry := range resolved {
+		if entry.Disabled {
+			continue
+		}
+		out = append(out, entry)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Rule.ID() < out[j].Rule.ID() })
+	return out
+}
+
+// Evaluate runs every rule that applies to the input and whose category is
+// enabled. A nil categoryEnabled runs all categories.
+func (r *TranslationQARuleRegistry) Evaluate(ctx context.Context, input TranslationQARuleInput, categoryEnabled func(category string) bool) []TranslationQAFinding {
+	findings := []TranslationQAFinding{}
+	for _, registration := range r.Rules(input) {
+		rule := registration.Rule
+		category := strings.ToLower(strings.TrimSpace(rule.Category()))
+		if categoryEnabled != nil && !categoryEnabled(category) {
+			continue
+		}
+		for _, finding := range rule.Evaluate(ctx, input) {
+			finding.RuleID = rule.ID()
+			if strings.TrimSpace(finding.Category) == "" {
+				finding.Category = category
+			}
+			if registration.Severity != "" {
+				finding.Severity = registration.Severity
+			}
+			finding.Severity = strings.ToLower(strings.TrimSpace(finding.Severity))
+			if !translationQASeverityValid(finding.Severity) {
+				finding.Severity = translationQASeverityWarning
+			}
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+func translationQARuleScopeMatches(registered, actual string) bool {
+	return registered == "" || strings.EqualFold(registered, actual)
+}
+
+func translationQARuleSpecificity(entry TranslationQARuleRegistration) int {
+	score := 0
+	if entry.TenantID != "" {
+		score += 4
+	}
+	if entry.OrgID != "" {
+		score += 2
+	}
+	if entry.ContentType != "" {
+		score++
+	}
+	return score
+}
+
+func translationQASeverityValid(severity string) bool {
+	switch severity {
+	case translationQASeverityBlocker, translationQASeverityWarning, translationQASeverityInfo:
+		return true
+	default:
+		return false
+	}
+}
+
+func (a *Admin) translationQARuleRegistry() *TranslationQARuleRegistry {
+	if a == nil || a.translationQARules == nil {
+		return defaultTranslationQARuleRegistry
+	}
+	return a.translationQARules
+}
+
+var defaultTranslationQARuleRegistry = NewDefaultTranslationQARuleRegistry()
+
+func translationQARuleInputFromEditor(editorCtx translationEditorContext) TranslationQARuleInput {
+	return TranslationQARuleInput{
+		TenantID:        strings.TrimSpace(editorCtx.Family.TenantID),
+		OrgID:           strings.TrimSpace(editorCtx.Family.OrgID),
+		ContentType:     strings.TrimSpace(editorCtx.Family.ContentType),
+		SourceLocale:    strings.TrimSpace(editorCtx.SourceVariant.Locale),
+		TargetLocale:    strings.TrimSpace(editorCtx.TargetVariant.Locale),
+		FieldPaths:      translationEditorFieldPaths(editorCtx),
+		SourceFields:    editorCtx.SourceFields,
+		TargetFields:    editorCtx.TargetFields,
+		FieldMaxLengths: editorCtx.FieldMaxLengths,
+		GlossaryTerms:   editorCtx.GlossaryTerms,
+	}
+}
+
+// editorContext rebuilds the editor view the legacy terminology/style checks expect.
+func (input TranslationQARuleInput) editorContext() translationEditorContext {
+	return translationEditorContext{
+		Family: translationservices.FamilyRecord{
+			TenantID:    input.TenantID,
+			OrgID:       input.OrgID,
+			ContentType: input.ContentType,
+		},
+		SourceVariant: translationservices.FamilyVariant{Locale: input.SourceLocale},
+		TargetVariant: translationservices.FamilyVariant{Locale: input.TargetLocale},
+		SourceFields:  input.SourceFields,
+		TargetFields:  input.TargetFields,
+		GlossaryTerms: input.GlossaryTerms,
+	}
+}
+
+func (input TranslationQARuleInput) fieldPaths() []string {
+	if len(input.FieldPaths) > 0 {
+		return input.FieldPaths
+	}
+	return translationEditorFieldPaths(input.editorContext())
+}
+
+func (f TranslationQAFinding) payload() map[string]any {
+	out := make(map[string]any, len(f.Metadata)+6)
+	maps.Copy(out, f.Metadata)
+	out["id"] = f.ID
+	out["rule_id"] = f.RuleID
+	out["category"] = f.Category
+	out["severity"] = f.Severity
+	out["field_path"] = f.FieldPath
+	out["message"] = f.Message
+	return out
+}
+
+func translationQAFindingFromPayload(payload map[string]any) TranslationQAFinding {
+	finding := TranslationQAFinding{
+		ID:        toString(payload["id"]),
+		Category:  toString(payload["category"]),
+		Severity:  toString(payload["severity"]),
+		FieldPath: toString(payload["field_path"]),
+		Message:   toString(payload["message"]),
+		Metadata:  map[string]any{},
+	}
+	for key, value := range payload {
+		switch key {
+		case "id", "rule_id", "category", "severity", "field_path", "message":
+			continue
+		}
+		finding.Metadata[key] = value
+	}
+	return finding
+}
+
+func translationQAFindingsFromPayloads(payloads []map[string]any) []TranslationQAFinding {
+	out := make([]TranslationQAFinding, 0, len(payloads))
+	for _, payload := range payloads {
+		out = append(out, translationQAFindingFromPayload(payload))
+	}
+	return out
+}
+
+// translationEditorFieldMaxLengths reads maxLength constraints from the content
+// type schema so the length rule can enforce them. Lookup failures disable the check.
+func (b *translationQueueBinding) translationEditorFieldMaxLengths(ctx context.Context, contentType string) map[string]int {
+	contentType = strings.TrimSpace(contentType)
+	if b == nil || b.admin == nil || b.admin.contentTypeSvc == nil || contentType == "" {
+		return nil
+	}
+	record, err := b.admin.contentTypeSvc.ContentTypeBySlug(ctx, contentType)
+	if err != nil || record == nil {
+		return nil
+	}
+	return translationSchemaFieldMaxLengths(record.Schema)
+}
+
+// translationSchemaFieldMaxLengths collects JSON schema maxLength values keyed
+// by dotted property path.
+func translationSchemaFieldMaxLengths(schema map[string]any) map[string]int {
+	out := map[string]int{}
+	var walk func(prefix string, node map[string]any)
+	walk = func(prefix string, node map[string]any) {
+		for name, raw := range extractMap(node["properties"]) {
+			property := extractMap(raw)
+			if len(property) == 0 {
+				continue
+			}
+			path := name
+			if prefix != "" {
+				path = prefix + "." + name
+			}
+			if maxLength := intValue(property["maxLength"]); maxLength > 0 {
+				out[path] = maxLength
+			}
+			walk(path, property)
+		}
+	}
+	walk("", schema)
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package admin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func translationQAFindingIDs(findings []TranslationQAFinding) map[string]TranslationQAFinding {
+	out := map[string]TranslationQAFinding{}
+	for _, finding := range findings {
+		out[finding.ID] = finding
+	}
+	return out
+}
+
+func TestTranslationQAICUMessageRuleChecksPluralAndSelectBranches(t *testing.T) {
+	input := TranslationQARuleInput{
+		TargetLocale: "ru",
+		SourceFields: map[string]string{
+			"count":  "{count, plural, =0 {No files} one {# file} other {# files}}",
+			"gender": "{gender, select, male {He} female {She} other {They}} replied",
+			"broken": "{count, plural, one {# item} other {# items}}",
+			"plain":  "Hello {{name}}",
+		},
+		TargetFields: map[string]string{
+			"count":  "{count, plural, =0 {Нет файлов} one {# файл} other {# файлов} two {# файла}}",
+			"gender": "{gender, select, male {Он} other {Они}} ответил",
+			"broken": "{count, plural, one {# элемент} other {# элементов}",
+			"plain":  "Привет {{name}}",
+		},
+	}
+	findings := translationQAFindingIDs(translationQAICUMessageFindings(context.Background(), input))
+
+	plural := findings["style:count:icu_plural:count"]
+	if plural.Severity != translationQASeverityBlocker || !reflect.DeepEqual(plural.Metadata["missing_branches"], []string{"few", "many"}) {
+		t.Fatalf("expected missing russian plural categories, got %+v", findings)
+	}
+	if unexpected := findings["style:count:icu_plural:count:unexpected"]; !reflect.DeepEqual(unexpected.Metadata["unexpected_branches"], []string{"two"}) {
+		t.Fatalf("expected unused plural branch warning, got %+v", findings)
+	}
+	if selectFinding := findings["style:gender:icu_select:gender"]; !reflect.DeepEqual(selectFinding.Metadata["missing_branches"], []string{"female"}) {
+		t.Fatalf("expected missing select branch, got %+v", findings)
+	}
+	if syntax := findings["style:broken:icu_syntax"]; syntax.Severity != translationQASeverityBlocker {
+		t.Fatalf("expected syntax blocker, got %+v", findings)
+	}
+	if len(findings) != 4 {
+		t.Fatalf("expected plain placeholder field to be ignored, got %+v", findings)
+	}
+}
+
+func TestTranslationQANumberRuleIgnoresLocaleFormatting(t *testing.T) {
+	input := TranslationQARuleInput{
+		SourceFields: map[string]string{
+			"body":  "Save 1,500.50 credits before 2024-03-05 with {{code}}.",
+			"title": "Only 20 seats left on 03/05/2024",
+		},
+		TargetFields: map[string]string{
+			"body":  "Économisez 1.500,50 crédits avant le 05/03/2024 avec {{code}}.",
+			"title": "Plus que 2 places le 04/05/2024",
+		},
+	}
+	findings := translationQAFindingIDs(translationQANumberFindings(context.Background(), input))
+	if len(findings) != 2 {
+		t.Fatalf("expected title number and date findings only, got %+v", findings)
+	}
+	if got := findings["style:title:number"].Metadata["missing_values"]; !reflect.DeepEqual(got, []string{"20"}) {
+		t.Fatalf("expected missing number 20, got %+v", findings)
+	}
+	if got := findings["style:title:date"].Metadata["missing_values"]; !reflect.DeepEqual(got, []string{"03/05/2024"}) {
+		t.Fatalf("expected changed date, got %+v", findings)
+	}
+}
+
+func TestTranslationQALengthRuleEnforcesSchemaAndRatio(t *testing.T) {
+	input := TranslationQARuleInput{
+		SourceFields:    map[string]string{"title": "Read more", "summary": "Short summary text"},
+		TargetFields:    map[string]string{"title": "En savoir plus", "summary": "Un résumé beaucoup trop long pour ce champ de texte"},
+		FieldMaxLengths: translationSchemaFieldMaxLengths(map[string]any{"properties": map[string]any{"title": map[string]any{"type": "string", "maxLength": 10}}}),
+	}
+	findings := translationQAFindingIDs(NewTranslationQALengthRule(0).Evaluate(context.Background(), input))
+	if got := findings["style:title:max_length"]; got.Severity != translationQASeverityBlocker {
+		t.Fatalf("expected schema maxLength blocker, got %+v", findings)
+	}
+	if got := findings["style:summary:length_ratio"]; got.Severity != translationQASeverityWarning {
+		t.Fatalf("expected ratio warning, got %+v", findings)
+	}
+}
+
+func TestTranslationQAMarkdownAndWhitespaceRules(t *testing.T) {
+	input := TranslationQARuleInput{
+		SourceFields: map[string]string{"body": "## Setup\n\n- Run `make`\n- See [docs](/docs)\n", "label": "Next "},
+		TargetFields: map[string]string{"body": "Configuration\n\n- Lancez `faire`\n- Voir [docs](/docs)\n", "label": "Suivant"},
+	}
+	markdown := translationQAMarkdownFindings(context.Background(), input)
+	if len(markdown) != 1 || len(markdown[0].Metadata["issues"].([]string)) != 2 {
+		t.Fatalf("expected heading and inline code issues, got %+v", markdown)
+	}
+	whitespace := translationQAWhitespaceFindings(context.Background(), input)
+	if len(whitespace) != 1 || whitespace[0].FieldPath != "label" || whitespace[0].Severity != translationQASeverityInfo {
+		t.Fatalf("expected trailing whitespace info, got %+v", whitespace)
+	}
+}
+
+func TestTranslationQARuleRegistryScopesSeverityOverrides(t *testing.T) {
+	registry := NewDefaultTranslationQARuleRegistry()
+	custom := TranslationQARuleFunc{
+		RuleID:       "brand.voice",
+		RuleCategory: "brand",
+		Fn: func(_ context.Context, input TranslationQARuleInput) []TranslationQAFinding {
+			return []TranslationQAFinding{{ID: "brand:title", FieldPath: "title", Message: "Use the brand voice.", Severity: translationQASeverityWarning}}
+		},
+	}
+	for _, registration := range []TranslationQARuleRegistration{
+		{Rule: custom, TenantID: "acme"},
+		{Rule: NewTranslationQAWhitespaceRule(), TenantID: "acme", Severity: translationQASeverityBlocker},
+		{Rule: NewTranslationQAWhitespaceRule(), TenantID: "acme", ContentType: "posts", Disabled: true},
+	} {
+		if err := registry.Register(registration); err != nil {
+			t.Fatalf("register: %v", err)
+		}
+	}
+	if err := registry.Register(TranslationQARuleRegistration{Rule: custom, Severity: "fatal"}); err == nil {
+		t.Fatal("expected unsupported severity error")
+	}
+
+	editorCtx := translationEditorContext{
+		SourceFields: map[string]string{"title": "Home "},
+		TargetFields: map[string]string{"title": "Accueil"},
+	}
+	editorCtx.Family.TenantID = "acme"
+	editorCtx.Family.ContentType = "pages"
+	categories := map[string]map[string]any{
+		"terminology": translationQACategoryEnvelope("terminology", false, string(FeatureTranslationQATerms)),
+		"style":       translationQACategoryEnvelope("style", true, string(FeatureTranslationQAStyle)),
+	}
+	findings := translationQAFindings(context.Background(), registry, editorCtx, categories, true)
+	byID := map[string]map[string]any{}
+	for _, finding := range findings {
+		byID[toString(finding["id"])] = finding
+	}
+	if got := byID["style:title:whitespace"]; toString(got["severity"]) != translationQASeverityBlocker || toString(got["rule_id"]) != TranslationQARuleWhitespace {
+		t.Fatalf("expected tenant severity override, got %+v", findings)
+	}
+	if got := byID["brand:title"]; toString(got["category"]) != "brand" || intValue(categories["brand"]["warning_count"]) != 1 {
+		t.Fatalf("expected host rule in its own category, got %+v %+v", findings, categories)
+	}
+
+	editorCtx.Family.ContentType = "posts"
+	for _, registration := range registry.Rules(translationQARuleInputFromEditor(editorCtx)) {
+		if registration.Rule.ID() == TranslationQARuleWhitespace {
+			t.Fatalf("expected whitespace rule disabled for posts")
+		}
+	}
+	editorCtx.Family.TenantID = "other"
+	if got := translationQAFindings(context.Background(), registry, editorCtx, categories, true); len(got) != 1 || toString(got[0]["severity"]) != translationQASeverityInfo {
+		t.Fatalf("expected default whitespace info outside tenant scope, got %+v", got)
+	}
+}
@@ -43,9 +43,12 @@ type TranslationQueueAutoCreateResult struct {
 }
 
 // DefaultTranslationQueueAutoCreateHook implements auto-create using the assignment repository.
+// When AutoAssigner is set, newly created open-pool assignments are handed to a
+// selected assignee.
 type DefaultTranslationQueueAutoCreateHook struct {
-	Repository TranslationAssignmentRepository `json:"repository"`
-	Logger     Logger                          `json:"logger"`
+	Repository   TranslationAssignmentRepository `json:"repository"`
+	Logger       Logger                          `json:"logger"`
+	AutoAssigner *TranslationQueueAutoAssigner   `json:"auto_assigner"`
 }
 
 // OnTranslationBlocker creates or reuses queue assignments for missing locales.
@@ -68,6 +71,9 @@ func (h *DefaultTranslationQueueAutoCreateHook) OnTranslationBlocker(ctx context
 			translationQueueAutoCreateRecordFailure(&result, logger, assignment, err)
 			continue
 		}
+		if isNew {
+			created = h.translationQueueAutoAssign(ctx, logger, created)
+		}
 		result.Assignments = append(result.Assignments, created)
 		translationQueueAutoCreateRecordSuccess(&result, logger, created, isNew)
 	}
@@ -81,6 +87,28 @@ func (h *DefaultTranslationQueueAutoCreateHook) translationQueueAutoCreateEnable
 		len(input.MissingLocales) > 0
 }
 
+func (h *DefaultTranslationQueueAutoCreateHook) translationQueueAutoAssign(ctx context.Context, logger Logger, assignment TranslationAssignment) TranslationAssignment {
+	assigned, ok, err := h.AutoAssigner.AutoAssign(ctx, assignment)
+	if err != nil {
+		logger.Warn("translation queue auto-assign failed",
+			"event", "translation.queue.auto_assign.error",
+			"assignment_id", assignment.ID,
+			"target_locale", assignment.TargetLocale,
+			"error", err.Error(),
+		)
+		return assignment
+	}
+	if ok {
+		logger.Info("translation queue auto-assign success",
+			"event", "translation.queue.auto_assign.assigned",
+			"assignment_id", assigned.ID,
+			"target_locale", assigned.TargetLocale,
+			"assignee_id", assigned.AssigneeID,
+		)
+	}
+	return assigned
+}
+
 func (h *DefaultTranslationQueueAutoCreateHook) translationQueueAutoCreateLogger() Logger {
 	if h != nil && h.Logger != nil {
 		return h.Logger
@@ -3234,7 +3234,7 @@ func (b *translationQueueBinding) reviewBlockedAssignments(ctx context.Context,
 			continue
 		}
 		for _, assignment := range familyAssignments {
-			if b.assignmentHasQABlockersForFamily(assignment, family, environment) {
+			if b.assignmentHasQABlockersForFamily(ctx, assignment, family, environment) {
 				blocked[strings.TrimSpace(assignment.ID)] = struct{}{}
 			}
 		}
@@ -3990,10 +3990,10 @@ func (b *translationQueueBinding) assignmentQASummary(ctx context.Context, assig
 	if err != nil {
 		return nil
 	}
-	return translationQASummaryPayload(b.translationQAResults(editorCtx))
+	return translationQASummaryPayload(b.translationQAResults(ctx, editorCtx))
 }
 
-func (b *translationQueueBinding) assignmentQASummaryForFamily(assignment TranslationAssignment, family translationservices.FamilyRecord, environment string) map[string]any {
+func (b *translationQueueBinding) assignmentQASummaryForFamily(ctx context.Context, assignment TranslationAssignment, family translationservices.FamilyRecord, environment string) map[string]any {
 	if !b.translationQAEnabled() || strings.TrimSpace(assignment.TargetRecordID) == "" {
 		return nil
 	}
@@ -4001,11 +4001,13 @@ func (b *translationQueueBinding) assignmentQASummaryForFamily(assignment Transl
 	if !ok {
 		return nil
 	}
-	return translationQASummaryPayload(b.translationQAResults(editorCtx))
+	editorCtx.GlossaryTerms = translationEditorGlossaryTerms(ctx, b.admin.translationGlossaryStore, primitives.FirstNonEmptyRaw(assignment.TenantID, family.TenantID), primitives.FirstNonEmptyRaw(assignment.OrgID, family.OrgID), editorCtx)
+	editorCtx.FieldMaxLengths = b.translationEditorFieldMaxLengths(ctx, family.ContentType)
+	return translationQASummaryPayload(b.translationQAResults(ctx, editorCtx))
 }
 
-func (b *translationQueueBinding) assignmentHasQABlockersForFamily(assignment TranslationAssignment, family translationservices.FamilyRecord, environment string) bool {
-	summary := b.assignmentQASummaryForFamily(assignment, family, environment)
+func (b *translationQueueBinding) assignmentHasQABlockersForFamily(ctx context.Context, assignment TranslationAssignment, family translationservices.FamilyRecord, environment string) bool {
+	summary := b.assignmentQASummaryForFamily(ctx, assignment, family, environment)
 	return intValue(summary["blocker_count"]) > 0
 }
 
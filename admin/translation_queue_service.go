@@ -26,11 +26,13 @@ type TranslationQueueService interface {
 }
 
 // DefaultTranslationQueueService implements queue lifecycle transitions over a repository.
+// When AutoAssigner is set, released assignments are handed to another translator.
 type DefaultTranslationQueueService struct {
 	Repository    TranslationAssignmentRepository `json:"repository"`
 	Activity      ActivitySink                    `json:"activity"`
 	Notifications NotificationService             `json:"notifications"`
 	URLs          urlkit.Resolver                 `json:"urls"`
+	AutoAssigner  *TranslationQueueAutoAssigner   `json:"auto_assigner"`
 }
 
 func (s *DefaultTranslationQueueService) ensureRepository() error {
@@ -152,6 +154,7 @@ func (s *DefaultTranslationQueueService) Release(ctx context.Context, input Tran
 		return TranslationAssignment{}, invalidQueueTransitionError(assignment.Status, "release", assignment)
 	}
 
+	previousAssignee := assignment.AssigneeID
 	assignment.AssignmentType = AssignmentTypeOpenPool
 	assignment.Status = AssignmentStatusOpen
 	assignment.AssigneeID = ""
@@ -162,6 +165,11 @@ func (s *DefaultTranslationQueueService) Release(ctx context.Context, input Tran
 		return TranslationAssignment{}, err
 	}
 	s.recordTransition(ctx, "released", strings.TrimSpace(input.ActorID), updated)
+	// Auto-assignment is best effort: the release already succeeded and the
+	// assignment stays claimable from the pool when no assignee is selected.
+	// The caller gets the released state; the hand-off is recorded as its own
+	// assigned transition.
+	s.AutoAssigner.AutoAssign(ctx, updated, previousAssignee) //nolint:errcheck // the release stands when auto-assignment fails
 	return updated, nil
 }
 
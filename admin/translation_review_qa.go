@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"sort"
@@ -26,7 +27,7 @@ func (b *translationQueueBinding) translationQAEnabled() bool {
 		featureEnabledKey(b.admin.featureGate, string(FeatureTranslationQAStyle))
 }
 
-func (b *translationQueueBinding) translationQAResults(editorCtx translationEditorContext) map[string]any {
+func (b *translationQueueBinding) translationQAResults(ctx context.Context, editorCtx translationEditorContext) map[string]any {
 	terminologyEnabled := b != nil && b.admin != nil && featureEnabledKey(b.admin.featureGate, string(FeatureTranslationQATerms))
 	styleEnabled := b != nil && b.admin != nil && featureEnabledKey(b.admin.featureGate, string(FeatureTranslationQAStyle))
 
@@ -34,9 +35,13 @@ func (b *translationQueueBinding) translationQAResults(editorCtx translationEdit
 		"terminology": translationQACategoryEnvelope("terminology", terminologyEnabled, string(FeatureTranslationQATerms)),
 		"style":       translationQACategoryEnvelope("style", styleEnabled, string(FeatureTranslationQAStyle)),
 	}
-	findings := translationQAFindings(editorCtx, categories, terminologyEnabled, styleEnabled)
+	var admin *Admin
+	if b != nil {
+		admin = b.admin
+	}
+	findings := translationQAFindings(ctx, admin.translationQARuleRegistry(), editorCtx, categories, terminologyEnabled || styleEnabled)
 	sortTranslationQAFindings(findings)
-	warningCount, blockerCount := translationQAFindingCounts(findings)
+	warningCount, blockerCount, infoCount := translationQAFindingCounts(findings)
 	categoryPayload := translationQACategoryPayload(categories)
 
 	return map[string]any{
@@ -45,6 +50,7 @@ func (b *translationQueueBinding) translationQAResults(editorCtx translationEdit
 			"finding_count": len(findings),
 			"warning_count": warningCount,
 			"blocker_count": blockerCount,
+			"info_count":    infoCount,
 		},
 		"categories":     categoryPayload,
 		"findings":       findings,
@@ -53,21 +59,29 @@ func (b *translationQueueBinding) translationQAResults(editorCtx translationEdit
 	}
 }
 
-func translationQAFindings(editorCtx translationEditorContext, categories map[string]map[string]any, terminologyEnabled, styleEnabled bool) []map[string]any {
+// translationQAFindings runs the registered QA rules whose category is enabled.
+// Categories without a feature flag (host rules) run whenever QA is enabled.
+func translationQAFindings(ctx context.Context, registry *TranslationQARuleRegistry, editorCtx translationEditorContext, categories map[string]map[string]any, qaEnabled bool) []map[string]any {
 	findings := make([]map[string]any, 0, 8)
-	if terminologyEnabled {
-		findings = appendTranslationQAFindings(findings, categories["terminology"], translationTerminologyQAFindings(editorCtx))
+	if !qaEnabled {
+		return findings
 	}
-	if styleEnabled {
-		findings = appendTranslationQAFindings(findings, categories["style"], translationStyleQAFindings(editorCtx))
+	categoryEnabled := func(category string) bool {
+		envelope, ok := categories[category]
+		if !ok {
+			return true
+		}
+		return toBool(envelope["enabled"])
 	}
-	return findings
-}
-
-func appendTranslationQAFindings(findings []map[string]any, category map[string]any, rows []map[string]any) []map[string]any {
-	for _, finding := range rows {
-		findings = append(findings, finding)
-		translationQAAccumulateCategory(category, finding)
+	for _, finding := range registry.Evaluate(ctx, translationQARuleInputFromEditor(editorCtx), categoryEnabled) {
+		category, ok := categories[finding.Category]
+		if !ok {
+			category = translationQACategoryEnvelope(finding.Category, true, "")
+			categories[finding.Category] = category
+		}
+		payload := finding.payload()
+		findings = append(findings, payload)
+		translationQAAccumulateCategory(category, payload)
 	}
 	return findings
 }
@@ -95,17 +109,21 @@ func sortTranslationQAFindings(findings []map[string]any) {
 	})
 }
 
-func translationQAFindingCounts(findings []map[string]any) (int, int) {
+func translationQAFindingCounts(findings []map[string]any) (int, int, int) {
 	warningCount := 0
 	blockerCount := 0
+	infoCount := 0
 	for _, finding := range findings {
-		if strings.TrimSpace(strings.ToLower(toString(finding["severity"]))) == translationQASeverityBlocker {
+		switch strings.TrimSpace(strings.ToLower(toString(finding["severity"]))) {
+		case translationQASeverityBlocker:
 			blockerCount++
-			continue
+		case translationQASeverityInfo:
+			infoCount++
+		default:
+			warningCount++
 		}
-		warningCount++
 	}
-	return warningCount, blockerCount
+	return warningCount, blockerCount, infoCount
 }
 
 func translationQACategoryPayload(categories map[string]map[string]any) map[string]any {
@@ -125,6 +143,7 @@ func translationQASummaryPayload(results map[string]any) map[string]any {
 		"enabled":       toBool(results["enabled"]),
 		"warning_count": intValue(summary["warning_count"]),
 		"blocker_count": intValue(summary["blocker_count"]),
+		"info_count":    intValue(summary["info_count"]),
 		"finding_count": intValue(summary["finding_count"]),
 	}
 }
@@ -137,6 +156,7 @@ func translationQACategoryEnvelope(category string, enabled bool, featureFlag st
 		"finding_count": 0,
 		"warning_count": 0,
 		"blocker_count": 0,
+		"info_count":    0,
 	}
 }
 
@@ -145,17 +165,22 @@ func translationQAAccumulateCategory(category map[string]any, finding map[string
 		return
 	}
 	category["finding_count"] = intValue(category["finding_count"]) + 1
-	if strings.EqualFold(strings.TrimSpace(toString(finding["severity"])), translationQASeverityBlocker) {
+	switch strings.TrimSpace(strings.ToLower(toString(finding["severity"]))) {
+	case translationQASeverityBlocker:
 		category["blocker_count"] = intValue(category["blocker_count"]) + 1
-		return
+	case translationQASeverityInfo:
+		category["info_count"] = intValue(category["info_count"]) + 1
+	default:
+		category["warning_count"] = intValue(category["warning_count"]) + 1
 	}
-	category["warning_count"] = intValue(category["warning_count"]) + 1
 }
 
 func translationQASeverityRank(value string) int {
 	switch strings.TrimSpace(strings.ToLower(value)) {
 	case translationQASeverityBlocker:
 		return 0
+	case translationQASeverityInfo:
+		return 2
 	default:
 		return 1
 	}
@@ -174,8 +199,8 @@ func translationTerminologyQAFindings(editorCtx translationEditorContext) []map[
 			if fieldPath == "" {
 				continue
 			}
-			targetValue := strings.ToLower(strings.TrimSpace(editorCtx.TargetFields[fieldPath]))
-			if targetValue == "" || strings.Contains(targetValue, strings.ToLower(preferred)) {
+			targetValue := strings.TrimSpace(editorCtx.TargetFields[fieldPath])
+			if targetValue == "" || translationGlossaryTextContains(targetValue, preferred, toBool(match["case_sensitive"])) {
 				continue
 			}
 			findings = append(findings, map[string]any{
@@ -191,6 +216,38 @@ func translationTerminologyQAFindings(editorCtx translationEditorContext) []map[
 			})
 		}
 	}
+	return append(findings, translationForbiddenTermQAFindings(editorCtx)...)
+}
+
+func translationForbiddenTermQAFindings(editorCtx translationEditorContext) []map[string]any {
+	findings := []map[string]any{}
+	for _, fieldPath := range translationEditorFieldPaths(editorCtx) {
+		targetValue := strings.TrimSpace(editorCtx.TargetFields[fieldPath])
+		if targetValue == "" {
+			continue
+		}
+		for _, term := range editorCtx.GlossaryTerms {
+			if !term.Forbidden || !translationGlossaryTextContains(targetValue, term.Term, term.CaseSensitive) {
+				continue
+			}
+			message := fmt.Sprintf("Remove the forbidden term %q.", term.Term)
+			if term.PreferredTranslation != "" {
+				message = fmt.Sprintf("Replace the forbidden term %q with %q.", term.Term, term.PreferredTranslation)
+			}
+			findings = append(findings, map[string]any{
+				"id":                    fmt.Sprintf("terminology:%s:forbidden:%s", fieldPath, strings.ToLower(term.Term)),
+				"category":              "terminology",
+				"severity":              translationQASeverityBlocker,
+				"field_path":            fieldPath,
+				"message":               message,
+				"term":                  term.Term,
+				"preferred_translation": term.PreferredTranslation,
+				"forbidden":             true,
+				"source_locale":         strings.TrimSpace(editorCtx.SourceVariant.Locale),
+				"target_locale":         strings.TrimSpace(editorCtx.TargetVariant.Locale),
+			})
+		}
+	}
 	return findings
 }
 
@@ -0,0 +1,461 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	urlkit "github.com/goliatone/go-urlkit"
+)
+
+// TranslationSLAState classifies an assignment against its SLA policy.
+type TranslationSLAState string
+
+const (
+	TranslationSLAStateNone     TranslationSLAState = ""
+	TranslationSLAStateOnTrack  TranslationSLAState = "on_track"
+	TranslationSLAStateAtRisk   TranslationSLAState = "at_risk"
+	TranslationSLAStateBreached TranslationSLAState = "breached"
+)
+
+// TranslationSLAAction is an escalation step applied when an assignment
+// enters the at-risk or breached state.
+type TranslationSLAAction string
+
+const (
+	// TranslationSLAActionRaisePriority bumps the priority one level (low -> normal -> high -> urgent).
+	TranslationSLAActionRaisePriority TranslationSLAAction = "raise_priority"
+	// TranslationSLAActionFallbackPool releases translator-held work back to the open pool.
+	TranslationSLAActionFallbackPool TranslationSLAAction = "fallback_pool"
+	// TranslationSLAActionNotifyReviewer notifies the reviewer, or the assigner when no reviewer is set.
+	TranslationSLAActionNotifyReviewer TranslationSLAAction = "notify_reviewer"
+)
+
+const (
+	translationSLADefaultAtRiskWindow = 24 * time.Hour
+	translationSLAListPerPage         = 200
+)
+
+// TranslationSLAPolicy configures SLA windows and escalation steps for a
+// target locale and content type. Empty Locale or ContentType match any value.
+type TranslationSLAPolicy struct {
+	Locale      string `json:"locale,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	// AtRiskWindow marks assignments due within the window as at risk. Zero uses 24h.
+	AtRiskWindow time.Duration `json:"at_risk_window,omitempty"`
+	// BreachGrace delays the breach past the due date.
+	BreachGrace   time.Duration          `json:"breach_grace,omitempty"`
+	AtRiskActions []TranslationSLAAction `json:"at_risk_actions,omitempty"`
+	BreachActions []TranslationSLAAction `json:"breach_actions,omitempty"`
+}
+
+// TranslationSLAPolicies resolves the most specific policy for an assignment.
+type TranslationSLAPolicies []TranslationSLAPolicy
+
+// Resolve prefers a locale and content type match, then locale only, then
+// content type only, then the catch-all policy.
+func (p TranslationSLAPolicies) Resolve(locale, contentType string) (TranslationSLAPolicy, bool) {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	best := -1
+	bestRank := -1
+	for index, policy := range p {
+		policyLocale := strings.ToLower(strings.TrimSpace(policy.Locale))
+		policyType := strings.ToLower(strings.TrimSpace(policy.ContentType))
+		if (policyLocale != "" && policyLocale != locale) || (policyType != "" && policyType != contentType) {
+			continue
+		}
+		rank := 0
+		if policyLocale != "" {
+			rank += 2
+		}
+		if policyType != "" {
+			rank++
+		}
+		if rank > bestRank {
+			best, bestRank = index, rank
+		}
+	}
+	if best < 0 {
+		return TranslationSLAPolicy{}, false
+	}
+	return p[best], true
+}
+
+// State classifies an assignment at now. Assignments without a due date or
+// outside the actionable statuses have no SLA state.
+func (p TranslationSLAPolicy) State(assignment TranslationAssignment, now time.Time) TranslationSLAState {
+	if assignment.DueDate == nil || assignment.DueDate.IsZero() || !translationSLAActionableStatus(assignment.Status) {
+		return TranslationSLAStateNone
+	}
+	due := assignment.DueDate.UTC()
+	now = now.UTC()
+	if now.After(due.Add(max(p.BreachGrace, 0))) {
+		return TranslationSLAStateBreached
+	}
+	window := p.AtRiskWindow
+	if window <= 0 {
+		window = translationSLADefaultAtRiskWindow
+	}
+	if !now.Before(due.Add(-window)) {
+		return TranslationSLAStateAtRisk
+	}
+	return TranslationSLAStateOnTrack
+}
+
+func (p TranslationSLAPolicy) actions(state TranslationSLAState) []TranslationSLAAction {
+	switch state {
+	case TranslationSLAStateAtRisk:
+		return p.AtRiskActions
+	case TranslationSLAStateBreached:
+		return p.BreachActions
+	default:
+		return nil
+	}
+}
+
+func translationSLAActionableStatus(status AssignmentStatus) bool {
+	switch normalizeTranslationAssignmentStatus(status) {
+	case AssignmentStatusOpen, AssignmentStatusAssigned, AssignmentStatusInProgress, AssignmentStatusInReview, AssignmentStatusChangesRequested:
+		return true
+	default:
+		return false
+	}
+}
+
+// TranslationSLACounts aggregates SLA states for dashboards.
+type TranslationSLACounts struct {
+	AtRisk   int `json:"at_risk"`
+	Breached int `json:"breached"`
+}
+
+// CountTranslationSLAStates classifies assignments against their resolved policies.
+func CountTranslationSLAStates(assignments []TranslationAssignment, policies TranslationSLAPolicies, now time.Time) TranslationSLACounts {
+	counts := TranslationSLACounts{}
+	for _, assignment := range assignments {
+		policy, ok := policies.Resolve(assignment.TargetLocale, assignment.EntityType)
+		if !ok {
+			continue
+		}
+		switch policy.State(assignment, now) {
+		case TranslationSLAStateAtRisk:
+			counts.AtRisk++
+		case TranslationSLAStateBreached:
+			counts.Breached++
+		}
+	}
+	return counts
+}
+
+// TranslationSLACountInput scopes an aggregate SLA count.
+type TranslationSLACountInput struct {
+	TenantID string
+	OrgID    string
+	Policies TranslationSLAPolicies
+	Now      time.Time
+}
+
+// TranslationSLACountStore counts SLA states in the store instead of loading
+// every actionable assignment. Assignment repositories may implement it.
+type TranslationSLACountStore interface {
+	TranslationSLACounts(ctx context.Context, input TranslationSLACountInput) (TranslationSLACounts, error)
+}
+
+// TranslationSLAEscalationLedger remembers applied escalations so scheduled
+// runs act once per assignment, SLA state and due date.
+type TranslationSLAEscalationLedger interface {
+	// Claim reports whether key was not yet escalated and records it.
+	Claim(ctx context.Context, key string) (bool, error)
+	// Release forgets a claim whose escalation could not be applied so a
+	// later run retries it.
+	Release(ctx context.Context, key string) error
+}
+
+// InMemoryTranslationSLAEscalationLedger keeps claimed escalations in process
+// memory. Claims are lost on restart and are not shared between instances;
+// use BunTranslationSLAEscalationLedger in production.
+type InMemoryTranslationSLAEscalationLedger struct {
+	mu   sync.Mutex
+	keys map[string]struct{}
+}
+
+var _ TranslationSLAEscalationLedger = (*InMemoryTranslationSLAEscalationLedger)(nil)
+
+// NewInMemoryTranslationSLAEscalationLedger builds an empty ledger.
+func NewInMemoryTranslationSLAEscalationLedger() *InMemoryTranslationSLAEscalationLedger {
+	return &InMemoryTranslationSLAEscalationLedger{keys: map[string]struct{}{}}
+}
+
+func (l *InMemoryTranslationSLAEscalationLedger) Claim(_ context.Context, key string) (bool, error) {
+	if l == nil {
+		return false, serviceNotConfiguredDomainError("translation sla escalation ledger", map[string]any{"component": "translation_sla"})
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.keys[key]; ok {
+		return false, nil
+	}
+	l.keys[key] = struct{}{}
+	return true, nil
+}
+
+func (l *InMemoryTranslationSLAEscalationLedger) Release(_ context.Context, key string) error {
+	if l == nil {
+		return serviceNotConfiguredDomainError("translation sla escalation ledger", map[string]any{"component": "translation_sla"})
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.keys, key)
+	return nil
+}
+
+// TranslationSLAEscalation reports the steps applied to one assignment.
+type TranslationSLAEscalation struct {
+	AssignmentID string                 `json:"assignment_id"`
+	State        TranslationSLAState    `json:"state"`
+	Actions      []TranslationSLAAction `json:"actions"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// TranslationSLAEscalationResult summarizes one escalation run.
+type TranslationSLAEscalationResult struct {
+	Scanned     int                        `json:"scanned"`
+	AtRisk      int                        `json:"at_risk"`
+	Breached    int                        `json:"breached"`
+	Escalations []TranslationSLAEscalation `json:"escalations"`
+}
+
+// TranslationSLAEscalator finds at-risk and breached assignments and applies
+// the escalation steps configured by their SLA policy.
+type TranslationSLAEscalator struct {
+	Repository    TranslationAssignmentRepository `json:"repository"`
+	Policies      TranslationSLAPolicies          `json:"policies"`
+	Ledger        TranslationSLAEscalationLedger  `json:"ledger"`
+	Activity      ActivitySink                    `json:"activity"`
+	Notifications NotificationService             `json:"notifications"`
+	URLs          urlkit.Resolver                 `json:"urls"`
+	Now           func() time.Time                `json:"-"`
+}
+
+// Run scans actionable assignments across every scope. Failures on a single
+// assignment are reported in its escalation entry and do not stop the run.
+// The ledger is claimed before an escalation so concurrent runs cannot apply
+// it twice, and released again when the assignment update fails.
+func (e *TranslationSLAEscalator) Run(ctx context.Context) (TranslationSLAEscalationResult, error) {
+	result := TranslationSLAEscalationResult{Escalations: []TranslationSLAEscalation{}}
+	if e == nil || e.Repository == nil {
+		return result, serviceNotConfiguredDomainError("translation assignment repository", map[string]any{
+			"component": "translation_sla",
+		})
+	}
+	if len(e.Policies) == 0 {
+		return result, nil
+	}
+	if e.Ledger == nil {
+		return result, serviceNotConfiguredDomainError("translation sla escalation ledger", map[string]any{
+			"component": "translation_sla",
+		})
+	}
+	now := time.Now().UTC()
+	if e.Now != nil {
+		now = e.Now().UTC()
+	}
+	assignments, err := listTranslationSLAAssignments(ctx, e.Repository, nil)
+	if err != nil {
+		return result, err
+	}
+	for _, assignment := range assignments {
+		result.Scanned++
+		policy, ok := e.Policies.Resolve(assignment.TargetLocale, assignment.EntityType)
+		if !ok {
+			continue
+		}
+		state := policy.State(assignment, now)
+		switch state {
+		case TranslationSLAStateAtRisk:
+			result.AtRisk++
+		case TranslationSLAStateBreached:
+			result.Breached++
+		default:
+			continue
+		}
+		actions := policy.actions(state)
+		if len(actions) == 0 {
+			continue
+		}
+		key := translationSLAEscalationKey(assignment, state)
+		claimed, err := e.Ledger.Claim(ctx, key)
+		if err != nil {
+			return result, err
+		}
+		if !claimed {
+			continue
+		}
+		escalation, err := e.escalate(ctx, assignment, state, actions)
+		if err != nil {
+			escalation.Error = err.Error()
+			if releaseErr := e.Ledger.Release(ctx, key); releaseErr != nil {
+				return result, releaseErr
+			}
+		}
+		result.Escalations = append(result.Escalations, escalation)
+	}
+	return result, nil
+}
+
+// escalate applies actions to assignment. It returns an error only when
+// nothing was applied, so the caller can release the ledger claim; later
+// failures are reported on the escalation entry.
+func (e *TranslationSLAEscalator) escalate(ctx context.Context, assignment TranslationAssignment, state TranslationSLAState, actions []TranslationSLAAction) (TranslationSLAEscalation, error) {
+	escalation := TranslationSLAEscalation{
+		AssignmentID: strings.TrimSpace(assignment.ID),
+		State:        state,
+		Actions:      []TranslationSLAAction{},
+	}
+	next := assignment
+	changed := false
+	notify := false
+	for _, action := range actions {
+		switch action {
+		case TranslationSLAActionRaisePriority:
+			if raised, ok := raiseTranslationPriority(next.Priority); ok {
+				next.Priority = raised
+				changed = true
+				escalation.Actions = append(escalation.Actions, action)
+			}
+		case TranslationSLAActionFallbackPool:
+			switch next.Status {
+			case AssignmentStatusAssigned, AssignmentStatusInProgress, AssignmentStatusChangesRequested:
+				next.AssignmentType = AssignmentTypeOpenPool
+				next.Status = AssignmentStatusOpen
+				next.AssigneeID = ""
+				next.AssignerID = ActivityActorTypeSystem
+				changed = true
+				escalation.Actions = append(escalation.Actions, action)
+			}
+		case TranslationSLAActionNotifyReviewer:
+			notify = true
+		}
+	}
+	if changed {
+		updated, err := e.Repository.Update(ctx, next, assignment.Version)
+		if err != nil {
+			escalation.Actions = []TranslationSLAAction{}
+			return escalation, err
+		}
+		next = updated
+	}
+	meta := e.escalationMetadata(next, assignment, state)
+	if notify && e.notifyReviewer(ctx, next, state, meta) {
+		escalation.Actions = append(escalation.Actions, TranslationSLAActionNotifyReviewer)
+	}
+	meta["actions"] = slices.Clone(escalation.Actions)
+	if e.Activity != nil {
+		if err := e.Activity.Record(ctx, ActivityEntry{
+			Actor:    ActivityActorTypeSystem,
+			Action:   "translation.sla." + string(state),
+			Object:   "translation_assignment:" + escalation.AssignmentID,
+			Metadata: tagActivityActorType(meta, ActivityActorTypeJob),
+		}); err != nil {
+			escalation.Error = err.Error()
+		}
+	}
+	return escalation, nil
+}
+
+func (e *TranslationSLAEscalator) notifyReviewer(ctx context.Context, assignment TranslationAssignment, state TranslationSLAState, meta map[string]any) bool {
+	target := strings.TrimSpace(primitives.FirstNonEmptyRaw(assignment.ReviewerID, assignment.LastReviewerID, assignment.AssignerID))
+	if e.Notifications == nil || target == "" || target == ActivityActorTypeSystem {
+		return false
+	}
+	title := "Translation SLA At Risk"
+	if state == TranslationSLAStateBreached {
+		title = "Translation SLA Breached"
+	}
+	subject := strings.TrimSpace(primitives.FirstNonEmptyRaw(assignment.SourceTitle, assignment.FamilyID, assignment.ID))
+	message := fmt.Sprintf("%s (%s -> %s) is due %s", subject, assignment.SourceLocale, assignment.TargetLocale, assignment.DueDate.UTC().Format(time.RFC3339))
+	_, err := e.Notifications.Add(ctx, Notification{
+		Title:     title,
+		Message:   message,
+		ActionURL: toString(meta["url"]),
+		Metadata:  primitives.CloneAnyMap(meta),
+		UserID:    target,
+	})
+	return err == nil
+}
+
+func (e *TranslationSLAEscalator) escalationMetadata(assignment, previous TranslationAssignment, state TranslationSLAState) map[string]any {
+	query := map[string]string{"assignment_id": strings.TrimSpace(assignment.ID)}
+	meta := map[string]any{
+		"event":             "translation.sla." + string(state),
+		"sla_state":         string(state),
+		"assignment_id":     strings.TrimSpace(assignment.ID),
+		"family_id":         strings.TrimSpace(assignment.FamilyID),
+		"entity_type":       strings.TrimSpace(assignment.EntityType),
+		"source_locale":     strings.TrimSpace(assignment.SourceLocale),
+		"target_locale":     strings.TrimSpace(assignment.TargetLocale),
+		"status":            strings.TrimSpace(string(assignment.Status)),
+		"priority":          strings.TrimSpace(string(assignment.Priority)),
+		"previous_priority": strings.TrimSpace(string(previous.Priority)),
+		"assignee_id":       strings.TrimSpace(assignment.AssigneeID),
+		"previous_assignee": strings.TrimSpace(previous.AssigneeID),
+		"due_date":          assignment.DueDate.UTC(),
+	}
+	if url := resolveURLWith(e.URLs, "admin", "translations.queue", nil, query); url != "" {
+		meta["url"] = url
+	}
+	return meta
+}
+
+// translationSLAEscalationKey changes when the due date moves, so a
+// rescheduled assignment can escalate again.
+func translationSLAEscalationKey(assignment TranslationAssignment, state TranslationSLAState) string {
+	return strings.Join([]string{
+		strings.TrimSpace(assignment.ID),
+		string(state),
+		strconv.FormatInt(assignment.DueDate.UTC().Unix(), 10),
+	}, "|")
+}
+
+func raiseTranslationPriority(priority Priority) (Priority, bool) {
+	switch priority {
+	case PriorityLow:
+		return PriorityNormal, true
+	case PriorityNormal, "":
+		return PriorityHigh, true
+	case PriorityHigh:
+		return PriorityUrgent, true
+	default:
+		return priority, false
+	}
+}
+
+// listTranslationSLAAssignments pages through actionable assignments matching filters.
+func listTranslationSLAAssignments(ctx context.Context, repo TranslationAssignmentRepository, filters map[string]any) ([]TranslationAssignment, error) {
+	scoped := primitives.CloneAnyMap(filters)
+	if scoped == nil {
+		scoped = map[string]any{}
+	}
+	scoped["status"] = translationDashboardActionableStatusFilter()
+	out := []TranslationAssignment{}
+	for page := 1; ; page++ {
+		batch, total, err := repo.List(ctx, ListOptions{
+			Page:    page,
+			PerPage: translationSLAListPerPage,
+			SortBy:  "due_date",
+			Filters: primitives.CloneAnyMap(scoped),
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, batch...)
+		if len(batch) < translationSLAListPerPage || (total > 0 && page*translationSLAListPerPage >= total) {
+			return out, nil
+		}
+	}
+}
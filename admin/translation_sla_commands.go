@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/goliatone/go-command"
+	"github.com/goliatone/go-command/dispatcher"
+)
+
+const (
+	translationSLAEscalationCommandName     = "jobs.translations.sla.escalate"
+	translationSLAEscalationDefaultSchedule = "*/15 * * * *"
+)
+
+// TranslationSLAEscalationInput triggers one SLA escalation run.
+type TranslationSLAEscalationInput struct {
+	Result *TranslationSLAEscalationResult `json:"-"`
+}
+
+func (TranslationSLAEscalationInput) Type() string { return translationSLAEscalationCommandName }
+
+func (TranslationSLAEscalationInput) Validate() error { return nil }
+
+// TranslationSLAEscalationCommand runs the SLA escalator on demand and, once
+// registered on the command bus, on its cron schedule through JobRegistry.
+type TranslationSLAEscalationCommand struct {
+	Schedule  string                   `json:"schedule"`
+	Escalator *TranslationSLAEscalator `json:"escalator"`
+}
+
+var _ command.Commander[TranslationSLAEscalationInput] = (*TranslationSLAEscalationCommand)(nil)
+var _ command.CronCommand = (*TranslationSLAEscalationCommand)(nil)
+
+func (c *TranslationSLAEscalationCommand) Execute(ctx context.Context, msg TranslationSLAEscalationInput) error {
+	if c == nil || c.Escalator == nil {
+		return serviceNotConfiguredDomainError("translation sla escalator", map[string]any{
+			"command": translationSLAEscalationCommandName,
+		})
+	}
+	result, err := c.Escalator.Run(ctx)
+	if err != nil {
+		return err
+	}
+	if msg.Result != nil {
+		*msg.Result = result
+	}
+	return nil
+}
+
+func (c *TranslationSLAEscalationCommand) CronHandler() func() error {
+	return func() error {
+		return dispatcher.Dispatch(context.Background(), TranslationSLAEscalationInput{})
+	}
+}
+
+func (c *TranslationSLAEscalationCommand) CronOptions() command.HandlerConfig {
+	if c == nil {
+		return command.HandlerConfig{}
+	}
+	schedule := strings.TrimSpace(c.Schedule)
+	if schedule == "" {
+		schedule = translationSLAEscalationDefaultSchedule
+	}
+	return command.HandlerConfig{Expression: schedule}
+}
+
+// RegisterTranslationSLACommands registers the scheduled SLA escalation job.
+// An empty schedule runs every 15 minutes.
+func RegisterTranslationSLACommands(bus *CommandBus, escalator *TranslationSLAEscalator, schedule string) error {
+	_, err := RegisterCommand(bus, &TranslationSLAEscalationCommand{Schedule: schedule, Escalator: escalator})
+	return err
+}
@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// BunTranslationSLAEscalationLedger records escalation claims in the
+// translation_sla_escalations table so every instance shares them.
+type BunTranslationSLAEscalationLedger struct {
+	db *bun.DB
+}
+
+var _ TranslationSLAEscalationLedger = (*BunTranslationSLAEscalationLedger)(nil)
+
+func NewBunTranslationSLAEscalationLedger(db *bun.DB) *BunTranslationSLAEscalationLedger {
+	if db == nil {
+		return nil
+	}
+	return &BunTranslationSLAEscalationLedger{db: db}
+}
+
+type bunTranslationSLAEscalationRecord struct {
+	bun.BaseModel `bun:"table:translation_sla_escalations,alias:tse"`
+
+	Key       string    `bun:"escalation_key,pk" json:"escalation_key"`
+	ClaimedAt time.Time `bun:"claimed_at" json:"claimed_at"`
+}
+
+func (l *BunTranslationSLAEscalationLedger) Claim(ctx context.Context, key string) (bool, error) {
+	if err := l.ready(); err != nil {
+		return false, err
+	}
+	record := bunTranslationSLAEscalationRecord{Key: strings.TrimSpace(key), ClaimedAt: time.Now().UTC()}
+	result, err := l.db.NewInsert().
+		Model(&record).
+		On("CONFLICT (escalation_key) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (l *BunTranslationSLAEscalationLedger) Release(ctx context.Context, key string) error {
+	if err := l.ready(); err != nil {
+		return err
+	}
+	_, err := l.db.NewDelete().
+		Model((*bunTranslationSLAEscalationRecord)(nil)).
+		Where("escalation_key = ?", strings.TrimSpace(key)).
+		Exec(ctx)
+	return err
+}
+
+func (l *BunTranslationSLAEscalationLedger) ready() error {
+	if l == nil || l.db == nil {
+		return serviceNotConfiguredDomainError("translation sla escalation ledger", map[string]any{
+			"component": "translation_sla_ledger_bun",
+		})
+	}
+	return nil
+}
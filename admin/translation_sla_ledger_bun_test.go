@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	admindata "github.com/goliatone/go-admin/data"
+)
+
+func TestBunTranslationSLAEscalationLedgerClaimsOnceUntilReleased(t *testing.T) {
+	ctx := context.Background()
+	db := setupMigratedSQLite(t, admindata.TranslationSLAEscalationMigrations(), "0025_translation_sla_escalations.up.sql")
+	ledger := NewBunTranslationSLAEscalationLedger(db)
+	other := NewBunTranslationSLAEscalationLedger(db)
+
+	claimed, err := ledger.Claim(ctx, "asg-1|breached|1700000000")
+	if err != nil || !claimed {
+		t.Fatalf("expected first claim, got %v (%v)", claimed, err)
+	}
+	if claimed, err := other.Claim(ctx, "asg-1|breached|1700000000"); err != nil || claimed {
+		t.Fatalf("expected claim shared across ledgers, got %v (%v)", claimed, err)
+	}
+	if err := other.Release(ctx, "asg-1|breached|1700000000"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if claimed, err := ledger.Claim(ctx, "asg-1|breached|1700000000"); err != nil || !claimed {
+		t.Fatalf("expected released key to be claimable, got %v (%v)", claimed, err)
+	}
+	if NewBunTranslationSLAEscalationLedger(nil) != nil {
+		t.Fatal("expected nil ledger without a database")
+	}
+}
@@ -0,0 +1,193 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTranslationSLAPoliciesResolveMostSpecificPolicy(t *testing.T) {
+	policies := TranslationSLAPolicies{
+		{BreachGrace: time.Hour},
+		{ContentType: "pages", BreachGrace: 2 * time.Hour},
+		{Locale: "fr", BreachGrace: 3 * time.Hour},
+		{Locale: "FR", ContentType: "Pages", BreachGrace: 4 * time.Hour},
+	}
+	cases := map[string]struct {
+		locale, contentType string
+		grace               time.Duration
+	}{
+		"locale and type": {"fr", "pages", 4 * time.Hour},
+		"locale only":     {"fr", "news", 3 * time.Hour},
+		"type only":       {"de", "pages", 2 * time.Hour},
+		"catch-all":       {"de", "news", time.Hour},
+	}
+	for name, tc := range cases {
+		policy, ok := policies.Resolve(tc.locale, tc.contentType)
+		if !ok || policy.BreachGrace != tc.grace {
+			t.Fatalf("%s: expected grace %s, got %+v (ok=%v)", name, tc.grace, policy, ok)
+		}
+	}
+	if _, ok := (TranslationSLAPolicies{{Locale: "fr"}}).Resolve("de", "pages"); ok {
+		t.Fatal("expected no policy for unmatched locale")
+	}
+}
+
+func TestTranslationSLAEscalatorEscalatesOncePerState(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	repo := NewInMemoryTranslationAssignmentRepository()
+	create := func(assignment TranslationAssignment, due time.Time) TranslationAssignment {
+		t.Helper()
+		assignment.FamilyID = "tg_" + assignment.SourceRecordID
+		assignment.SourceLocale = "en"
+		assignment.DueDate = &due
+		if assignment.AssignmentType == "" {
+			assignment.AssignmentType = AssignmentTypeDirect
+		}
+		created, err := repo.Create(ctx, assignment)
+		if err != nil {
+			t.Fatalf("create assignment: %v", err)
+		}
+		return created
+	}
+	breached := create(TranslationAssignment{EntityType: "pages", SourceRecordID: "page_1", SourceTitle: "Home", TargetLocale: "fr", Status: AssignmentStatusInProgress, Priority: PriorityNormal, AssigneeID: "translator_1", ReviewerID: "reviewer_1"}, now.Add(-2*time.Hour))
+	atRisk := create(TranslationAssignment{EntityType: "news", SourceRecordID: "news_1", TargetLocale: "fr", Status: AssignmentStatusAssigned, Priority: PriorityLow, AssigneeID: "translator_2"}, now.Add(2*time.Hour))
+	onTrack := create(TranslationAssignment{EntityType: "pages", SourceRecordID: "page_2", TargetLocale: "de", Status: AssignmentStatusAssigned, Priority: PriorityNormal, AssigneeID: "translator_3"}, now.Add(72*time.Hour))
+	create(TranslationAssignment{EntityType: "pages", SourceRecordID: "page_3", TargetLocale: "fr", Status: AssignmentStatusApproved, Priority: PriorityNormal}, now.Add(-48*time.Hour))
+
+	activity := NewActivityFeed()
+	notifications := NewInMemoryNotificationService()
+	policies := TranslationSLAPolicies{
+		{Locale: "fr", ContentType: "pages", BreachActions: []TranslationSLAAction{TranslationSLAActionRaisePriority, TranslationSLAActionFallbackPool, TranslationSLAActionNotifyReviewer}},
+		{Locale: "fr", AtRiskWindow: 4 * time.Hour, AtRiskActions: []TranslationSLAAction{TranslationSLAActionRaisePriority}},
+		{AtRiskWindow: 24 * time.Hour},
+	}
+	escalator := &TranslationSLAEscalator{
+		Repository:    repo,
+		Policies:      policies,
+		Ledger:        NewInMemoryTranslationSLAEscalationLedger(),
+		Activity:      activity,
+		Notifications: notifications,
+		Now:           func() time.Time { return now },
+	}
+
+	result, err := escalator.Run(ctx)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if result.Scanned != 3 || result.Breached != 1 || result.AtRisk != 1 || len(result.Escalations) != 2 {
+		t.Fatalf("unexpected run result %+v", result)
+	}
+
+	updated, _ := repo.Get(ctx, breached.ID)
+	if updated.Priority != PriorityHigh || updated.Status != AssignmentStatusOpen || updated.AssignmentType != AssignmentTypeOpenPool || updated.AssigneeID != "" {
+		t.Fatalf("expected breached assignment raised and released to pool, got %+v", updated)
+	}
+	if risky, _ := repo.Get(ctx, atRisk.ID); risky.Priority != PriorityNormal || risky.AssigneeID != "translator_2" {
+		t.Fatalf("expected at-risk assignment priority raised only, got %+v", risky)
+	}
+	if untouched, _ := repo.Get(ctx, onTrack.ID); untouched.Version != onTrack.Version {
+		t.Fatalf("expected on-track assignment untouched, got %+v", untouched)
+	}
+
+	inbox, _ := notifications.List(ctx)
+	if len(inbox) != 1 || inbox[0].UserID != "reviewer_1" || inbox[0].Title != "Translation SLA Breached" {
+		t.Fatalf("expected one reviewer breach notification, got %+v", inbox)
+	}
+	entries, _ := activity.List(ctx, 10)
+	actions := map[string]int{}
+	for _, entry := range entries {
+		actions[entry.Action]++
+	}
+	if actions["translation.sla.breached"] != 1 || actions["translation.sla.at_risk"] != 1 {
+		t.Fatalf("expected one activity entry per escalation, got %+v", actions)
+	}
+
+	again, err := escalator.Run(ctx)
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if len(again.Escalations) != 0 {
+		t.Fatalf("expected no repeat escalations, got %+v", again.Escalations)
+	}
+	if inbox, _ := notifications.List(ctx); len(inbox) != 1 {
+		t.Fatalf("expected no repeat notifications, got %d", len(inbox))
+	}
+
+	all, _, _ := repo.List(ctx, ListOptions{PerPage: 10})
+	counts := CountTranslationSLAStates(all, policies, now)
+	if counts.Breached != 1 || counts.AtRisk != 1 {
+		t.Fatalf("unexpected SLA counts %+v", counts)
+	}
+}
+
+type failingOnceAssignmentRepository struct {
+	*InMemoryTranslationAssignmentRepository
+	failed bool
+}
+
+func (r *failingOnceAssignmentRepository) Update(ctx context.Context, assignment TranslationAssignment, expectedVersion int64) (TranslationAssignment, error) {
+	if !r.failed {
+		r.failed = true
+		return TranslationAssignment{}, errors.New("database unavailable")
+	}
+	return r.InMemoryTranslationAssignmentRepository.Update(ctx, assignment, expectedVersion)
+}
+
+func TestTranslationSLAEscalatorReleasesClaimWhenUpdateFails(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	repo := &failingOnceAssignmentRepository{InMemoryTranslationAssignmentRepository: NewInMemoryTranslationAssignmentRepository()}
+	due := now.Add(-time.Hour)
+	created, err := repo.Create(ctx, TranslationAssignment{
+		FamilyID:       "tg_page_1",
+		EntityType:     "pages",
+		SourceRecordID: "page_1",
+		SourceLocale:   "en",
+		TargetLocale:   "fr",
+		AssignmentType: AssignmentTypeDirect,
+		Status:         AssignmentStatusAssigned,
+		Priority:       PriorityNormal,
+		AssigneeID:     "translator_1",
+		DueDate:        &due,
+	})
+	if err != nil {
+		t.Fatalf("create assignment: %v", err)
+	}
+	escalator := &TranslationSLAEscalator{
+		Repository: repo,
+		Policies:   TranslationSLAPolicies{{BreachActions: []TranslationSLAAction{TranslationSLAActionRaisePriority}}},
+		Ledger:     NewInMemoryTranslationSLAEscalationLedger(),
+		Now:        func() time.Time { return now },
+	}
+
+	result, err := escalator.Run(ctx)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(result.Escalations) != 1 || result.Escalations[0].Error == "" || len(result.Escalations[0].Actions) != 0 {
+		t.Fatalf("expected failed escalation without applied actions, got %+v", result.Escalations)
+	}
+	retry, err := escalator.Run(ctx)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if len(retry.Escalations) != 1 || retry.Escalations[0].Error != "" {
+		t.Fatalf("expected released claim to escalate on the next run, got %+v", retry.Escalations)
+	}
+	if updated, _ := repo.Get(ctx, created.ID); updated.Priority != PriorityHigh {
+		t.Fatalf("expected priority raised once, got %s", updated.Priority)
+	}
+}
+
+func TestTranslationSLAEscalatorRequiresLedger(t *testing.T) {
+	escalator := &TranslationSLAEscalator{
+		Repository: NewInMemoryTranslationAssignmentRepository(),
+		Policies:   TranslationSLAPolicies{{}},
+	}
+	if _, err := escalator.Run(context.Background()); err == nil {
+		t.Fatal("expected missing ledger to be rejected")
+	}
+}
@@ -63,6 +63,11 @@ type TranslationSuggestionResult struct {
 	SourceLocale  string         `json:"source_locale,omitempty"`
 	TargetLocale  string         `json:"target_locale,omitempty"`
 	Diagnostics   map[string]any `json:"diagnostics,omitempty"`
+	// QAFindings lists terminology findings that remain on SuggestedText.
+	QAFindings []TranslationQAFinding `json:"qa_findings,omitempty"`
+	// QABlocked reports that SuggestedText still violates a blocker finding
+	// after retries; editors should not apply it without review.
+	QABlocked bool `json:"qa_blocked,omitempty"`
 }
 
 // TranslationSuggestionProviderInput is the sanitized provider request built
@@ -117,6 +122,10 @@ type TranslationSuggestionAssignmentContext struct {
 	TargetRecordID   string                `json:"target_record_id,omitempty"`
 	SourceVersion    string                `json:"source_version,omitempty"`
 	TargetRowVersion int64                 `json:"target_row_version,omitempty"`
+	// GlossaryTerms and MemorySuggestions feed the provider prompt and the
+	// terminology check on the returned suggestion.
+	GlossaryTerms     []TranslationGlossaryTerm           `json:"glossary_terms,omitempty"`
+	MemorySuggestions []TranslationEditorMemorySuggestion `json:"-"`
 }
 
 func normalizeTranslationSuggestionDecision(decision TranslationSuggestionDecision) TranslationSuggestionDecision {
@@ -2,9 +2,12 @@ package admin
 
 import (
 	"context"
+	"sort"
 	"strings"
 )
 
+const defaultTranslationSuggestionMemoryLimit = 3
+
 // TranslationSuggestionService contains suggestion generation behavior used by
 // commands and transports.
 type TranslationSuggestionService interface {
@@ -79,6 +82,15 @@ type DefaultTranslationSuggestionService struct {
 	Eligibility   TranslationSuggestionEligibilityChecker
 	AssistContext TranslationSuggestionAssistContextExtractor
 	Provider      TranslationSuggestionProvider
+	// MemorySuggestionLimit caps the translation memory matches added to the
+	// prompt. Zero uses the default of 3; a negative value disables them.
+	MemorySuggestionLimit int
+	// QARules validates suggestions against terminology findings. Nil uses the
+	// default registry.
+	QARules *TranslationQARuleRegistry
+	// DisableTerminologyRetry returns blocker-flagged suggestions without
+	// asking the provider for a corrected suggestion first.
+	DisableTerminologyRetry bool
 }
 
 func mergeTranslationSuggestionServiceDependencies(base, override TranslationSuggestionServiceDependencies) TranslationSuggestionServiceDependencies {
@@ -229,11 +241,36 @@ func (s *DefaultTranslationSuggestionService) SuggestTranslation(ctx context.Con
 	if err != nil {
 		return TranslationSuggestionResult{}, err
 	}
-	providerResult, err := s.Provider.SuggestTranslation(ctx, executionCtx.providerInput(input))
+	providerInput := executionCtx.providerInput(input)
+	providerResult, err := s.Provider.SuggestTranslation(ctx, providerInput)
+	if err != nil {
+		return TranslationSuggestionResult{}, err
+	}
+	result, err := executionCtx.result(providerResult)
 	if err != nil {
 		return TranslationSuggestionResult{}, err
 	}
-	return executionCtx.result(providerResult)
+	findings := s.translationSuggestionTerminologyFindings(ctx, executionCtx, result.SuggestedText)
+	if translationSuggestionHasBlocker(findings) && !s.DisableTerminologyRetry {
+		providerInput.AssistContext = translationSuggestionRetryAssist(providerInput.AssistContext, result.SuggestedText, findings)
+		retryResult, retryErr := s.Provider.SuggestTranslation(ctx, providerInput)
+		if retryErr == nil {
+			var retried TranslationSuggestionResult
+			if retried, retryErr = executionCtx.result(retryResult); retryErr == nil {
+				result = retried
+				findings = s.translationSuggestionTerminologyFindings(ctx, executionCtx, result.SuggestedText)
+			}
+		}
+		result.Diagnostics["terminology_retried"] = true
+		if retryErr != nil {
+			result.Diagnostics["terminology_retry_error"] = retryErr.Error()
+		}
+	}
+	if len(findings) > 0 {
+		result.QAFindings = findings
+		result.QABlocked = translationSuggestionHasBlocker(findings)
+	}
+	return result, nil
 }
 
 func (s *DefaultTranslationSuggestionService) requireSuggestionServiceReady(input TranslationSuggestionInput) error {
@@ -278,6 +315,7 @@ func (s *DefaultTranslationSuggestionService) prepareTranslationSuggestion(ctx c
 	if err != nil {
 		return translationSuggestionExecutionContext{}, err
 	}
+	assist = translationSuggestionPromptAssist(assist, loaded, fieldPath, sourceText, s.memorySuggestionLimit())
 	return translationSuggestionExecutionContext{
 		Assignment: assignment,
 		Loaded:     loaded,
@@ -505,7 +543,9 @@ func (l adminTranslationSuggestionContextLoader) LoadTranslationSuggestionContex
 	if err != nil {
 		return TranslationSuggestionAssignmentContext{}, err
 	}
-	return translationSuggestionContextFromEditor(assignment, editorCtx), nil
+	loaded := translationSuggestionContextFromEditor(assignment, editorCtx)
+	loaded.MemorySuggestions = translationEditorMemoryMatches(ctx, binding, editorCtx, translationEditorMemoryMatchLimit)
+	return loaded, nil
 }
 
 func translationSuggestionContextFromEditor(assignment TranslationAssignment, editorCtx translationEditorContext) TranslationSuggestionAssignmentContext {
@@ -521,5 +561,141 @@ func translationSuggestionContextFromEditor(assignment TranslationAssignment, ed
 		TargetRecordID:   strings.TrimSpace(editorCtx.TargetRecordID),
 		SourceVersion:    strings.TrimSpace(editorCtx.SourceVersion),
 		TargetRowVersion: editorCtx.TargetRowVersion,
+		GlossaryTerms:    append([]TranslationGlossaryTerm{}, editorCtx.GlossaryTerms...),
+	}
+}
+
+func (s *DefaultTranslationSuggestionService) memorySuggestionLimit() int {
+	if s.MemorySuggestionLimit == 0 {
+		return defaultTranslationSuggestionMemoryLimit
 	}
+	return max(s.MemorySuggestionLimit, 0)
+}
+
+func (s *DefaultTranslationSuggestionService) qaRuleRegistry() *TranslationQARuleRegistry {
+	if s.QARules == nil {
+		return defaultTranslationQARuleRegistry
+	}
+	return s.QARules
+}
+
+// translationSuggestionPromptAssist adds the glossary terms found in the source
+// text and the closest translation memory matches for the field to the
+// provider assist context. Keys already set by the host extractor are kept.
+func translationSuggestionPromptAssist(assist map[string]any, loaded TranslationSuggestionAssignmentContext, fieldPath, sourceText string, memoryLimit int) map[string]any {
+	glossary := translationSuggestionGlossaryAssist(loaded.GlossaryTerms, sourceText)
+	memory := translationSuggestionMemoryAssist(loaded.MemorySuggestions, fieldPath, memoryLimit)
+	if len(glossary) == 0 && len(memory) == 0 {
+		return assist
+	}
+	out := cloneAnyMap(assist)
+	if out == nil {
+		out = map[string]any{}
+	}
+	if _, exists := out["glossary_terms"]; !exists && len(glossary) > 0 {
+		out["glossary_terms"] = glossary
+	}
+	if _, exists := out["translation_memory"]; !exists && len(memory) > 0 {
+		out["translation_memory"] = memory
+	}
+	return out
+}
+
+func translationSuggestionGlossaryAssist(terms []TranslationGlossaryTerm, sourceText string) []map[string]any {
+	out := []map[string]any{}
+	for _, term := range terms {
+		value := strings.TrimSpace(term.Term)
+		if value == "" {
+			continue
+		}
+		if term.Forbidden {
+			entry := map[string]any{"term": value, "forbidden": true}
+			if term.PreferredTranslation != "" {
+				entry["preferred_translation"] = term.PreferredTranslation
+			}
+			out = append(out, entry)
+			continue
+		}
+		if term.PreferredTranslation == "" || !translationGlossaryTextContains(sourceText, value, term.CaseSensitive) {
+			continue
+		}
+		entry := map[string]any{"term": value, "preferred_translation": term.PreferredTranslation}
+		if term.Notes != "" {
+			entry["notes"] = term.Notes
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func translationSuggestionMemoryAssist(matches []TranslationEditorMemorySuggestion, fieldPath string, limit int) []map[string]any {
+	if limit <= 0 {
+		return nil
+	}
+	candidates := []map[string]any{}
+	for _, match := range matches {
+		if !strings.EqualFold(strings.TrimSpace(match.FieldPath), fieldPath) || strings.TrimSpace(match.SuggestedText) == "" {
+			continue
+		}
+		payload := translationEditorMemorySuggestionPayload(match.Family, match.SourceVariant, match.TargetVariant, match.FieldPath, match.SourceText, match.SuggestedText, translationEditorHashFields(match.SourceVariant.Fields))
+		candidates = append(candidates, map[string]any{
+			"source_text":    payload["source_text"],
+			"suggested_text": payload["suggested_text"],
+			"score":          payload["score"],
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return float64FromAny(candidates[i]["score"]) > float64FromAny(candidates[j]["score"])
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
+// translationSuggestionTerminologyFindings runs the terminology QA rules
+// against the suggested text for the requested field only.
+func (s *DefaultTranslationSuggestionService) translationSuggestionTerminologyFindings(ctx context.Context, executionCtx translationSuggestionExecutionContext, suggested string) []TranslationQAFinding {
+	if len(executionCtx.Loaded.GlossaryTerms) == 0 || strings.TrimSpace(suggested) == "" {
+		return nil
+	}
+	input := TranslationQARuleInput{
+		TenantID:      strings.TrimSpace(executionCtx.Assignment.TenantID),
+		OrgID:         strings.TrimSpace(executionCtx.Assignment.OrgID),
+		ContentType:   strings.TrimSpace(executionCtx.Loaded.EntityType),
+		SourceLocale:  strings.TrimSpace(executionCtx.Loaded.SourceLocale),
+		TargetLocale:  strings.TrimSpace(executionCtx.Loaded.TargetLocale),
+		FieldPaths:    []string{executionCtx.FieldPath},
+		SourceFields:  map[string]string{executionCtx.FieldPath: executionCtx.SourceText},
+		TargetFields:  map[string]string{executionCtx.FieldPath: suggested},
+		GlossaryTerms: executionCtx.Loaded.GlossaryTerms,
+	}
+	return s.qaRuleRegistry().Evaluate(ctx, input, func(category string) bool {
+		return category == translationQACategoryTerminology
+	})
+}
+
+func translationSuggestionHasBlocker(findings []TranslationQAFinding) bool {
+	for _, finding := range findings {
+		if finding.Severity == translationQASeverityBlocker {
+			return true
+		}
+	}
+	return false
+}
+
+func translationSuggestionRetryAssist(assist map[string]any, rejected string, findings []TranslationQAFinding) map[string]any {
+	out := cloneAnyMap(assist)
+	if out == nil {
+		out = map[string]any{}
+	}
+	violations := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		if finding.Severity == translationQASeverityBlocker {
+			violations = append(violations, finding.Message)
+		}
+	}
+	out["rejected_suggestion"] = rejected
+	out["terminology_violations"] = violations
+	return out
 }
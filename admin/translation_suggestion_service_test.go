@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -551,3 +552,111 @@ func TestDefaultTranslationSuggestionServiceRejectsReadOnlyAssignment(t *testing
 		t.Fatalf("provider was called for read-only assignment: %d", provider.calls)
 	}
 }
+
+type scriptedTranslationSuggestionProvider struct {
+	texts  []string
+	errs   []error
+	inputs []TranslationSuggestionProviderInput
+}
+
+func (p *scriptedTranslationSuggestionProvider) SuggestTranslation(_ context.Context, input TranslationSuggestionProviderInput) (TranslationSuggestionProviderResult, error) {
+	p.inputs = append(p.inputs, input)
+	if call := len(p.inputs) - 1; call < len(p.errs) && p.errs[call] != nil {
+		return TranslationSuggestionProviderResult{}, p.errs[call]
+	}
+	text := p.texts[min(len(p.inputs), len(p.texts))-1]
+	return TranslationSuggestionProviderResult{Text: text, Provider: "fake"}, nil
+}
+
+func TestDefaultTranslationSuggestionServiceInjectsGlossaryAndMemoryAndRetriesBlockers(t *testing.T) {
+	repo := NewInMemoryTranslationAssignmentRepository()
+	assignment, err := repo.Create(context.Background(), TranslationAssignment{
+		FamilyID:       "family_1",
+		EntityType:     "pages",
+		SourceRecordID: "page_1",
+		SourceLocale:   "en",
+		TargetLocale:   "es",
+		AssignmentType: AssignmentTypeDirect,
+		Status:         AssignmentStatusInProgress,
+		Priority:       PriorityNormal,
+		WorkScope:      "default",
+	})
+	if err != nil {
+		t.Fatalf("create assignment: %v", err)
+	}
+	memory := func(fieldPath, source, target string) TranslationEditorMemorySuggestion {
+		return TranslationEditorMemorySuggestion{FieldPath: fieldPath, SourceText: source, SuggestedText: target}
+	}
+	loader := &fakeTranslationSuggestionContextLoader{
+		ctx: TranslationSuggestionAssignmentContext{
+			EntityType:   "pages",
+			SourceLocale: "en",
+			TargetLocale: "es",
+			SourceFields: map[string]string{"title": "Publish your home page"},
+			TargetFields: map[string]string{"title": ""},
+			GlossaryTerms: []TranslationGlossaryTerm{
+				{ID: "publish", TargetLocale: "es", Term: "publish", PreferredTranslation: "publicar"},
+				{ID: "unused", TargetLocale: "es", Term: "checkout", PreferredTranslation: "pago"},
+				{ID: "banned", TargetLocale: "es", Term: "hogar", PreferredTranslation: "inicio", Forbidden: true},
+			},
+			MemorySuggestions: []TranslationEditorMemorySuggestion{
+				memory("title", "Publish your post", "Publica tu entrada"),
+				memory("body", "Body copy", "Texto"),
+				memory("title", "Publish now", "Publicar ahora"),
+				memory("title", "Publish later", "Publicar luego"),
+				memory("title", "Publish soon", "Publicar pronto"),
+			},
+		},
+	}
+	provider := &scriptedTranslationSuggestionProvider{texts: []string{"Publicar tu página de hogar", "Publicar tu página de inicio"}}
+	service := &DefaultTranslationSuggestionService{
+		Repository:    repo,
+		ContextLoader: loader,
+		Authorizer:    fakeTranslationSuggestionAuthorizer{allowed: true},
+		Eligibility:   TranslationSuggestionAllowAllEligibility{},
+		Provider:      provider,
+	}
+	input := TranslationSuggestionInput{AssignmentID: assignment.ID, FieldPath: "title"}
+
+	result, err := service.SuggestTranslation(context.Background(), input)
+	if err != nil {
+		t.Fatalf("suggest translation: %v", err)
+	}
+	assist := provider.inputs[0].AssistContext
+	glossary, _ := assist["glossary_terms"].([]map[string]any)
+	if len(glossary) != 2 || glossary[0]["preferred_translation"] != "publicar" || glossary[1]["forbidden"] != true {
+		t.Fatalf("expected matching and forbidden glossary terms in prompt, got %+v", assist)
+	}
+	if tm, _ := assist["translation_memory"].([]map[string]any); len(tm) != defaultTranslationSuggestionMemoryLimit || tm[0]["suggested_text"] != "Publica tu entrada" {
+		t.Fatalf("expected top title memory matches in prompt, got %+v", assist["translation_memory"])
+	}
+	if len(provider.inputs) != 2 || len(provider.inputs[1].AssistContext["terminology_violations"].([]string)) != 1 {
+		t.Fatalf("expected one retry with violation feedback, got %+v", provider.inputs)
+	}
+	if result.SuggestedText != "Publicar tu página de inicio" || result.QABlocked || len(result.QAFindings) != 0 {
+		t.Fatalf("expected retried suggestion to pass terminology QA, got %+v", result)
+	}
+
+	provider.inputs = nil
+	provider.texts = []string{"Publicar tu página de hogar"}
+	result, err = service.SuggestTranslation(context.Background(), input)
+	if err != nil {
+		t.Fatalf("suggest translation: %v", err)
+	}
+	if !result.QABlocked || len(result.QAFindings) != 1 || result.QAFindings[0].RuleID != TranslationQARuleTerminology {
+		t.Fatalf("expected suggestion flagged after failed retry, got %+v", result)
+	}
+	if _, ok := result.Diagnostics["terminology_retry_error"]; ok {
+		t.Fatalf("expected no retry error when the retry ran, got %+v", result.Diagnostics)
+	}
+
+	provider.inputs = nil
+	provider.errs = []error{nil, errors.New("provider timeout")}
+	result, err = service.SuggestTranslation(context.Background(), input)
+	if err != nil {
+		t.Fatalf("suggest translation: %v", err)
+	}
+	if !result.QABlocked || result.Diagnostics["terminology_retried"] != true || result.Diagnostics["terminology_retry_error"] != "provider timeout" {
+		t.Fatalf("expected the retry failure in diagnostics, got %+v", result)
+	}
+}
@@ -535,6 +535,10 @@ func defaultAdminAPIRoutes() map[string]string {
 		"translations.options.families":       "/translations/options/families",
 		"translations.options.assignees":      "/translations/options/assignees",
 		"translations.options.reviewers":      "/translations/options/reviewers",
+		"translations.glossary.export":        "/translations/glossary/export",
+		"translations.glossary.import":        "/translations/glossary/import",
+		"translations.memory.export":          "/translations/memory/export",
+		"translations.memory.import":          "/translations/memory/import",
 		"users.bulk.assign_role":              "/users/bulk/assign-role",
 		"users.bulk.unassign_role":            "/users/bulk/unassign-role",
 		"panel":                               "/panels/:panel",
@@ -543,6 +547,13 @@ func defaultAdminAPIRoutes() map[string]string {
 		"panel.bulk.state":                    "/panels/:panel/bulk-actions/state",
 		"panel.bulk":                          "/panels/:panel/bulk/:action",
 		"panel.preview":                       "/panels/:panel/:id/preview",
+		"panel.trash":                         "/trash/:panel",
+		"panel.trash.id":                      "/trash/:panel/:id",
+		"panel.trash.restore":                 "/trash/:panel/:id/restore",
+		"panel.import":                        "/import/:panel",
+		"panel.import.template":               "/import/:panel/template",
+		"panel.import.preview":                "/import/:panel/preview",
+		"panel.import.job":                    "/import/:panel/jobs/:id",
 		"panel.subresource":                   "/panels/:panel/:id/:subresource/:value",
 		"content_types.validate":              "/content_types/validate",
 		"content_types.preview":               "/content_types/preview",
@@ -47,6 +47,87 @@ func WorkflowRuntimeMigrations() fs.FS {
 	)
 }
 
+// PlacementRunMigrations returns the placement run history migration set.
+func PlacementRunMigrations() fs.FS {
+	return migrationSubset(
+		"0016_placement_runs.up.sql",
+		"0016_placement_runs.down.sql",
+	)
+}
+
+// BulkJobMigrations returns the durable bulk job migration set.
+func BulkJobMigrations() fs.FS {
+	return migrationSubset(
+		"0017_bulk_jobs.up.sql",
+		"0017_bulk_jobs.down.sql",
+	)
+}
+
+// TranslationGlossaryMigrations returns the translation glossary migration set.
+func TranslationGlossaryMigrations() fs.FS {
+	return migrationSubset(
+		"0018_translation_glossary_terms.up.sql",
+		"0018_translation_glossary_terms.down.sql",
+	)
+}
+
+// TranslationMemoryMigrations returns the translation memory migration set.
+func TranslationMemoryMigrations() fs.FS {
+	return migrationSubset(
+		"0019_translation_memory_units.up.sql",
+		"0019_translation_memory_units.down.sql",
+	)
+}
+
+// RecordRevisionMigrations returns the record revision history migration set.
+func RecordRevisionMigrations() fs.FS {
+	return migrationSubset(
+		"0020_record_revisions.up.sql",
+		"0020_record_revisions.down.sql",
+	)
+}
+
+// ContentScheduleMigrations returns the scheduled publish migration set.
+func ContentScheduleMigrations() fs.FS {
+	return migrationSubset(
+		"0021_content_schedules.up.sql",
+		"0021_content_schedules.down.sql",
+	)
+}
+
+// PanelImportJobMigrations returns the panel import job migration set.
+func PanelImportJobMigrations() fs.FS {
+	return migrationSubset(
+		"0023_panel_import_jobs.up.sql",
+		"0023_panel_import_jobs.down.sql",
+	)
+}
+
+// ExportScheduleMigrations returns the recurring export schedule migration set.
+func ExportScheduleMigrations() fs.FS {
+	return migrationSubset(
+		"0024_export_schedules.up.sql",
+		"0024_export_schedules.down.sql",
+	)
+}
+
+// TranslationSLAEscalationMigrations returns the SLA escalation ledger migration set.
+func TranslationSLAEscalationMigrations() fs.FS {
+	return migrationSubset(
+		"0025_translation_sla_escalations.up.sql",
+		"0025_translation_sla_escalations.down.sql",
+	)
+}
+
+// SearchIndexMigrations returns the embedded SQLite FTS5 search index
+// migration set. The FTS5 module must be available in the SQLite build.
+func SearchIndexMigrations() fs.FS {
+	return migrationSubset(
+		"sqlite/0022_search_documents.up.sql",
+		"sqlite/0022_search_documents.down.sql",
+	)
+}
+
 func migrationSubset(paths ...string) fs.FS {
 	if len(paths) == 0 {
 		return fstest.MapFS{}
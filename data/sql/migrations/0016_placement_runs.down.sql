@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS ix_placement_feedback_run;
+DROP TABLE IF EXISTS placement_feedback;
+
+DROP INDEX IF EXISTS ix_placement_runs_agreement;
+DROP INDEX IF EXISTS ix_placement_runs_fingerprint;
+DROP TABLE IF EXISTS placement_runs;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS placement_runs (
+    id TEXT PRIMARY KEY,
+    agreement_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    document_fingerprint TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL,
+    reason_code TEXT NOT NULL DEFAULT '',
+    selected_source TEXT NOT NULL DEFAULT '',
+    created_by_user_id TEXT NOT NULL DEFAULT '',
+    payload_json TEXT NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_placement_runs_fingerprint
+    ON placement_runs(org_id, document_fingerprint, created_at);
+CREATE INDEX IF NOT EXISTS ix_placement_runs_agreement
+    ON placement_runs(agreement_id, created_at);
+
+CREATE TABLE IF NOT EXISTS placement_feedback (
+    id TEXT PRIMARY KEY,
+    run_id TEXT NOT NULL REFERENCES placement_runs(id) ON DELETE CASCADE,
+    suggestion_id TEXT NOT NULL DEFAULT '',
+    field_definition_id TEXT NOT NULL,
+    field_type TEXT NOT NULL DEFAULT '',
+    field_label TEXT NOT NULL DEFAULT '',
+    resolver_id TEXT NOT NULL DEFAULT '',
+    decision TEXT NOT NULL CHECK (decision IN ('accepted', 'moved', 'rejected', 'placed')),
+    source TEXT NOT NULL CHECK (source IN ('auto', 'manual')),
+    suggested_geometry_json TEXT NOT NULL DEFAULT '{}',
+    final_geometry_json TEXT NOT NULL DEFAULT '{}',
+    actor_id TEXT NOT NULL DEFAULT '',
+    recorded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_placement_feedback_run
+    ON placement_feedback(run_id, recorded_at);
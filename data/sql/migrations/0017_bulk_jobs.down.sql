@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_bulk_job_records_status;
+DROP TABLE IF EXISTS bulk_job_records;
+DROP INDEX IF EXISTS ix_bulk_jobs_status;
+DROP TABLE IF EXISTS bulk_jobs;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS bulk_jobs (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    action TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL CHECK (status IN ('running', 'completed', 'failed', 'rolling_back', 'rolled_back')),
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    payload_json TEXT NOT NULL DEFAULT '{}',
+    errors_json TEXT NOT NULL DEFAULT '[]',
+    rollback_available BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by TEXT NOT NULL DEFAULT '',
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    lease_owner TEXT NOT NULL DEFAULT '',
+    lease_expires_at TIMESTAMP,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_bulk_jobs_status
+    ON bulk_jobs(status, started_at);
+
+CREATE TABLE IF NOT EXISTS bulk_job_records (
+    job_id TEXT NOT NULL REFERENCES bulk_jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    record_id TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'snapshotted', 'applied', 'failed', 'rolled_back', 'rollback_failed')),
+    snapshot_json TEXT NOT NULL DEFAULT '{}',
+    error TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (job_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS ix_bulk_job_records_status
+    ON bulk_job_records(job_id, status, position);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_translation_glossary_terms_target_locale;
+DROP INDEX IF EXISTS ux_translation_glossary_terms_scope_key;
+DROP TABLE IF EXISTS translation_glossary_terms;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS translation_glossary_terms (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    source_locale TEXT NOT NULL DEFAULT '',
+    target_locale TEXT NOT NULL,
+    content_type TEXT NOT NULL DEFAULT '',
+    term TEXT NOT NULL,
+    term_key TEXT NOT NULL,
+    preferred_translation TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    case_sensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    forbidden BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_translation_glossary_terms_scope_key
+    ON translation_glossary_terms(tenant_id, org_id, source_locale, target_locale, content_type, term_key);
+
+CREATE INDEX IF NOT EXISTS ix_translation_glossary_terms_target_locale
+    ON translation_glossary_terms(target_locale, term_key);
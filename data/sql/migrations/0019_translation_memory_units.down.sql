@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_translation_memory_units_candidates;
+DROP INDEX IF EXISTS ux_translation_memory_units_scope_hash;
+DROP TABLE IF EXISTS translation_memory_units;
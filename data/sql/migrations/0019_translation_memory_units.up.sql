@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS translation_memory_units (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    source_locale TEXT NOT NULL,
+    target_locale TEXT NOT NULL,
+    source_text TEXT NOT NULL,
+    target_text TEXT NOT NULL,
+    source_hash TEXT NOT NULL,
+    target_hash TEXT NOT NULL,
+    source_length INTEGER NOT NULL DEFAULT 0,
+    origin TEXT NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_translation_memory_units_scope_hash
+    ON translation_memory_units(tenant_id, org_id, source_locale, target_locale, source_hash, target_hash);
+
+CREATE INDEX IF NOT EXISTS ix_translation_memory_units_candidates
+    ON translation_memory_units(tenant_id, org_id, source_locale, target_locale, source_length);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS ux_record_revisions_version;
+DROP TABLE IF EXISTS record_revisions;
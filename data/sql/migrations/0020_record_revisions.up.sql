@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS record_revisions (
+    id TEXT PRIMARY KEY,
+    resource TEXT NOT NULL,
+    record_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    action TEXT NOT NULL DEFAULT 'update',
+    actor_id TEXT NOT NULL DEFAULT '',
+    state TEXT NOT NULL DEFAULT '',
+    reverted_from TEXT NOT NULL DEFAULT '',
+    before_json TEXT NOT NULL DEFAULT '{}',
+    after_json TEXT NOT NULL DEFAULT '{}',
+    changes_json TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_record_revisions_version
+    ON record_revisions(resource, record_id, version);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_content_schedules_record;
+DROP INDEX IF EXISTS ix_content_schedules_due;
+DROP TABLE IF EXISTS content_schedules;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS content_schedules (
+    id TEXT PRIMARY KEY,
+    panel TEXT NOT NULL,
+    record_id TEXT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('publish', 'unpublish')),
+    run_at TIMESTAMP NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('scheduled', 'running', 'applied', 'cancelled', 'blocked', 'skipped', 'failed')),
+    created_by TEXT NOT NULL DEFAULT '',
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    state TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    applied_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_content_schedules_due
+    ON content_schedules(status, run_at);
+
+CREATE INDEX IF NOT EXISTS ix_content_schedules_record
+    ON content_schedules(panel, record_id, status);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS ix_panel_import_job_rows_status;
+DROP TABLE IF EXISTS panel_import_job_rows;
+DROP INDEX IF EXISTS ix_panel_import_jobs_status;
+DROP INDEX IF EXISTS ix_panel_import_jobs_panel;
+DROP TABLE IF EXISTS panel_import_jobs;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS panel_import_jobs (
+    id TEXT PRIMARY KEY,
+    panel TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('running', 'completed', 'failed')),
+    format TEXT NOT NULL DEFAULT '',
+    file_name TEXT NOT NULL DEFAULT '',
+    key_field TEXT NOT NULL DEFAULT 'id',
+    mapping_json TEXT NOT NULL DEFAULT '{}',
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    summary_json TEXT NOT NULL DEFAULT '{}',
+    created_by TEXT NOT NULL DEFAULT '',
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    locale TEXT NOT NULL DEFAULT '',
+    lease_owner TEXT NOT NULL DEFAULT '',
+    lease_expires_at TIMESTAMP,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_panel_import_jobs_panel
+    ON panel_import_jobs(panel, started_at);
+
+CREATE INDEX IF NOT EXISTS ix_panel_import_jobs_status
+    ON panel_import_jobs(status, started_at);
+
+CREATE TABLE IF NOT EXISTS panel_import_job_rows (
+    job_id TEXT NOT NULL REFERENCES panel_import_jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    action TEXT NOT NULL DEFAULT '',
+    record_id TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL CHECK (status IN ('pending', 'processing', 'created', 'updated', 'failed')),
+    values_json TEXT NOT NULL DEFAULT '{}',
+    error TEXT NOT NULL DEFAULT '',
+    fields_json TEXT NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (job_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS ix_panel_import_job_rows_status
+    ON panel_import_job_rows(job_id, status, position);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_export_schedules_owner;
+DROP INDEX IF EXISTS ix_export_schedules_due;
+DROP TABLE IF EXISTS export_schedules;
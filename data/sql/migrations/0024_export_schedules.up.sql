@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS export_schedules (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL DEFAULT '',
+    definition TEXT NOT NULL,
+    variant TEXT NOT NULL DEFAULT '',
+    format TEXT NOT NULL,
+    columns_json TEXT NOT NULL DEFAULT '[]',
+    query_json TEXT NOT NULL DEFAULT '{}',
+    cadence TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT '',
+    recipients_json TEXT NOT NULL DEFAULT '[]',
+    skip_notification BOOLEAN NOT NULL DEFAULT FALSE,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    owner_id TEXT NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    locale TEXT NOT NULL DEFAULT '',
+    next_run_at TIMESTAMP,
+    last_run_json TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_export_schedules_due
+    ON export_schedules(paused, next_run_at);
+
+CREATE INDEX IF NOT EXISTS ix_export_schedules_owner
+    ON export_schedules(owner_id, created_at);
@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS translation_sla_escalations (
+    escalation_key TEXT PRIMARY KEY,
+    claimed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS search_documents_fts;
+DROP INDEX IF EXISTS ix_search_documents_title;
+DROP INDEX IF EXISTS ix_search_documents_scope;
+DROP INDEX IF EXISTS ix_search_documents_type;
+DROP TABLE IF EXISTS search_documents;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS search_documents (
+    id TEXT PRIMARY KEY,
+    type TEXT NOT NULL,
+    record_id TEXT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL DEFAULT '',
+    url TEXT NOT NULL DEFAULT '',
+    locale TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMP,
+    tenant_id TEXT NOT NULL DEFAULT '',
+    org_id TEXT NOT NULL DEFAULT '',
+    fields_json TEXT NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ix_search_documents_type
+    ON search_documents(type, record_id);
+
+CREATE INDEX IF NOT EXISTS ix_search_documents_scope
+    ON search_documents(tenant_id, org_id);
+
+CREATE INDEX IF NOT EXISTS ix_search_documents_title
+    ON search_documents(title COLLATE NOCASE);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS search_documents_fts USING fts5(
+    doc_id UNINDEXED,
+    title,
+    summary,
+    body,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
@@ -100,6 +100,18 @@ const (
 	BrowserCSRFErrorQueryKey                       = core.BrowserCSRFErrorQueryKey
 	BrowserCSRFFormExpiredCode                     = core.BrowserCSRFFormExpiredCode
 	BrowserCSRFFormExpiredMessage                  = core.BrowserCSRFFormExpiredMessage
+	BulkActionDelete                               = core.BulkActionDelete
+	BulkJobStatusCompleted                         = core.BulkJobStatusCompleted
+	BulkJobStatusFailed                            = core.BulkJobStatusFailed
+	BulkJobStatusRolledBack                        = core.BulkJobStatusRolledBack
+	BulkJobStatusRollingBack                       = core.BulkJobStatusRollingBack
+	BulkJobStatusRunning                           = core.BulkJobStatusRunning
+	BulkRecordStatusApplied                        = core.BulkRecordStatusApplied
+	BulkRecordStatusFailed                         = core.BulkRecordStatusFailed
+	BulkRecordStatusPending                        = core.BulkRecordStatusPending
+	BulkRecordStatusRollbackFailed                 = core.BulkRecordStatusRollbackFailed
+	BulkRecordStatusRolledBack                     = core.BulkRecordStatusRolledBack
+	BulkRecordStatusSnapshotted                    = core.BulkRecordStatusSnapshotted
 	CMSPageContentTypeSlug                         = core.CMSPageContentTypeSlug
 	CMSPagePolicyEntity                            = core.CMSPagePolicyEntity
 	CommandRunDiagnosticClosed                     = core.CommandRunDiagnosticClosed
@@ -122,6 +134,15 @@ const (
 	CommandRunTransportDurabilityDurable           = core.CommandRunTransportDurabilityDurable
 	CommandRunTransportDurabilityEphemeral         = core.CommandRunTransportDurabilityEphemeral
 	ContentChannelScopeQueryParam                  = core.ContentChannelScopeQueryParam
+	ContentScheduleActionPublish                   = core.ContentScheduleActionPublish
+	ContentScheduleActionUnpublish                 = core.ContentScheduleActionUnpublish
+	ContentScheduleStatusApplied                   = core.ContentScheduleStatusApplied
+	ContentScheduleStatusBlocked                   = core.ContentScheduleStatusBlocked
+	ContentScheduleStatusCancelled                 = core.ContentScheduleStatusCancelled
+	ContentScheduleStatusFailed                    = core.ContentScheduleStatusFailed
+	ContentScheduleStatusRunning                   = core.ContentScheduleStatusRunning
+	ContentScheduleStatusScheduled                 = core.ContentScheduleStatusScheduled
+	ContentScheduleStatusSkipped                   = core.ContentScheduleStatusSkipped
 	ContentTypeCapabilityKeyBlockTypes             = core.ContentTypeCapabilityKeyBlockTypes
 	ContentTypeCapabilityKeyBlocks                 = core.ContentTypeCapabilityKeyBlocks
 	ContentTypeCapabilityKeyI18N                   = core.ContentTypeCapabilityKeyI18N
@@ -188,6 +209,7 @@ const (
 	DefaultSchemaMaxDepth                          = core.DefaultSchemaMaxDepth
 	DefaultSchemaMaxFields                         = core.DefaultSchemaMaxFields
 	DefaultSchemaMaxSizeBytes                      = core.DefaultSchemaMaxSizeBytes
+	DefaultSearchAdapterTimeout                    = core.DefaultSearchAdapterTimeout
 	DefaultUISchemaMaxSizeBytes                    = core.DefaultUISchemaMaxSizeBytes
 	DeploymentPersonaMediaTypePNG                  = core.DeploymentPersonaMediaTypePNG
 	DeploymentPersonaVisualImage                   = core.DeploymentPersonaVisualImage
@@ -202,6 +224,13 @@ const (
 	EnhancedFragmentModeReplace                    = core.EnhancedFragmentModeReplace
 	EnhancedMutationMediaType                      = core.EnhancedMutationMediaType
 	EnhancedMutationResponseVersion                = core.EnhancedMutationResponseVersion
+	ExportScheduleCadenceDaily                     = core.ExportScheduleCadenceDaily
+	ExportScheduleCadenceHourly                    = core.ExportScheduleCadenceHourly
+	ExportScheduleCadenceMonthly                   = core.ExportScheduleCadenceMonthly
+	ExportScheduleCadenceWeekly                    = core.ExportScheduleCadenceWeekly
+	ExportScheduleRunDenied                        = core.ExportScheduleRunDenied
+	ExportScheduleRunFailed                        = core.ExportScheduleRunFailed
+	ExportScheduleRunSucceeded                     = core.ExportScheduleRunSucceeded
 	FamilyDetailFragmentActivity                   = core.FamilyDetailFragmentActivity
 	FamilyDetailFragmentAssignments                = core.FamilyDetailFragmentAssignments
 	FamilyDetailFragmentLocaleCoverage             = core.FamilyDetailFragmentLocaleCoverage
@@ -327,6 +356,24 @@ const (
 	PanelActionDefaultsModeNone                    = core.PanelActionDefaultsModeNone
 	PanelEntryModeDetailCurrentUser                = core.PanelEntryModeDetailCurrentUser
 	PanelEntryModeList                             = core.PanelEntryModeList
+	PanelImportActionCreate                        = core.PanelImportActionCreate
+	PanelImportActionUpdate                        = core.PanelImportActionUpdate
+	PanelImportFormatCSV                           = core.PanelImportFormatCSV
+	PanelImportFormatNDJSON                        = core.PanelImportFormatNDJSON
+	PanelImportFormatXLSX                          = core.PanelImportFormatXLSX
+	PanelImportJobStatusCompleted                  = core.PanelImportJobStatusCompleted
+	PanelImportJobStatusFailed                     = core.PanelImportJobStatusFailed
+	PanelImportJobStatusRunning                    = core.PanelImportJobStatusRunning
+	PanelImportRowStatusCreated                    = core.PanelImportRowStatusCreated
+	PanelImportRowStatusFailed                     = core.PanelImportRowStatusFailed
+	PanelImportRowStatusPending                    = core.PanelImportRowStatusPending
+	PanelImportRowStatusProcessing                 = core.PanelImportRowStatusProcessing
+	PanelImportRowStatusUpdated                    = core.PanelImportRowStatusUpdated
+	PanelImportRowStatusValid                      = core.PanelImportRowStatusValid
+	PanelSubresourceHistory                        = core.PanelSubresourceHistory
+	PanelSubresourceRevert                         = core.PanelSubresourceRevert
+	PanelSubresourceSchedule                       = core.PanelSubresourceSchedule
+	PanelSubresourceUnschedule                     = core.PanelSubresourceUnschedule
 	PanelTabScopeDetail                            = core.PanelTabScopeDetail
 	PanelTabScopeForm                              = core.PanelTabScopeForm
 	PanelTabScopeList                              = core.PanelTabScopeList
@@ -348,6 +395,7 @@ const (
 	PermAdminDebugSessionAttach                    = core.PermAdminDebugSessionAttach
 	PermAdminDebugSessionView                      = core.PermAdminDebugSessionView
 	PermAdminDebugView                             = core.PermAdminDebugView
+	PermAdminExportsSchedule                       = core.PermAdminExportsSchedule
 	PermAdminFeatureFlagsUpdate                    = core.PermAdminFeatureFlagsUpdate
 	PermAdminFeatureFlagsView                      = core.PermAdminFeatureFlagsView
 	PermAdminIntegrationsManage                    = core.PermAdminIntegrationsManage
@@ -446,6 +494,9 @@ const (
 	ResolveSourceFallbackCMSError                  = core.ResolveSourceFallbackCMSError
 	ResolveSourceFallbackCMSMissing                = core.ResolveSourceFallbackCMSMissing
 	ResolveSourceFallbackNoCMS                     = core.ResolveSourceFallbackNoCMS
+	RevisionActionRevert                           = core.RevisionActionRevert
+	RevisionActionUpdate                           = core.RevisionActionUpdate
+	RevisionResourceContent                        = core.RevisionResourceContent
 	RolesOpenAPISource                             = core.RolesOpenAPISource
 	SchemaFormatJSONSchema                         = core.SchemaFormatJSONSchema
 	SchemaFormatOpenAPI                            = core.SchemaFormatOpenAPI
@@ -459,6 +510,9 @@ const (
 	ScopePolicySingle                              = core.ScopePolicySingle
 	ScopeTenantIDKey                               = core.ScopeTenantIDKey
 	ScopeTenantKey                                 = core.ScopeTenantKey
+	SearchAdapterErrorFailed                       = core.SearchAdapterErrorFailed
+	SearchAdapterErrorTimeout                      = core.SearchAdapterErrorTimeout
+	SearchAdapterErrorUnavailable                  = core.SearchAdapterErrorUnavailable
 	SearchCountAccuracyApproximate                 = core.SearchCountAccuracyApproximate
 	SearchCountAccuracyExact                       = core.SearchCountAccuracyExact
 	SearchCountAccuracyLowerBound                  = core.SearchCountAccuracyLowerBound
@@ -481,6 +535,8 @@ const (
 	SiteRouteMenuByCode                            = core.SiteRouteMenuByCode
 	SiteRouteMenuByLocation                        = core.SiteRouteMenuByLocation
 	SiteRouteNavigationLegacy                      = core.SiteRouteNavigationLegacy
+	SoftDeleteDeletedAtField                       = core.SoftDeleteDeletedAtField
+	SoftDeleteDeletedByField                       = core.SoftDeleteDeletedByField
 	TextCodeActivityActorContextInvalid            = core.TextCodeActivityActorContextInvalid
 	TextCodeAdminCSRFInvalid                       = core.TextCodeAdminCSRFInvalid
 	TextCodeAutosaveConflict                       = core.TextCodeAutosaveConflict
@@ -727,6 +783,9 @@ type (
 	BulkCommand                                       = core.BulkCommand
 	BulkConfig                                        = core.BulkConfig
 	BulkJob                                           = core.BulkJob
+	BulkJobRecord                                     = core.BulkJobRecord
+	BulkJobStore                                      = core.BulkJobStore
+	BulkRepositoryResolver                            = core.BulkRepositoryResolver
 	BulkRequest                                       = core.BulkRequest
 	BulkRoleChangeRequest                             = core.BulkRoleChangeRequest
 	BulkRoleChangeResponse                            = core.BulkRoleChangeResponse
@@ -735,9 +794,14 @@ type (
 	BulkRollbacker                                    = core.BulkRollbacker
 	BulkService                                       = core.BulkService
 	BulkStartMsg                                      = core.BulkStartMsg
+	BunBulkJobStore                                   = core.BunBulkJobStore
+	BunContentScheduleStore                           = core.BunContentScheduleStore
+	BunExportScheduleStore                            = core.BunExportScheduleStore
+	BunPanelImportJobStore                            = core.BunPanelImportJobStore
 	BunRecordMapper[T any]                            = core.BunRecordMapper[T]
 	BunRepositoryAdapter[T any]                       = core.BunRepositoryAdapter[T]
 	BunRepositoryOption[T any]                        = core.BunRepositoryOption[T]
+	BunRevisionStore                                  = core.BunRevisionStore
 	BunSettingsAdapter                                = core.BunSettingsAdapter
 	BunTranslationAssignmentRepository                = core.BunTranslationAssignmentRepository
 	BunTranslationExchangeRuntimeStore                = core.BunTranslationExchangeRuntimeStore
@@ -827,6 +891,12 @@ type (
 	CommandStatusEvent                                = core.CommandStatusEvent
 	Config                                            = core.Config
 	ContentPreviewPathOptions                         = core.ContentPreviewPathOptions
+	ContentSchedule                                   = core.ContentSchedule
+	ContentScheduleCommand                            = core.ContentScheduleCommand
+	ContentScheduleFilter                             = core.ContentScheduleFilter
+	ContentScheduleInput                              = core.ContentScheduleInput
+	ContentScheduleResult                             = core.ContentScheduleResult
+	ContentScheduleStore                              = core.ContentScheduleStore
 	ContentTranslation                                = core.ContentTranslation
 	ContentTypeBuilderModule                          = core.ContentTypeBuilderModule
 	ContentTypeBuilderOption                          = core.ContentTypeBuilderOption
@@ -838,6 +908,7 @@ type (
 	ContextMessageFactory                             = core.ContextMessageFactory
 	ConvertedFields                                   = core.ConvertedFields
 	CountTranslator                                   = core.CountTranslator
+	CursorRepository                                  = core.CursorRepository
 	CustomLogEntry                                    = core.CustomLogEntry
 	Dashboard                                         = core.Dashboard
 	DashboardAssetOwnershipProvider                   = core.DashboardAssetOwnershipProvider
@@ -929,6 +1000,8 @@ type (
 	DoctorSeverity                                    = core.DoctorSeverity
 	DoctorSummary                                     = core.DoctorSummary
 	DomainErrorCode                                   = core.DomainErrorCode
+	DurableBulkService                                = core.DurableBulkService
+	DurableBulkServiceOption                          = core.DurableBulkServiceOption
 	DynamicPanelFactory                               = core.DynamicPanelFactory
 	DynamicPanelFactoryOption                         = core.DynamicPanelFactoryOption
 	DynamicPanelHooks                                 = core.DynamicPanelHooks
@@ -953,12 +1026,25 @@ type (
 	ExportColumn                                      = core.ExportColumn
 	ExportConfig                                      = core.ExportConfig
 	ExportDefinition                                  = core.ExportDefinition
+	ExportDownloadClaims                              = core.ExportDownloadClaims
+	ExportDownloadSigner                              = core.ExportDownloadSigner
 	ExportHTTPRegistrar                               = core.ExportHTTPRegistrar
 	ExportMetadata                                    = core.ExportMetadata
 	ExportMetadataProvider                            = core.ExportMetadataProvider
 	ExportRegistry                                    = core.ExportRegistry
 	ExportRouteOptions                                = core.ExportRouteOptions
 	ExportRouteWrapper                                = core.ExportRouteWrapper
+	ExportSchedule                                    = core.ExportSchedule
+	ExportScheduleCommand                             = core.ExportScheduleCommand
+	ExportScheduleFilter                              = core.ExportScheduleFilter
+	ExportScheduleIdentityFunc                        = core.ExportScheduleIdentityFunc
+	ExportScheduleIdentityResolver                    = core.ExportScheduleIdentityResolver
+	ExportScheduleInput                               = core.ExportScheduleInput
+	ExportScheduleRun                                 = core.ExportScheduleRun
+	ExportScheduleRunResult                           = core.ExportScheduleRunResult
+	ExportScheduleService                             = core.ExportScheduleService
+	ExportScheduleServiceOption                       = core.ExportScheduleServiceOption
+	ExportScheduleStore                               = core.ExportScheduleStore
 	ExternalAssetConfig                               = core.ExternalAssetConfig
 	FSMLifecycleActivitySinkAdapter                   = core.FSMLifecycleActivitySinkAdapter
 	FSMWorkflowEngine                                 = core.FSMWorkflowEngine
@@ -1018,17 +1104,22 @@ type (
 	IconServiceDefaults                               = core.IconServiceDefaults
 	IconServiceOption                                 = core.IconServiceOption
 	IconType                                          = core.IconType
+	InMemoryBulkJobStore                              = core.InMemoryBulkJobStore
 	InMemoryBulkService                               = core.InMemoryBulkService
+	InMemoryContentScheduleStore                      = core.InMemoryContentScheduleStore
 	InMemoryContentService                            = core.InMemoryContentService
 	InMemoryDashboardPreferences                      = core.InMemoryDashboardPreferences
 	InMemoryDebugREPLSessionStore                     = core.InMemoryDebugREPLSessionStore
 	InMemoryDebugUserSessionStore                     = core.InMemoryDebugUserSessionStore
+	InMemoryExportScheduleStore                       = core.InMemoryExportScheduleStore
 	InMemoryMediaLibrary                              = core.InMemoryMediaLibrary
 	InMemoryMenuService                               = core.InMemoryMenuService
 	InMemoryNotificationService                       = core.InMemoryNotificationService
 	InMemoryOrganizationStore                         = core.InMemoryOrganizationStore
+	InMemoryPanelImportJobStore                       = core.InMemoryPanelImportJobStore
 	InMemoryPreferencesStore                          = core.InMemoryPreferencesStore
 	InMemoryProfileStore                              = core.InMemoryProfileStore
+	InMemoryRevisionStore                             = core.InMemoryRevisionStore
 	InMemoryTenantStore                               = core.InMemoryTenantStore
 	InMemoryTranslationAssignmentRepository           = core.InMemoryTranslationAssignmentRepository
 	InMemoryUserStore                                 = core.InMemoryUserStore
@@ -1045,6 +1136,7 @@ type (
 	JobRegistry                                       = core.JobRegistry
 	LegacyChartSampleWidgetPayload                    = core.LegacyChartSampleWidgetPayload
 	ListOptions                                       = core.ListOptions
+	ListPage                                          = core.ListPage
 	ListPredicate                                     = core.ListPredicate
 	LocalCommandRunTransport                          = core.LocalCommandRunTransport
 	LocalCommandRunTransportConfig                    = core.LocalCommandRunTransportConfig
@@ -1215,8 +1307,23 @@ type (
 	PanelFormAdapter                                  = core.PanelFormAdapter
 	PanelFormRequest                                  = core.PanelFormRequest
 	PanelHooks                                        = core.PanelHooks
+	PanelImportConfig                                 = core.PanelImportConfig
+	PanelImportField                                  = core.PanelImportField
+	PanelImportJob                                    = core.PanelImportJob
+	PanelImportJobStore                               = core.PanelImportJobStore
+	PanelImportMapping                                = core.PanelImportMapping
+	PanelImportPreview                                = core.PanelImportPreview
+	PanelImportRequest                                = core.PanelImportRequest
+	PanelImportRow                                    = core.PanelImportRow
+	PanelImportService                                = core.PanelImportService
+	PanelImportServiceOption                          = core.PanelImportServiceOption
+	PanelImportSource                                 = core.PanelImportSource
+	PanelImportSummary                                = core.PanelImportSummary
+	PanelImportTemplate                               = core.PanelImportTemplate
 	PanelListCapabilities                             = core.PanelListCapabilities
 	PanelPermissions                                  = core.PanelPermissions
+	PanelSearchIndexer                                = core.PanelSearchIndexer
+	PanelSoftDeleteConfig                             = core.PanelSoftDeleteConfig
 	PanelSubresource                                  = core.PanelSubresource
 	PanelSubresourceRepository                        = core.PanelSubresourceRepository
 	PanelSubresourceResponder                         = core.PanelSubresourceResponder
@@ -1225,6 +1332,9 @@ type (
 	PanelTabPermissionEvaluator                       = core.PanelTabPermissionEvaluator
 	PanelTabScope                                     = core.PanelTabScope
 	PanelTabTarget                                    = core.PanelTabTarget
+	PanelTrashRetentionCommand                        = core.PanelTrashRetentionCommand
+	PanelTrashRetentionInput                          = core.PanelTrashRetentionInput
+	PanelTrashRetentionResult                         = core.PanelTrashRetentionResult
 	PanelUIRouteMode                                  = core.PanelUIRouteMode
 	PermissionDeniedError                             = core.PermissionDeniedError
 	PermissionEntry                                   = core.PermissionEntry
@@ -1286,6 +1396,10 @@ type (
 	ResolvedSetting                                   = core.ResolvedSetting
 	ResolverActivityPageEnricherConfig                = core.ResolverActivityPageEnricherConfig
 	ResultDispatchFactory                             = core.ResultDispatchFactory
+	Revision                                          = core.Revision
+	RevisionFieldChange                               = core.RevisionFieldChange
+	RevisionFilter                                    = core.RevisionFilter
+	RevisionStore                                     = core.RevisionStore
 	RingBuffer[T any]                                 = core.RingBuffer[T]
 	RoleAssignmentLookup                              = core.RoleAssignmentLookup
 	RolePanelRepository                               = core.RolePanelRepository
@@ -1298,6 +1412,14 @@ type (
 	RouteEntry                                        = core.RouteEntry
 	RouterContext                                     = core.RouterContext
 	SQLEntry                                          = core.SQLEntry
+	SQLiteSearchGlobalAdapter                         = core.SQLiteSearchGlobalAdapter
+	SQLiteSearchIndex                                 = core.SQLiteSearchIndex
+	SQLiteSearchSiteProvider                          = core.SQLiteSearchSiteProvider
+	ScheduledExportArtifact                           = core.ScheduledExportArtifact
+	ScheduledExportEmail                              = core.ScheduledExportEmail
+	ScheduledExportMailer                             = core.ScheduledExportMailer
+	ScheduledExportRequest                            = core.ScheduledExportRequest
+	ScheduledExportRunner                             = core.ScheduledExportRunner
 	Schema                                            = core.Schema
 	SchemaGuardrails                                  = core.SchemaGuardrails
 	SchemaGuardrailsOption                            = core.SchemaGuardrailsOption
@@ -1313,8 +1435,13 @@ type (
 	ScopePolicy                                       = core.ScopePolicy
 	ScopePolicyMode                                   = core.ScopePolicyMode
 	SearchAdapter                                     = core.SearchAdapter
+	SearchAdapterReport                               = core.SearchAdapterReport
+	SearchAdapterTimeout                              = core.SearchAdapterTimeout
 	SearchCount                                       = core.SearchCount
 	SearchCountAccuracy                               = core.SearchCountAccuracy
+	SearchDocument                                    = core.SearchDocument
+	SearchDocumentFields                              = core.SearchDocumentFields
+	SearchDocumentMapper                              = core.SearchDocumentMapper
 	SearchEngine                                      = core.SearchEngine
 	SearchEvidence                                    = core.SearchEvidence
 	SearchEvidenceLocation                            = core.SearchEvidenceLocation
@@ -1326,8 +1453,13 @@ type (
 	SearchHit                                         = core.SearchHit
 	SearchProvider                                    = core.SearchProvider
 	SearchRange                                       = core.SearchRange
+	SearchReindexCommand                              = core.SearchReindexCommand
+	SearchReindexInput                                = core.SearchReindexInput
+	SearchReindexResult                               = core.SearchReindexResult
 	SearchRequest                                     = core.SearchRequest
+	SearchResponse                                    = core.SearchResponse
 	SearchResult                                      = core.SearchResult
+	SearchResultGroup                                 = core.SearchResultGroup
 	SearchResultPage                                  = core.SearchResultPage
 	SearchTotalAccuracy                               = core.SearchTotalAccuracy
 	SearchVariant                                     = core.SearchVariant
@@ -1362,6 +1494,7 @@ type (
 	SiteResponseMeta                                  = core.SiteResponseMeta
 	SiteTranslationMissingDetails                     = core.SiteTranslationMissingDetails
 	SiteURLResolver                                   = core.SiteURLResolver
+	SoftDeleteRepository                              = core.SoftDeleteRepository
 	SourceContext                                     = core.SourceContext
 	SourceLine                                        = core.SourceLine
 	StackFrameInfo                                    = core.StackFrameInfo
@@ -1535,6 +1668,7 @@ type (
 	TranslationSummaryWidgetPayload                   = core.TranslationSummaryWidgetPayload
 	Translator                                        = core.Translator
 	TranslatorAware                                   = core.TranslatorAware
+	TrashConfig                                       = core.TrashConfig
 	URLConfig                                         = core.URLConfig
 	URLNamespaceConfig                                = core.URLNamespaceConfig
 	UUIDRoleAssignmentLookup                          = core.UUIDRoleAssignmentLookup
@@ -1865,6 +1999,10 @@ func DeliveryGraphQLControllers() []registrar.Controller {
 	return core.DeliveryGraphQLControllers()
 }
 
+func DiffRevisionRecords(before, after map[string]any) []RevisionFieldChange {
+	return core.DiffRevisionRecords(before, after)
+}
+
 func DomainErrorCodeFor(code string) (DomainErrorCode, bool) {
 	return core.DomainErrorCodeFor(code)
 }
@@ -2081,14 +2219,34 @@ func NewAdminObjectResolver(cfg AdminObjectResolverConfig) activity.ObjectResolv
 	return core.NewAdminObjectResolver(cfg)
 }
 
+func NewBunBulkJobStore(db *bun.DB) *BunBulkJobStore {
+	return core.NewBunBulkJobStore(db)
+}
+
+func NewBunContentScheduleStore(db *bun.DB) *BunContentScheduleStore {
+	return core.NewBunContentScheduleStore(db)
+}
+
+func NewBunExportScheduleStore(db *bun.DB) *BunExportScheduleStore {
+	return core.NewBunExportScheduleStore(db)
+}
+
 func NewBunNotificationRuntime(ctx context.Context, db *bun.DB, opts ...storage.Option) (*NotificationRuntimeOptions, error) {
 	return core.NewBunNotificationRuntime(ctx, db, opts...)
 }
 
+func NewBunPanelImportJobStore(db *bun.DB) *BunPanelImportJobStore {
+	return core.NewBunPanelImportJobStore(db)
+}
+
 func NewBunRepositoryAdapter[T any](repo repository.Repository[T], opts ...BunRepositoryOption[T]) *BunRepositoryAdapter[T] {
 	return core.NewBunRepositoryAdapter[T](repo, opts...)
 }
 
+func NewBunRevisionStore(db *bun.DB) *BunRevisionStore {
+	return core.NewBunRevisionStore(db)
+}
+
 func NewBunSettingsAdapter(db *bun.DB, repoOptions ...repository.Option) (*BunSettingsAdapter, error) {
 	return core.NewBunSettingsAdapter(db, repoOptions...)
 }
@@ -2265,6 +2423,10 @@ func NewDomainError(code string, message string, meta map[string]any) *errors.Er
 	return core.NewDomainError(code, message, meta)
 }
 
+func NewDurableBulkService(store BulkJobStore, repos BulkRepositoryResolver, opts ...DurableBulkServiceOption) (*DurableBulkService, error) {
+	return core.NewDurableBulkService(store, repos, opts...)
+}
+
 func NewDynamicPanelFactory(admin *Admin, opts ...DynamicPanelFactoryOption) *DynamicPanelFactory {
 	return core.NewDynamicPanelFactory(admin, opts...)
 }
@@ -2277,6 +2439,14 @@ func NewErrorPresenter(cfg ErrorConfig, mappers ...errors.ErrorMapper) ErrorPres
 	return core.NewErrorPresenter(cfg, mappers...)
 }
 
+func NewExportDownloadSigner(secret []byte) (*ExportDownloadSigner, error) {
+	return core.NewExportDownloadSigner(secret)
+}
+
+func NewExportScheduleService(store ExportScheduleStore, runner ScheduledExportRunner, opts ...ExportScheduleServiceOption) (*ExportScheduleService, error) {
+	return core.NewExportScheduleService(store, runner, opts...)
+}
+
 func NewFSMLifecycleActivitySinkAdapter(sink ActivitySink) *FSMLifecycleActivitySinkAdapter {
 	return core.NewFSMLifecycleActivitySinkAdapter(sink)
 }
@@ -2385,10 +2555,18 @@ func NewIconService(opts ...IconServiceOption) *IconService {
 	return core.NewIconService(opts...)
 }
 
+func NewInMemoryBulkJobStore() *InMemoryBulkJobStore {
+	return core.NewInMemoryBulkJobStore()
+}
+
 func NewInMemoryBulkService() *InMemoryBulkService {
 	return core.NewInMemoryBulkService()
 }
 
+func NewInMemoryContentScheduleStore() *InMemoryContentScheduleStore {
+	return core.NewInMemoryContentScheduleStore()
+}
+
 func NewInMemoryContentService() *InMemoryContentService {
 	return core.NewInMemoryContentService()
 }
@@ -2405,6 +2583,10 @@ func NewInMemoryDebugUserSessionStore() *InMemoryDebugUserSessionStore {
 	return core.NewInMemoryDebugUserSessionStore()
 }
 
+func NewInMemoryExportScheduleStore() *InMemoryExportScheduleStore {
+	return core.NewInMemoryExportScheduleStore()
+}
+
 func NewInMemoryMediaLibrary(baseURL string) *InMemoryMediaLibrary {
 	return core.NewInMemoryMediaLibrary(baseURL)
 }
@@ -2421,6 +2603,10 @@ func NewInMemoryOrganizationStore() *InMemoryOrganizationStore {
 	return core.NewInMemoryOrganizationStore()
 }
 
+func NewInMemoryPanelImportJobStore() *InMemoryPanelImportJobStore {
+	return core.NewInMemoryPanelImportJobStore()
+}
+
 func NewInMemoryPreferencesStore() *InMemoryPreferencesStore {
 	return core.NewInMemoryPreferencesStore()
 }
@@ -2429,6 +2615,10 @@ func NewInMemoryProfileStore() *InMemoryProfileStore {
 	return core.NewInMemoryProfileStore()
 }
 
+func NewInMemoryRevisionStore() *InMemoryRevisionStore {
+	return core.NewInMemoryRevisionStore()
+}
+
 func NewInMemoryTenantStore() *InMemoryTenantStore {
 	return core.NewInMemoryTenantStore()
 }
@@ -2533,6 +2723,10 @@ func NewOrganizationsModule() *OrganizationsModule {
 	return core.NewOrganizationsModule()
 }
 
+func NewPanelImportService(store PanelImportJobStore, registry *Registry, opts ...PanelImportServiceOption) (*PanelImportService, error) {
+	return core.NewPanelImportService(store, registry, opts...)
+}
+
 func NewPermissionsDebugPanel(admin *Admin) *PermissionsDebugPanel {
 	return core.NewPermissionsDebugPanel(admin)
 }
@@ -2597,6 +2791,10 @@ func NewRoleWorkflowAuthorizer(minRole string, opts ...RoleWorkflowAuthorizerOpt
 	return core.NewRoleWorkflowAuthorizer(minRole, opts...)
 }
 
+func NewSQLiteSearchIndex(db *bun.DB) *SQLiteSearchIndex {
+	return core.NewSQLiteSearchIndex(db)
+}
+
 func NewSchemaGuardrails() *SchemaGuardrails {
 	return core.NewSchemaGuardrails()
 }
@@ -2605,6 +2803,10 @@ func NewSchemaToFieldsConverter() *SchemaToFieldsConverter {
 	return core.NewSchemaToFieldsConverter()
 }
 
+func NewSearchDocumentMapper(fields SearchDocumentFields) SearchDocumentMapper {
+	return core.NewSearchDocumentMapper(fields)
+}
+
 func NewSearchEngine(authorizer Authorizer) *SearchEngine {
 	return core.NewSearchEngine(authorizer)
 }
@@ -2657,6 +2859,10 @@ func NewTranslationSuggestionContextLoader(adm *Admin) TranslationSuggestionCont
 	return core.NewTranslationSuggestionContextLoader(adm)
 }
 
+func NewUserExportScheduleIdentity(users *UserManagementService) ExportScheduleIdentityResolver {
+	return core.NewUserExportScheduleIdentity(users)
+}
+
 func NewUserManagementModule(opts ...UserManagementModuleOption) *UserManagementModule {
 	return core.NewUserManagementModule(opts...)
 }
@@ -2749,6 +2955,10 @@ func NotificationSystemAuthorityFromContext(ctx context.Context) bool {
 	return core.NotificationSystemAuthorityFromContext(ctx)
 }
 
+func PanelBulkRepositoryResolver(registry *Registry) BulkRepositoryResolver {
+	return core.PanelBulkRepositoryResolver(registry)
+}
+
 func ParseContentTypeCapabilityContracts(capabilities map[string]any) ContentTypeCapabilityContracts {
 	return core.ParseContentTypeCapabilityContracts(capabilities)
 }
@@ -2769,6 +2979,10 @@ func ParseMediaDeliveryRange(header string, size int64) (MediaDeliveryRange, boo
 	return core.ParseMediaDeliveryRange(header, size)
 }
 
+func ParsePanelImportSource(format, fileName string, r io.Reader, maxRows int) (PanelImportSource, error) {
+	return core.ParsePanelImportSource(format, fileName, r, maxRows)
+}
+
 func PermissionGrantMatches(grant string, permission string) bool {
 	return core.PermissionGrantMatches(grant, permission)
 }
@@ -2813,6 +3027,10 @@ func RegisterCommand[T any](bus *CommandBus, cmd command.Commander[T], runnerOpt
 	return core.RegisterCommand[T](bus, cmd, runnerOpts...)
 }
 
+func RegisterContentScheduleCommands(bus *CommandBus, registry *Registry, notifications NotificationService, schedule string) error {
+	return core.RegisterContentScheduleCommands(bus, registry, notifications, schedule)
+}
+
 func RegisterCoreCommandFactories(bus *CommandBus) error {
 	return core.RegisterCoreCommandFactories(bus)
 }
@@ -2841,6 +3059,10 @@ func RegisterDomainErrorCodes(codes ...DomainErrorCode) {
 	core.RegisterDomainErrorCodes(codes...)
 }
 
+func RegisterExportScheduleCommands(bus *CommandBus, service *ExportScheduleService, schedule string) error {
+	return core.RegisterExportScheduleCommands(bus, service, schedule)
+}
+
 func RegisterManagementGraphQLSchemas() {
 	core.RegisterManagementGraphQLSchemas()
 }
@@ -2853,10 +3075,18 @@ func RegisterQuery[T any, R any](bus *CommandBus, qry command.Querier[T, R], run
 	return core.RegisterQuery[T, R](bus, qry, runnerOpts...)
 }
 
+func RegisterSearchIndexCommands(bus *CommandBus, registry *Registry) error {
+	return core.RegisterSearchIndexCommands(bus, registry)
+}
+
 func RegisterSetCommand[T any](set *CommandRegistrationSet, handler command.Commander[T], opts ...runner.Option) error {
 	return core.RegisterSetCommand[T](set, handler, opts...)
 }
 
+func RegisterPanelTrashCommands(bus *CommandBus, registry *Registry, schedule string) error {
+	return core.RegisterPanelTrashCommands(bus, registry, schedule)
+}
+
 func RegisterTranslationExchangeCommandFactories(bus *CommandBus) error {
 	return core.RegisterTranslationExchangeCommandFactories(bus)
 }
@@ -2961,6 +3191,10 @@ func SchemaEditorDescriptor(basePath string) components.Descriptor {
 	return core.SchemaEditorDescriptor(basePath)
 }
 
+func SearchDocumentFromRecord(panel string, record map[string]any) (SearchDocument, bool) {
+	return core.SearchDocumentFromRecord(panel, record)
+}
+
 func SetDefaultErrorPresenter(presenter ErrorPresenter) {
 	core.SetDefaultErrorPresenter(presenter)
 }
@@ -3129,6 +3363,10 @@ func WithBunSearchColumns[T any](columns ...string) BunRepositoryOption[T] {
 	return core.WithBunSearchColumns[T](columns...)
 }
 
+func WithBunSoftDelete[T any]() BunRepositoryOption[T] {
+	return core.WithBunSoftDelete[T]()
+}
+
 func WithBunUpdateCriteria[T any](criteria ...repository.UpdateCriteria) BunRepositoryOption[T] {
 	return core.WithBunUpdateCriteria[T](criteria...)
 }
@@ -3233,6 +3471,18 @@ func WithDerivedFields() CMSContentListOption {
 	return core.WithDerivedFields()
 }
 
+func WithDurableBulkChunkSize(size int) DurableBulkServiceOption {
+	return core.WithDurableBulkChunkSize(size)
+}
+
+func WithDurableBulkLeaseTTL(ttl time.Duration) DurableBulkServiceOption {
+	return core.WithDurableBulkLeaseTTL(ttl)
+}
+
+func WithDurableBulkLogger(logger Logger) DurableBulkServiceOption {
+	return core.WithDurableBulkLogger(logger)
+}
+
 func WithDynamicPanelHooks(hooks DynamicPanelHooks) DynamicPanelFactoryOption {
 	return core.WithDynamicPanelHooks(hooks)
 }
@@ -3253,6 +3503,38 @@ func WithEnvironment(ctx context.Context, environment string) context.Context {
 	return core.WithEnvironment(ctx, environment)
 }
 
+func WithExportScheduleActivitySink(sink ActivitySink) ExportScheduleServiceOption {
+	return core.WithExportScheduleActivitySink(sink)
+}
+
+func WithExportScheduleAuthorizer(authorizer Authorizer, permission string) ExportScheduleServiceOption {
+	return core.WithExportScheduleAuthorizer(authorizer, permission)
+}
+
+func WithExportScheduleIdentity(resolver ExportScheduleIdentityResolver) ExportScheduleServiceOption {
+	return core.WithExportScheduleIdentity(resolver)
+}
+
+func WithExportScheduleLinkTTL(ttl time.Duration) ExportScheduleServiceOption {
+	return core.WithExportScheduleLinkTTL(ttl)
+}
+
+func WithExportScheduleLinks(signer *ExportDownloadSigner, linkURL func(token string) string) ExportScheduleServiceOption {
+	return core.WithExportScheduleLinks(signer, linkURL)
+}
+
+func WithExportScheduleLogger(logger Logger) ExportScheduleServiceOption {
+	return core.WithExportScheduleLogger(logger)
+}
+
+func WithExportScheduleMailer(mailer ScheduledExportMailer) ExportScheduleServiceOption {
+	return core.WithExportScheduleMailer(mailer)
+}
+
+func WithExportScheduleNotifications(notifications NotificationService) ExportScheduleServiceOption {
+	return core.WithExportScheduleNotifications(notifications)
+}
+
 func WithFSMWorkflowActivitySink(sink ActivitySink) FSMWorkflowEngineOption {
 	return core.WithFSMWorkflowActivitySink(sink)
 }
@@ -3353,6 +3635,18 @@ func WithOptionalAuth(optional bool) GoAuthAuthenticatorOption {
 	return core.WithOptionalAuth(optional)
 }
 
+func WithPanelImportChunkSize(size int) PanelImportServiceOption {
+	return core.WithPanelImportChunkSize(size)
+}
+
+func WithPanelImportLeaseTTL(ttl time.Duration) PanelImportServiceOption {
+	return core.WithPanelImportLeaseTTL(ttl)
+}
+
+func WithPanelImportLogger(logger Logger) PanelImportServiceOption {
+	return core.WithPanelImportLogger(logger)
+}
+
 func WithProtectedSurfaceRoots(browserRoots []string, apiRoots []string) GoAuthAuthenticatorOption {
 	return core.WithProtectedSurfaceRoots(browserRoots, apiRoots)
 }
@@ -22,6 +22,7 @@ const (
 	DetailCurrentRevision = "current_revision"
 	DetailLatestSnapshot  = "latest_snapshot"
 	DetailIdempotencyKey  = "idempotency_key"
+	DetailMergeConflicts  = "merge_conflicts"
 )
 
 // SyncError is the canonical domain error shape used before transport mapping.
@@ -98,6 +99,25 @@ func NewStaleRevisionError(currentRevision int64, latest *Snapshot) *SyncError {
 	return NewError(CodeStaleRevision, "resource has a newer revision", details)
 }
 
+// NewMergeConflictError builds a stale-revision error for a write that could
+// not be merged automatically, listing the conflicting JSON pointer paths.
+func NewMergeConflictError(currentRevision int64, latest *Snapshot, conflicts []MergeConflict) *SyncError {
+	err := NewStaleRevisionError(currentRevision, latest)
+	err.Message = "resource has a newer revision with conflicting changes"
+	err.Details[DetailMergeConflicts] = append([]MergeConflict(nil), conflicts...)
+	return err
+}
+
+// MergeConflictsOf extracts merge conflicts from a stale-revision error.
+func MergeConflictsOf(err error) []MergeConflict {
+	var syncErr *SyncError
+	if !errors.As(err, &syncErr) || syncErr == nil || syncErr.Code != CodeStaleRevision || syncErr.Details == nil {
+		return nil
+	}
+	conflicts, _ := syncErr.Details[DetailMergeConflicts].([]MergeConflict)
+	return conflicts
+}
+
 // StaleRevisionDetails extracts stale-revision details from canonical sync errors.
 func StaleRevisionDetails(err error) (int64, *Snapshot, bool) {
 	var syncErr *SyncError
@@ -7,3 +7,14 @@ type SyncService interface {
 	Get(ctx context.Context, ref ResourceRef) (Snapshot, error)
 	Mutate(ctx context.Context, input MutationInput) (MutationResult, error)
 }
+
+// WatchService is implemented by sync services that can stream revisions.
+// Transports type-assert for it and report the capability as unavailable
+// otherwise.
+type WatchService interface {
+	// Watch subscribes to revisions of a resource. The channel first yields the
+	// current snapshot, then every newer revision, and is closed when ctx ends.
+	// Slow receivers observe only the latest revision; intermediate revisions
+	// may be skipped but revisions never go backwards.
+	Watch(ctx context.Context, ref ResourceRef) (<-chan Snapshot, error)
+}
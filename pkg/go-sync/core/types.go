@@ -1,6 +1,9 @@
 package core
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // ResourceRef identifies a single mutable resource within a logical scope.
 type ResourceRef struct {
@@ -37,4 +40,16 @@ type MutationResult struct {
 	Snapshot Snapshot `json:"snapshot"`
 	Applied  bool     `json:"applied"`
 	Replay   bool     `json:"replay"`
+	// Merged reports that the write was three-way merged onto a newer revision.
+	Merged bool `json:"merged,omitempty"`
+}
+
+// MergeConflict describes a JSON pointer path changed differently by the
+// client and by a concurrent writer since the client's base revision.
+// Missing values mean the path was absent in that version.
+type MergeConflict struct {
+	Path   string          `json:"path"`
+	Base   json.RawMessage `json:"base,omitempty"`
+	Local  json.RawMessage `json:"local,omitempty"`
+	Remote json.RawMessage `json:"remote,omitempty"`
 }
@@ -5,12 +5,13 @@ import (
 	"io/fs"
 )
 
-//go:embed client
+//go:embed client sql/migrations/sqlite/*.sql sql/migrations/postgres/*.sql
 var embeddedFS embed.FS
 
 var (
 	clientFS         = mustSubFS(embeddedFS, "client")
 	clientSyncCoreFS = mustSubFS(embeddedFS, "client/sync-core")
+	migrationsFS     = mustSubFS(embeddedFS, "sql/migrations")
 )
 
 // ClientFS returns embedded sync client artifacts rooted at `data/client`.
@@ -23,6 +24,12 @@ func ClientSyncCoreFS() fs.FS {
 	return clientSyncCoreFS
 }
 
+// SQLMigrationsFS returns the SQL store schema rooted at `data/sql/migrations`,
+// with one directory per dialect (`sqlite`, `postgres`).
+func SQLMigrationsFS() fs.FS {
+	return migrationsFS
+}
+
 func mustSubFS(root fs.FS, path string) fs.FS {
 	sub, err := fs.Sub(root, path)
 	if err != nil {
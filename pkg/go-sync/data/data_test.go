@@ -70,3 +70,23 @@ func TestEmbeddedSyncCoreArtifactMatchesWorkspaceDistOutput(t *testing.T) {
 		}
 	}
 }
+
+func TestSQLMigrationsFSShipsEveryDialect(t *testing.T) {
+	for _, dialect := range []string{"sqlite", "postgres"} {
+		for _, direction := range []string{"up", "down"} {
+			for migration, table := range map[string]string{
+				"0001_sync_store":     "sync_idempotency",
+				"0002_sync_revisions": "sync_resource_revisions",
+			} {
+				name := dialect + "/" + migration + "." + direction + ".sql"
+				content, err := fs.ReadFile(SQLMigrationsFS(), name)
+				if err != nil {
+					t.Fatalf("read %s: %v", name, err)
+				}
+				if !strings.Contains(string(content), table) {
+					t.Fatalf("expected %s to manage %s", name, table)
+				}
+			}
+		}
+	}
+}
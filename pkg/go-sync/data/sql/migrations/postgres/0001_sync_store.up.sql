@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS sync_resources (
+    kind TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    scope_key TEXT NOT NULL DEFAULT '',
+    scope_json TEXT NOT NULL DEFAULT '{}',
+    data BYTEA,
+    revision BIGINT NOT NULL,
+    metadata_json TEXT NOT NULL DEFAULT '{}',
+    updated_at_ns BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (kind, resource_id, scope_key)
+);
+
+CREATE TABLE IF NOT EXISTS sync_idempotency (
+    idempotency_key TEXT PRIMARY KEY,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'committed')),
+    token TEXT NOT NULL DEFAULT '',
+    result_json TEXT NOT NULL DEFAULT '',
+    reserved_at_ns BIGINT NOT NULL DEFAULT 0,
+    expires_at_ns BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS ix_sync_idempotency_expires_at
+    ON sync_idempotency(expires_at_ns)
+    WHERE expires_at_ns > 0;
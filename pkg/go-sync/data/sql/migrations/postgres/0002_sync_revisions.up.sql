@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS sync_resource_revisions (
+    kind TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    scope_key TEXT NOT NULL DEFAULT '',
+    revision BIGINT NOT NULL,
+    data BYTEA,
+    metadata_json TEXT NOT NULL DEFAULT '{}',
+    updated_at_ns BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (kind, resource_id, scope_key, revision)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_sync_idempotency_expires_at;
+DROP TABLE IF EXISTS sync_idempotency;
+DROP TABLE IF EXISTS sync_resources;
@@ -15,6 +15,17 @@ type Metrics interface {
 	IncrementRetry(ctx context.Context, attrs map[string]string)
 }
 
+// WatchMetrics is an optional extension of Metrics for watch subscriptions.
+// Sinks that do not implement it simply do not receive watch telemetry.
+type WatchMetrics interface {
+	// ObserveWatch records a finished watch subscription and how long it was open.
+	ObserveWatch(ctx context.Context, duration time.Duration, attrs map[string]string)
+	// IncrementWatchEvent counts snapshots queued for a watch subscriber.
+	IncrementWatchEvent(ctx context.Context, attrs map[string]string)
+	// IncrementWatchCoalesced counts unread snapshots replaced by a newer revision.
+	IncrementWatchCoalesced(ctx context.Context, attrs map[string]string)
+}
+
 // Logger captures structured sync-kernel logs.
 type Logger interface {
 	Log(ctx context.Context, level slog.Level, msg string, args ...any)
@@ -33,6 +44,14 @@ func (NopMetrics) IncrementReplay(context.Context, map[string]string) {}
 
 func (NopMetrics) IncrementRetry(context.Context, map[string]string) {}
 
+var _ WatchMetrics = NopMetrics{}
+
+func (NopMetrics) ObserveWatch(context.Context, time.Duration, map[string]string) {}
+
+func (NopMetrics) IncrementWatchEvent(context.Context, map[string]string) {}
+
+func (NopMetrics) IncrementWatchCoalesced(context.Context, map[string]string) {}
+
 // NopLogger drops all emitted logs.
 type NopLogger struct{}
 
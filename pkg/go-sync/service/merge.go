@@ -0,0 +1,185 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+)
+
+// MergeStrategy reconciles a stale write with the revision that superseded it.
+type MergeStrategy interface {
+	Merge(ctx context.Context, input MergeInput) (MergeResult, error)
+}
+
+// MergeInput carries the three payloads of a stale write: Base is the payload
+// at the client's expected revision, Local is the client's payload, and Remote
+// is the current stored payload.
+type MergeInput struct {
+	ResourceRef core.ResourceRef
+	Base        []byte
+	Local       []byte
+	Remote      []byte
+}
+
+// MergeResult is the merged payload and any paths that could not be merged.
+// Payload is only applied when Conflicts is empty.
+type MergeResult struct {
+	Payload   []byte
+	Conflicts []core.MergeConflict
+}
+
+// JSONMergeStrategy merges JSON documents field by field. Object members are
+// merged recursively and addressed by JSON pointer; arrays and scalars are
+// replaced as a whole. A path conflicts when the client and the concurrent
+// writer both changed it to different values.
+type JSONMergeStrategy struct{}
+
+var _ MergeStrategy = JSONMergeStrategy{}
+
+// Merge performs the three-way merge. Payloads that are not valid JSON return
+// an INVALID_MUTATION error so the caller keeps the original stale revision.
+func (JSONMergeStrategy) Merge(_ context.Context, input MergeInput) (MergeResult, error) {
+	base, err := decodeMergeDocument(input.Base, "base")
+	if err != nil {
+		return MergeResult{}, err
+	}
+	local, err := decodeMergeDocument(input.Local, "local")
+	if err != nil {
+		return MergeResult{}, err
+	}
+	remote, err := decodeMergeDocument(input.Remote, "remote")
+	if err != nil {
+		return MergeResult{}, err
+	}
+
+	merger := &jsonMerger{}
+	merged, present := merger.merge("", mergeValue{base, true}, mergeValue{local, true}, mergeValue{remote, true})
+	if len(merger.conflicts) > 0 {
+		return MergeResult{Conflicts: merger.conflicts}, nil
+	}
+	if !present {
+		return MergeResult{}, nil
+	}
+	payload, err := json.Marshal(merged)
+	if err != nil {
+		return MergeResult{}, core.NewWrappedError(core.CodeInvalidMutation, "encode merged payload", nil, err)
+	}
+	return MergeResult{Payload: payload}, nil
+}
+
+type mergeValue struct {
+	value   any
+	present bool
+}
+
+func (v mergeValue) equal(other mergeValue) bool {
+	if v.present != other.present {
+		return false
+	}
+	return !v.present || reflect.DeepEqual(v.value, other.value)
+}
+
+func (v mergeValue) object() (map[string]any, bool) {
+	if !v.present {
+		return nil, false
+	}
+	object, ok := v.value.(map[string]any)
+	return object, ok
+}
+
+type jsonMerger struct {
+	conflicts []core.MergeConflict
+}
+
+func (m *jsonMerger) merge(path string, base, local, remote mergeValue) (any, bool) {
+	switch {
+	case local.equal(remote), base.equal(remote):
+		return local.value, local.present
+	case base.equal(local):
+		return remote.value, remote.present
+	}
+
+	localObject, localOK := local.object()
+	remoteObject, remoteOK := remote.object()
+	baseObject, baseOK := base.object()
+	if localOK && remoteOK && (baseOK || !base.present) {
+		return m.mergeObjects(path, baseObject, localObject, remoteObject), true
+	}
+
+	m.conflicts = append(m.conflicts, core.MergeConflict{
+		Path:   path,
+		Base:   rawMergeValue(base),
+		Local:  rawMergeValue(local),
+		Remote: rawMergeValue(remote),
+	})
+	return remote.value, remote.present
+}
+
+func (m *jsonMerger) mergeObjects(path string, base, local, remote map[string]any) map[string]any {
+	keys := make(map[string]struct{}, len(local)+len(remote))
+	for key := range base {
+		keys[key] = struct{}{}
+	}
+	for key := range local {
+		keys[key] = struct{}{}
+	}
+	for key := range remote {
+		keys[key] = struct{}{}
+	}
+	ordered := make([]string, 0, len(keys))
+	for key := range keys {
+		ordered = append(ordered, key)
+	}
+	sort.Strings(ordered)
+
+	merged := make(map[string]any, len(ordered))
+	for _, key := range ordered {
+		value, present := m.merge(
+			path+"/"+escapeJSONPointer(key),
+			memberValue(base, key),
+			memberValue(local, key),
+			memberValue(remote, key),
+		)
+		if present {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+func memberValue(object map[string]any, key string) mergeValue {
+	value, ok := object[key]
+	return mergeValue{value: value, present: ok}
+}
+
+func decodeMergeDocument(raw []byte, name string) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, core.NewWrappedError(core.CodeInvalidMutation, "merge payload is not valid JSON", map[string]any{
+			"payload": name,
+		}, err)
+	}
+	return value, nil
+}
+
+func rawMergeValue(value mergeValue) json.RawMessage {
+	if !value.present {
+		return nil
+	}
+	raw, err := json.Marshal(value.value)
+	if err != nil {
+		return nil
+	}
+	return raw
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/service"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+)
+
+func TestJSONMergeStrategyMergesDisjointFieldChanges(t *testing.T) {
+	result, err := service.JSONMergeStrategy{}.Merge(context.Background(), service.MergeInput{
+		Base:   []byte(`{"title":"Draft","body":{"intro":"a","outro":"b"},"tags":["x"],"notes":"n"}`),
+		Local:  []byte(`{"title":"Draft v2","body":{"intro":"a","outro":"b"},"tags":["x"]}`),
+		Remote: []byte(`{"title":"Draft","body":{"intro":"a","outro":"c"},"tags":["x","y"],"notes":"n"}`),
+	})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if len(result.Conflicts) != 0 {
+		t.Fatalf("expected no conflicts, got %+v", result.Conflicts)
+	}
+	assertJSONEqual(t, result.Payload, `{"title":"Draft v2","body":{"intro":"a","outro":"c"},"tags":["x","y"]}`)
+}
+
+func TestJSONMergeStrategyReportsConflictingPointers(t *testing.T) {
+	result, err := service.JSONMergeStrategy{}.Merge(context.Background(), service.MergeInput{
+		Base:   []byte(`{"title":"Draft","meta/seo":{"slug":"a"},"tags":["x"]}`),
+		Local:  []byte(`{"title":"Mine","meta/seo":{"slug":"b"},"tags":["x","local"]}`),
+		Remote: []byte(`{"title":"Theirs","meta/seo":{"slug":"b"},"tags":["x","remote"]}`),
+	})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if result.Payload != nil {
+		t.Fatalf("expected no payload when conflicts exist, got %s", result.Payload)
+	}
+	paths := make([]string, 0, len(result.Conflicts))
+	for _, conflict := range result.Conflicts {
+		paths = append(paths, conflict.Path)
+	}
+	if !reflect.DeepEqual(paths, []string{"/tags", "/title"}) {
+		t.Fatalf("expected conflicts at /tags and /title, got %v", paths)
+	}
+	if string(result.Conflicts[1].Local) != `"Mine"` || string(result.Conflicts[1].Remote) != `"Theirs"` {
+		t.Fatalf("expected conflict values, got %+v", result.Conflicts[1])
+	}
+}
+
+func TestJSONMergeStrategyRejectsInvalidJSON(t *testing.T) {
+	_, err := service.JSONMergeStrategy{}.Merge(context.Background(), service.MergeInput{
+		Base:   []byte(`{}`),
+		Local:  []byte(`not json`),
+		Remote: []byte(`{}`),
+	})
+	if !core.HasCode(err, core.CodeInvalidMutation) {
+		t.Fatalf("expected invalid mutation, got %v", err)
+	}
+}
+
+func TestSyncServiceMutateMergesStaleWriteWithDisjointChanges(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC)
+	resourceStore := store.NewMemoryResourceStore(mergeSnapshot(now, 12, `{"title":"Draft","body":"old"}`))
+	svc := mustNewSyncService(t, resourceStore, nil, service.WithMergeStrategy(service.JSONMergeStrategy{}))
+
+	if _, err := svc.Mutate(ctx, mergeMutation(12, `{"title":"Draft","body":"remote"}`)); err != nil {
+		t.Fatalf("remote mutate: %v", err)
+	}
+	result, err := svc.Mutate(ctx, mergeMutation(12, `{"title":"Local title","body":"old"}`))
+	if err != nil {
+		t.Fatalf("expected stale write to merge, got %v", err)
+	}
+	if !result.Applied || !result.Merged || result.Snapshot.Revision != 14 {
+		t.Fatalf("expected merged revision 14, got %+v", result)
+	}
+	assertJSONEqual(t, result.Snapshot.Data, `{"title":"Local title","body":"remote"}`)
+}
+
+func TestSyncServiceMutateReturnsMergeConflicts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC)
+	resourceStore := store.NewMemoryResourceStore(mergeSnapshot(now, 12, `{"title":"Draft","body":"old"}`))
+	metrics := &captureMetrics{}
+	svc := mustNewSyncService(t, resourceStore, nil, service.WithMergeStrategy(service.JSONMergeStrategy{}), service.WithMetrics(metrics))
+
+	if _, err := svc.Mutate(ctx, mergeMutation(12, `{"title":"Remote title","body":"old"}`)); err != nil {
+		t.Fatalf("remote mutate: %v", err)
+	}
+	_, err := svc.Mutate(ctx, mergeMutation(12, `{"title":"Local title","body":"old"}`))
+	if !core.HasCode(err, core.CodeStaleRevision) {
+		t.Fatalf("expected stale revision, got %v", err)
+	}
+	conflicts := core.MergeConflictsOf(err)
+	if len(conflicts) != 1 || conflicts[0].Path != "/title" {
+		t.Fatalf("expected /title conflict, got %+v", conflicts)
+	}
+	if currentRevision, latest, _ := core.StaleRevisionDetails(err); currentRevision != 13 || latest == nil {
+		t.Fatalf("expected latest revision 13 in details, got %d %+v", currentRevision, latest)
+	}
+	if metrics.conflicts != 1 {
+		t.Fatalf("expected one conflict metric, got %d", metrics.conflicts)
+	}
+}
+
+func TestSyncServiceMutateKeepsStaleErrorWithoutMergeStrategy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC)
+	resourceStore := store.NewMemoryResourceStore(mergeSnapshot(now, 12, `{"title":"Draft","body":"old"}`))
+	svc := mustNewSyncService(t, resourceStore, nil)
+
+	if _, err := svc.Mutate(ctx, mergeMutation(12, `{"title":"Draft","body":"remote"}`)); err != nil {
+		t.Fatalf("remote mutate: %v", err)
+	}
+	_, err := svc.Mutate(ctx, mergeMutation(12, `{"title":"Local title","body":"old"}`))
+	if !core.HasCode(err, core.CodeStaleRevision) || core.MergeConflictsOf(err) != nil {
+		t.Fatalf("expected plain stale revision, got %v", err)
+	}
+}
+
+func mergeSnapshot(now time.Time, revision int64, data string) core.Snapshot {
+	snapshot := seedSnapshot(now, revision)
+	snapshot.Data = []byte(data)
+	return snapshot
+}
+
+func mergeMutation(expectedRevision int64, payload string) core.MutationInput {
+	return core.MutationInput{
+		ResourceRef:      seededRef(),
+		Operation:        "autosave",
+		Payload:          []byte(payload),
+		ExpectedRevision: expectedRevision,
+	}
+}
+
+func assertJSONEqual(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var gotValue, wantValue any
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("unmarshal got %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("unmarshal want: %v", err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
@@ -13,7 +13,12 @@ import (
 	"github.com/goliatone/go-admin/pkg/go-sync/store"
 )
 
-const defaultIdempotencyTTL = 24 * time.Hour
+const (
+	defaultIdempotencyTTL = 24 * time.Hour
+	// mergeAttempts bounds merge-and-retry rounds when concurrent writers keep
+	// advancing the revision.
+	mergeAttempts = 3
+)
 
 // Option customizes SyncService behavior.
 type Option func(*SyncService)
@@ -45,6 +50,30 @@ func WithIdempotencyTTL(ttl time.Duration) Option {
 	}
 }
 
+// WithMergeStrategy enables automatic merging of stale writes. When a mutation
+// fails with STALE_REVISION and the resource store implements
+// store.RevisionStore, the client payload is merged against the current
+// payload and the compare-and-swap is retried. Conflicting paths are returned
+// on the STALE_REVISION error.
+func WithMergeStrategy(strategy MergeStrategy) Option {
+	return func(s *SyncService) {
+		if strategy != nil {
+			s.merge = strategy
+		}
+	}
+}
+
+// WithWatchPollInterval makes Watch re-read each watched resource every
+// interval so revisions written by other processes reach local subscribers.
+// Without it, subscribers only observe mutations applied through this service.
+func WithWatchPollInterval(interval time.Duration) Option {
+	return func(s *SyncService) {
+		if interval > 0 {
+			s.watchPollInterval = interval
+		}
+	}
+}
+
 // SyncService orchestrates revision-safe reads, writes, and idempotent replay.
 type SyncService struct {
 	resources      store.ResourceStore
@@ -52,9 +81,16 @@ type SyncService struct {
 	metrics        observability.Metrics
 	logger         observability.Logger
 	idempotencyTTL time.Duration
+	merge          MergeStrategy
+
+	watches           *watchHub
+	watchPollInterval time.Duration
 }
 
-var _ core.SyncService = (*SyncService)(nil)
+var (
+	_ core.SyncService  = (*SyncService)(nil)
+	_ core.WatchService = (*SyncService)(nil)
+)
 
 // NewSyncService builds a sync orchestration service around store contracts.
 func NewSyncService(resources store.ResourceStore, idempotency store.ReservingIdempotencyStore, opts ...Option) (*SyncService, error) {
@@ -68,6 +104,7 @@ func NewSyncService(resources store.ResourceStore, idempotency store.ReservingId
 		metrics:        observability.NopMetrics{},
 		logger:         observability.NopLogger{},
 		idempotencyTTL: defaultIdempotencyTTL,
+		watches:        newWatchHub(),
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -117,15 +154,17 @@ func (s *SyncService) Mutate(ctx context.Context, input core.MutationInput) (cor
 		return *replayed, nil
 	}
 
-	snapshot, err := s.applyResourceMutation(ctx, input, reservation, scopedKey, startedAt)
+	snapshot, merged, err := s.applyResourceMutation(ctx, input, reservation, scopedKey, startedAt)
 	if err != nil {
 		return core.MutationResult{}, err
 	}
+	s.publishWatch(ctx, input.ResourceRef, snapshot)
 
 	result := core.MutationResult{
 		Snapshot: snapshot,
 		Applied:  true,
 		Replay:   false,
+		Merged:   merged,
 	}
 	recovered, err := s.commitMutationReplay(ctx, input, reservation, result, scopedKey, startedAt)
 	if err != nil {
@@ -203,18 +242,26 @@ func (s *SyncService) applyResourceMutation(
 	reservation *store.IdempotencyReservation,
 	scopedKey string,
 	startedAt time.Time,
-) (core.Snapshot, error) {
+) (core.Snapshot, bool, error) {
 	snapshot, err := s.resources.Mutate(ctx, input)
+	merged := false
+	if core.HasCode(err, core.CodeStaleRevision) && s.merge != nil {
+		snapshot, merged, err = s.mergeStaleMutation(ctx, input, err)
+	}
 	if err == nil {
-		return snapshot, nil
+		return snapshot, merged, nil
 	}
 	s.releaseReplayKey(ctx, input, reservation, scopedKey)
 	if core.HasCode(err, core.CodeStaleRevision) {
 		enriched := s.enrichStaleRevision(ctx, input.ResourceRef, err)
 		s.metrics.IncrementConflict(ctx, mutationAttrs(input, enriched))
 		s.metrics.ObserveMutation(ctx, time.Since(startedAt), false, mutationAttrs(input, enriched))
-		s.logMutation(ctx, slog.LevelWarn, input, enriched, nil)
-		return core.Snapshot{}, enriched
+		var extra map[string]any
+		if conflicts := core.MergeConflictsOf(enriched); len(conflicts) > 0 {
+			extra = map[string]any{"merge_conflicts": len(conflicts)}
+		}
+		s.logMutation(ctx, slog.LevelWarn, input, enriched, extra)
+		return core.Snapshot{}, false, enriched
 	}
 	mapped := s.mapError(err, "mutate resource")
 	if core.HasCode(mapped, core.CodeTemporaryFailure) {
@@ -222,7 +269,59 @@ func (s *SyncService) applyResourceMutation(
 	}
 	s.metrics.ObserveMutation(ctx, time.Since(startedAt), false, mutationAttrs(input, mapped))
 	s.logMutation(ctx, slog.LevelWarn, input, mapped, nil)
-	return core.Snapshot{}, mapped
+	return core.Snapshot{}, false, mapped
+}
+
+// mergeStaleMutation merges the client payload onto the latest revision and
+// retries the compare-and-swap. It returns staleErr unchanged whenever a merge
+// is not possible: no payload, no retained base revision, or payloads the
+// strategy cannot parse.
+func (s *SyncService) mergeStaleMutation(ctx context.Context, input core.MutationInput, staleErr error) (core.Snapshot, bool, error) {
+	history, ok := s.resources.(store.RevisionStore)
+	if !ok || input.Payload == nil {
+		return core.Snapshot{}, false, staleErr
+	}
+	base, err := history.GetRevision(ctx, input.ResourceRef, input.ExpectedRevision)
+	if err != nil {
+		return core.Snapshot{}, false, staleErr
+	}
+
+	for range mergeAttempts {
+		_, latest, _ := core.StaleRevisionDetails(staleErr)
+		if latest == nil {
+			current, err := s.resources.Get(ctx, input.ResourceRef)
+			if err != nil {
+				return core.Snapshot{}, false, staleErr
+			}
+			latest = &current
+		}
+
+		outcome, err := s.merge.Merge(ctx, MergeInput{
+			ResourceRef: input.ResourceRef,
+			Base:        base.Data,
+			Local:       input.Payload,
+			Remote:      latest.Data,
+		})
+		if err != nil {
+			return core.Snapshot{}, false, staleErr
+		}
+		if len(outcome.Conflicts) > 0 {
+			return core.Snapshot{}, false, core.NewMergeConflictError(latest.Revision, latest, outcome.Conflicts)
+		}
+
+		retry := input
+		retry.ExpectedRevision = latest.Revision
+		retry.Payload = outcome.Payload
+		snapshot, err := s.resources.Mutate(ctx, retry)
+		if err == nil {
+			return snapshot, true, nil
+		}
+		if !core.HasCode(err, core.CodeStaleRevision) {
+			return core.Snapshot{}, false, err
+		}
+		staleErr = err
+	}
+	return core.Snapshot{}, false, staleErr
 }
 
 func (s *SyncService) commitMutationReplay(
@@ -268,6 +367,7 @@ func (s *SyncService) observeMutationSuccess(
 	s.metrics.ObserveMutation(ctx, time.Since(startedAt), true, mutationAttrs(input, nil))
 	s.logMutation(ctx, slog.LevelInfo, input, nil, map[string]any{
 		"applied":  true,
+		"merged":   result.Merged,
 		"revision": result.Snapshot.Revision,
 	})
 }
@@ -419,6 +519,9 @@ func (s *SyncService) enrichStaleRevision(ctx context.Context, ref core.Resource
 	if currentRevision == 0 {
 		return core.NewError(core.CodeStaleRevision, "resource has a newer revision", nil)
 	}
+	if conflicts := core.MergeConflictsOf(err); len(conflicts) > 0 {
+		return core.NewMergeConflictError(currentRevision, latest, conflicts)
+	}
 	return core.NewStaleRevisionError(currentRevision, latest)
 }
 
@@ -515,5 +618,6 @@ func cloneMutationResult(result core.MutationResult) core.MutationResult {
 		Snapshot: result.Snapshot,
 		Applied:  result.Applied,
 		Replay:   result.Replay,
+		Merged:   result.Merged,
 	}
 }
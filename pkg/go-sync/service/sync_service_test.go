@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"maps"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -390,9 +391,18 @@ type captureMetrics struct {
 	conflicts int
 	replays   int
 	retries   int
+
+	// Watch metrics are emitted from subscription goroutines.
+	watchMu        sync.Mutex
+	watches        int
+	watchEvents    int
+	watchCoalesced int
 }
 
-var _ observability.Metrics = (*captureMetrics)(nil)
+var (
+	_ observability.Metrics      = (*captureMetrics)(nil)
+	_ observability.WatchMetrics = (*captureMetrics)(nil)
+)
 
 func (c *captureMetrics) ObserveRead(_ context.Context, _ time.Duration, success bool, attrs map[string]string) {
 	c.reads = append(c.reads, metricCall{success: success, attrs: cloneStringMap(attrs)})
@@ -414,6 +424,30 @@ func (c *captureMetrics) IncrementRetry(_ context.Context, _ map[string]string)
 	c.retries++
 }
 
+func (c *captureMetrics) ObserveWatch(_ context.Context, _ time.Duration, _ map[string]string) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	c.watches++
+}
+
+func (c *captureMetrics) IncrementWatchEvent(_ context.Context, _ map[string]string) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	c.watchEvents++
+}
+
+func (c *captureMetrics) IncrementWatchCoalesced(_ context.Context, _ map[string]string) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	c.watchCoalesced++
+}
+
+func (c *captureMetrics) watchCounts() (watches, events, coalesced int) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	return c.watches, c.watchEvents, c.watchCoalesced
+}
+
 type logEntry struct {
 	level slog.Level
 	msg   string
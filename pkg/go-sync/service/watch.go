@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/observability"
+)
+
+// Watch subscribes to revisions of a resource. The returned channel yields the
+// current snapshot first and then every newer revision applied through this
+// service (and, with WithWatchPollInterval, revisions written elsewhere). The
+// channel holds at most one pending snapshot: a newer revision replaces an
+// unread one, so slow receivers skip intermediate revisions. It is closed when
+// ctx is canceled.
+func (s *SyncService) Watch(ctx context.Context, ref core.ResourceRef) (<-chan core.Snapshot, error) {
+	if err := validateResourceRef(ref); err != nil {
+		return nil, err
+	}
+
+	// Subscribe before loading so a mutation racing the initial read is not lost;
+	// the revision check in offer drops whichever snapshot arrives stale.
+	key := watchKey(ref)
+	subscriber := s.watches.subscribe(key)
+	snapshot, err := s.Get(ctx, ref)
+	if err != nil {
+		s.watches.unsubscribe(key, subscriber)
+		return nil, err
+	}
+	s.offerWatch(ctx, ref, subscriber, snapshot)
+	s.logWatch(ctx, slog.LevelInfo, ref, nil, map[string]any{
+		"subscribed": true,
+		"revision":   snapshot.Revision,
+	})
+
+	go s.runWatch(ctx, ref, key, subscriber, time.Now())
+	return subscriber.ch, nil
+}
+
+func (s *SyncService) runWatch(ctx context.Context, ref core.ResourceRef, key string, subscriber *watchSubscriber, startedAt time.Time) {
+	defer func() {
+		s.watches.unsubscribe(key, subscriber)
+		if metrics, ok := s.metrics.(observability.WatchMetrics); ok {
+			metrics.ObserveWatch(context.WithoutCancel(ctx), time.Since(startedAt), readAttrs(ref, nil))
+		}
+		s.logWatch(context.WithoutCancel(ctx), slog.LevelInfo, ref, nil, map[string]any{
+			"subscribed":  false,
+			"duration_ms": time.Since(startedAt).Milliseconds(),
+		})
+	}()
+
+	if s.watchPollInterval <= 0 {
+		<-ctx.Done()
+		return
+	}
+	ticker := time.NewTicker(s.watchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			snapshot, err := s.resources.Get(ctx, ref)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				s.logWatch(ctx, slog.LevelWarn, ref, s.mapError(err, "poll watched resource"), nil)
+				continue
+			}
+			s.offerWatch(ctx, ref, subscriber, snapshot)
+		}
+	}
+}
+
+func (s *SyncService) offerWatch(ctx context.Context, ref core.ResourceRef, subscriber *watchSubscriber, snapshot core.Snapshot) {
+	queued, coalesced := s.watches.offer(subscriber, snapshot)
+	s.observeWatchDelivery(ctx, ref, queued, coalesced)
+}
+
+// publishWatch fans an applied mutation out to local subscribers of the resource.
+func (s *SyncService) publishWatch(ctx context.Context, ref core.ResourceRef, snapshot core.Snapshot) {
+	queued, coalesced := s.watches.publish(watchKey(ref), snapshot)
+	s.observeWatchDelivery(ctx, ref, queued, coalesced)
+}
+
+func (s *SyncService) observeWatchDelivery(ctx context.Context, ref core.ResourceRef, queued, coalesced int) {
+	metrics, ok := s.metrics.(observability.WatchMetrics)
+	if !ok {
+		return
+	}
+	attrs := readAttrs(ref, nil)
+	for range queued {
+		metrics.IncrementWatchEvent(ctx, attrs)
+	}
+	for range coalesced {
+		metrics.IncrementWatchCoalesced(ctx, attrs)
+	}
+}
+
+func (s *SyncService) logWatch(ctx context.Context, level slog.Level, ref core.ResourceRef, err error, extra map[string]any) {
+	args := []any{
+		"event", "go_sync.watch",
+		"resource_kind", strings.TrimSpace(ref.Kind),
+		"resource_id", strings.TrimSpace(ref.ID),
+		"scope", scopeLogValue(ref.Scope),
+	}
+	if code, ok := core.ErrorCodeOf(err); ok {
+		args = append(args, "error_code", string(code))
+		args = append(args, "error", err.Error())
+	}
+	for key, value := range extra {
+		args = append(args, key, value)
+	}
+	s.logger.Log(ctx, level, "go-sync watch", args...)
+}
+
+func watchKey(ref core.ResourceRef) string {
+	return kindPart(ref.Kind) + "|" + kindPart(ref.ID) + "|" + strings.Join(scopeLogValue(ref.Scope), ",")
+}
+
+// watchHub tracks in-process watch subscribers per resource key.
+type watchHub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*watchSubscriber]struct{}
+}
+
+type watchSubscriber struct {
+	ch       chan core.Snapshot
+	revision int64
+	closed   bool
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{subscribers: make(map[string]map[*watchSubscriber]struct{})}
+}
+
+func (h *watchHub) subscribe(key string) *watchSubscriber {
+	subscriber := &watchSubscriber{
+		ch:       make(chan core.Snapshot, 1),
+		revision: -1,
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[key] == nil {
+		h.subscribers[key] = make(map[*watchSubscriber]struct{})
+	}
+	h.subscribers[key][subscriber] = struct{}{}
+	return subscriber
+}
+
+func (h *watchHub) unsubscribe(key string, subscriber *watchSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if subscriber.closed {
+		return
+	}
+	subscriber.closed = true
+	close(subscriber.ch)
+	delete(h.subscribers[key], subscriber)
+	if len(h.subscribers[key]) == 0 {
+		delete(h.subscribers, key)
+	}
+}
+
+func (h *watchHub) publish(key string, snapshot core.Snapshot) (queued, coalesced int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for subscriber := range h.subscribers[key] {
+		ok, replaced := h.offerLocked(subscriber, snapshot)
+		if ok {
+			queued++
+		}
+		if replaced {
+			coalesced++
+		}
+	}
+	return queued, coalesced
+}
+
+func (h *watchHub) offer(subscriber *watchSubscriber, snapshot core.Snapshot) (queued, coalesced int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ok, replaced := h.offerLocked(subscriber, snapshot)
+	if ok {
+		queued = 1
+	}
+	if replaced {
+		coalesced = 1
+	}
+	return queued, coalesced
+}
+
+// offerLocked queues snapshot when it is newer than anything already sent to
+// subscriber, replacing an unread snapshot. Senders hold h.mu, so the buffer
+// slot is guaranteed free after the drain.
+func (h *watchHub) offerLocked(subscriber *watchSubscriber, snapshot core.Snapshot) (queued, coalesced bool) {
+	if subscriber.closed || snapshot.Revision <= subscriber.revision {
+		return false, false
+	}
+	select {
+	case <-subscriber.ch:
+		coalesced = true
+	default:
+	}
+	subscriber.ch <- cloneSnapshot(snapshot)
+	subscriber.revision = snapshot.Revision
+	return true, coalesced
+}
+
+func cloneSnapshot(snapshot core.Snapshot) core.Snapshot {
+	cloned := snapshot
+	cloned.ResourceRef.Scope = cloneStringMap(snapshot.ResourceRef.Scope)
+	if snapshot.Data != nil {
+		cloned.Data = append([]byte(nil), snapshot.Data...)
+	}
+	if snapshot.Metadata != nil {
+		cloned.Metadata = make(map[string]any, len(snapshot.Metadata))
+		maps.Copy(cloned.Metadata, snapshot.Metadata)
+	}
+	return cloned
+}
+
+func cloneStringMap(input map[string]string) map[string]string {
+	if input == nil {
+		return nil
+	}
+	out := make(map[string]string, len(input))
+	maps.Copy(out, input)
+	return out
+}
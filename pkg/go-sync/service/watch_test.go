@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/service"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+)
+
+func TestSyncServiceWatchEmitsCurrentSnapshotThenAppliedRevisions(t *testing.T) {
+	now := time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC)
+	resourceStore := store.NewMemoryResourceStore(seedSnapshot(now, 12))
+	metrics := &captureMetrics{}
+	svc := mustNewSyncService(t, resourceStore, nil, service.WithMetrics(metrics), service.WithLogger(&captureLogger{}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	snapshots, err := svc.Watch(ctx, seededRef())
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	if got := receiveSnapshot(t, snapshots); got.Revision != 12 {
+		t.Fatalf("expected current revision 12 first, got %d", got.Revision)
+	}
+
+	input := seededMutation()
+	input.IdempotencyKey = ""
+	if _, err := svc.Mutate(context.Background(), input); err != nil {
+		t.Fatalf("mutate: %v", err)
+	}
+	got := receiveSnapshot(t, snapshots)
+	if got.Revision != 13 || string(got.Data) != string(input.Payload) {
+		t.Fatalf("expected applied revision 13, got %d data=%s", got.Revision, got.Data)
+	}
+
+	cancel()
+	if _, ok := <-snapshots; ok {
+		t.Fatal("expected watch channel to close after cancel")
+	}
+	waitFor(t, func() bool {
+		watches, events, _ := metrics.watchCounts()
+		return watches == 1 && events == 2
+	})
+}
+
+func TestSyncServiceWatchCoalescesUnreadRevisions(t *testing.T) {
+	now := time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC)
+	resourceStore := store.NewMemoryResourceStore(seedSnapshot(now, 12))
+	metrics := &captureMetrics{}
+	svc := mustNewSyncService(t, resourceStore, nil, service.WithMetrics(metrics))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	snapshots, err := svc.Watch(ctx, seededRef())
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+
+	for revision := int64(12); revision < 15; revision++ {
+		input := seededMutation()
+		input.IdempotencyKey = ""
+		input.ExpectedRevision = revision
+		if _, err := svc.Mutate(context.Background(), input); err != nil {
+			t.Fatalf("mutate revision %d: %v", revision, err)
+		}
+	}
+
+	if got := receiveSnapshot(t, snapshots); got.Revision != 15 {
+		t.Fatalf("expected only the latest revision 15 to be pending, got %d", got.Revision)
+	}
+	if _, _, coalesced := metrics.watchCounts(); coalesced != 3 {
+		t.Fatalf("expected 3 coalesced snapshots, got %d", coalesced)
+	}
+}
+
+func TestSyncServiceWatchPollsRevisionsWrittenOutsideTheService(t *testing.T) {
+	now := time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC)
+	resourceStore := store.NewMemoryResourceStore(seedSnapshot(now, 12))
+	svc := mustNewSyncService(t, resourceStore, nil, service.WithWatchPollInterval(5*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	snapshots, err := svc.Watch(ctx, seededRef())
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	receiveSnapshot(t, snapshots)
+
+	input := seededMutation()
+	input.IdempotencyKey = ""
+	if _, err := resourceStore.Mutate(context.Background(), input); err != nil {
+		t.Fatalf("mutate store directly: %v", err)
+	}
+	if got := receiveSnapshot(t, snapshots); got.Revision != 13 {
+		t.Fatalf("expected polled revision 13, got %d", got.Revision)
+	}
+}
+
+func TestSyncServiceWatchRejectsMissingResource(t *testing.T) {
+	svc := mustNewSyncService(t, store.NewMemoryResourceStore(), nil)
+
+	_, err := svc.Watch(context.Background(), seededRef())
+	if !core.HasCode(err, core.CodeNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func receiveSnapshot(t *testing.T, snapshots <-chan core.Snapshot) core.Snapshot {
+	t.Helper()
+	select {
+	case snapshot, ok := <-snapshots:
+		if !ok {
+			t.Fatal("watch channel closed unexpectedly")
+		}
+		return snapshot
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for watched snapshot")
+	}
+	return core.Snapshot{}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
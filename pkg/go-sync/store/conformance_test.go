@@ -0,0 +1,25 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+	"github.com/goliatone/go-admin/pkg/go-sync/store/storetest"
+)
+
+func TestMemoryResourceStoreConformance(t *testing.T) {
+	storetest.RunResourceStoreTests(t, func(_ *testing.T, clock *storetest.Clock, seed ...core.Snapshot) store.ResourceStore {
+		resources := store.NewMemoryResourceStore(seed...)
+		resources.Now = clock.Now
+		return resources
+	})
+}
+
+func TestMemoryIdempotencyStoreConformance(t *testing.T) {
+	storetest.RunIdempotencyStoreTests(t, func(_ *testing.T, clock *storetest.Clock) store.ReservingIdempotencyStore {
+		replay := store.NewMemoryIdempotencyStore()
+		replay.Now = clock.Now
+		return replay
+	})
+}
@@ -49,10 +49,22 @@ type CommitRecoveryStore interface {
 	RecoverCommit(ctx context.Context, reservation IdempotencyReservation, result core.MutationResult, ttl time.Duration) error
 }
 
+// RevisionStore can load a prior revision of a resource. Stores that implement
+// it let the service three-way merge stale writes against the revision the
+// client started from. Revisions that are no longer retained return NOT_FOUND.
+type RevisionStore interface {
+	GetRevision(ctx context.Context, ref core.ResourceRef, revision int64) (core.Snapshot, error)
+}
+
+const defaultMemoryHistoryLimit = 32
+
 // MemoryResourceStore is a deterministic in-memory ResourceStore useful for tests.
 type MemoryResourceStore struct {
-	mu              sync.RWMutex
-	snapshots       map[string]core.Snapshot
+	mu        sync.RWMutex
+	snapshots map[string]core.Snapshot
+	history   map[string][]core.Snapshot
+	// HistoryLimit bounds how many prior revisions GetRevision can return per resource.
+	HistoryLimit    int                `json:"history_limit"`
 	Now             func() time.Time   `json:"now"`
 	GetError        error              `json:"get_error"`
 	MutateError     error              `json:"mutate_error"`
@@ -65,8 +77,10 @@ type MemoryResourceStore struct {
 // NewMemoryResourceStore builds an in-memory store seeded with snapshots.
 func NewMemoryResourceStore(snapshots ...core.Snapshot) *MemoryResourceStore {
 	store := &MemoryResourceStore{
-		snapshots: make(map[string]core.Snapshot, len(snapshots)),
-		Now:       time.Now,
+		snapshots:    make(map[string]core.Snapshot, len(snapshots)),
+		history:      make(map[string][]core.Snapshot),
+		HistoryLimit: defaultMemoryHistoryLimit,
+		Now:          time.Now,
 	}
 	for _, snapshot := range snapshots {
 		store.Seed(snapshot)
@@ -78,7 +92,9 @@ func NewMemoryResourceStore(snapshots ...core.Snapshot) *MemoryResourceStore {
 func (s *MemoryResourceStore) Seed(snapshot core.Snapshot) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.snapshots[resourceKey(snapshot.ResourceRef)] = cloneSnapshot(snapshot)
+	key := resourceKey(snapshot.ResourceRef)
+	s.snapshots[key] = cloneSnapshot(snapshot)
+	delete(s.history, key)
 }
 
 // Get returns the authoritative snapshot for the requested resource.
@@ -132,11 +148,49 @@ func (s *MemoryResourceStore) Mutate(_ context.Context, input core.MutationInput
 		next.Metadata = make(map[string]any)
 	}
 	next.Metadata["operation"] = strings.TrimSpace(input.Operation)
+	s.retainRevision(key, current)
 	s.snapshots[key] = cloneSnapshot(next)
 
 	return cloneSnapshot(next), nil
 }
 
+// GetRevision returns the current snapshot or a retained prior revision.
+func (s *MemoryResourceStore) GetRevision(_ context.Context, ref core.ResourceRef, revision int64) (core.Snapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key := resourceKey(ref)
+	current, ok := s.snapshots[key]
+	if !ok {
+		return core.Snapshot{}, core.NewError(core.CodeNotFound, "resource not found", nil)
+	}
+	if current.Revision == revision {
+		return cloneSnapshot(current), nil
+	}
+	for _, snapshot := range s.history[key] {
+		if snapshot.Revision == revision {
+			return cloneSnapshot(snapshot), nil
+		}
+	}
+	return core.Snapshot{}, core.NewError(core.CodeNotFound, "resource revision not retained", map[string]any{
+		"revision": revision,
+	})
+}
+
+func (s *MemoryResourceStore) retainRevision(key string, snapshot core.Snapshot) {
+	if s.HistoryLimit <= 0 {
+		return
+	}
+	if s.history == nil {
+		s.history = make(map[string][]core.Snapshot)
+	}
+	history := append(s.history[key], snapshot)
+	if len(history) > s.HistoryLimit {
+		history = history[len(history)-s.HistoryLimit:]
+	}
+	s.history[key] = history
+}
+
 // MemoryIdempotencyStore is a deterministic in-memory IdempotencyStore useful for tests.
 type MemoryIdempotencyStore struct {
 	mu             sync.RWMutex
@@ -230,7 +284,9 @@ func (s *MemoryIdempotencyStore) Reserve(_ context.Context, key string, ttl time
 	}
 
 	if entry, ok := s.entries[s.LastReserveKey]; ok {
-		if !entry.pending && !entry.expiresAt.IsZero() && s.now().After(entry.expiresAt) {
+		// Expired reservations are reclaimed too, so a writer that crashed
+		// between Reserve and Commit cannot block the key forever.
+		if !entry.expiresAt.IsZero() && s.now().After(entry.expiresAt) {
 			delete(s.entries, s.LastReserveKey)
 		} else if entry.pending {
 			return IdempotencyReserveResult{Pending: true}, nil
@@ -353,6 +409,7 @@ func cloneMutationResult(result core.MutationResult) core.MutationResult {
 		Snapshot: cloneSnapshot(result.Snapshot),
 		Applied:  result.Applied,
 		Replay:   result.Replay,
+		Merged:   result.Merged,
 	}
 }
 
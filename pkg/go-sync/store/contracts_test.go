@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"testing"
 	"time"
 
@@ -295,3 +296,39 @@ func TestMemoryIdempotencyStoreSurfacesInjectedErrors(t *testing.T) {
 		t.Fatalf("expected injected reserve error, got %v", err)
 	}
 }
+
+func TestMemoryResourceStoreGetRevisionReturnsRetainedRevisions(t *testing.T) {
+	t.Parallel()
+
+	ref := core.ResourceRef{Kind: "article_draft", ID: "draft_123"}
+	resourceStore := store.NewMemoryResourceStore(core.Snapshot{
+		ResourceRef: ref,
+		Data:        []byte(`{"v":1}`),
+		Revision:    1,
+	})
+	resourceStore.HistoryLimit = 2
+	for revision := int64(1); revision <= 3; revision++ {
+		if _, err := resourceStore.Mutate(context.Background(), core.MutationInput{
+			ResourceRef:      ref,
+			Operation:        "autosave",
+			Payload:          []byte(`{"v":` + strconv.FormatInt(revision+1, 10) + `}`),
+			ExpectedRevision: revision,
+		}); err != nil {
+			t.Fatalf("mutate revision %d: %v", revision, err)
+		}
+	}
+
+	snapshot, err := resourceStore.GetRevision(context.Background(), ref, 2)
+	if err != nil {
+		t.Fatalf("get revision 2: %v", err)
+	}
+	if string(snapshot.Data) != `{"v":2}` {
+		t.Fatalf("expected revision 2 payload, got %s", snapshot.Data)
+	}
+	if current, err := resourceStore.GetRevision(context.Background(), ref, 4); err != nil || current.Revision != 4 {
+		t.Fatalf("expected current revision 4, got %+v err=%v", current, err)
+	}
+	if _, err := resourceStore.GetRevision(context.Background(), ref, 1); !core.HasCode(err, core.CodeNotFound) {
+		t.Fatalf("expected revision 1 to be trimmed, got %v", err)
+	}
+}
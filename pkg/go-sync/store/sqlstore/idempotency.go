@@ -0,0 +1,321 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+)
+
+const (
+	statusPending   = "pending"
+	statusCommitted = "committed"
+
+	// reserveAttempts bounds the read/claim loop when concurrent callers
+	// race on the same key.
+	reserveAttempts = 4
+)
+
+// IdempotencyStore is a SQL-backed replay store over the sync_idempotency
+// table. Pending reservations carry an opaque token; only the holder of that
+// token can commit or release the key. Reservations and results expire after
+// their TTL: expired rows are reclaimed lazily by Reserve and Get, and
+// removed in bulk by SweepExpired.
+type IdempotencyStore struct {
+	db  *sql.DB
+	cfg config
+}
+
+var (
+	_ store.IdempotencyStore          = (*IdempotencyStore)(nil)
+	_ store.ReservingIdempotencyStore = (*IdempotencyStore)(nil)
+	_ store.CommitRecoveryStore       = (*IdempotencyStore)(nil)
+)
+
+// NewIdempotencyStore builds a SQL idempotency store.
+func NewIdempotencyStore(db *sql.DB, opts ...Option) (*IdempotencyStore, error) {
+	cfg, err := newConfig(db, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &IdempotencyStore{db: db, cfg: cfg}, nil
+}
+
+type idempotencyRow struct {
+	status      string
+	token       string
+	resultJSON  string
+	expiresAtNS int64
+}
+
+func (r idempotencyRow) expired(now time.Time) bool {
+	return r.expiresAtNS > 0 && now.UnixNano() > r.expiresAtNS
+}
+
+func (r idempotencyRow) result() (core.MutationResult, error) {
+	var result core.MutationResult
+	if err := json.Unmarshal([]byte(r.resultJSON), &result); err != nil {
+		return core.MutationResult{}, temporaryFailure("decode idempotency result", err)
+	}
+	return result, nil
+}
+
+// Get returns a committed, unexpired mutation result.
+func (s *IdempotencyStore) Get(ctx context.Context, key string) (core.MutationResult, bool, error) {
+	key = strings.TrimSpace(key)
+	row, ok, err := s.load(ctx, key)
+	if err != nil || !ok {
+		return core.MutationResult{}, false, err
+	}
+	if row.expired(s.cfg.clock()) {
+		if err := s.deleteRow(ctx, key, row); err != nil {
+			return core.MutationResult{}, false, err
+		}
+		return core.MutationResult{}, false, nil
+	}
+	if row.status != statusCommitted {
+		return core.MutationResult{}, false, nil
+	}
+	result, err := row.result()
+	if err != nil {
+		return core.MutationResult{}, false, err
+	}
+	return result, true, nil
+}
+
+// Put stores a committed result, replacing any reservation for key.
+func (s *IdempotencyStore) Put(ctx context.Context, key string, result core.MutationResult, ttl time.Duration) error {
+	resultJSON, err := encodeJSON(result)
+	if err != nil {
+		return err
+	}
+	now := s.cfg.clock()
+	_, err = s.db.ExecContext(ctx, s.cfg.rebind(`
+INSERT INTO sync_idempotency (idempotency_key, status, token, result_json, reserved_at_ns, expires_at_ns)
+VALUES (?, ?, '', ?, ?, ?)
+ON CONFLICT (idempotency_key) DO UPDATE SET
+    status = excluded.status,
+    token = '',
+    result_json = excluded.result_json,
+    expires_at_ns = excluded.expires_at_ns`),
+		strings.TrimSpace(key), statusCommitted, resultJSON, now.UnixNano(), expiresAt(now, ttl),
+	)
+	return err
+}
+
+// Reserve claims key for an in-flight mutation, reports Pending when another
+// caller holds an unexpired reservation, or returns the committed result.
+func (s *IdempotencyStore) Reserve(ctx context.Context, key string, ttl time.Duration) (store.IdempotencyReserveResult, error) {
+	key = strings.TrimSpace(key)
+	for range reserveAttempts {
+		now := s.cfg.clock()
+		row, ok, err := s.load(ctx, key)
+		if err != nil {
+			return store.IdempotencyReserveResult{}, err
+		}
+		switch {
+		case !ok:
+			reservation, claimed, err := s.insertReservation(ctx, key, now, ttl)
+			if err != nil || claimed {
+				return reservation, err
+			}
+		case row.expired(now):
+			reservation, claimed, err := s.reclaimReservation(ctx, key, row, now, ttl)
+			if err != nil || claimed {
+				return reservation, err
+			}
+		case row.status == statusPending:
+			return store.IdempotencyReserveResult{Pending: true}, nil
+		default:
+			result, err := row.result()
+			if err != nil {
+				return store.IdempotencyReserveResult{}, err
+			}
+			return store.IdempotencyReserveResult{Result: &result}, nil
+		}
+	}
+	// Every claim attempt lost a race; the winner holds the key.
+	return store.IdempotencyReserveResult{Pending: true}, nil
+}
+
+func (s *IdempotencyStore) insertReservation(ctx context.Context, key string, now time.Time, ttl time.Duration) (store.IdempotencyReserveResult, bool, error) {
+	token, err := newReservationToken()
+	if err != nil {
+		return store.IdempotencyReserveResult{}, false, err
+	}
+	res, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+INSERT INTO sync_idempotency (idempotency_key, status, token, result_json, reserved_at_ns, expires_at_ns)
+VALUES (?, ?, ?, '', ?, ?)
+ON CONFLICT (idempotency_key) DO NOTHING`),
+		key, statusPending, token, now.UnixNano(), expiresAt(now, ttl),
+	)
+	return reservationOutcome(res, err, key, token)
+}
+
+// reclaimReservation takes over an expired row. The UPDATE is conditioned on
+// the row still carrying the observed token and expiry, so only one caller
+// can reclaim it.
+func (s *IdempotencyStore) reclaimReservation(ctx context.Context, key string, row idempotencyRow, now time.Time, ttl time.Duration) (store.IdempotencyReserveResult, bool, error) {
+	token, err := newReservationToken()
+	if err != nil {
+		return store.IdempotencyReserveResult{}, false, err
+	}
+	res, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+UPDATE sync_idempotency
+SET status = ?, token = ?, result_json = '', reserved_at_ns = ?, expires_at_ns = ?
+WHERE idempotency_key = ? AND status = ? AND token = ? AND expires_at_ns = ?`),
+		statusPending, token, now.UnixNano(), expiresAt(now, ttl),
+		key, row.status, row.token, row.expiresAtNS,
+	)
+	return reservationOutcome(res, err, key, token)
+}
+
+func reservationOutcome(res sql.Result, err error, key, token string) (store.IdempotencyReserveResult, bool, error) {
+	if err != nil {
+		return store.IdempotencyReserveResult{}, false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return store.IdempotencyReserveResult{}, false, err
+	}
+	if affected != 1 {
+		return store.IdempotencyReserveResult{}, false, nil
+	}
+	return store.IdempotencyReserveResult{
+		Reservation: &store.IdempotencyReservation{Key: key, Token: token},
+	}, true, nil
+}
+
+// Commit converts the caller's pending reservation into a replayable result.
+func (s *IdempotencyStore) Commit(ctx context.Context, reservation store.IdempotencyReservation, result core.MutationResult, ttl time.Duration) error {
+	resultJSON, err := encodeJSON(result)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+UPDATE sync_idempotency
+SET status = ?, token = '', result_json = ?, expires_at_ns = ?
+WHERE idempotency_key = ? AND status = ? AND token = ?`),
+		statusCommitted, resultJSON, expiresAt(s.cfg.clock(), ttl),
+		strings.TrimSpace(reservation.Key), statusPending, reservation.Token,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected != 1 {
+		return core.NewError(core.CodeTemporaryFailure, "idempotency reservation not found", nil)
+	}
+	return nil
+}
+
+// RecoverCommit stores a replay result after the mutation already applied but
+// Commit failed. It succeeds unless another caller now holds the key with a
+// different pending reservation.
+func (s *IdempotencyStore) RecoverCommit(ctx context.Context, reservation store.IdempotencyReservation, result core.MutationResult, ttl time.Duration) error {
+	resultJSON, err := encodeJSON(result)
+	if err != nil {
+		return err
+	}
+	now := s.cfg.clock()
+	res, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+INSERT INTO sync_idempotency (idempotency_key, status, token, result_json, reserved_at_ns, expires_at_ns)
+VALUES (?, ?, '', ?, ?, ?)
+ON CONFLICT (idempotency_key) DO UPDATE SET
+    status = excluded.status,
+    token = '',
+    result_json = excluded.result_json,
+    expires_at_ns = excluded.expires_at_ns
+WHERE sync_idempotency.status <> ? OR sync_idempotency.token = ?`),
+		strings.TrimSpace(reservation.Key), statusCommitted, resultJSON, now.UnixNano(), expiresAt(now, ttl),
+		statusPending, reservation.Token,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return core.NewError(core.CodeTemporaryFailure, "idempotency reservation not found", nil)
+	}
+	return nil
+}
+
+// Release abandons the caller's pending reservation. Releasing a key held by
+// another token, or already committed, is a no-op.
+func (s *IdempotencyStore) Release(ctx context.Context, reservation store.IdempotencyReservation) error {
+	_, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+DELETE FROM sync_idempotency
+WHERE idempotency_key = ? AND status = ? AND token = ?`),
+		strings.TrimSpace(reservation.Key), statusPending, reservation.Token,
+	)
+	return err
+}
+
+// SweepExpired deletes every reservation and result whose TTL has elapsed
+// and reports how many rows were removed.
+func (s *IdempotencyStore) SweepExpired(ctx context.Context) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+DELETE FROM sync_idempotency
+WHERE expires_at_ns > 0 AND expires_at_ns < ?`),
+		s.cfg.clock().UnixNano(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RunSweeper calls SweepExpired every interval until ctx is canceled.
+// Sweep failures are passed to onError when provided and do not stop the loop.
+func (s *IdempotencyStore) RunSweeper(ctx context.Context, interval time.Duration, onError func(error)) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.SweepExpired(ctx); err != nil && onError != nil && ctx.Err() == nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func (s *IdempotencyStore) load(ctx context.Context, key string) (idempotencyRow, bool, error) {
+	var row idempotencyRow
+	err := s.db.QueryRowContext(ctx, s.cfg.rebind(`
+SELECT status, token, result_json, expires_at_ns
+FROM sync_idempotency
+WHERE idempotency_key = ?`), key).Scan(&row.status, &row.token, &row.resultJSON, &row.expiresAtNS)
+	if errors.Is(err, sql.ErrNoRows) {
+		return idempotencyRow{}, false, nil
+	}
+	if err != nil {
+		return idempotencyRow{}, false, err
+	}
+	return row, true, nil
+}
+
+func (s *IdempotencyStore) deleteRow(ctx context.Context, key string, row idempotencyRow) error {
+	_, err := s.db.ExecContext(ctx, s.cfg.rebind(`
+DELETE FROM sync_idempotency
+WHERE idempotency_key = ? AND status = ? AND token = ? AND expires_at_ns = ?`),
+		key, row.status, row.token, row.expiresAtNS,
+	)
+	return err
+}
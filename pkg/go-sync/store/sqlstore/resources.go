@@ -0,0 +1,299 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+)
+
+// ResourceStore is a SQL-backed store.ResourceStore over the sync_resources
+// table. Prior payloads are kept in sync_resource_revisions so it also
+// satisfies store.RevisionStore.
+type ResourceStore struct {
+	db  *sql.DB
+	cfg config
+}
+
+var (
+	_ store.ResourceStore = (*ResourceStore)(nil)
+	_ store.RevisionStore = (*ResourceStore)(nil)
+)
+
+// NewResourceStore builds a SQL resource store.
+func NewResourceStore(db *sql.DB, opts ...Option) (*ResourceStore, error) {
+	cfg, err := newConfig(db, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceStore{db: db, cfg: cfg}, nil
+}
+
+// Seed inserts or replaces a snapshot, keeping its revision as given. Retained
+// revisions of the resource are discarded.
+func (s *ResourceStore) Seed(ctx context.Context, snapshot core.Snapshot) error {
+	scopeJSON, err := encodeJSON(snapshot.ResourceRef.Scope)
+	if err != nil {
+		return err
+	}
+	metadataJSON, err := encodeJSON(snapshot.Metadata)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.cfg.rebind(`
+INSERT INTO sync_resources (kind, resource_id, scope_key, scope_json, data, revision, metadata_json, updated_at_ns)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (kind, resource_id, scope_key) DO UPDATE SET
+    scope_json = excluded.scope_json,
+    data = excluded.data,
+    revision = excluded.revision,
+    metadata_json = excluded.metadata_json,
+    updated_at_ns = excluded.updated_at_ns`),
+		strings.TrimSpace(snapshot.ResourceRef.Kind),
+		strings.TrimSpace(snapshot.ResourceRef.ID),
+		scopeKey(snapshot.ResourceRef.Scope),
+		scopeJSON,
+		snapshot.Data,
+		snapshot.Revision,
+		metadataJSON,
+		snapshot.UpdatedAt.UnixNano(),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.cfg.rebind(`
+DELETE FROM sync_resource_revisions
+WHERE kind = ? AND resource_id = ? AND scope_key = ?`),
+		strings.TrimSpace(snapshot.ResourceRef.Kind),
+		strings.TrimSpace(snapshot.ResourceRef.ID),
+		scopeKey(snapshot.ResourceRef.Scope),
+	)
+	return err
+}
+
+// Get returns the authoritative snapshot for the requested resource.
+func (s *ResourceStore) Get(ctx context.Context, ref core.ResourceRef) (core.Snapshot, error) {
+	snapshot, ok, err := s.load(ctx, ref)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	if !ok {
+		return core.Snapshot{}, core.NewError(core.CodeNotFound, "resource not found", nil)
+	}
+	return snapshot, nil
+}
+
+// Mutate applies the mutation only when the stored revision still equals
+// ExpectedRevision. The UPDATE is conditioned on that revision, so two
+// writers that read the same snapshot cannot both apply. The replaced
+// snapshot is retained in the same transaction.
+func (s *ResourceStore) Mutate(ctx context.Context, input core.MutationInput) (core.Snapshot, error) {
+	current, ok, err := s.load(ctx, input.ResourceRef)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	if !ok {
+		return core.Snapshot{}, core.NewError(core.CodeNotFound, "resource not found", nil)
+	}
+	if input.ExpectedRevision != current.Revision {
+		return core.Snapshot{}, core.NewStaleRevisionError(current.Revision, &current)
+	}
+
+	next := current
+	if input.Payload != nil {
+		next.Data = append([]byte(nil), input.Payload...)
+	}
+	next.Revision = current.Revision + 1
+	next.UpdatedAt = s.cfg.clock()
+	if next.Metadata == nil {
+		next.Metadata = make(map[string]any)
+	}
+	next.Metadata["operation"] = strings.TrimSpace(input.Operation)
+	metadataJSON, err := encodeJSON(next.Metadata)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, s.cfg.rebind(`
+UPDATE sync_resources
+SET data = ?, revision = ?, metadata_json = ?, updated_at_ns = ?
+WHERE kind = ? AND resource_id = ? AND scope_key = ? AND revision = ?`),
+		next.Data,
+		next.Revision,
+		metadataJSON,
+		next.UpdatedAt.UnixNano(),
+		strings.TrimSpace(input.ResourceRef.Kind),
+		strings.TrimSpace(input.ResourceRef.ID),
+		scopeKey(input.ResourceRef.Scope),
+		current.Revision,
+	)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	if affected == 1 {
+		if err := s.retainRevision(ctx, tx, current); err != nil {
+			return core.Snapshot{}, err
+		}
+		if err := tx.Commit(); err != nil {
+			return core.Snapshot{}, err
+		}
+		return next, nil
+	}
+	if err := tx.Rollback(); err != nil {
+		return core.Snapshot{}, err
+	}
+
+	latest, ok, err := s.load(ctx, input.ResourceRef)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	if !ok {
+		return core.Snapshot{}, core.NewError(core.CodeNotFound, "resource not found", nil)
+	}
+	return core.Snapshot{}, core.NewStaleRevisionError(latest.Revision, &latest)
+}
+
+// GetRevision returns the current snapshot or a retained prior revision.
+// Revisions pruned by the retention limit return NOT_FOUND.
+func (s *ResourceStore) GetRevision(ctx context.Context, ref core.ResourceRef, revision int64) (core.Snapshot, error) {
+	current, err := s.Get(ctx, ref)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+	if current.Revision == revision {
+		return current, nil
+	}
+
+	var (
+		data         []byte
+		metadataJSON string
+		updatedAtNS  int64
+	)
+	err = s.db.QueryRowContext(ctx, s.cfg.rebind(`
+SELECT data, metadata_json, updated_at_ns
+FROM sync_resource_revisions
+WHERE kind = ? AND resource_id = ? AND scope_key = ? AND revision = ?`),
+		strings.TrimSpace(ref.Kind),
+		strings.TrimSpace(ref.ID),
+		scopeKey(ref.Scope),
+		revision,
+	).Scan(&data, &metadataJSON, &updatedAtNS)
+	if errors.Is(err, sql.ErrNoRows) {
+		return core.Snapshot{}, core.NewError(core.CodeNotFound, "resource revision not retained", map[string]any{
+			"revision": revision,
+		})
+	}
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+
+	snapshot := core.Snapshot{
+		ResourceRef: current.ResourceRef,
+		Data:        data,
+		Revision:    revision,
+		UpdatedAt:   time.Unix(0, updatedAtNS).UTC(),
+	}
+	if len(data) == 0 {
+		snapshot.Data = nil
+	}
+	if err := json.Unmarshal([]byte(metadataJSON), &snapshot.Metadata); err != nil {
+		return core.Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// retainRevision stores the replaced snapshot and prunes revisions beyond the
+// configured limit.
+func (s *ResourceStore) retainRevision(ctx context.Context, tx *sql.Tx, snapshot core.Snapshot) error {
+	if s.cfg.revisionLimit <= 0 {
+		return nil
+	}
+	metadataJSON, err := encodeJSON(snapshot.Metadata)
+	if err != nil {
+		return err
+	}
+	kind := strings.TrimSpace(snapshot.ResourceRef.Kind)
+	id := strings.TrimSpace(snapshot.ResourceRef.ID)
+	scope := scopeKey(snapshot.ResourceRef.Scope)
+	if _, err := tx.ExecContext(ctx, s.cfg.rebind(`
+INSERT INTO sync_resource_revisions (kind, resource_id, scope_key, revision, data, metadata_json, updated_at_ns)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (kind, resource_id, scope_key, revision) DO UPDATE SET
+    data = excluded.data,
+    metadata_json = excluded.metadata_json,
+    updated_at_ns = excluded.updated_at_ns`),
+		kind, id, scope,
+		snapshot.Revision,
+		snapshot.Data,
+		metadataJSON,
+		snapshot.UpdatedAt.UnixNano(),
+	); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, s.cfg.rebind(`
+DELETE FROM sync_resource_revisions
+WHERE kind = ? AND resource_id = ? AND scope_key = ? AND revision <= ?`),
+		kind, id, scope,
+		snapshot.Revision-int64(s.cfg.revisionLimit),
+	)
+	return err
+}
+
+func (s *ResourceStore) load(ctx context.Context, ref core.ResourceRef) (core.Snapshot, bool, error) {
+	var (
+		scopeJSON    string
+		data         []byte
+		revision     int64
+		metadataJSON string
+		updatedAtNS  int64
+	)
+	err := s.db.QueryRowContext(ctx, s.cfg.rebind(`
+SELECT scope_json, data, revision, metadata_json, updated_at_ns
+FROM sync_resources
+WHERE kind = ? AND resource_id = ? AND scope_key = ?`),
+		strings.TrimSpace(ref.Kind),
+		strings.TrimSpace(ref.ID),
+		scopeKey(ref.Scope),
+	).Scan(&scopeJSON, &data, &revision, &metadataJSON, &updatedAtNS)
+	if errors.Is(err, sql.ErrNoRows) {
+		return core.Snapshot{}, false, nil
+	}
+	if err != nil {
+		return core.Snapshot{}, false, err
+	}
+
+	snapshot := core.Snapshot{
+		ResourceRef: core.ResourceRef{
+			Kind: strings.TrimSpace(ref.Kind),
+			ID:   strings.TrimSpace(ref.ID),
+		},
+		Data:      data,
+		Revision:  revision,
+		UpdatedAt: time.Unix(0, updatedAtNS).UTC(),
+	}
+	if len(data) == 0 {
+		snapshot.Data = nil
+	}
+	if err := json.Unmarshal([]byte(scopeJSON), &snapshot.ResourceRef.Scope); err != nil {
+		return core.Snapshot{}, false, err
+	}
+	if err := json.Unmarshal([]byte(metadataJSON), &snapshot.Metadata); err != nil {
+		return core.Snapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
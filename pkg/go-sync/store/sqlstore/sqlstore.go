@@ -0,0 +1,180 @@
+// Package sqlstore implements go-sync store contracts on database/sql.
+//
+// ResourceStore enforces compare-and-swap with a conditional UPDATE on the
+// stored revision and retains recent prior payloads for GetRevision.
+// IdempotencyStore keeps pending reservations and committed results in one
+// row per replay key so concurrent Reserve calls cannot both win. Apply the
+// schema from data.SQLMigrationsFS (or Migrate) before use.
+package sqlstore
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	syncdata "github.com/goliatone/go-admin/pkg/go-sync/data"
+)
+
+// Dialect selects placeholder syntax and the migration directory.
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectPostgres Dialect = "postgres"
+)
+
+// Option customizes SQL store behavior.
+type Option func(*config)
+
+type config struct {
+	dialect       Dialect
+	now           func() time.Time
+	revisionLimit int
+}
+
+const defaultRevisionLimit = 32
+
+// WithDialect overrides the default SQLite dialect.
+func WithDialect(dialect Dialect) Option {
+	return func(c *config) {
+		if dialect != "" {
+			c.dialect = dialect
+		}
+	}
+}
+
+// WithNow overrides the clock used for updated_at and TTL bookkeeping.
+func WithNow(now func() time.Time) Option {
+	return func(c *config) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// WithRevisionLimit bounds how many prior revisions ResourceStore retains per
+// resource for GetRevision. Zero or less disables retention.
+func WithRevisionLimit(limit int) Option {
+	return func(c *config) {
+		c.revisionLimit = limit
+	}
+}
+
+func newConfig(db *sql.DB, opts []Option) (config, error) {
+	cfg := config{dialect: DialectSQLite, now: time.Now, revisionLimit: defaultRevisionLimit}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	if db == nil {
+		return cfg, core.NewError(core.CodeTemporaryFailure, "sql database is required", nil)
+	}
+	switch cfg.dialect {
+	case DialectSQLite, DialectPostgres:
+	default:
+		return cfg, core.NewError(core.CodeTemporaryFailure, "unsupported sql dialect", map[string]any{"dialect": string(cfg.dialect)})
+	}
+	return cfg, nil
+}
+
+func (c config) clock() time.Time {
+	return c.now().UTC()
+}
+
+// rebind rewrites `?` placeholders into the dialect's bind syntax.
+func (c config) rebind(query string) string {
+	if c.dialect != DialectPostgres {
+		return query
+	}
+	var out strings.Builder
+	out.Grow(len(query) + 8)
+	index := 0
+	for _, r := range query {
+		if r == '?' {
+			index++
+			out.WriteString("$" + strconv.Itoa(index))
+			continue
+		}
+		out.WriteRune(r)
+	}
+	return out.String()
+}
+
+// Migrate applies the embedded up migrations for dialect in order. The
+// statements are idempotent, so running Migrate on an existing schema is safe.
+// Hosts with their own migration runner should load data.SQLMigrationsFS instead.
+func Migrate(ctx context.Context, db *sql.DB, dialect Dialect) error {
+	if db == nil {
+		return core.NewError(core.CodeTemporaryFailure, "sql database is required", nil)
+	}
+	root := syncdata.SQLMigrationsFS()
+	names, err := fs.Glob(root, string(dialect)+"/*.up.sql")
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return core.NewError(core.CodeTemporaryFailure, "unsupported sql dialect", map[string]any{"dialect": string(dialect)})
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		content, err := fs.ReadFile(root, name)
+		if err != nil {
+			return err
+		}
+		for _, statement := range strings.Split(string(content), ";") {
+			if strings.TrimSpace(statement) == "" {
+				continue
+			}
+			if _, err := db.ExecContext(ctx, statement); err != nil {
+				return fmt.Errorf("apply %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func scopeKey(scope map[string]string) string {
+	parts := make([]string, 0, len(scope))
+	for key, value := range scope {
+		parts = append(parts, strings.TrimSpace(key)+"="+strings.TrimSpace(value))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func encodeJSON(value any) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func newReservationToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "reservation_" + hex.EncodeToString(buf), nil
+}
+
+func expiresAt(now time.Time, ttl time.Duration) int64 {
+	if ttl <= 0 {
+		return 0
+	}
+	return now.Add(ttl).UnixNano()
+}
+
+func temporaryFailure(message string, err error) error {
+	return core.NewWrappedError(core.CodeTemporaryFailure, message, nil, err)
+}
@@ -0,0 +1,98 @@
+package sqlstore_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+	"github.com/goliatone/go-admin/pkg/go-sync/store/sqlstore"
+	"github.com/goliatone/go-admin/pkg/go-sync/store/storetest"
+	"github.com/uptrace/bun/driver/sqliteshim"
+)
+
+func setupSyncSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "sync.db") + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	db, err := sql.Open(sqliteshim.ShimName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(4)
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("close sync database: %v", err)
+		}
+	})
+	if err := sqlstore.Migrate(context.Background(), db, sqlstore.DialectSQLite); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+func TestSQLResourceStoreConformance(t *testing.T) {
+	storetest.RunResourceStoreTests(t, func(t *testing.T, clock *storetest.Clock, seed ...core.Snapshot) store.ResourceStore {
+		resources, err := sqlstore.NewResourceStore(setupSyncSQLiteDB(t), sqlstore.WithNow(clock.Now))
+		if err != nil {
+			t.Fatalf("new resource store: %v", err)
+		}
+		for _, snapshot := range seed {
+			if err := resources.Seed(context.Background(), snapshot); err != nil {
+				t.Fatalf("seed: %v", err)
+			}
+		}
+		return resources
+	})
+}
+
+func TestSQLIdempotencyStoreConformance(t *testing.T) {
+	storetest.RunIdempotencyStoreTests(t, func(t *testing.T, clock *storetest.Clock) store.ReservingIdempotencyStore {
+		replay, err := sqlstore.NewIdempotencyStore(setupSyncSQLiteDB(t), sqlstore.WithNow(clock.Now))
+		if err != nil {
+			t.Fatalf("new idempotency store: %v", err)
+		}
+		return replay
+	})
+}
+
+func TestSQLIdempotencyStoreSweepsExpiredRows(t *testing.T) {
+	ctx := context.Background()
+	clock := storetest.NewClock(time.Date(2026, 3, 12, 12, 0, 0, 0, time.UTC))
+	replay, err := sqlstore.NewIdempotencyStore(setupSyncSQLiteDB(t), sqlstore.WithNow(clock.Now))
+	if err != nil {
+		t.Fatalf("new idempotency store: %v", err)
+	}
+	if _, err := replay.Reserve(ctx, "idem_short", time.Minute); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if err := replay.Put(ctx, "idem_long", core.MutationResult{Applied: true}, time.Hour); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := replay.Put(ctx, "idem_forever", core.MutationResult{Applied: true}, 0); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	clock.Advance(2 * time.Minute)
+	swept, err := replay.SweepExpired(ctx)
+	if err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	if swept != 1 {
+		t.Fatalf("expected one expired row swept, got %d", swept)
+	}
+	for _, key := range []string{"idem_long", "idem_forever"} {
+		if _, ok, err := replay.Get(ctx, key); err != nil || !ok {
+			t.Fatalf("expected %s to survive sweep, ok=%v err=%v", key, ok, err)
+		}
+	}
+}
+
+func TestNewResourceStoreRejectsUnknownDialect(t *testing.T) {
+	_, err := sqlstore.NewResourceStore(&sql.DB{}, sqlstore.WithDialect("oracle"))
+	if !core.HasCode(err, core.CodeTemporaryFailure) {
+		t.Fatalf("expected unsupported dialect error, got %v", err)
+	}
+}
@@ -0,0 +1,470 @@
+// Package storetest provides conformance suites that every go-sync store
+// implementation must pass.
+//
+// Implementations call RunResourceStoreTests and RunIdempotencyStoreTests
+// from their own tests with factories that build fresh, isolated stores.
+package storetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/go-sync/core"
+	"github.com/goliatone/go-admin/pkg/go-sync/store"
+)
+
+// Clock is a controllable time source shared by a store and its conformance test.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a clock frozen at now.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now.UTC()}
+}
+
+// Now returns the current clock time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// ResourceStoreFactory builds an empty resource store that reads time from
+// clock and is seeded with snapshots.
+type ResourceStoreFactory func(t *testing.T, clock *Clock, seed ...core.Snapshot) store.ResourceStore
+
+// IdempotencyStoreFactory builds an empty reserving idempotency store that
+// reads time from clock. Stores that also implement store.CommitRecoveryStore
+// run the recovery cases.
+type IdempotencyStoreFactory func(t *testing.T, clock *Clock) store.ReservingIdempotencyStore
+
+var conformanceEpoch = time.Date(2026, 3, 12, 12, 0, 0, 0, time.UTC)
+
+func conformanceSnapshot(id string, revision int64) core.Snapshot {
+	return core.Snapshot{
+		ResourceRef: core.ResourceRef{
+			Kind:  "conformance_resource",
+			ID:    id,
+			Scope: map[string]string{"tenant": "tenant_1"},
+		},
+		Data:      []byte(`{"title":"Seed"}`),
+		Revision:  revision,
+		UpdatedAt: conformanceEpoch.Add(-time.Hour),
+	}
+}
+
+// RunResourceStoreTests verifies read, compare-and-swap and scope semantics.
+func RunResourceStoreTests(t *testing.T, newStore ResourceStoreFactory) {
+	t.Helper()
+
+	t.Run("GetMissingReturnsNotFound", func(t *testing.T) {
+		resources := newStore(t, NewClock(conformanceEpoch))
+		_, err := resources.Get(context.Background(), conformanceSnapshot("missing", 1).ResourceRef)
+		if !core.HasCode(err, core.CodeNotFound) {
+			t.Fatalf("expected NOT_FOUND, got %v", err)
+		}
+	})
+
+	t.Run("GetReturnsSeededSnapshot", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_get", 7)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		got, err := resources.Get(context.Background(), seed.ResourceRef)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if got.Revision != 7 || string(got.Data) != string(seed.Data) {
+			t.Fatalf("expected seeded snapshot, got %+v", got)
+		}
+		if got.ResourceRef.Kind != seed.ResourceRef.Kind || got.ResourceRef.ID != seed.ResourceRef.ID || got.ResourceRef.Scope["tenant"] != "tenant_1" {
+			t.Fatalf("expected seeded resource ref, got %+v", got.ResourceRef)
+		}
+	})
+
+	t.Run("ScopeIsolatesResources", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_scope", 1)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		other := seed.ResourceRef
+		other.Scope = map[string]string{"tenant": "tenant_2"}
+		if _, err := resources.Get(context.Background(), other); !core.HasCode(err, core.CodeNotFound) {
+			t.Fatalf("expected other scope to be NOT_FOUND, got %v", err)
+		}
+	})
+
+	t.Run("MutateAdvancesRevision", func(t *testing.T) {
+		clock := NewClock(conformanceEpoch)
+		seed := conformanceSnapshot("draft_mutate", 3)
+		resources := newStore(t, clock, seed)
+		updated, err := resources.Mutate(context.Background(), core.MutationInput{
+			ResourceRef:      seed.ResourceRef,
+			Operation:        "autosave",
+			Payload:          []byte(`{"title":"After"}`),
+			ExpectedRevision: 3,
+		})
+		if err != nil {
+			t.Fatalf("mutate: %v", err)
+		}
+		if updated.Revision != 4 || string(updated.Data) != `{"title":"After"}` {
+			t.Fatalf("expected revision 4 with new payload, got %+v", updated)
+		}
+		if !updated.UpdatedAt.Equal(clock.Now()) {
+			t.Fatalf("expected updated_at %s, got %s", clock.Now(), updated.UpdatedAt)
+		}
+		if updated.Metadata["operation"] != "autosave" {
+			t.Fatalf("expected operation metadata, got %+v", updated.Metadata)
+		}
+		stored, err := resources.Get(context.Background(), seed.ResourceRef)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if stored.Revision != 4 || string(stored.Data) != `{"title":"After"}` {
+			t.Fatalf("expected mutation to persist, got %+v", stored)
+		}
+	})
+
+	t.Run("MutateWithoutPayloadKeepsData", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_touch", 1)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		updated, err := resources.Mutate(context.Background(), core.MutationInput{
+			ResourceRef:      seed.ResourceRef,
+			Operation:        "touch",
+			ExpectedRevision: 1,
+		})
+		if err != nil {
+			t.Fatalf("mutate: %v", err)
+		}
+		if updated.Revision != 2 || string(updated.Data) != string(seed.Data) {
+			t.Fatalf("expected data to be kept, got %+v", updated)
+		}
+	})
+
+	t.Run("MutateStaleRevisionReturnsLatest", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_stale", 5)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		_, err := resources.Mutate(context.Background(), core.MutationInput{
+			ResourceRef:      seed.ResourceRef,
+			Operation:        "autosave",
+			Payload:          []byte(`{"title":"Conflict"}`),
+			ExpectedRevision: 4,
+		})
+		if !core.HasCode(err, core.CodeStaleRevision) {
+			t.Fatalf("expected STALE_REVISION, got %v", err)
+		}
+		current, latest, ok := core.StaleRevisionDetails(err)
+		if !ok || current != 5 || latest == nil || latest.Revision != 5 || string(latest.Data) != string(seed.Data) {
+			t.Fatalf("expected stale details for revision 5, got current=%d latest=%+v", current, latest)
+		}
+	})
+
+	t.Run("MutateMissingReturnsNotFound", func(t *testing.T) {
+		resources := newStore(t, NewClock(conformanceEpoch))
+		_, err := resources.Mutate(context.Background(), core.MutationInput{
+			ResourceRef:      conformanceSnapshot("missing", 1).ResourceRef,
+			Operation:        "autosave",
+			ExpectedRevision: 1,
+		})
+		if !core.HasCode(err, core.CodeNotFound) {
+			t.Fatalf("expected NOT_FOUND, got %v", err)
+		}
+	})
+
+	t.Run("ConcurrentMutateAppliesOnce", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_race", 1)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		const writers = 8
+		var wg sync.WaitGroup
+		errs := make(chan error, writers)
+		for range writers {
+			wg.Go(func() {
+				_, err := resources.Mutate(context.Background(), core.MutationInput{
+					ResourceRef:      seed.ResourceRef,
+					Operation:        "autosave",
+					Payload:          []byte(`{"title":"Race"}`),
+					ExpectedRevision: 1,
+				})
+				errs <- err
+			})
+		}
+		wg.Wait()
+		close(errs)
+		applied := 0
+		for err := range errs {
+			switch {
+			case err == nil:
+				applied++
+			case !core.HasCode(err, core.CodeStaleRevision):
+				t.Fatalf("expected STALE_REVISION for losing writers, got %v", err)
+			}
+		}
+		if applied != 1 {
+			t.Fatalf("expected exactly one writer to apply, got %d", applied)
+		}
+		stored, err := resources.Get(context.Background(), seed.ResourceRef)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if stored.Revision != 2 {
+			t.Fatalf("expected revision 2 after race, got %d", stored.Revision)
+		}
+	})
+	t.Run("GetRevisionReturnsRetainedPayloads", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_history", 1)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		history, ok := resources.(store.RevisionStore)
+		if !ok {
+			t.Skip("store does not implement store.RevisionStore")
+		}
+		for revision, title := range []string{"Second", "Third"} {
+			if _, err := resources.Mutate(context.Background(), core.MutationInput{
+				ResourceRef:      seed.ResourceRef,
+				Operation:        "autosave",
+				Payload:          []byte(`{"title":"` + title + `"}`),
+				ExpectedRevision: int64(revision + 1),
+			}); err != nil {
+				t.Fatalf("mutate: %v", err)
+			}
+		}
+
+		base, err := history.GetRevision(context.Background(), seed.ResourceRef, 1)
+		if err != nil {
+			t.Fatalf("get revision 1: %v", err)
+		}
+		if base.Revision != 1 || string(base.Data) != string(seed.Data) {
+			t.Fatalf("expected seeded payload at revision 1, got %+v", base)
+		}
+		middle, err := history.GetRevision(context.Background(), seed.ResourceRef, 2)
+		if err != nil {
+			t.Fatalf("get revision 2: %v", err)
+		}
+		if string(middle.Data) != `{"title":"Second"}` {
+			t.Fatalf("expected revision 2 payload, got %s", middle.Data)
+		}
+		current, err := history.GetRevision(context.Background(), seed.ResourceRef, 3)
+		if err != nil {
+			t.Fatalf("get revision 3: %v", err)
+		}
+		if string(current.Data) != `{"title":"Third"}` {
+			t.Fatalf("expected current payload at revision 3, got %s", current.Data)
+		}
+		if _, err := history.GetRevision(context.Background(), seed.ResourceRef, 9); !core.HasCode(err, core.CodeNotFound) {
+			t.Fatalf("expected NOT_FOUND for unknown revision, got %v", err)
+		}
+	})
+
+	t.Run("GetRevisionIgnoresStaleWrites", func(t *testing.T) {
+		seed := conformanceSnapshot("draft_history_stale", 2)
+		resources := newStore(t, NewClock(conformanceEpoch), seed)
+		history, ok := resources.(store.RevisionStore)
+		if !ok {
+			t.Skip("store does not implement store.RevisionStore")
+		}
+		if _, err := resources.Mutate(context.Background(), core.MutationInput{
+			ResourceRef:      seed.ResourceRef,
+			Operation:        "autosave",
+			Payload:          []byte(`{"title":"Stale"}`),
+			ExpectedRevision: 1,
+		}); !core.HasCode(err, core.CodeStaleRevision) {
+			t.Fatalf("expected STALE_REVISION, got %v", err)
+		}
+		if _, err := history.GetRevision(context.Background(), seed.ResourceRef, 1); !core.HasCode(err, core.CodeNotFound) {
+			t.Fatalf("expected rejected write not to create history, got %v", err)
+		}
+	})
+}
+
+// RunIdempotencyStoreTests verifies reservation, replay, release, expiry
+// and commit-recovery semantics.
+func RunIdempotencyStoreTests(t *testing.T, newStore IdempotencyStoreFactory) {
+	t.Helper()
+	ctx := context.Background()
+	result := core.MutationResult{
+		Snapshot: core.Snapshot{
+			ResourceRef: core.ResourceRef{Kind: "conformance_resource", ID: "draft_send"},
+			Data:        []byte(`{"status":"sent"}`),
+			Revision:    9,
+			UpdatedAt:   conformanceEpoch,
+			Metadata:    map[string]any{"idempotency_key": "idem_send"},
+		},
+		Applied: true,
+	}
+
+	t.Run("ReserveCommitReplay", func(t *testing.T) {
+		replay := newStore(t, NewClock(conformanceEpoch))
+		first := mustReserve(t, replay, "idem_send", time.Minute)
+		if first.Reservation == nil || first.Pending || first.Result != nil {
+			t.Fatalf("expected fresh reservation, got %+v", first)
+		}
+		if first.Reservation.Key != "idem_send" || first.Reservation.Token == "" {
+			t.Fatalf("expected keyed reservation with token, got %+v", first.Reservation)
+		}
+		if pending := mustReserve(t, replay, "idem_send", time.Minute); !pending.Pending || pending.Reservation != nil || pending.Result != nil {
+			t.Fatalf("expected pending while in flight, got %+v", pending)
+		}
+		if err := replay.Commit(ctx, *first.Reservation, result, time.Minute); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+		replayed := mustReserve(t, replay, "idem_send", time.Minute)
+		if replayed.Result == nil || replayed.Reservation != nil || replayed.Pending {
+			t.Fatalf("expected committed replay, got %+v", replayed)
+		}
+		if replayed.Result.Snapshot.Revision != 9 || !replayed.Result.Applied || string(replayed.Result.Snapshot.Data) != `{"status":"sent"}` {
+			t.Fatalf("expected stored mutation result, got %+v", replayed.Result)
+		}
+		if replayed.Result.Snapshot.Metadata["idempotency_key"] != "idem_send" {
+			t.Fatalf("expected replay metadata, got %+v", replayed.Result.Snapshot.Metadata)
+		}
+	})
+
+	t.Run("CommitRequiresMatchingToken", func(t *testing.T) {
+		replay := newStore(t, NewClock(conformanceEpoch))
+		err := replay.Commit(ctx, store.IdempotencyReservation{Key: "idem_missing", Token: "missing"}, result, time.Minute)
+		if !core.HasCode(err, core.CodeTemporaryFailure) {
+			t.Fatalf("expected TEMPORARY_FAILURE for unknown reservation, got %v", err)
+		}
+		first := mustReserve(t, replay, "idem_token", time.Minute)
+		forged := store.IdempotencyReservation{Key: "idem_token", Token: first.Reservation.Token + "_forged"}
+		if err := replay.Commit(ctx, forged, result, time.Minute); !core.HasCode(err, core.CodeTemporaryFailure) {
+			t.Fatalf("expected TEMPORARY_FAILURE for foreign token, got %v", err)
+		}
+		if pending := mustReserve(t, replay, "idem_token", time.Minute); !pending.Pending {
+			t.Fatalf("expected reservation to stay pending after rejected commit, got %+v", pending)
+		}
+	})
+
+	t.Run("ReleaseFreesKeyForOwnerOnly", func(t *testing.T) {
+		replay := newStore(t, NewClock(conformanceEpoch))
+		first := mustReserve(t, replay, "idem_release", time.Minute)
+		foreign := store.IdempotencyReservation{Key: "idem_release", Token: first.Reservation.Token + "_foreign"}
+		if err := replay.Release(ctx, foreign); err != nil {
+			t.Fatalf("release foreign: %v", err)
+		}
+		if pending := mustReserve(t, replay, "idem_release", time.Minute); !pending.Pending {
+			t.Fatalf("expected foreign release to be ignored, got %+v", pending)
+		}
+		if err := replay.Release(ctx, *first.Reservation); err != nil {
+			t.Fatalf("release: %v", err)
+		}
+		again := mustReserve(t, replay, "idem_release", time.Minute)
+		if again.Reservation == nil {
+			t.Fatalf("expected key to be reservable after release, got %+v", again)
+		}
+		if err := replay.Commit(ctx, *first.Reservation, result, time.Minute); !core.HasCode(err, core.CodeTemporaryFailure) {
+			t.Fatalf("expected released token to be unusable, got %v", err)
+		}
+	})
+
+	t.Run("CommittedResultExpiresAfterTTL", func(t *testing.T) {
+		clock := NewClock(conformanceEpoch)
+		replay := newStore(t, clock)
+		first := mustReserve(t, replay, "idem_expiry", time.Minute)
+		if err := replay.Commit(ctx, *first.Reservation, result, 5*time.Minute); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+		clock.Advance(4 * time.Minute)
+		if replayed := mustReserve(t, replay, "idem_expiry", time.Minute); replayed.Result == nil {
+			t.Fatalf("expected replay inside ttl, got %+v", replayed)
+		}
+		clock.Advance(2 * time.Minute)
+		if fresh := mustReserve(t, replay, "idem_expiry", time.Minute); fresh.Reservation == nil {
+			t.Fatalf("expected new reservation after ttl, got %+v", fresh)
+		}
+	})
+
+	t.Run("ExpiredPendingReservationIsReclaimed", func(t *testing.T) {
+		clock := NewClock(conformanceEpoch)
+		replay := newStore(t, clock)
+		abandoned := mustReserve(t, replay, "idem_abandoned", time.Minute)
+		clock.Advance(2 * time.Minute)
+		reclaimed := mustReserve(t, replay, "idem_abandoned", time.Minute)
+		if reclaimed.Reservation == nil {
+			t.Fatalf("expected expired reservation to be reclaimed, got %+v", reclaimed)
+		}
+		if reclaimed.Reservation.Token == abandoned.Reservation.Token {
+			t.Fatal("expected reclaimed reservation to issue a new token")
+		}
+		if err := replay.Commit(ctx, *abandoned.Reservation, result, time.Minute); !core.HasCode(err, core.CodeTemporaryFailure) {
+			t.Fatalf("expected abandoned token to be rejected, got %v", err)
+		}
+		if err := replay.Commit(ctx, *reclaimed.Reservation, result, time.Minute); err != nil {
+			t.Fatalf("commit reclaimed: %v", err)
+		}
+	})
+
+	t.Run("ConcurrentReserveGrantsOneReservation", func(t *testing.T) {
+		replay := newStore(t, NewClock(conformanceEpoch))
+		const callers = 8
+		var wg sync.WaitGroup
+		outcomes := make(chan store.IdempotencyReserveResult, callers)
+		for range callers {
+			wg.Go(func() {
+				outcome, err := replay.Reserve(ctx, "idem_race", time.Minute)
+				if err != nil {
+					t.Errorf("reserve: %v", err)
+					return
+				}
+				outcomes <- outcome
+			})
+		}
+		wg.Wait()
+		close(outcomes)
+		granted := 0
+		for outcome := range outcomes {
+			switch {
+			case outcome.Reservation != nil:
+				granted++
+			case !outcome.Pending:
+				t.Fatalf("expected losers to observe pending, got %+v", outcome)
+			}
+		}
+		if granted != 1 {
+			t.Fatalf("expected exactly one reservation, got %d", granted)
+		}
+	})
+
+	t.Run("RecoverCommit", func(t *testing.T) {
+		replay := newStore(t, NewClock(conformanceEpoch))
+		recovering, ok := replay.(store.CommitRecoveryStore)
+		if !ok {
+			t.Skip("store does not implement CommitRecoveryStore")
+		}
+		first := mustReserve(t, replay, "idem_recover", time.Minute)
+		if err := recovering.RecoverCommit(ctx, *first.Reservation, result, time.Minute); err != nil {
+			t.Fatalf("recover commit: %v", err)
+		}
+		if replayed := mustReserve(t, replay, "idem_recover", time.Minute); replayed.Result == nil || replayed.Result.Snapshot.Revision != 9 {
+			t.Fatalf("expected recovered replay, got %+v", replayed)
+		}
+
+		if err := recovering.RecoverCommit(ctx, store.IdempotencyReservation{Key: "idem_recover_lost", Token: "lost"}, result, time.Minute); err != nil {
+			t.Fatalf("recover commit for lost reservation: %v", err)
+		}
+		if replayed := mustReserve(t, replay, "idem_recover_lost", time.Minute); replayed.Result == nil {
+			t.Fatalf("expected recovery to store result without a live reservation, got %+v", replayed)
+		}
+
+		owner := mustReserve(t, replay, "idem_recover_owned", time.Minute)
+		foreign := store.IdempotencyReservation{Key: "idem_recover_owned", Token: owner.Reservation.Token + "_foreign"}
+		if err := recovering.RecoverCommit(ctx, foreign, result, time.Minute); !core.HasCode(err, core.CodeTemporaryFailure) {
+			t.Fatalf("expected recovery over another pending reservation to fail, got %v", err)
+		}
+	})
+}
+
+func mustReserve(t *testing.T, replay store.ReservingIdempotencyStore, key string, ttl time.Duration) store.IdempotencyReserveResult {
+	t.Helper()
+	outcome, err := replay.Reserve(context.Background(), key, ttl)
+	if err != nil {
+		t.Fatalf("reserve %s: %v", key, err)
+	}
+	return outcome
+}
@@ -24,6 +24,7 @@ type MutationResponse struct {
 	UpdatedAt string          `json:"updated_at"`
 	Applied   bool            `json:"applied"`
 	Replay    bool            `json:"replay"`
+	Merged    bool            `json:"merged,omitempty"`
 	Metadata  map[string]any  `json:"metadata,omitempty"`
 }
 
@@ -41,8 +42,9 @@ type ErrorPayload struct {
 
 // StaleRevisionDetails provides the latest revision and optional snapshot payload.
 type StaleRevisionDetails struct {
-	CurrentRevision int64         `json:"current_revision"`
-	Resource        *ReadResponse `json:"resource,omitempty"`
+	CurrentRevision int64                `json:"current_revision"`
+	Resource        *ReadResponse        `json:"resource,omitempty"`
+	Conflicts       []core.MergeConflict `json:"conflicts,omitempty"`
 }
 
 // IdempotencyReplayDetails captures the replay metadata for repeated actions.
@@ -69,6 +71,7 @@ func MutationResponseFromResult(result core.MutationResult) MutationResponse {
 		UpdatedAt: result.Snapshot.UpdatedAt.UTC().Format(time.RFC3339),
 		Applied:   result.Applied,
 		Replay:    result.Replay,
+		Merged:    result.Merged,
 		Metadata:  envelopeMetadata(result.Snapshot),
 	}
 	return response
@@ -99,6 +102,7 @@ func ErrorEnvelopeFromError(err error) ErrorEnvelope {
 		currentRevision, latest, _ := core.StaleRevisionDetails(err)
 		details := StaleRevisionDetails{
 			CurrentRevision: currentRevision,
+			Conflicts:       core.MergeConflictsOf(err),
 		}
 		if latest != nil {
 			resource := ReadResponseFromSnapshot(*latest)
@@ -115,6 +115,27 @@ func TestErrorEnvelopeFromErrorIncludesCanonicalStaleRevisionPayload(t *testing.
 	}
 }
 
+func TestErrorEnvelopeFromErrorIncludesMergeConflicts(t *testing.T) {
+	err := core.NewMergeConflictError(13, nil, []core.MergeConflict{{
+		Path:   "/title",
+		Base:   json.RawMessage(`"Draft"`),
+		Local:  json.RawMessage(`"Mine"`),
+		Remote: json.RawMessage(`"Theirs"`),
+	}})
+	rawDetails, marshalErr := json.Marshal(ErrorEnvelopeFromError(err).Error.Details)
+	if marshalErr != nil {
+		t.Fatalf("marshal stale revision details: %v", marshalErr)
+	}
+
+	var details StaleRevisionDetails
+	if err := json.Unmarshal(rawDetails, &details); err != nil {
+		t.Fatalf("unmarshal stale revision details: %v", err)
+	}
+	if len(details.Conflicts) != 1 || details.Conflicts[0].Path != "/title" || string(details.Conflicts[0].Remote) != `"Theirs"` {
+		t.Fatalf("expected /title conflict in details, got %+v", details.Conflicts)
+	}
+}
+
 func TestErrorEnvelopeFromErrorOmitsLatestSnapshotWhenUnavailable(t *testing.T) {
 	envelope := ErrorEnvelopeFromError(core.NewStaleRevisionError(13, nil))
 	rawDetails, err := json.Marshal(envelope.Error.Details)
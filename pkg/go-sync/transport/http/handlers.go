@@ -1,12 +1,16 @@
 package httptransport
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"maps"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/goliatone/go-admin/pkg/go-sync/core"
 )
@@ -16,7 +20,12 @@ const (
 	ResourceRoutePattern = "/sync/resources/{kind}/{id}"
 	// ResourceActionRoutePattern is the canonical v1 HTTP route for idempotent actions.
 	ResourceActionRoutePattern = "/sync/resources/{kind}/{id}/actions/{operation}"
-	defaultMaxBodyBytes        = 1 << 20
+	// ResourceEventsRoutePattern is the canonical v1 HTTP route for server-sent snapshot events.
+	ResourceEventsRoutePattern = "/sync/resources/{kind}/{id}/events"
+	// SnapshotEventName is the SSE event name used for snapshot revisions.
+	SnapshotEventName     = "snapshot"
+	defaultMaxBodyBytes   = 1 << 20
+	defaultWatchHeartbeat = 15 * time.Second
 )
 
 // RequestIdentity contains trusted request-derived sync metadata.
@@ -51,11 +60,22 @@ func WithMaxBodyBytes(limit int64) HandlerOption {
 	}
 }
 
+// WithWatchHeartbeat overrides how often idle event streams send an SSE comment
+// to keep intermediaries from closing the connection.
+func WithWatchHeartbeat(interval time.Duration) HandlerOption {
+	return func(handler *Handler) {
+		if interval > 0 {
+			handler.watchHeartbeat = interval
+		}
+	}
+}
+
 // Handler maps the canonical sync service onto HTTP handlers without owning route registration.
 type Handler struct {
 	service         core.SyncService
 	resolveIdentity RequestIdentityResolver
 	maxBodyBytes    int64
+	watchHeartbeat  time.Duration
 }
 
 // MutationRequestBody is the canonical HTTP request envelope for PATCH and POST action mutations.
@@ -83,6 +103,7 @@ func NewHandler(service core.SyncService, opts ...HandlerOption) (*Handler, erro
 		service:         service,
 		resolveIdentity: resolveAnonymousIdentity,
 		maxBodyBytes:    defaultMaxBodyBytes,
+		watchHeartbeat:  defaultWatchHeartbeat,
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -206,6 +227,92 @@ func (handler *Handler) handleMutation(w http.ResponseWriter, r *http.Request, e
 	writeJSON(w, http.StatusOK, MutationResponseFromResult(result))
 }
 
+// HandleWatch maps `GET /sync/resources/{kind}/{id}/events` onto
+// core.WatchService as a server-sent event stream. Each `snapshot` event
+// carries a ReadResponse and uses the revision as its event id, so a
+// reconnecting client that sends Last-Event-ID only receives newer revisions.
+// Services that do not implement core.WatchService answer TRANSPORT_UNAVAILABLE.
+func (handler *Handler) HandleWatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, core.NewError(core.CodeInvalidMutation, "method not allowed", map[string]any{
+			"method": r.Method,
+		}))
+		return
+	}
+
+	params, err := parseRouteParams(r, false)
+	if err != nil {
+		writeMappedError(w, err)
+		return
+	}
+
+	identity, err := handler.resolveIdentity(r)
+	if err != nil {
+		writeMappedError(w, resolveIdentityError(err))
+		return
+	}
+
+	lastRevision, err := parseLastEventID(r)
+	if err != nil {
+		writeMappedError(w, err)
+		return
+	}
+
+	watcher, ok := handler.service.(core.WatchService)
+	if !ok {
+		writeMappedError(w, core.NewError(core.CodeTransportUnavailable, "sync service does not support watch", nil))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	snapshots, err := watcher.Watch(ctx, core.ResourceRef{
+		Kind:  params.Kind,
+		ID:    params.ID,
+		Scope: cloneScope(identity.Scope),
+	})
+	if err != nil {
+		writeMappedError(w, err)
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(handler.watchHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case snapshot, ok := <-snapshots:
+			if !ok {
+				return
+			}
+			if snapshot.Revision <= lastRevision {
+				continue
+			}
+			if err := writeSnapshotEvent(w, snapshot); err != nil {
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
+
 // StatusCodeForError maps canonical sync errors onto stable HTTP statuses.
 func StatusCodeForError(err error) int {
 	switch code, ok := core.ErrorCodeOf(err); {
@@ -254,6 +361,31 @@ func parseRouteParams(r *http.Request, expectsOperation bool) (routeParams, erro
 	return params, nil
 }
 
+// parseLastEventID reads the revision a reconnecting event-stream client already
+// has. A missing header yields -1 so every revision is sent.
+func parseLastEventID(r *http.Request) (int64, error) {
+	raw := strings.TrimSpace(r.Header.Get("Last-Event-ID"))
+	if raw == "" {
+		return -1, nil
+	}
+	revision, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, core.NewError(core.CodeInvalidMutation, "last event id must be a non-negative revision", map[string]any{
+			"field": "last_event_id",
+		})
+	}
+	return revision, nil
+}
+
+func writeSnapshotEvent(w io.Writer, snapshot core.Snapshot) error {
+	payload, err := json.Marshal(ReadResponseFromSnapshot(snapshot))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", snapshot.Revision, SnapshotEventName, payload)
+	return err
+}
+
 func decodeMutationRequestBody(w http.ResponseWriter, r *http.Request, maxBodyBytes int64) (MutationRequestBody, error) {
 	if r.Body == nil {
 		return MutationRequestBody{}, core.NewError(core.CodeInvalidMutation, "request body is required", nil)
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -276,9 +278,121 @@ func TestHandleActionMapsTemporaryFailureEnvelope(t *testing.T) {
 	}
 }
 
+func TestHandleWatchStreamsSnapshotEventsAfterLastEventID(t *testing.T) {
+	handler := mustNewHandler(t, syncServiceStub{
+		watch: func(_ context.Context, ref core.ResourceRef) (<-chan core.Snapshot, error) {
+			if ref.Scope["tenant"] != "tenant_1" {
+				t.Fatalf("expected server-derived scope, got %+v", ref.Scope)
+			}
+			snapshots := make(chan core.Snapshot, 3)
+			for revision := int64(12); revision <= 14; revision++ {
+				snapshots <- core.Snapshot{
+					ResourceRef: ref,
+					Data:        []byte(`{"id":"article_draft_123"}`),
+					Revision:    revision,
+					UpdatedAt:   time.Date(2026, time.March, 12, 18, 0, 0, 0, time.UTC),
+				}
+			}
+			close(snapshots)
+			return snapshots, nil
+		},
+	}, WithRequestIdentityResolver(func(*http.Request) (RequestIdentity, error) {
+		return RequestIdentity{Scope: map[string]string{"tenant": "tenant_1"}}, nil
+	}))
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/sync/resources/article_draft/article_draft_123/events", nil)
+	req.SetPathValue("kind", "article_draft")
+	req.SetPathValue("id", "article_draft_123")
+	req.Header.Set("Last-Event-ID", "12")
+
+	res := httptest.NewRecorder()
+	handler.HandleWatch(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d body=%s", res.Code, res.Body.String())
+	}
+	if got := res.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Fatalf("expected event stream content type, got %q", got)
+	}
+	if !res.Flushed {
+		t.Fatal("expected event stream to be flushed")
+	}
+
+	events := strings.Split(strings.TrimSpace(res.Body.String()), "\n\n")
+	if len(events) != 2 {
+		t.Fatalf("expected revisions after Last-Event-ID only, got %q", res.Body.String())
+	}
+	for i, revision := range []int64{13, 14} {
+		lines := strings.Split(events[i], "\n")
+		if len(lines) != 3 || lines[0] != fmt.Sprintf("id: %d", revision) || lines[1] != "event: snapshot" {
+			t.Fatalf("unexpected event %d: %q", i, events[i])
+		}
+		var envelope ReadResponse
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &envelope); err != nil {
+			t.Fatalf("unmarshal event data: %v", err)
+		}
+		if envelope.Revision != revision {
+			t.Fatalf("expected revision %d, got %d", revision, envelope.Revision)
+		}
+	}
+}
+
+func TestHandleWatchMapsSubscribeErrorsBeforeStreaming(t *testing.T) {
+	handler := mustNewHandler(t, syncServiceStub{})
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/sync/resources/article_draft/missing/events", nil)
+	req.SetPathValue("kind", "article_draft")
+	req.SetPathValue("id", "missing")
+
+	res := httptest.NewRecorder()
+	handler.HandleWatch(res, req)
+
+	if res.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d body=%s", res.Code, res.Body.String())
+	}
+	if got := res.Header().Get("Content-Type"); got != "application/json" {
+		t.Fatalf("expected JSON error envelope, got %q", got)
+	}
+}
+
+func TestHandleWatchRejectsInvalidLastEventID(t *testing.T) {
+	handler := mustNewHandler(t, syncServiceStub{})
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/sync/resources/article_draft/article_draft_123/events", nil)
+	req.SetPathValue("kind", "article_draft")
+	req.SetPathValue("id", "article_draft_123")
+	req.Header.Set("Last-Event-ID", "latest")
+
+	res := httptest.NewRecorder()
+	handler.HandleWatch(res, req)
+
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d body=%s", res.Code, res.Body.String())
+	}
+}
+
+func TestHandleWatchReportsUnavailableWithoutWatchService(t *testing.T) {
+	handler := mustNewHandler(t, struct{ core.SyncService }{syncServiceStub{}})
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/sync/resources/article_draft/article_draft_123/events", nil)
+	req.SetPathValue("kind", "article_draft")
+	req.SetPathValue("id", "article_draft_123")
+
+	res := httptest.NewRecorder()
+	handler.HandleWatch(res, req)
+
+	if res.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d body=%s", res.Code, res.Body.String())
+	}
+	if !strings.Contains(res.Body.String(), string(core.CodeTransportUnavailable)) {
+		t.Fatalf("expected TRANSPORT_UNAVAILABLE envelope, got %s", res.Body.String())
+	}
+}
+
 type syncServiceStub struct {
 	get    func(context.Context, core.ResourceRef) (core.Snapshot, error)
 	mutate func(context.Context, core.MutationInput) (core.MutationResult, error)
+	watch  func(context.Context, core.ResourceRef) (<-chan core.Snapshot, error)
 }
 
 func (stub syncServiceStub) Get(ctx context.Context, ref core.ResourceRef) (core.Snapshot, error) {
@@ -295,6 +409,13 @@ func (stub syncServiceStub) Mutate(ctx context.Context, input core.MutationInput
 	return stub.mutate(ctx, input)
 }
 
+func (stub syncServiceStub) Watch(ctx context.Context, ref core.ResourceRef) (<-chan core.Snapshot, error) {
+	if stub.watch == nil {
+		return nil, core.NewError(core.CodeNotFound, "resource not found", nil)
+	}
+	return stub.watch(ctx, ref)
+}
+
 func mustNewHandler(t *testing.T, service core.SyncService, opts ...HandlerOption) *Handler {
 	t.Helper()
 	handler, err := NewHandler(service, opts...)
@@ -88,6 +88,9 @@ func (p *AnthropicProvider) GenerateTranslation(ctx context.Context, req Provide
 		return ProviderResponse{}, errors.New("anthropic model is required")
 	}
 	maxTokens := p.config.MaxTokens
+	if req.MaxTokens > 0 {
+		maxTokens = req.MaxTokens
+	}
 	if maxTokens <= 0 {
 		maxTokens = 1024
 	}
@@ -0,0 +1,459 @@
+package translationai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Batch output limits used when BatchConfig.MaxTokens is unset. The estimate
+// allows roughly two tokens per source character for the translated JSON
+// plus a per-field allowance for keys and quoting.
+const (
+	minBatchMaxTokens      = 1024
+	maxBatchMaxTokens      = 8192
+	batchTokensPerChar     = 2
+	batchTokensPerFieldKey = 32
+)
+
+var (
+	// ErrNoBatchProviders is returned when a batch translator has no providers.
+	ErrNoBatchProviders = errors.New("translationai: no batch providers configured")
+	// ErrBudgetExceeded is returned when a tenant has spent its monthly budget.
+	ErrBudgetExceeded = errors.New("translationai: monthly translation budget exceeded")
+)
+
+// BatchField is one field of a translation variant.
+type BatchField struct {
+	Path string `json:"path"`
+	Text string `json:"text"`
+}
+
+// BatchRequest asks for every field of a variant to be translated in one
+// structured provider call.
+type BatchRequest struct {
+	TenantID      string         `json:"tenant_id,omitempty"`
+	FamilyID      string         `json:"family_id,omitempty"`
+	EntityType    string         `json:"entity_type,omitempty"`
+	SourceLocale  string         `json:"source_locale"`
+	TargetLocale  string         `json:"target_locale"`
+	Fields        []BatchField   `json:"fields"`
+	AssistContext map[string]any `json:"assist_context,omitempty"`
+	CorrelationID string         `json:"correlation_id,omitempty"`
+}
+
+// BatchResult holds translated text keyed by field path.
+type BatchResult struct {
+	Fields       map[string]string `json:"fields"`
+	CachedFields []string          `json:"cached_fields,omitempty"`
+	Attempts     []BatchAttempt    `json:"attempts,omitempty"`
+	Usage        Usage             `json:"usage"`
+	CostUSD      float64           `json:"cost_usd"`
+}
+
+// BatchAttempt records one provider call made while serving a batch.
+type BatchAttempt struct {
+	Provider string  `json:"provider"`
+	Model    string  `json:"model,omitempty"`
+	Fields   int     `json:"fields"`
+	Resolved int     `json:"resolved"`
+	Usage    Usage   `json:"usage"`
+	CostUSD  float64 `json:"cost_usd"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// BatchIncompleteError reports fields no provider managed to translate. The
+// accompanying BatchResult still carries every field that did succeed.
+type BatchIncompleteError struct {
+	Missing []string
+}
+
+func (e *BatchIncompleteError) Error() string {
+	return fmt.Sprintf("translationai: batch translation incomplete; missing fields: %s", strings.Join(e.Missing, ", "))
+}
+
+// BatchProvider is one entry in the ordered provider fallback chain. Model must
+// be set for cached translations to be found on later requests.
+type BatchProvider struct {
+	Name     string
+	Provider Provider
+	Model    string
+	Pricing  ModelPricing
+}
+
+// BatchPromptInput contains the fields to translate in one provider call.
+type BatchPromptInput struct {
+	EntityType    string
+	SourceLocale  string
+	TargetLocale  string
+	Fields        []BatchField
+	AssistContext map[string]any
+	CorrelationID string
+}
+
+// BatchPromptBuilder builds the structured provider request for a batch.
+type BatchPromptBuilder interface {
+	BuildBatchPrompt(BatchPromptInput, PromptConfig) (ProviderRequest, error)
+}
+
+// BatchConfig configures a BatchTranslator.
+type BatchConfig struct {
+	Providers     []BatchProvider
+	Cache         BatchCache
+	Ledger        UsageLedger
+	Budgets       BudgetPolicy
+	PromptBuilder BatchPromptBuilder
+	PromptConfig  PromptConfig
+	// MaxTokens is passed to providers for each batch call when positive.
+	// Otherwise the limit is estimated from the pending fields, between 1024
+	// and 8192 tokens, so provider defaults do not truncate the JSON reply.
+	MaxTokens int
+	Now       func() time.Time
+}
+
+// BatchTranslator translates whole variants with provider fallback, per-field
+// caching and per-tenant usage accounting.
+type BatchTranslator struct {
+	providers []BatchProvider
+	cache     BatchCache
+	ledger    UsageLedger
+	budgets   BudgetPolicy
+	builder   BatchPromptBuilder
+	prompt    PromptConfig
+	maxTokens int
+	now       func() time.Time
+}
+
+func NewBatchTranslator(cfg BatchConfig) *BatchTranslator {
+	providers := make([]BatchProvider, 0, len(cfg.Providers))
+	for _, entry := range cfg.Providers {
+		if entry.Provider == nil {
+			continue
+		}
+		entry.Name = strings.TrimSpace(entry.Name)
+		entry.Model = strings.TrimSpace(entry.Model)
+		providers = append(providers, entry)
+	}
+	builder := cfg.PromptBuilder
+	if builder == nil {
+		builder = DefaultBatchPromptBuilder{}
+	}
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+	return &BatchTranslator{
+		providers: providers,
+		cache:     cfg.Cache,
+		ledger:    cfg.Ledger,
+		budgets:   cfg.Budgets,
+		builder:   builder,
+		prompt:    cfg.PromptConfig,
+		maxTokens: max(cfg.MaxTokens, 0),
+		now:       now,
+	}
+}
+
+// TranslateBatch translates every field in req. Cached fields are served
+// without a provider call; the remaining fields go to each provider in order
+// until all are resolved. The tenant budget is checked before every provider
+// call, including fallbacks. When fields remain after the last provider the
+// partial result is returned with a *BatchIncompleteError.
+func (t *BatchTranslator) TranslateBatch(ctx context.Context, req BatchRequest) (BatchResult, error) {
+	result := BatchResult{Fields: map[string]string{}}
+	if t == nil || len(t.providers) == 0 {
+		return result, ErrNoBatchProviders
+	}
+	req.TenantID = strings.TrimSpace(req.TenantID)
+	req.SourceLocale = strings.TrimSpace(req.SourceLocale)
+	req.TargetLocale = strings.TrimSpace(req.TargetLocale)
+	if req.SourceLocale == "" || req.TargetLocale == "" {
+		return result, errors.New("translationai: source and target locales are required")
+	}
+	pending := normalizeBatchFields(req.Fields)
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	pending = t.resolveFromCache(ctx, req, pending, &result)
+	if len(pending) == 0 {
+		return result, nil
+	}
+	for _, entry := range t.providers {
+		if len(pending) == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if err := t.checkBudget(ctx, req.TenantID); err != nil {
+			return result, err
+		}
+		pending = t.attempt(ctx, req, entry, pending, &result)
+	}
+	if len(pending) > 0 {
+		missing := make([]string, 0, len(pending))
+		for _, field := range pending {
+			missing = append(missing, field.Path)
+		}
+		return result, &BatchIncompleteError{Missing: missing}
+	}
+	return result, nil
+}
+
+func (t *BatchTranslator) attempt(ctx context.Context, req BatchRequest, entry BatchProvider, pending []BatchField, result *BatchResult) []BatchField {
+	attempt := BatchAttempt{Provider: entry.Name, Model: entry.Model, Fields: len(pending)}
+	defer func() { result.Attempts = append(result.Attempts, attempt) }()
+
+	providerReq, err := t.builder.BuildBatchPrompt(BatchPromptInput{
+		EntityType:    strings.TrimSpace(req.EntityType),
+		SourceLocale:  req.SourceLocale,
+		TargetLocale:  req.TargetLocale,
+		Fields:        pending,
+		AssistContext: req.AssistContext,
+		CorrelationID: strings.TrimSpace(req.CorrelationID),
+	}, t.prompt)
+	if err != nil {
+		attempt.Error = err.Error()
+		return pending
+	}
+	providerReq.Model = firstNonEmpty(entry.Model, providerReq.Model)
+	providerReq.MaxTokens = t.batchMaxTokens(pending)
+	resp, err := entry.Provider.GenerateTranslation(ctx, providerReq)
+	attempt.Provider = firstNonEmpty(entry.Name, resp.Provider, "provider")
+	attempt.Model = firstNonEmpty(resp.Model, providerReq.Model)
+	attempt.Usage = UsageFromDiagnostics(resp.Diagnostics)
+	attempt.CostUSD = entry.Pricing.Cost(attempt.Usage)
+	result.Usage = result.Usage.Add(attempt.Usage)
+	result.CostUSD += attempt.CostUSD
+	t.recordUsage(ctx, req, attempt)
+	if err != nil {
+		attempt.Error = err.Error()
+		return pending
+	}
+
+	translated, err := ParseBatchResponse(resp.Text)
+	if err != nil {
+		attempt.Error = err.Error()
+		return pending
+	}
+	remaining := make([]BatchField, 0, len(pending))
+	for _, field := range pending {
+		text := strings.TrimSpace(translated[field.Path])
+		if text == "" {
+			remaining = append(remaining, field)
+			continue
+		}
+		result.Fields[field.Path] = text
+		attempt.Resolved++
+		t.storeCache(ctx, req, firstNonEmpty(entry.Model, attempt.Model), field, text)
+	}
+	if len(remaining) > 0 {
+		attempt.Error = fmt.Sprintf("response omitted %d field(s)", len(remaining))
+	}
+	return remaining
+}
+
+func (t *BatchTranslator) batchMaxTokens(fields []BatchField) int {
+	if t.maxTokens > 0 {
+		return t.maxTokens
+	}
+	estimate := 0
+	for _, field := range fields {
+		estimate += len(field.Text)*batchTokensPerChar + batchTokensPerFieldKey
+	}
+	return min(max(estimate, minBatchMaxTokens), maxBatchMaxTokens)
+}
+
+func (t *BatchTranslator) resolveFromCache(ctx context.Context, req BatchRequest, fields []BatchField, result *BatchResult) []BatchField {
+	if t.cache == nil {
+		return fields
+	}
+	remaining := make([]BatchField, 0, len(fields))
+	for _, field := range fields {
+		hit := false
+		for _, entry := range t.providers {
+			if entry.Model == "" {
+				continue
+			}
+			text, ok, err := t.cache.Get(ctx, NewBatchCacheKey(field.Text, req.SourceLocale, req.TargetLocale, entry.Model))
+			if err == nil && ok && strings.TrimSpace(text) != "" {
+				result.Fields[field.Path] = text
+				result.CachedFields = append(result.CachedFields, field.Path)
+				hit = true
+				break
+			}
+		}
+		if !hit {
+			remaining = append(remaining, field)
+		}
+	}
+	return remaining
+}
+
+func (t *BatchTranslator) storeCache(ctx context.Context, req BatchRequest, model string, field BatchField, text string) {
+	if t.cache == nil || strings.TrimSpace(model) == "" {
+		return
+	}
+	_ = t.cache.Set(ctx, NewBatchCacheKey(field.Text, req.SourceLocale, req.TargetLocale, model), text)
+}
+
+func (t *BatchTranslator) checkBudget(ctx context.Context, tenantID string) error {
+	if t.ledger == nil || t.budgets == nil {
+		return nil
+	}
+	limit, ok := t.budgets.MonthlyBudgetUSD(ctx, tenantID)
+	if !ok {
+		return nil
+	}
+	totals, err := t.ledger.MonthlyTotals(ctx, tenantID, t.now())
+	if err != nil {
+		return err
+	}
+	if totals.CostUSD >= limit {
+		return fmt.Errorf("%w: tenant %q spent %.4f of %.4f USD", ErrBudgetExceeded, tenantID, totals.CostUSD, limit)
+	}
+	return nil
+}
+
+func (t *BatchTranslator) recordUsage(ctx context.Context, req BatchRequest, attempt BatchAttempt) {
+	if t.ledger == nil || attempt.Usage.IsZero() {
+		return
+	}
+	_ = t.ledger.Record(ctx, UsageRecord{
+		TenantID:      req.TenantID,
+		Provider:      attempt.Provider,
+		Model:         attempt.Model,
+		InputTokens:   attempt.Usage.InputTokens,
+		OutputTokens:  attempt.Usage.OutputTokens,
+		CostUSD:       attempt.CostUSD,
+		CorrelationID: strings.TrimSpace(req.CorrelationID),
+		RecordedAt:    t.now().UTC(),
+	})
+}
+
+func normalizeBatchFields(fields []BatchField) []BatchField {
+	out := make([]BatchField, 0, len(fields))
+	seen := map[string]struct{}{}
+	for _, field := range fields {
+		field.Path = strings.TrimSpace(field.Path)
+		if field.Path == "" || strings.TrimSpace(field.Text) == "" {
+			continue
+		}
+		if _, ok := seen[field.Path]; ok {
+			continue
+		}
+		seen[field.Path] = struct{}{}
+		out = append(out, field)
+	}
+	return out
+}
+
+// DefaultBatchPromptBuilder asks for a JSON object keyed by field path.
+type DefaultBatchPromptBuilder struct{}
+
+func (DefaultBatchPromptBuilder) BuildBatchPrompt(input BatchPromptInput, cfg PromptConfig) (ProviderRequest, error) {
+	if len(input.Fields) == 0 {
+		return ProviderRequest{}, errors.New("batch fields are required")
+	}
+	source := make(map[string]string, len(input.Fields))
+	paths := make([]string, 0, len(input.Fields))
+	for _, field := range input.Fields {
+		source[field.Path] = field.Text
+		paths = append(paths, field.Path)
+	}
+	sort.Strings(paths)
+	payload, err := json.MarshalIndent(source, "", "  ")
+	if err != nil {
+		return ProviderRequest{}, err
+	}
+	instruction := strings.TrimSpace(cfg.Instruction)
+	if instruction == "" {
+		instruction = "Translate every value of the JSON object into the target locale. Preserve placeholders, variables, HTML tags, markdown, product names, and formatting intent. Return only a JSON object with exactly the same keys, mapping each key to its translated text. Do not include reasoning, analysis, or any text outside the JSON object."
+	}
+	systemPrompt := strings.TrimSpace(cfg.SystemPrompt)
+	if systemPrompt == "" {
+		systemPrompt = "You are a professional localization assistant. Respond with a single JSON object and nothing else."
+	}
+	parts := []string{
+		instruction,
+		fmt.Sprintf("Source locale: %s", strings.TrimSpace(input.SourceLocale)),
+		fmt.Sprintf("Target locale: %s", strings.TrimSpace(input.TargetLocale)),
+	}
+	if entityType := strings.TrimSpace(input.EntityType); entityType != "" {
+		parts = append(parts, fmt.Sprintf("Entity type: %s", entityType))
+	}
+	if assist := formatAssistContext(input.AssistContext); assist != "" {
+		parts = append(parts, "Assist context:", assist)
+	}
+	parts = append(parts, "Source fields:", string(payload))
+
+	return ProviderRequest{
+		SystemPrompt: systemPrompt,
+		Prompt:       strings.Join(parts, "\n\n"),
+		SourceLocale: strings.TrimSpace(input.SourceLocale),
+		TargetLocale: strings.TrimSpace(input.TargetLocale),
+		EntityType:   strings.TrimSpace(input.EntityType),
+		Metadata: map[string]any{
+			"batch":          true,
+			"field_paths":    paths,
+			"correlation_id": strings.TrimSpace(input.CorrelationID),
+		},
+	}, nil
+}
+
+// ParseBatchResponse extracts the field-path keyed JSON object from a
+// provider response, tolerating markdown code fences and surrounding text.
+// A top-level "fields" or "translations" object is unwrapped.
+func ParseBatchResponse(text string) (map[string]string, error) {
+	start := strings.Index(text, "{")
+	end := strings.LastIndex(text, "}")
+	if start < 0 || end <= start {
+		return nil, errors.New("batch response does not contain a JSON object")
+	}
+	raw := map[string]any{}
+	if err := json.Unmarshal([]byte(text[start:end+1]), &raw); err != nil {
+		return nil, fmt.Errorf("batch response is not valid JSON: %w", err)
+	}
+	for _, wrapper := range []string{"fields", "translations"} {
+		if nested, ok := raw[wrapper].(map[string]any); ok && len(raw) == 1 {
+			raw = nested
+		}
+	}
+	out := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if text, ok := value.(string); ok {
+			out[strings.TrimSpace(key)] = text
+		}
+	}
+	return out, nil
+}
+
+// BatchCacheKey identifies a cached field translation.
+type BatchCacheKey struct {
+	SourceHash   string
+	SourceLocale string
+	TargetLocale string
+	Model        string
+}
+
+// NewBatchCacheKey hashes the source text and normalizes the locale pair and model.
+func NewBatchCacheKey(sourceText, sourceLocale, targetLocale, model string) BatchCacheKey {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(sourceText)))
+	return BatchCacheKey{
+		SourceHash:   hex.EncodeToString(sum[:]),
+		SourceLocale: strings.ToLower(strings.TrimSpace(sourceLocale)),
+		TargetLocale: strings.ToLower(strings.TrimSpace(targetLocale)),
+		Model:        strings.TrimSpace(model),
+	}
+}
+
+func (k BatchCacheKey) String() string {
+	return strings.Join([]string{k.SourceHash, k.SourceLocale, k.TargetLocale, k.Model}, "|")
+}
@@ -0,0 +1,219 @@
+package translationai
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type batchStubProvider struct {
+	calls    []ProviderRequest
+	text     string
+	err      error
+	usage    map[string]any
+	provider string
+}
+
+func (p *batchStubProvider) GenerateTranslation(_ context.Context, req ProviderRequest) (ProviderResponse, error) {
+	p.calls = append(p.calls, req)
+	resp := ProviderResponse{Text: p.text, Provider: p.provider, Model: req.Model, Diagnostics: map[string]any{"usage": p.usage}}
+	return resp, p.err
+}
+
+func batchTestFields() []BatchField {
+	return []BatchField{
+		{Path: "title", Text: "Hello"},
+		{Path: "body", Text: "Welcome back"},
+		{Path: "title", Text: "Duplicate"},
+		{Path: "empty", Text: "  "},
+	}
+}
+
+func TestBatchTranslatorFallsBackAcrossProviders(t *testing.T) {
+	primary := &batchStubProvider{err: errors.New("upstream unavailable"), usage: map[string]any{"prompt_tokens": float64(50)}}
+	partial := &batchStubProvider{text: "```json\n{\"title\": \"Hola\"}\n```"}
+	last := &batchStubProvider{text: `{"translations": {"body": "Bienvenido de nuevo"}}`}
+	translator := NewBatchTranslator(BatchConfig{
+		Providers: []BatchProvider{
+			{Name: "primary", Provider: primary, Model: "model-a"},
+			{Name: "partial", Provider: partial, Model: "model-b"},
+			{Name: "last", Provider: last, Model: "model-c"},
+		},
+		MaxTokens: 2048,
+	})
+
+	result, err := translator.TranslateBatch(context.Background(), BatchRequest{SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields()})
+	if err != nil {
+		t.Fatalf("TranslateBatch: %v", err)
+	}
+	if result.Fields["title"] != "Hola" || result.Fields["body"] != "Bienvenido de nuevo" || len(result.Fields) != 2 {
+		t.Fatalf("unexpected fields: %+v", result.Fields)
+	}
+	if len(result.Attempts) != 3 || result.Attempts[0].Error == "" || result.Attempts[1].Resolved != 1 || result.Attempts[2].Fields != 1 {
+		t.Fatalf("unexpected attempts: %+v", result.Attempts)
+	}
+	if !strings.Contains(last.calls[0].Prompt, "Welcome back") || strings.Contains(last.calls[0].Prompt, "Hello") {
+		t.Fatalf("expected fallback to request only unresolved fields, got %q", last.calls[0].Prompt)
+	}
+	if primary.calls[0].MaxTokens != 2048 || primary.calls[0].Model != "model-a" {
+		t.Fatalf("expected model and max tokens passthrough, got %+v", primary.calls[0])
+	}
+	if result.Usage.InputTokens != 50 {
+		t.Fatalf("expected failed attempt usage to be counted, got %+v", result.Usage)
+	}
+}
+
+func TestBatchTranslatorReportsIncompleteResult(t *testing.T) {
+	provider := &batchStubProvider{text: `{"title": "Hola"}`}
+	translator := NewBatchTranslator(BatchConfig{Providers: []BatchProvider{{Name: "only", Provider: provider, Model: "m"}}})
+
+	result, err := translator.TranslateBatch(context.Background(), BatchRequest{SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields()})
+	var incomplete *BatchIncompleteError
+	if !errors.As(err, &incomplete) || len(incomplete.Missing) != 1 || incomplete.Missing[0] != "body" {
+		t.Fatalf("expected incomplete error for body, got %v", err)
+	}
+	if result.Fields["title"] != "Hola" {
+		t.Fatalf("expected partial result, got %+v", result.Fields)
+	}
+}
+
+func TestBatchTranslatorServesCachedFields(t *testing.T) {
+	cache := NewMemoryBatchCache(time.Hour)
+	provider := &batchStubProvider{text: `{"title": "Hola", "body": "Bienvenido"}`}
+	translator := NewBatchTranslator(BatchConfig{
+		Providers: []BatchProvider{{Name: "only", Provider: provider, Model: "m"}},
+		Cache:     cache,
+	})
+	req := BatchRequest{SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields()}
+	if _, err := translator.TranslateBatch(context.Background(), req); err != nil {
+		t.Fatalf("first TranslateBatch: %v", err)
+	}
+	result, err := translator.TranslateBatch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("second TranslateBatch: %v", err)
+	}
+	if len(provider.calls) != 1 || len(result.CachedFields) != 2 || result.Fields["body"] != "Bienvenido" {
+		t.Fatalf("expected cached second batch, calls=%d result=%+v", len(provider.calls), result)
+	}
+
+	other := BatchRequest{SourceLocale: "en", TargetLocale: "fr", Fields: batchTestFields()}
+	if _, err := translator.TranslateBatch(context.Background(), other); err != nil {
+		t.Fatalf("fr TranslateBatch: %v", err)
+	}
+	if len(provider.calls) != 2 {
+		t.Fatalf("expected locale pair to be part of the cache key")
+	}
+}
+
+func TestBatchTranslatorRecordsCostAndEnforcesBudget(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	ledger := NewMemoryUsageLedger()
+	provider := &batchStubProvider{
+		text:  `{"title": "Hola", "body": "Bienvenido"}`,
+		usage: map[string]any{"input_tokens": float64(1000), "output_tokens": float64(500)},
+	}
+	translator := NewBatchTranslator(BatchConfig{
+		Providers: []BatchProvider{{
+			Name:     "anthropic",
+			Provider: provider,
+			Model:    "m",
+			Pricing:  ModelPricing{InputPerMillionUSD: 3, OutputPerMillionUSD: 15},
+		}},
+		Ledger:  ledger,
+		Budgets: StaticBudgets{ByTenant: map[string]float64{"acme": 0.02}},
+		Now:     func() time.Time { return now },
+	})
+	req := BatchRequest{TenantID: "acme", SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields(), CorrelationID: "job-1"}
+
+	result, err := translator.TranslateBatch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("TranslateBatch: %v", err)
+	}
+	if result.CostUSD < 0.01049 || result.CostUSD > 0.01051 {
+		t.Fatalf("expected 0.0105 USD cost, got %v", result.CostUSD)
+	}
+	records := ledger.Records()
+	if len(records) != 1 || records[0].TenantID != "acme" || records[0].CorrelationID != "job-1" || records[0].InputTokens != 1000 {
+		t.Fatalf("unexpected ledger records: %+v", records)
+	}
+
+	if _, err := translator.TranslateBatch(context.Background(), req); err != nil {
+		t.Fatalf("second TranslateBatch under budget: %v", err)
+	}
+	if _, err := translator.TranslateBatch(context.Background(), req); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected budget exceeded, got %v", err)
+	}
+	if _, err := translator.TranslateBatch(context.Background(), BatchRequest{TenantID: "other", SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields()}); err != nil {
+		t.Fatalf("expected tenants without a budget to be unlimited, got %v", err)
+	}
+
+	totals, err := ledger.MonthlyTotals(context.Background(), "acme", now.AddDate(0, 1, 0))
+	if err != nil || totals.Requests != 0 {
+		t.Fatalf("expected next month totals to be empty, got %+v %v", totals, err)
+	}
+}
+
+func TestBatchTranslatorSizesMaxTokensFromPendingFields(t *testing.T) {
+	provider := &batchStubProvider{text: `{"title": "Hola", "body": "Bienvenido"}`}
+	translator := NewBatchTranslator(BatchConfig{Providers: []BatchProvider{{Name: "only", Provider: provider, Model: "m"}}})
+
+	if _, err := translator.TranslateBatch(context.Background(), BatchRequest{SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields()}); err != nil {
+		t.Fatalf("TranslateBatch: %v", err)
+	}
+	if got := provider.calls[0].MaxTokens; got != minBatchMaxTokens {
+		t.Fatalf("expected small batches to get the minimum limit, got %d", got)
+	}
+
+	long := []BatchField{{Path: "body", Text: strings.Repeat("word ", 600)}, {Path: "title", Text: "Hello"}}
+	if _, err := translator.TranslateBatch(context.Background(), BatchRequest{SourceLocale: "en", TargetLocale: "es", Fields: long}); err != nil {
+		t.Fatalf("TranslateBatch: %v", err)
+	}
+	if got := provider.calls[1].MaxTokens; got != (3000+5)*batchTokensPerChar+2*batchTokensPerFieldKey {
+		t.Fatalf("expected the limit to scale with the input, got %d", got)
+	}
+
+	huge := []BatchField{{Path: "body", Text: strings.Repeat("x", 10000)}}
+	_, _ = translator.TranslateBatch(context.Background(), BatchRequest{SourceLocale: "en", TargetLocale: "es", Fields: huge})
+	if got := provider.calls[2].MaxTokens; got != maxBatchMaxTokens {
+		t.Fatalf("expected the limit to be capped, got %d", got)
+	}
+}
+
+func TestBatchTranslatorChecksBudgetBeforeFallbacks(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	ledger := NewMemoryUsageLedger()
+	primary := &batchStubProvider{
+		err:   errors.New("response truncated"),
+		usage: map[string]any{"input_tokens": float64(1000), "output_tokens": float64(2000)},
+	}
+	fallback := &batchStubProvider{text: `{"title": "Hola", "body": "Bienvenido"}`}
+	translator := NewBatchTranslator(BatchConfig{
+		Providers: []BatchProvider{
+			{Name: "primary", Provider: primary, Model: "a", Pricing: ModelPricing{InputPerMillionUSD: 3, OutputPerMillionUSD: 15}},
+			{Name: "fallback", Provider: fallback, Model: "b"},
+		},
+		Ledger:  ledger,
+		Budgets: StaticBudgets{ByTenant: map[string]float64{"acme": 0.02}},
+		Now:     func() time.Time { return now },
+	})
+
+	result, err := translator.TranslateBatch(context.Background(), BatchRequest{TenantID: "acme", SourceLocale: "en", TargetLocale: "es", Fields: batchTestFields()})
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected the fallback to be stopped by the budget, got %v", err)
+	}
+	if len(fallback.calls) != 0 || len(result.Attempts) != 1 {
+		t.Fatalf("expected no fallback call after the budget was spent, got %d calls %+v", len(fallback.calls), result.Attempts)
+	}
+}
+
+func TestParseBatchResponseRejectsNonJSON(t *testing.T) {
+	if _, err := ParseBatchResponse("Sorry, I cannot help with that."); err == nil {
+		t.Fatal("expected error for non-JSON response")
+	}
+	got, err := ParseBatchResponse("Here you go:\n{\"fields\": {\"a\": \"x\"}}")
+	if err != nil || got["a"] != "x" {
+		t.Fatalf("expected wrapped fields to be unwrapped, got %+v %v", got, err)
+	}
+}
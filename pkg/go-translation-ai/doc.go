@@ -1,6 +1,10 @@
 // Package translationai provides generic AI translation provider contracts,
 // prompt construction, and OpenAI-compatible or Anthropic-compatible providers.
 //
+// BatchTranslator translates every field of a variant in one structured
+// request, falling back across an ordered provider list, caching per-field
+// results and recording per-tenant token cost against monthly budgets.
+//
 // The root package does not depend on go-admin. go-admin-specific translation
 // suggestion wiring lives under adapters/goadmin so the provider core can move
 // to a standalone repository without carrying go-admin internals.
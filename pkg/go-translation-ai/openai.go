@@ -127,6 +127,9 @@ func (p *OpenAIProvider) GenerateTranslation(ctx context.Context, req ProviderRe
 	}
 	systemPrompt := firstNonEmpty(req.SystemPrompt, p.config.SystemPrompt, "You are a professional localization assistant.")
 	maxTokens := p.config.MaxTokens
+	if req.MaxTokens > 0 {
+		maxTokens = req.MaxTokens
+	}
 	if maxTokens <= 0 {
 		maxTokens = 256
 	}
@@ -26,14 +26,16 @@ func firstNonEmpty(values ...string) string {
 // ProviderRequest contains the prompt and safe translation context sent to a
 // host-supplied provider implementation.
 type ProviderRequest struct {
-	Model        string         `json:"model,omitempty"`
-	SystemPrompt string         `json:"system_prompt,omitempty"`
-	Prompt       string         `json:"prompt"`
-	SourceLocale string         `json:"source_locale,omitempty"`
-	TargetLocale string         `json:"target_locale,omitempty"`
-	FieldPath    string         `json:"field_path,omitempty"`
-	EntityType   string         `json:"entity_type,omitempty"`
-	Metadata     map[string]any `json:"metadata,omitempty"`
+	Model        string `json:"model,omitempty"`
+	SystemPrompt string `json:"system_prompt,omitempty"`
+	Prompt       string `json:"prompt"`
+	SourceLocale string `json:"source_locale,omitempty"`
+	TargetLocale string `json:"target_locale,omitempty"`
+	FieldPath    string `json:"field_path,omitempty"`
+	EntityType   string `json:"entity_type,omitempty"`
+	// MaxTokens overrides the provider's configured output limit when positive.
+	MaxTokens int            `json:"max_tokens,omitempty"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
 }
 
 // ProviderResponse is the provider result before core admin normalization.
@@ -0,0 +1,221 @@
+package translationai
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Usage counts provider tokens.
+type Usage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		InputTokens:  u.InputTokens + other.InputTokens,
+		OutputTokens: u.OutputTokens + other.OutputTokens,
+	}
+}
+
+func (u Usage) IsZero() bool {
+	return u.InputTokens == 0 && u.OutputTokens == 0
+}
+
+// UsageFromDiagnostics reads token counts from the "usage" diagnostics entry
+// reported by the OpenAI-compatible and Anthropic-compatible providers.
+func UsageFromDiagnostics(diagnostics map[string]any) Usage {
+	usage, ok := diagnostics["usage"].(map[string]any)
+	if !ok {
+		return Usage{}
+	}
+	return Usage{
+		InputTokens:  usageCount(usage, "input_tokens", "prompt_tokens"),
+		OutputTokens: usageCount(usage, "output_tokens", "completion_tokens"),
+	}
+}
+
+func usageCount(usage map[string]any, keys ...string) int {
+	for _, key := range keys {
+		switch value := usage[key].(type) {
+		case int:
+			return value
+		case int64:
+			return int(value)
+		case float64:
+			return int(value)
+		case json.Number:
+			if n, err := value.Int64(); err == nil {
+				return int(n)
+			}
+		}
+	}
+	return 0
+}
+
+// ModelPricing is the USD price per million input and output tokens.
+type ModelPricing struct {
+	InputPerMillionUSD  float64
+	OutputPerMillionUSD float64
+}
+
+func (p ModelPricing) Cost(usage Usage) float64 {
+	return (float64(usage.InputTokens)*p.InputPerMillionUSD + float64(usage.OutputTokens)*p.OutputPerMillionUSD) / 1_000_000
+}
+
+// UsageRecord is one accounted provider call.
+type UsageRecord struct {
+	TenantID      string    `json:"tenant_id,omitempty"`
+	Provider      string    `json:"provider"`
+	Model         string    `json:"model,omitempty"`
+	InputTokens   int       `json:"input_tokens"`
+	OutputTokens  int       `json:"output_tokens"`
+	CostUSD       float64   `json:"cost_usd"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	RecordedAt    time.Time `json:"recorded_at"`
+}
+
+// UsageTotals aggregates usage records.
+type UsageTotals struct {
+	Requests     int     `json:"requests"`
+	InputTokens  int     `json:"input_tokens"`
+	OutputTokens int     `json:"output_tokens"`
+	CostUSD      float64 `json:"cost_usd"`
+}
+
+// UsageLedger stores per-tenant token and cost accounting.
+type UsageLedger interface {
+	Record(context.Context, UsageRecord) error
+	// MonthlyTotals aggregates the tenant's usage for the UTC calendar month containing at.
+	MonthlyTotals(ctx context.Context, tenantID string, at time.Time) (UsageTotals, error)
+}
+
+// BudgetPolicy resolves the monthly USD budget for a tenant. ok=false means unlimited.
+type BudgetPolicy interface {
+	MonthlyBudgetUSD(ctx context.Context, tenantID string) (limit float64, ok bool)
+}
+
+// StaticBudgets is a BudgetPolicy backed by per-tenant limits and a default.
+// A zero Default means tenants without an explicit entry are unlimited.
+type StaticBudgets struct {
+	Default  float64
+	ByTenant map[string]float64
+}
+
+func (b StaticBudgets) MonthlyBudgetUSD(_ context.Context, tenantID string) (float64, bool) {
+	if limit, ok := b.ByTenant[strings.TrimSpace(tenantID)]; ok {
+		return limit, true
+	}
+	if b.Default > 0 {
+		return b.Default, true
+	}
+	return 0, false
+}
+
+// MemoryUsageLedger keeps usage records in memory.
+type MemoryUsageLedger struct {
+	mu      sync.RWMutex
+	records []UsageRecord
+}
+
+var _ UsageLedger = (*MemoryUsageLedger)(nil)
+
+func NewMemoryUsageLedger() *MemoryUsageLedger {
+	return &MemoryUsageLedger{}
+}
+
+func (l *MemoryUsageLedger) Record(_ context.Context, record UsageRecord) error {
+	if record.RecordedAt.IsZero() {
+		record.RecordedAt = time.Now().UTC()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, record)
+	return nil
+}
+
+func (l *MemoryUsageLedger) MonthlyTotals(_ context.Context, tenantID string, at time.Time) (UsageTotals, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	year, month, _ := at.UTC().Date()
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	totals := UsageTotals{}
+	for _, record := range l.records {
+		recordYear, recordMonth, _ := record.RecordedAt.UTC().Date()
+		if record.TenantID != tenantID || recordYear != year || recordMonth != month {
+			continue
+		}
+		totals.Requests++
+		totals.InputTokens += record.InputTokens
+		totals.OutputTokens += record.OutputTokens
+		totals.CostUSD += record.CostUSD
+	}
+	return totals, nil
+}
+
+// Records returns a copy of every recorded entry.
+func (l *MemoryUsageLedger) Records() []UsageRecord {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return append([]UsageRecord{}, l.records...)
+}
+
+// BatchCache stores translated field text by BatchCacheKey.
+type BatchCache interface {
+	Get(ctx context.Context, key BatchCacheKey) (string, bool, error)
+	Set(ctx context.Context, key BatchCacheKey, text string) error
+}
+
+// MemoryBatchCache is an in-process BatchCache. A zero TTL keeps entries
+// until the process exits.
+type MemoryBatchCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[BatchCacheKey]memoryBatchCacheEntry
+}
+
+type memoryBatchCacheEntry struct {
+	text      string
+	expiresAt time.Time
+}
+
+var _ BatchCache = (*MemoryBatchCache)(nil)
+
+func NewMemoryBatchCache(ttl time.Duration) *MemoryBatchCache {
+	return &MemoryBatchCache{
+		ttl:     max(ttl, 0),
+		now:     time.Now,
+		entries: map[BatchCacheKey]memoryBatchCacheEntry{},
+	}
+}
+
+func (c *MemoryBatchCache) Get(_ context.Context, key BatchCacheKey) (string, bool, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok {
+		return "", false, nil
+	}
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+		return "", false, nil
+	}
+	return entry.text, true, nil
+}
+
+func (c *MemoryBatchCache) Set(_ context.Context, key BatchCacheKey, text string) error {
+	entry := memoryBatchCacheEntry{text: text}
+	if c.ttl > 0 {
+		entry.expiresAt = c.now().Add(c.ttl)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry
+	return nil
+}
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/goliatone/go-admin/internal/primitives"
 	"sort"
 	"strings"
@@ -33,10 +34,16 @@ type RunInput struct {
 	NativeFormFields   []models.NativeFormField   `json:"native_form_fields"`
 }
 
+// RunRecorder persists completed runs; store.RunStore implementations satisfy it.
+type RunRecorder interface {
+	SaveRun(ctx context.Context, run models.Run) error
+}
+
 // Orchestrator is the default placement orchestrator implementation.
 type Orchestrator struct {
 	registry *resolvers.Registry
 	policies PlacementPolicy
+	runs     RunRecorder
 	now      func() time.Time
 }
 
@@ -49,10 +56,20 @@ func NewOrchestrator(registry *resolvers.Registry, policies PlacementPolicy) *Or
 	}
 }
 
+// WithRunStore records every finished run in runs so suggestions can later be
+// matched with user feedback.
+func (o *Orchestrator) WithRunStore(runs RunRecorder) *Orchestrator {
+	if o != nil {
+		o.runs = runs
+	}
+	return o
+}
+
 func (o *Orchestrator) Run(ctx context.Context, input RunInput) (models.Run, error) {
 	if o == nil || o.registry == nil {
 		return models.Run{}, errors.New("placement orchestrator not configured")
 	}
+	ctx = resolvers.WithDocumentCache(ctx)
 	startedAt := o.now()
 	run := newPlacementRun(input, startedAt)
 	policy, err := o.resolvePolicy(ctx, input)
@@ -66,13 +83,14 @@ func (o *Orchestrator) Run(ctx context.Context, input RunInput) (models.Run, err
 	unresolved := unresolvedDefinitionIDs(input.FieldDefinitions, input.ExistingPlacements)
 	if len(unresolved) == 0 {
 		completeRunWithoutWork(&run, startedAt)
-		return run, nil
+		return o.persistRun(ctx, run)
 	}
 
-	resolverInput := buildResolverInput(input)
+	resolverInput := buildResolverInput(ctx, input)
+	resolverInput.DocumentFingerprint = run.DocumentFingerprint
 	allDefinitions := mapDefinitions(input.FieldDefinitions)
 	accepted := map[string]models.Suggestion{}
-	estimates := o.estimateResolvers(ctx, order, resolverInput)
+	estimates := o.estimateResolvers(ctx, order, estimateInput(resolverInput, policy, unresolved, allDefinitions, startedAt))
 	run.Estimates = append(run.Estimates, estimates...)
 	run.Scores = RankScores(estimates, policy.Weights)
 
@@ -88,17 +106,32 @@ func (o *Orchestrator) Run(ctx context.Context, input RunInput) (models.Run, err
 		order:          executionOrder,
 	})
 	o.finalizeRun(&run, unresolved, accepted, startedAt)
+	return o.persistRun(ctx, run)
+}
+
+// persistRun stores run when a run store is configured. On failure it returns
+// the zero run so callers cannot mistake an unsaved run for a recorded one.
+func (o *Orchestrator) persistRun(ctx context.Context, run models.Run) (models.Run, error) {
+	if o.runs == nil {
+		return run, nil
+	}
+	if err := o.runs.SaveRun(ctx, run); err != nil {
+		return models.Run{}, fmt.Errorf("save placement run: %w", err)
+	}
 	return run, nil
 }
 
 func newPlacementRun(input RunInput, startedAt time.Time) models.Run {
 	return models.Run{
-		ID:              primitives.FirstNonEmpty(strings.TrimSpace(input.RunID), uuid.NewString()),
-		AgreementID:     strings.TrimSpace(input.AgreementID),
-		CreatedByUserID: strings.TrimSpace(input.CreatedByUserID),
-		CreatedAt:       startedAt,
-		Status:          models.RunStatusFailed,
-		ReasonCode:      models.RunReasonResolverError,
+		ID:                  primitives.FirstNonEmpty(strings.TrimSpace(input.RunID), uuid.NewString()),
+		AgreementID:         strings.TrimSpace(input.AgreementID),
+		OrgID:               strings.TrimSpace(input.OrgID),
+		DocumentFingerprint: models.DocumentFingerprint(input.DocumentBytes),
+		FieldDefinitions:    append([]models.FieldDefinition{}, input.FieldDefinitions...),
+		CreatedByUserID:     strings.TrimSpace(input.CreatedByUserID),
+		CreatedAt:           startedAt,
+		Status:              models.RunStatusFailed,
+		ReasonCode:          models.RunReasonResolverError,
 	}
 }
 
@@ -108,15 +141,40 @@ func completeRunWithoutWork(run *models.Run, completedAt time.Time) {
 	run.CompletedAt = completedAt
 }
 
-func buildResolverInput(input RunInput) resolvers.ResolveInput {
+// buildResolverInput extracts native AcroForm fields once per run when the
+// caller did not supply them, so every resolver sees the same form metadata.
+func buildResolverInput(ctx context.Context, input RunInput) resolvers.ResolveInput {
+	nativeFields := append([]models.NativeFormField{}, input.NativeFormFields...)
+	if len(nativeFields) == 0 && len(input.DocumentBytes) > 0 {
+		if extracted, err := resolvers.ExtractNativeFormFieldsContext(ctx, input.DocumentBytes); err == nil {
+			nativeFields = extracted
+		}
+	}
 	return resolvers.ResolveInput{
 		DocumentBytes:      append([]byte{}, input.DocumentBytes...),
 		DocumentPageCount:  input.DocumentPageCount,
+		OrgID:              strings.TrimSpace(input.OrgID),
 		ExistingPlacements: append([]models.ExistingPlacement{}, input.ExistingPlacements...),
-		NativeFormFields:   append([]models.NativeFormField{}, input.NativeFormFields...),
+		NativeFormFields:   nativeFields,
 	}
 }
 
+// estimateInput exposes the run budget, time limit and pending definitions
+// to resolver estimates so cost-aware resolvers can opt out up front.
+func estimateInput(
+	resolverInput resolvers.ResolveInput,
+	policy models.Policy,
+	unresolved []string,
+	definitions map[string]models.FieldDefinition,
+	startedAt time.Time,
+) resolvers.ResolveInput {
+	out := resolverInput
+	out.FieldDefinitions = unresolvedDefinitions(unresolved, map[string]models.Suggestion{}, definitions)
+	out.BudgetRemaining = remainingBudget(policy, 0)
+	out.TimeRemaining = remainingTime(policy, startedAt)
+	return out
+}
+
 func (o *Orchestrator) estimateResolvers(
 	ctx context.Context,
 	order []string,
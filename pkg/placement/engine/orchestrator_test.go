@@ -2,11 +2,14 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/goliatone/go-admin/pkg/placement/models"
 	"github.com/goliatone/go-admin/pkg/placement/resolvers"
+	"github.com/goliatone/go-admin/pkg/placement/store"
 )
 
 type stubResolver struct {
@@ -248,3 +251,124 @@ func TestOrchestratorEnforcesMaxTimeWithPartialOutcome(t *testing.T) {
 		t.Fatalf("expected one partial suggestion, got %d", len(run.Suggestions))
 	}
 }
+
+func TestOrchestratorSkipsOCRWhenEstimateExceedsPolicyBudget(t *testing.T) {
+	engine := resolvers.NewFixtureOCREngine(resolvers.OCRPage{
+		PageNumber: 1,
+		Width:      612,
+		Height:     792,
+		Words:      []resolvers.OCRWord{{Text: "Signature", X: 72, Y: 600, Width: 80, Height: 12, Confidence: 0.9}},
+	})
+	registry := resolvers.NewRegistry()
+	registry.Register(resolvers.NewOCRAnchorResolver(engine), resolvers.ResolverCapability{SupportsOCR: true})
+
+	orchestrator := NewOrchestrator(registry, NewStaticPolicyResolver(models.Policy{
+		Weights: models.ScoringWeights{Accuracy: 1, Cost: 0.1, Latency: 0.1},
+		Limits:  models.ExecutionLimits{MaxBudget: 0.1},
+	}))
+	run, err := orchestrator.Run(context.Background(), RunInput{
+		AgreementID:       "agreement-1",
+		DocumentBytes:     []byte("%PDF-1.7\n1 0 obj << /Type /Page >> endobj\n"),
+		DocumentPageCount: 1,
+		FieldDefinitions:  []models.FieldDefinition{{ID: "field-1", FieldType: "signature", Label: "Signature"}},
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(run.Estimates) != 1 || run.Estimates[0].Reason != "budget_exceeded" {
+		t.Fatalf("expected OCR estimate to report budget_exceeded, got %+v", run.Estimates)
+	}
+	if engine.Calls() != 0 || len(run.ExecutedResolvers) != 0 {
+		t.Fatalf("expected OCR not to execute, calls=%d executed=%v", engine.Calls(), run.ExecutedResolvers)
+	}
+}
+
+func TestOrchestratorReplaysAcceptedPlacementsForSameDocument(t *testing.T) {
+	ctx := context.Background()
+	runs := store.NewMemoryRunStore()
+	registry := resolvers.NewRegistry()
+	registry.Register(resolvers.NewHistoryResolver(runs), resolvers.ResolverCapability{Deterministic: true})
+	registry.Register(stubResolver{
+		id:       "guess",
+		estimate: models.Estimate{Accuracy: 0.5, Cost: 0.1, Latency: 0.1, Supported: true},
+		result: models.ResolveResult{Suggestions: []models.Suggestion{{
+			ID:                "guess-1",
+			FieldDefinitionID: "first-sig",
+			ResolverID:        "guess",
+			Confidence:        0.5,
+			Geometry:          models.Geometry{PageNumber: 1, X: 10, Y: 10, Width: 100, Height: 20},
+		}}},
+	}, resolvers.ResolverCapability{})
+	orchestrator := NewOrchestrator(registry, nil).WithRunStore(runs)
+	document := []byte("%PDF-1.7\n% contract template\n")
+
+	first, err := orchestrator.Run(ctx, RunInput{
+		OrgID:            "org-1",
+		AgreementID:      "agreement-1",
+		DocumentBytes:    document,
+		FieldDefinitions: []models.FieldDefinition{{ID: "first-sig", FieldType: "signature", Label: "Signature"}},
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if first.SelectedSource != "guess" {
+		t.Fatalf("expected first run to fall through to guess, got %+v", first)
+	}
+	manual := models.Geometry{PageNumber: 3, X: 72, Y: 140, Width: 180, Height: 36}
+	if _, err := runs.RecordFeedback(ctx, models.PlacementFeedback{
+		RunID:         first.ID,
+		SuggestionID:  "guess-1",
+		Decision:      models.FeedbackDecisionMoved,
+		FinalGeometry: manual,
+	}); err != nil {
+		t.Fatalf("RecordFeedback: %v", err)
+	}
+
+	second, err := orchestrator.Run(ctx, RunInput{
+		OrgID:            "org-1",
+		AgreementID:      "agreement-2",
+		DocumentBytes:    document,
+		FieldDefinitions: []models.FieldDefinition{{ID: "second-sig", FieldType: "signature", Label: "Signature"}},
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(second.Suggestions) != 1 || second.Suggestions[0].ResolverID != resolvers.HistoryResolverID {
+		t.Fatalf("expected history suggestion, got %+v", second.Suggestions)
+	}
+	if second.Suggestions[0].Geometry != manual || second.DocumentFingerprint != first.DocumentFingerprint {
+		t.Fatalf("expected manual geometry replayed, got %+v", second.Suggestions[0])
+	}
+	stored, err := runs.ListRuns(ctx, store.RunFilter{DocumentFingerprint: first.DocumentFingerprint})
+	if err != nil || len(stored) != 2 {
+		t.Fatalf("expected both runs persisted, got %d (%v)", len(stored), err)
+	}
+}
+
+type failingRunStore struct {
+	*store.MemoryRunStore
+}
+
+func (failingRunStore) SaveRun(context.Context, models.Run) error {
+	return errors.New("database unavailable")
+}
+
+func TestOrchestratorReturnsZeroRunWhenSaveFails(t *testing.T) {
+	registry := resolvers.NewRegistry()
+	registry.Register(stubResolver{
+		id:       "guess",
+		estimate: models.Estimate{Accuracy: 0.5, Cost: 0.1, Latency: 0.1, Supported: true},
+	}, resolvers.ResolverCapability{})
+	orchestrator := NewOrchestrator(registry, nil).WithRunStore(failingRunStore{store.NewMemoryRunStore()})
+
+	run, err := orchestrator.Run(context.Background(), RunInput{
+		DocumentBytes:    []byte("%PDF-1.7\n"),
+		FieldDefinitions: []models.FieldDefinition{{ID: "sig", FieldType: "signature"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "save placement run") {
+		t.Fatalf("expected save error, got %v", err)
+	}
+	if run.ID != "" || run.Status != "" {
+		t.Fatalf("expected zero run on save failure, got %+v", run)
+	}
+}
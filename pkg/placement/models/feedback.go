@@ -0,0 +1,65 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Feedback decisions recorded against placement run suggestions.
+const (
+	FeedbackDecisionAccepted = "accepted"
+	FeedbackDecisionMoved    = "moved"
+	FeedbackDecisionRejected = "rejected"
+	FeedbackDecisionPlaced   = "placed"
+)
+
+// PlacementFeedback records what the user did with one field after a run:
+// accepted or moved a suggestion, rejected it, or placed the field by hand.
+// FinalGeometry is empty for rejections.
+type PlacementFeedback struct {
+	ID                string    `json:"id"`
+	RunID             string    `json:"run_id"`
+	SuggestionID      string    `json:"suggestion_id"`
+	FieldDefinitionID string    `json:"field_definition_id"`
+	FieldType         string    `json:"field_type"`
+	FieldLabel        string    `json:"field_label"`
+	ResolverID        string    `json:"resolver_id"`
+	Decision          string    `json:"decision"`
+	Source            string    `json:"source"`
+	SuggestedGeometry Geometry  `json:"suggested_geometry"`
+	FinalGeometry     Geometry  `json:"final_geometry"`
+	ActorID           string    `json:"actor_id"`
+	RecordedAt        time.Time `json:"recorded_at"`
+}
+
+// LearnedPlacement is geometry confirmed by users on earlier runs over the
+// same document fingerprint, grouped by field key.
+type LearnedPlacement struct {
+	FieldKey        string    `json:"field_key"`
+	FieldType       string    `json:"field_type"`
+	FieldLabel      string    `json:"field_label"`
+	Geometry        Geometry  `json:"geometry"`
+	Source          string    `json:"source"`
+	Confirmations   int       `json:"confirmations"`
+	Rejections      int       `json:"rejections"`
+	SourceRunID     string    `json:"source_run_id"`
+	LastConfirmedAt time.Time `json:"last_confirmed_at"`
+}
+
+// DocumentFingerprint returns the SHA-256 hex digest used to match runs over
+// the same source document. Empty documents have no fingerprint.
+func DocumentFingerprint(document []byte) string {
+	if len(document) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(document)
+	return hex.EncodeToString(sum[:])
+}
+
+// FieldKey identifies a field across agreements that share a template, where
+// definition IDs differ but the field type and label repeat.
+func FieldKey(fieldType, label string) string {
+	return strings.ToLower(strings.TrimSpace(fieldType)) + "|" + strings.Join(strings.Fields(strings.ToLower(label)), " ")
+}
@@ -15,6 +15,11 @@ type Geometry struct {
 	Height     float64 `json:"height"`
 }
 
+// Empty reports whether the geometry has no page assignment.
+func (g Geometry) Empty() bool {
+	return g.PageNumber <= 0 && g.Width == 0 && g.Height == 0
+}
+
 // FieldDefinition is a logical definition input for placement suggestions.
 type FieldDefinition struct {
 	ID            string `json:"id"`
@@ -33,6 +38,8 @@ type ExistingPlacement struct {
 // NativeFormField represents an extracted native form field from a PDF source.
 type NativeFormField struct {
 	Name          string   `json:"name"`
+	Label         string   `json:"label"`
 	FieldTypeHint string   `json:"field_type_hint"`
+	Required      bool     `json:"required"`
 	Geometry      Geometry `json:"geometry"`
 }
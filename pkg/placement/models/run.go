@@ -71,21 +71,24 @@ type ResolveResult struct {
 
 // Run captures full run telemetry and merged suggestions.
 type Run struct {
-	ID                      string          `json:"id"`
-	AgreementID             string          `json:"agreement_id"`
-	Status                  string          `json:"status"`
-	ReasonCode              string          `json:"reason_code"`
-	Policy                  Policy          `json:"policy"`
-	ResolverOrder           []string        `json:"resolver_order"`
-	ExecutedResolvers       []string        `json:"executed_resolvers"`
-	Estimates               []Estimate      `json:"estimates"`
-	Scores                  []ResolverScore `json:"scores"`
-	Suggestions             []Suggestion    `json:"suggestions"`
-	UnresolvedDefinitionIDs []string        `json:"unresolved_definition_i_ds"`
-	SelectedSource          string          `json:"selected_source"`
-	BudgetUsed              float64         `json:"budget_used"`
-	Elapsed                 time.Duration   `json:"elapsed"`
-	CreatedByUserID         string          `json:"created_by_user_id"`
-	CreatedAt               time.Time       `json:"created_at"`
-	CompletedAt             time.Time       `json:"completed_at"`
+	ID                      string            `json:"id"`
+	AgreementID             string            `json:"agreement_id"`
+	OrgID                   string            `json:"org_id"`
+	DocumentFingerprint     string            `json:"document_fingerprint"`
+	FieldDefinitions        []FieldDefinition `json:"field_definitions"`
+	Status                  string            `json:"status"`
+	ReasonCode              string            `json:"reason_code"`
+	Policy                  Policy            `json:"policy"`
+	ResolverOrder           []string          `json:"resolver_order"`
+	ExecutedResolvers       []string          `json:"executed_resolvers"`
+	Estimates               []Estimate        `json:"estimates"`
+	Scores                  []ResolverScore   `json:"scores"`
+	Suggestions             []Suggestion      `json:"suggestions"`
+	UnresolvedDefinitionIDs []string          `json:"unresolved_definition_i_ds"`
+	SelectedSource          string            `json:"selected_source"`
+	BudgetUsed              float64           `json:"budget_used"`
+	Elapsed                 time.Duration     `json:"elapsed"`
+	CreatedByUserID         string            `json:"created_by_user_id"`
+	CreatedAt               time.Time         `json:"created_at"`
+	CompletedAt             time.Time         `json:"completed_at"`
 }
@@ -16,13 +16,16 @@ type PlacementResolver interface {
 
 // ResolveInput is the canonical resolver execution input.
 type ResolveInput struct {
-	DocumentBytes      []byte                     `json:"document_bytes"`
-	DocumentPageCount  int                        `json:"document_page_count"`
-	FieldDefinitions   []models.FieldDefinition   `json:"field_definitions"`
-	ExistingPlacements []models.ExistingPlacement `json:"existing_placements"`
-	NativeFormFields   []models.NativeFormField   `json:"native_form_fields"`
-	BudgetRemaining    float64                    `json:"budget_remaining"`
-	TimeRemaining      time.Duration              `json:"time_remaining"`
+	DocumentBytes     []byte `json:"document_bytes"`
+	DocumentPageCount int    `json:"document_page_count"`
+	// DocumentFingerprint is models.DocumentFingerprint of DocumentBytes.
+	DocumentFingerprint string                     `json:"document_fingerprint"`
+	OrgID               string                     `json:"org_id"`
+	FieldDefinitions    []models.FieldDefinition   `json:"field_definitions"`
+	ExistingPlacements  []models.ExistingPlacement `json:"existing_placements"`
+	NativeFormFields    []models.NativeFormField   `json:"native_form_fields"`
+	BudgetRemaining     float64                    `json:"budget_remaining"`
+	TimeRemaining       time.Duration              `json:"time_remaining"`
 }
 
 // ResolverCapability advertises resolver strategy capabilities.
@@ -3,6 +3,12 @@ package resolvers
 // NewDefaultRegistry wires the baseline resolver set with capability metadata.
 func NewDefaultRegistry() *Registry {
 	registry := NewRegistry()
+	registry.Register(HistoryResolver{}, ResolverCapability{
+		Description:      "Replay geometry users accepted on earlier runs over the same document; requires a PlacementHistory.",
+		Deterministic:    true,
+		EstimatedCost:    "low",
+		EstimatedLatency: "low",
+	})
 	registry.Register(NativePDFFormsResolver{}, ResolverCapability{
 		Description:      "Extract native PDF form fields and map suggestions to field definitions.",
 		Deterministic:    true,
@@ -11,14 +17,14 @@ func NewDefaultRegistry() *Registry {
 		EstimatedLatency: "low",
 	})
 	registry.Register(TextAnchorResolver{}, ResolverCapability{
-		Description:      "Stub for text-anchor matching strategy.",
+		Description:      "Match field labels to anchor strings in the PDF text layer.",
 		Deterministic:    true,
 		SupportsText:     true,
 		EstimatedCost:    "medium",
 		EstimatedLatency: "medium",
 	})
 	registry.Register(OCRAnchorResolver{}, ResolverCapability{
-		Description:      "Stub for OCR-assisted placement strategy.",
+		Description:      "Match field labels to OCR word boxes in scanned PDFs; requires an OCREngine.",
 		Deterministic:    false,
 		SupportsOCR:      true,
 		EstimatedCost:    "high",
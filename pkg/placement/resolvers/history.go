@@ -0,0 +1,165 @@
+package resolvers
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+	"github.com/google/uuid"
+)
+
+const HistoryResolverID = "history_resolver"
+
+const (
+	historyBaseConfidence    = 0.9
+	historyConfirmationBonus = 0.02
+	historyRejectionPenalty  = 0.1
+	historyMaxConfidence     = 0.98
+	historyMinConfidence     = 0.5
+)
+
+var historySuggestionNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("go-admin/placement/"+HistoryResolverID))
+
+// PlacementHistory returns geometry users confirmed on earlier runs over the
+// same document fingerprint. store.RunStore implementations satisfy it.
+type PlacementHistory interface {
+	LearnedPlacements(ctx context.Context, orgID, fingerprint string) ([]models.LearnedPlacement, error)
+}
+
+// HistoryResolver replays placements accepted or corrected on earlier runs
+// over the same document, so re-uploaded templates do not need to be placed
+// by hand again. Definitions match learned placements by field type and
+// label; repeated fields are assigned in page order. The zero value has no
+// history source and reports itself unsupported.
+type HistoryResolver struct {
+	History PlacementHistory
+}
+
+// NewHistoryResolver returns a resolver backed by history.
+func NewHistoryResolver(history PlacementHistory) HistoryResolver {
+	return HistoryResolver{History: history}
+}
+
+func (HistoryResolver) ID() string { return HistoryResolverID }
+
+func (r HistoryResolver) Estimate(ctx context.Context, input ResolveInput) (models.Estimate, error) {
+	estimate := models.Estimate{
+		ResolverID: HistoryResolverID,
+		Accuracy:   0.2,
+		Cost:       0.1,
+		Latency:    0.1,
+	}
+	if r.History == nil {
+		estimate.Reason = "history_store_missing"
+		return estimate, nil
+	}
+	fingerprint := historyFingerprint(input)
+	if fingerprint == "" {
+		estimate.Reason = "document_missing"
+		return estimate, nil
+	}
+	learned, err := r.History.LearnedPlacements(ctx, input.OrgID, fingerprint)
+	if err != nil {
+		return models.Estimate{}, err
+	}
+	matched := len(assignHistoryPlacements(input.FieldDefinitions, learned))
+	if matched == 0 {
+		estimate.Reason = "history_missing"
+		return estimate, nil
+	}
+	coverage := 1.0
+	if len(input.FieldDefinitions) > 0 {
+		coverage = float64(matched) / float64(len(input.FieldDefinitions))
+	}
+	estimate.Accuracy = 0.6 + 0.38*coverage
+	estimate.Cost = 0.02
+	estimate.Latency = 0.05
+	estimate.Supported = true
+	estimate.Reason = "history_match"
+	return estimate, nil
+}
+
+func (r HistoryResolver) Resolve(ctx context.Context, input ResolveInput) (models.ResolveResult, error) {
+	definitions := append([]models.FieldDefinition{}, input.FieldDefinitions...)
+	if len(definitions) == 0 {
+		return models.ResolveResult{}, nil
+	}
+	fingerprint := historyFingerprint(input)
+	if r.History == nil || fingerprint == "" {
+		return unresolvedDefinitions(input), nil
+	}
+	learned, err := r.History.LearnedPlacements(ctx, input.OrgID, fingerprint)
+	if err != nil {
+		return models.ResolveResult{}, err
+	}
+	assignments := assignHistoryPlacements(definitions, learned)
+	suggestions := make([]models.Suggestion, 0, len(assignments))
+	unresolved := make([]string, 0)
+	for _, definition := range definitions {
+		placement, ok := assignments[strings.TrimSpace(definition.ID)]
+		if !ok {
+			unresolved = append(unresolved, definition.ID)
+			continue
+		}
+		suggestions = append(suggestions, buildHistorySuggestion(definition, placement, fingerprint))
+	}
+	sort.Strings(unresolved)
+	return models.ResolveResult{
+		Suggestions:             suggestions,
+		UnresolvedDefinitionIDs: unresolved,
+	}, nil
+}
+
+func historyFingerprint(input ResolveInput) string {
+	if fingerprint := strings.TrimSpace(input.DocumentFingerprint); fingerprint != "" {
+		return fingerprint
+	}
+	return models.DocumentFingerprint(input.DocumentBytes)
+}
+
+// assignHistoryPlacements pairs definitions with learned placements sharing
+// their field key. Learned placements arrive in page order, so the n-th
+// definition of a repeated key takes the n-th confirmed position.
+func assignHistoryPlacements(definitions []models.FieldDefinition, learned []models.LearnedPlacement) map[string]models.LearnedPlacement {
+	byKey := map[string][]models.LearnedPlacement{}
+	for _, placement := range learned {
+		byKey[placement.FieldKey] = append(byKey[placement.FieldKey], placement)
+	}
+	out := map[string]models.LearnedPlacement{}
+	for _, definition := range definitions {
+		id := strings.TrimSpace(definition.ID)
+		key := models.FieldKey(definition.FieldType, definition.Label)
+		if id == "" || len(byKey[key]) == 0 {
+			continue
+		}
+		out[id] = byKey[key][0]
+		byKey[key] = byKey[key][1:]
+	}
+	return out
+}
+
+func buildHistorySuggestion(def models.FieldDefinition, placement models.LearnedPlacement, fingerprint string) models.Suggestion {
+	confidence := historyBaseConfidence +
+		historyConfirmationBonus*float64(placement.Confirmations-1) -
+		historyRejectionPenalty*float64(placement.Rejections)
+	confidence = math.Max(math.Min(confidence, historyMaxConfidence), historyMinConfidence)
+	return models.Suggestion{
+		ID:                uuid.NewSHA1(historySuggestionNamespace, []byte(fingerprint+"\x00"+def.ID)).String(),
+		FieldDefinitionID: def.ID,
+		ResolverID:        HistoryResolverID,
+		Confidence:        math.Round(confidence*1000) / 1000,
+		Geometry:          placement.Geometry,
+		Label:             strings.TrimSpace(def.Label),
+		Metadata: map[string]any{
+			"match_type":      "history",
+			"field_key":       placement.FieldKey,
+			"history_source":  placement.Source,
+			"source_run_id":   placement.SourceRunID,
+			"confirmations":   placement.Confirmations,
+			"rejections":      placement.Rejections,
+			"document_sha256": fingerprint,
+		},
+	}
+}
@@ -3,10 +3,10 @@ package resolvers
 import (
 	"context"
 	"fmt"
-	"regexp"
+	"math"
 	"sort"
-	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/goliatone/go-admin/pkg/placement/models"
 	"github.com/google/uuid"
@@ -14,22 +14,23 @@ import (
 
 const NativePDFFormsResolverID = "native_pdf_forms_resolver"
 
-var (
-	pdfObjectPattern = regexp.MustCompile(`(?s)<<.*?>>`)
-	pdfFieldName     = regexp.MustCompile(`/T\s*\(([^)]+)\)`)
-	pdfFieldRect     = regexp.MustCompile(`/Rect\s*\[\s*([\-0-9.]+)\s+([\-0-9.]+)\s+([\-0-9.]+)\s+([\-0-9.]+)\s*\]`)
+const (
+	nativeNameWeight    = 0.7
+	nativeTypeWeight    = 0.3
+	nativeMinMatchScore = 0.3
 )
 
+// nativeSuggestionNamespace seeds deterministic suggestion IDs so repeated
+// runs over the same template produce stable identifiers.
+var nativeSuggestionNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("go-admin/placement/"+NativePDFFormsResolverID))
+
 // NativePDFFormsResolver resolves suggestions from native PDF form field metadata.
 type NativePDFFormsResolver struct{}
 
 func (NativePDFFormsResolver) ID() string { return NativePDFFormsResolverID }
 
-func (NativePDFFormsResolver) Estimate(_ context.Context, input ResolveInput) (models.Estimate, error) {
-	nativeCount := len(input.NativeFormFields)
-	if nativeCount == 0 {
-		nativeCount = len(extractNativeFormFields(input.DocumentBytes))
-	}
+func (NativePDFFormsResolver) Estimate(ctx context.Context, input ResolveInput) (models.Estimate, error) {
+	nativeFields := nativeFieldsForInput(ctx, input)
 	estimate := models.Estimate{
 		ResolverID: NativePDFFormsResolverID,
 		Accuracy:   0.35,
@@ -38,36 +39,33 @@ func (NativePDFFormsResolver) Estimate(_ context.Context, input ResolveInput) (m
 		Supported:  true,
 		Reason:     "fallback_estimate",
 	}
-	if nativeCount > 0 {
+	if len(nativeFields) > 0 {
 		estimate.Accuracy = 0.92
 		estimate.Cost = 0.12
 		estimate.Latency = 0.1
 		estimate.Reason = "native_forms_detected"
+		if len(input.FieldDefinitions) > 0 {
+			matched := len(assignNativeFields(input.FieldDefinitions, nativeFields))
+			coverage := float64(matched) / float64(len(input.FieldDefinitions))
+			estimate.Accuracy = 0.5 + 0.45*coverage
+		}
 	}
 	return estimate, nil
 }
 
-func (NativePDFFormsResolver) Resolve(_ context.Context, input ResolveInput) (models.ResolveResult, error) {
+func (NativePDFFormsResolver) Resolve(ctx context.Context, input ResolveInput) (models.ResolveResult, error) {
 	definitions := append([]models.FieldDefinition{}, input.FieldDefinitions...)
 	if len(definitions) == 0 {
 		return models.ResolveResult{}, nil
 	}
 
-	nativeFields := append([]models.NativeFormField{}, input.NativeFormFields...)
-	if len(nativeFields) == 0 {
-		nativeFields = extractNativeFormFields(input.DocumentBytes)
-	}
-
+	nativeFields := nativeFieldsForInput(ctx, input)
 	usedDefinition := map[string]bool{}
 	suggestions := make([]models.Suggestion, 0)
-	for _, native := range nativeFields {
-		targetIdx := matchDefinitionIndex(definitions, usedDefinition, native)
-		if targetIdx < 0 {
-			continue
-		}
-		def := definitions[targetIdx]
+	for _, match := range assignNativeFields(definitions, nativeFields) {
+		def := definitions[match.definitionIndex]
 		usedDefinition[def.ID] = true
-		suggestions = append(suggestions, buildNativeSuggestion(def, native))
+		suggestions = append(suggestions, buildNativeSuggestion(def, nativeFields[match.nativeIndex], match))
 	}
 
 	if len(suggestions) == 0 {
@@ -89,26 +87,107 @@ func (NativePDFFormsResolver) Resolve(_ context.Context, input ResolveInput) (mo
 	}, nil
 }
 
-func buildNativeSuggestion(def models.FieldDefinition, native models.NativeFormField) models.Suggestion {
-	confidence := 0.65
-	matchType := "fallback"
+func nativeFieldsForInput(ctx context.Context, input ResolveInput) []models.NativeFormField {
+	if len(input.NativeFormFields) > 0 {
+		return append([]models.NativeFormField{}, input.NativeFormFields...)
+	}
+	fields, err := ExtractNativeFormFieldsContext(ctx, input.DocumentBytes)
+	if err != nil {
+		return nil
+	}
+	return fields
+}
+
+// nativeMatch is one scored definition/native field pairing.
+type nativeMatch struct {
+	definitionIndex int
+	nativeIndex     int
+	nameScore       float64
+	typeScore       float64
+	score           float64
+}
+
+// assignNativeFields pairs definitions with native fields greedily by score.
+// Ties fall back to native field order (page, top-to-bottom) and then to
+// definition order, so the assignment is deterministic.
+func assignNativeFields(definitions []models.FieldDefinition, nativeFields []models.NativeFormField) []nativeMatch {
+	candidates := make([]nativeMatch, 0)
+	for defIndex, definition := range definitions {
+		for nativeIndex, native := range nativeFields {
+			match := scoreNativeMatch(definition, native)
+			if match.score < nativeMinMatchScore {
+				continue
+			}
+			match.definitionIndex = defIndex
+			match.nativeIndex = nativeIndex
+			candidates = append(candidates, match)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		left, right := candidates[i], candidates[j]
+		if left.score != right.score {
+			return left.score > right.score
+		}
+		if left.nativeIndex != right.nativeIndex {
+			return left.nativeIndex < right.nativeIndex
+		}
+		return left.definitionIndex < right.definitionIndex
+	})
+	usedDefinition := map[int]bool{}
+	usedNative := map[int]bool{}
+	out := make([]nativeMatch, 0)
+	for _, candidate := range candidates {
+		if usedDefinition[candidate.definitionIndex] || usedNative[candidate.nativeIndex] {
+			continue
+		}
+		usedDefinition[candidate.definitionIndex] = true
+		usedNative[candidate.nativeIndex] = true
+		out = append(out, candidate)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].nativeIndex < out[j].nativeIndex })
+	return out
+}
+
+func scoreNativeMatch(def models.FieldDefinition, native models.NativeFormField) nativeMatch {
+	nameScore := max(
+		nameSimilarity(def.Label, native.Name),
+		nameSimilarity(def.Label, native.Label),
+		nameSimilarity(def.ID, native.Name),
+	)
 	if matchesFieldLabel(def, native.Name) {
-		confidence = 0.95
+		nameScore = max(nameScore, 0.8)
+	}
+	typeScore := typeCompatibility(def.FieldType, native.FieldTypeHint)
+	match := nativeMatch{nameScore: nameScore, typeScore: typeScore}
+	if typeScore == 0 && nameScore < 0.8 {
+		return match
+	}
+	match.score = nativeNameWeight*nameScore + nativeTypeWeight*typeScore
+	return match
+}
+
+func buildNativeSuggestion(def models.FieldDefinition, native models.NativeFormField, match nativeMatch) models.Suggestion {
+	matchType := "type_hint"
+	switch {
+	case match.nameScore >= 1:
 		matchType = "exact_label"
-	} else if matchesTypeHint(def.FieldType, native.FieldTypeHint) {
-		confidence = 0.8
-		matchType = "type_hint"
+	case match.nameScore >= 0.5:
+		matchType = "name_similarity"
 	}
+	name := strings.TrimSpace(native.Name)
 	return models.Suggestion{
-		ID:                uuid.NewString(),
+		ID:                uuid.NewSHA1(nativeSuggestionNamespace, []byte(def.ID+"\x00"+name)).String(),
 		FieldDefinitionID: def.ID,
 		ResolverID:        NativePDFFormsResolverID,
-		Confidence:        confidence,
+		Confidence:        math.Round((0.55+0.4*match.score)*1000) / 1000,
 		Geometry:          native.Geometry,
-		Label:             strings.TrimSpace(native.Name),
+		Label:             name,
 		Metadata: map[string]any{
-			"native_field_name": strings.TrimSpace(native.Name),
+			"native_field_name": name,
+			"native_field_type": native.FieldTypeHint,
 			"match_type":        matchType,
+			"name_score":        math.Round(match.nameScore*1000) / 1000,
+			"type_score":        match.typeScore,
 		},
 	}
 }
@@ -148,36 +227,6 @@ func fallbackSuggestions(definitions []models.FieldDefinition, used map[string]b
 	return out
 }
 
-func matchDefinitionIndex(definitions []models.FieldDefinition, used map[string]bool, native models.NativeFormField) int {
-	bestIndex := -1
-	bestScore := -1
-	for i, definition := range definitions {
-		if used[definition.ID] {
-			continue
-		}
-		score := 0
-		if matchesFieldLabel(definition, native.Name) {
-			score += 3
-		}
-		if matchesTypeHint(definition.FieldType, native.FieldTypeHint) {
-			score += 2
-		}
-		if score > bestScore {
-			bestScore = score
-			bestIndex = i
-		}
-	}
-	if bestIndex >= 0 {
-		return bestIndex
-	}
-	for i, definition := range definitions {
-		if !used[definition.ID] {
-			return i
-		}
-	}
-	return -1
-}
-
 func matchesFieldLabel(def models.FieldDefinition, name string) bool {
 	nameKey := canonicalToken(name)
 	if nameKey == "" {
@@ -204,64 +253,104 @@ func matchesTypeHint(fieldType, hint string) bool {
 	return strings.Contains(h, ft) || strings.Contains(ft, h)
 }
 
-func canonicalToken(value string) string {
-	value = strings.ToLower(strings.TrimSpace(value))
-	if value == "" {
-		return ""
+// typeCompatibility scores how well a native type hint fits a definition
+// field type: 1 for the same type, 0.5 for types a native field can host.
+func typeCompatibility(fieldType, hint string) float64 {
+	ft := canonicalFieldType(fieldType)
+	h := canonicalFieldType(hint)
+	if ft == "" || h == "" {
+		return 0
 	}
-	replacer := strings.NewReplacer("-", "", "_", "", " ", "")
-	return replacer.Replace(value)
+	if ft == h || matchesTypeHint(ft, h) {
+		return 1
+	}
+	switch h {
+	case "text":
+		if ft != "signature" && ft != "checkbox" {
+			return 0.5
+		}
+	case "date", "name", "email", "title", "company":
+		if ft == "text" {
+			return 0.5
+		}
+	case "radio":
+		if ft == "checkbox" {
+			return 0.5
+		}
+	case "signature":
+		if ft == "initials" {
+			return 0.5
+		}
+	}
+	return 0
 }
 
-func extractNativeFormFields(pdf []byte) []models.NativeFormField {
-	if len(pdf) == 0 {
-		return nil
+// nameSimilarity compares two field names using token overlap (Dice
+// coefficient) after splitting on separators and camelCase boundaries.
+func nameSimilarity(left, right string) float64 {
+	leftKey, rightKey := canonicalToken(left), canonicalToken(right)
+	if leftKey == "" || rightKey == "" {
+		return 0
 	}
-	text := string(pdf)
-	objects := pdfObjectPattern.FindAllString(text, -1)
-	out := make([]models.NativeFormField, 0)
-	for _, object := range objects {
-		nameMatches := pdfFieldName.FindStringSubmatch(object)
-		rectMatches := pdfFieldRect.FindStringSubmatch(object)
-		if len(nameMatches) < 2 || len(rectMatches) < 5 {
-			continue
+	if leftKey == rightKey {
+		return 1
+	}
+	leftTokens, rightTokens := nameTokens(left), nameTokens(right)
+	if len(leftTokens) == 0 || len(rightTokens) == 0 {
+		return 0
+	}
+	shared := 0
+	for token := range leftTokens {
+		if rightTokens[token] {
+			shared++
 		}
-		rect, ok := parseRect(rectMatches[1:5])
-		if !ok {
+	}
+	score := 2 * float64(shared) / float64(len(leftTokens)+len(rightTokens))
+	if strings.Contains(leftKey, rightKey) || strings.Contains(rightKey, leftKey) {
+		score = max(score, 0.6)
+	}
+	return score
+}
+
+func nameTokens(value string) map[string]bool {
+	out := map[string]bool{}
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			out[strings.ToLower(string(current))] = true
+		}
+		current = current[:0]
+	}
+	runes := []rune(strings.TrimSpace(value))
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
 			continue
+		case i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]):
+			flush()
 		}
-		out = append(out, models.NativeFormField{
-			Name: strings.TrimSpace(nameMatches[1]),
-			Geometry: models.Geometry{
-				PageNumber: 1,
-				X:          rect[0],
-				Y:          rect[1],
-				Width:      rect[2],
-				Height:     rect[3],
-			},
-		})
+		current = append(current, r)
 	}
+	flush()
 	return out
 }
 
-func parseRect(raw []string) ([4]float64, bool) {
-	var out [4]float64
-	if len(raw) != 4 {
-		return out, false
-	}
-	for i := range 4 {
-		parsed, err := strconv.ParseFloat(strings.TrimSpace(raw[i]), 64)
-		if err != nil {
-			return out, false
-		}
-		out[i] = parsed
+func canonicalToken(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return ""
 	}
-	width := out[2] - out[0]
-	height := out[3] - out[1]
-	if width <= 0 || height <= 0 {
-		return out, false
+	replacer := strings.NewReplacer("-", "", "_", "", " ", "", ".", "")
+	return replacer.Replace(value)
+}
+
+func extractNativeFormFields(pdf []byte) []models.NativeFormField {
+	fields, err := ExtractNativeFormFields(pdf)
+	if err != nil {
+		return nil
 	}
-	return [4]float64{out[0], out[1], width, height}, true
+	return fields
 }
 
 func ParseNativeFormFieldsForDebug(pdf []byte) []models.NativeFormField {
@@ -0,0 +1,127 @@
+package resolvers
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+const acroFormFixture = `%PDF-1.7
+1 0 obj << /Type /Catalog /Pages 2 0 R /AcroForm 5 0 R >> endobj
+2 0 obj << /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 /MediaBox [0 0 612 792] >> endobj
+3 0 obj << /Type /Page /Parent 2 0 R /Annots [7 0 R 8 0 R] >> endobj
+4 0 obj << /Type /Page /Parent 2 0 R /Annots [9 0 R] >> endobj
+5 0 obj << /Fields [6 0 R 9 0 R] >> endobj
+6 0 obj << /T (buyer) /Kids [7 0 R 8 0 R] >> endobj
+7 0 obj << /Type /Annot /Subtype /Widget /Parent 6 0 R /T (signature) /FT /Sig /Ff 2 /Rect [72 120 252 156] /P 3 0 R >> endobj
+8 0 obj << /Type /Annot /Subtype /Widget /Parent 6 0 R /T (dateSigned) /FT /Tx /Rect [300 120 420 144] >> endobj
+9 0 obj << /T <FEFF006100630063006500700074> /TU (Accept terms) /FT /Btn /Kids [10 0 R] >> endobj
+10 0 obj << /Type /Annot /Subtype /Widget /Parent 9 0 R /Rect [80 600 94 614] /P 4 0 R >> endobj
+trailer << /Root 1 0 R >>
+%%EOF`
+
+func TestExtractNativeFormFieldsWalksAcroFormTree(t *testing.T) {
+	fields, err := ExtractNativeFormFields([]byte(acroFormFixture))
+	if err != nil {
+		t.Fatalf("ExtractNativeFormFields: %v", err)
+	}
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 widgets, got %+v", fields)
+	}
+	expected := []models.NativeFormField{
+		{Name: "buyer.signature", FieldTypeHint: "signature", Required: true, Geometry: models.Geometry{PageNumber: 1, X: 72, Y: 120, Width: 180, Height: 36}},
+		{Name: "buyer.dateSigned", FieldTypeHint: "date", Geometry: models.Geometry{PageNumber: 1, X: 300, Y: 120, Width: 120, Height: 24}},
+		{Name: "accept", Label: "Accept terms", FieldTypeHint: "checkbox", Geometry: models.Geometry{PageNumber: 2, X: 80, Y: 600, Width: 14, Height: 14}},
+	}
+	for i, want := range expected {
+		if fields[i] != want {
+			t.Fatalf("field %d: expected %+v, got %+v", i, want, fields[i])
+		}
+	}
+}
+
+func TestExtractNativeFormFieldsReadsObjectStreams(t *testing.T) {
+	field := "<< /T (initials) /FT /Tx /Kids [8 0 R] >> "
+	widget := "<< /Type /Annot /Subtype /Widget /Parent 7 0 R /Rect [10 10 70 40] >>"
+	header := fmt.Sprintf("7 0 8 %d ", len(field))
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(header + field + widget)); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.7\n")
+	pdf.WriteString("1 0 obj << /Type /Catalog /Pages 2 0 R /AcroForm << /Fields [7 0 R] >> >> endobj\n")
+	pdf.WriteString("2 0 obj << /Type /Pages /Kids [3 0 R] /Count 1 >> endobj\n")
+	pdf.WriteString("3 0 obj << /Type /Page /Parent 2 0 R /Annots [8 0 R] >> endobj\n")
+	fmt.Fprintf(&pdf, "4 0 obj << /Type /ObjStm /N 2 /First %d /Filter /FlateDecode /Length %d >>\nstream\n", len(header), compressed.Len())
+	pdf.Write(compressed.Bytes())
+	pdf.WriteString("\nendstream\nendobj\ntrailer << /Root 1 0 R >>\n%%EOF")
+
+	fields, err := ExtractNativeFormFields(pdf.Bytes())
+	if err != nil {
+		t.Fatalf("ExtractNativeFormFields: %v", err)
+	}
+	if len(fields) != 1 || fields[0].Name != "initials" || fields[0].FieldTypeHint != "initials" {
+		t.Fatalf("expected packed initials widget, got %+v", fields)
+	}
+	if fields[0].Geometry != (models.Geometry{PageNumber: 1, X: 10, Y: 10, Width: 60, Height: 30}) {
+		t.Fatalf("unexpected geometry %+v", fields[0].Geometry)
+	}
+}
+
+func TestNativePDFFormsResolverMatchesByNameAndType(t *testing.T) {
+	input := ResolveInput{
+		DocumentBytes: []byte(acroFormFixture),
+		FieldDefinitions: []models.FieldDefinition{
+			{ID: "def-terms", FieldType: "checkbox", Label: "I accept the terms"},
+			{ID: "def-company", FieldType: "text", Label: "Company"},
+			{ID: "def-date", FieldType: "date_signed", Label: "Date Signed"},
+			{ID: "def-sign", FieldType: "signature", Label: "Buyer Signature"},
+		},
+	}
+	resolver := NativePDFFormsResolver{}
+	result, err := resolver.Resolve(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if len(result.UnresolvedDefinitionIDs) != 1 || result.UnresolvedDefinitionIDs[0] != "def-company" {
+		t.Fatalf("expected company to stay unresolved, got %v", result.UnresolvedDefinitionIDs)
+	}
+	got := map[string]string{}
+	for _, suggestion := range result.Suggestions {
+		got[suggestion.FieldDefinitionID] = suggestion.Label
+	}
+	want := map[string]string{"def-sign": "buyer.signature", "def-date": "buyer.dateSigned", "def-terms": "accept"}
+	for defID, name := range want {
+		if got[defID] != name {
+			t.Fatalf("expected %s -> %s, got %v", defID, name, got)
+		}
+	}
+
+	again, err := resolver.Resolve(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	for i := range result.Suggestions {
+		if result.Suggestions[i].ID != again.Suggestions[i].ID || result.Suggestions[i].Confidence != again.Suggestions[i].Confidence {
+			t.Fatalf("expected deterministic suggestions, got %+v vs %+v", result.Suggestions[i], again.Suggestions[i])
+		}
+	}
+
+	estimate, err := resolver.Estimate(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if estimate.Reason != "native_forms_detected" || estimate.Accuracy <= 0.8 {
+		t.Fatalf("expected coverage-weighted native estimate, got %+v", estimate)
+	}
+}
@@ -2,21 +2,187 @@ package resolvers
 
 import (
 	"context"
+	"math"
+	"time"
 
 	"github.com/goliatone/go-admin/pkg/placement/models"
 )
 
 const OCRAnchorResolverID = "ocr_anchor_resolver"
 
-// OCRAnchorResolver is a contract-first stub for OCR-assisted placement strategies.
-type OCRAnchorResolver struct{}
+const (
+	defaultOCRCostPerPage       = 0.2
+	defaultOCRLatencyPerPage    = 2 * time.Second
+	defaultOCRLatencyReference  = 30 * time.Second
+	defaultOCRMinWordConfidence = 0.4
+)
+
+// OCRAnchorResolver finds label anchors in scanned PDFs using word boxes from
+// an OCREngine. Cost and latency scale with page count and are reported in
+// the estimate so policy budgets can skip OCR before it runs. The zero value
+// has no engine and reports itself unsupported; register a configured
+// instance with NewOCRAnchorResolver to replace the default entry.
+type OCRAnchorResolver struct {
+	Engine OCREngine
+	// CostPerPage is charged against the policy MaxBudget for each page.
+	CostPerPage float64
+	// LatencyPerPage is the expected recognition time for one page.
+	LatencyPerPage time.Duration
+	// LatencyReference is the duration reported as the maximum latency score (1.0).
+	LatencyReference time.Duration
+	// MinWordConfidence drops low-confidence words (0-1) before anchor matching.
+	MinWordConfidence float64
+}
+
+// NewOCRAnchorResolver returns an OCR anchor resolver with default cost and latency models.
+func NewOCRAnchorResolver(engine OCREngine) OCRAnchorResolver {
+	return OCRAnchorResolver{
+		Engine:            engine,
+		CostPerPage:       defaultOCRCostPerPage,
+		LatencyPerPage:    defaultOCRLatencyPerPage,
+		LatencyReference:  defaultOCRLatencyReference,
+		MinWordConfidence: defaultOCRMinWordConfidence,
+	}
+}
 
 func (OCRAnchorResolver) ID() string { return OCRAnchorResolverID }
 
-func (OCRAnchorResolver) Estimate(_ context.Context, _ ResolveInput) (models.Estimate, error) {
-	return unsupportedEstimate(OCRAnchorResolverID, 0.65, 0.8, 0.9), nil
+func (r OCRAnchorResolver) Estimate(ctx context.Context, input ResolveInput) (models.Estimate, error) {
+	if r.Engine == nil {
+		return models.Estimate{
+			ResolverID: OCRAnchorResolverID,
+			Accuracy:   0.65,
+			Cost:       0.8,
+			Latency:    0.9,
+			Reason:     "ocr_engine_missing",
+		}, nil
+	}
+	pages := ocrDocumentPages(ctx, input)
+	cost, expected := r.projectedCost(len(pages))
+	estimate := models.Estimate{
+		ResolverID: OCRAnchorResolverID,
+		Accuracy:   0.75,
+		Cost:       cost,
+		Latency:    r.latencyScore(expected),
+		Supported:  true,
+		Reason:     "scanned_document",
+	}
+	switch {
+	case len(input.DocumentBytes) == 0:
+		estimate.Supported = false
+		estimate.Reason = "document_missing"
+	case input.BudgetRemaining > 0 && cost > input.BudgetRemaining:
+		estimate.Supported = false
+		estimate.Reason = "budget_exceeded"
+	case input.TimeRemaining > 0 && expected > input.TimeRemaining:
+		estimate.Supported = false
+		estimate.Reason = "time_budget_exceeded"
+	default:
+		if layer, err := loadPDFTextLayer(ctx, input); err == nil && !layer.empty() {
+			estimate.Accuracy = 0.5
+			estimate.Reason = "text_layer_present"
+		}
+	}
+	return estimate, nil
+}
+
+func (r OCRAnchorResolver) Resolve(ctx context.Context, input ResolveInput) (models.ResolveResult, error) {
+	definitions := append([]models.FieldDefinition{}, input.FieldDefinitions...)
+	if len(definitions) == 0 {
+		return models.ResolveResult{}, nil
+	}
+	if r.Engine == nil || len(input.DocumentBytes) == 0 {
+		return unresolvedDefinitions(input), nil
+	}
+	pages := ocrDocumentPages(ctx, input)
+	if cost, _ := r.projectedCost(len(pages)); input.BudgetRemaining > 0 && cost > input.BudgetRemaining {
+		return unresolvedDefinitions(input), nil
+	}
+	recognized, err := r.Engine.Recognize(ctx, OCRRequest{
+		DocumentBytes: input.DocumentBytes,
+		PageCount:     len(pages),
+	})
+	if err != nil {
+		return models.ResolveResult{}, err
+	}
+	layer := r.ocrTextLayer(pages, recognized)
+	return resolveTextAnchors(OCRAnchorResolverID, definitions, layer), nil
+}
+
+func (r OCRAnchorResolver) projectedCost(pageCount int) (float64, time.Duration) {
+	pageCount = max(pageCount, 1)
+	costPerPage := r.CostPerPage
+	if costPerPage <= 0 {
+		costPerPage = defaultOCRCostPerPage
+	}
+	latencyPerPage := r.LatencyPerPage
+	if latencyPerPage <= 0 {
+		latencyPerPage = defaultOCRLatencyPerPage
+	}
+	return costPerPage * float64(pageCount), latencyPerPage * time.Duration(pageCount)
+}
+
+func (r OCRAnchorResolver) latencyScore(expected time.Duration) float64 {
+	reference := r.LatencyReference
+	if reference <= 0 {
+		reference = defaultOCRLatencyReference
+	}
+	return math.Min(float64(expected)/float64(reference), 1)
+}
+
+// ocrDocumentPages returns page boxes from the PDF when it parses, otherwise
+// US Letter placeholders for the declared page count.
+func ocrDocumentPages(ctx context.Context, input ResolveInput) []pdfPage {
+	if doc, err := loadPDFDocument(ctx, input.DocumentBytes, input.DocumentFingerprint); err == nil {
+		if pages := doc.pages(); len(pages) > 0 {
+			return pages
+		}
+	}
+	count := max(input.DocumentPageCount, 1)
+	out := make([]pdfPage, 0, count)
+	for i := range count {
+		out = append(out, pdfPage{Number: i + 1, MediaBox: [4]float64{0, 0, 612, 792}})
+	}
+	return out
 }
 
-func (OCRAnchorResolver) Resolve(_ context.Context, input ResolveInput) (models.ResolveResult, error) {
-	return unresolvedDefinitions(input), nil
+// ocrTextLayer converts top-left image-space word boxes into PDF user space
+// runs so the text-anchor matcher and geometry rules apply unchanged.
+func (r OCRAnchorResolver) ocrTextLayer(pages []pdfPage, recognized []OCRPage) pdfTextLayer {
+	layer := pdfTextLayer{Pages: pages}
+	minConfidence := r.MinWordConfidence
+	for _, ocrPage := range recognized {
+		page, ok := layer.page(ocrPage.PageNumber)
+		if !ok {
+			page = pdfPage{Number: ocrPage.PageNumber, MediaBox: [4]float64{0, 0, 612, 792}}
+			layer.Pages = append(layer.Pages, page)
+		}
+		pageWidth := page.MediaBox[2] - page.MediaBox[0]
+		pageHeight := page.MediaBox[3] - page.MediaBox[1]
+		scaleX, scaleY := 1.0, 1.0
+		if ocrPage.Width > 0 && ocrPage.Height > 0 {
+			scaleX = pageWidth / ocrPage.Width
+			scaleY = pageHeight / ocrPage.Height
+		}
+		runs := make([]pdfTextRun, 0, len(ocrPage.Words))
+		for _, word := range ocrPage.Words {
+			if word.Text == "" || word.Width <= 0 || word.Height <= 0 {
+				continue
+			}
+			if word.Confidence > 0 && word.Confidence < minConfidence {
+				continue
+			}
+			runs = append(runs, pdfTextRun{
+				Page:    page.Number,
+				X:       page.MediaBox[0] + word.X*scaleX,
+				Y:       page.MediaBox[3] - (word.Y+word.Height)*scaleY,
+				Width:   word.Width * scaleX,
+				Height:  word.Height * scaleY,
+				Text:    word.Text + " ",
+				Quality: math.Min(word.Confidence, 1),
+			})
+		}
+		layer.Lines = append(layer.Lines, groupPDFTextLines(runs)...)
+	}
+	return layer
 }
@@ -0,0 +1,167 @@
+package resolvers
+
+import (
+	"context"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+func loadOCRFixtureEngine(t *testing.T) *FixtureOCREngine {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", "ocr_scanned_contract.json"))
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer file.Close()
+	engine, err := LoadOCRFixture(file)
+	if err != nil {
+		t.Fatalf("LoadOCRFixture: %v", err)
+	}
+	return engine
+}
+
+func TestOCRAnchorResolverMatchesScannedAnchors(t *testing.T) {
+	engine := loadOCRFixtureEngine(t)
+	scanned := buildTextPDF(t, "q 612 0 0 792 0 0 cm /Im0 Do Q")
+	resolver := NewOCRAnchorResolver(engine)
+
+	result, err := resolver.Resolve(context.Background(), ResolveInput{
+		DocumentBytes: scanned,
+		FieldDefinitions: []models.FieldDefinition{
+			{ID: "sig", FieldType: "signature", Label: "Signature"},
+			{ID: "date", FieldType: "date", Label: "Date"},
+			{ID: "initials", FieldType: "initials", Label: "Initials"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if engine.Calls() != 1 {
+		t.Fatalf("expected one OCR call, got %d", engine.Calls())
+	}
+	if len(result.UnresolvedDefinitionIDs) != 1 || result.UnresolvedDefinitionIDs[0] != "initials" {
+		t.Fatalf("expected low-confidence initials anchor to be ignored, got %v", result.UnresolvedDefinitionIDs)
+	}
+	byField := map[string]models.Suggestion{}
+	for _, suggestion := range result.Suggestions {
+		byField[suggestion.FieldDefinitionID] = suggestion
+	}
+	sig := byField["sig"]
+	if sig.ResolverID != OCRAnchorResolverID || sig.Metadata["placement"] != "placeholder" {
+		t.Fatalf("expected OCR placeholder suggestion, got %+v", sig)
+	}
+	// 730px at 300dpi on a 2550px wide image maps to 175.2pt on a 612pt page.
+	if sig.Geometry.X < 170 || sig.Geometry.X > 180 || sig.Geometry.Y > 150 {
+		t.Fatalf("expected signature converted to PDF space, got %+v", sig.Geometry)
+	}
+	if sig.Confidence <= 0.5 || sig.Confidence >= textAnchorLabelConfidence+textAnchorPlaceholderBonus {
+		t.Fatalf("expected OCR confidence to temper suggestion confidence, got %.3f", sig.Confidence)
+	}
+}
+
+func TestOCRAnchorResolverEstimateHonoursBudgets(t *testing.T) {
+	ctx := context.Background()
+	engine := NewFixtureOCREngine()
+	scanned := buildTextPDF(t, "q Q", "q Q", "q Q")
+	resolver := NewOCRAnchorResolver(engine)
+
+	estimate, err := OCRAnchorResolver{}.Estimate(ctx, ResolveInput{DocumentBytes: scanned})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if estimate.Supported || estimate.Reason != "ocr_engine_missing" {
+		t.Fatalf("expected missing engine estimate, got %+v", estimate)
+	}
+
+	estimate, err = resolver.Estimate(ctx, ResolveInput{DocumentBytes: scanned})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if !estimate.Supported || estimate.Reason != "scanned_document" || math.Abs(estimate.Cost-0.6) > 1e-9 {
+		t.Fatalf("expected per-page cost for three pages, got %+v", estimate)
+	}
+
+	estimate, err = resolver.Estimate(ctx, ResolveInput{DocumentBytes: scanned, BudgetRemaining: 0.5})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if estimate.Supported || estimate.Reason != "budget_exceeded" {
+		t.Fatalf("expected budget_exceeded, got %+v", estimate)
+	}
+
+	estimate, err = resolver.Estimate(ctx, ResolveInput{DocumentBytes: scanned, TimeRemaining: time.Second})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if estimate.Supported || estimate.Reason != "time_budget_exceeded" {
+		t.Fatalf("expected time_budget_exceeded, got %+v", estimate)
+	}
+
+	result, err := resolver.Resolve(ctx, ResolveInput{
+		DocumentBytes:    scanned,
+		BudgetRemaining:  0.1,
+		FieldDefinitions: []models.FieldDefinition{{ID: "sig", FieldType: "signature"}},
+	})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if engine.Calls() != 0 || len(result.UnresolvedDefinitionIDs) != 1 {
+		t.Fatalf("expected over-budget resolve to skip OCR, calls=%d result=%+v", engine.Calls(), result)
+	}
+}
+
+func TestParseTesseractTSV(t *testing.T) {
+	file, err := os.Open(filepath.Join("testdata", "ocr_tesseract.tsv"))
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer file.Close()
+	pages, err := ParseTesseractTSV(file)
+	if err != nil {
+		t.Fatalf("ParseTesseractTSV: %v", err)
+	}
+	if len(pages) != 2 || pages[0].Width != 2550 || pages[0].Height != 3300 {
+		t.Fatalf("expected two sized pages, got %+v", pages)
+	}
+	if len(pages[0].Words) != 2 || pages[0].Words[0].Text != "Signature:" || pages[0].Words[0].Confidence != 0.935 {
+		t.Fatalf("unexpected page 1 words %+v", pages[0].Words)
+	}
+	if len(pages[1].Words) != 1 || pages[1].Words[0].Text != "Date" {
+		t.Fatalf("unexpected page 2 words %+v", pages[1].Words)
+	}
+}
+
+func TestCommandOCREngineRunsLocalBinary(t *testing.T) {
+	shell, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+	fixture, err := filepath.Abs(filepath.Join("testdata", "ocr_tesseract.tsv"))
+	if err != nil {
+		t.Fatalf("abs: %v", err)
+	}
+	engine := CommandOCREngine{
+		Binary:  shell,
+		Args:    []string{"-c", `test -s "$1" && cat "$0"`, fixture, "{input}"},
+		Timeout: 5 * time.Second,
+		TempDir: t.TempDir(),
+	}
+	pages, err := engine.Recognize(context.Background(), OCRRequest{DocumentBytes: []byte("%PDF-1.7"), PageCount: 2})
+	if err != nil {
+		t.Fatalf("Recognize: %v", err)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("expected two pages from command output, got %+v", pages)
+	}
+
+	failing := CommandOCREngine{Binary: shell, Args: []string{"-c", "echo boom >&2; exit 3"}}
+	if _, err := failing.Recognize(context.Background(), OCRRequest{DocumentBytes: []byte("x")}); err == nil {
+		t.Fatalf("expected command failure to surface")
+	}
+}
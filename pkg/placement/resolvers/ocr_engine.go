@@ -0,0 +1,292 @@
+package resolvers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OCRWord is one recognized word. Coordinates use the page image space of
+// the owning OCRPage: origin at the top-left corner, Y grows downwards,
+// which matches Tesseract TSV output.
+type OCRWord struct {
+	Text       string  `json:"text"`
+	X          float64 `json:"x"`
+	Y          float64 `json:"y"`
+	Width      float64 `json:"width"`
+	Height     float64 `json:"height"`
+	Confidence float64 `json:"confidence"`
+}
+
+// OCRPage carries recognized words for one 1-based page and the image
+// dimensions those word boxes are expressed in.
+type OCRPage struct {
+	PageNumber int       `json:"page_number"`
+	Width      float64   `json:"width"`
+	Height     float64   `json:"height"`
+	Words      []OCRWord `json:"words"`
+}
+
+// OCRRequest is the input handed to an OCR backend.
+type OCRRequest struct {
+	DocumentBytes []byte `json:"document_bytes"`
+	PageCount     int    `json:"page_count"`
+}
+
+// OCREngine recognizes word boxes for every page of a scanned document.
+type OCREngine interface {
+	Recognize(ctx context.Context, req OCRRequest) ([]OCRPage, error)
+}
+
+// FixtureOCREngine is an offline OCREngine that replays recorded word boxes.
+// Documents are matched by SHA-256 digest; Default answers any document
+// without a dedicated fixture.
+type FixtureOCREngine struct {
+	mu        sync.RWMutex
+	documents map[string][]OCRPage
+	Default   []OCRPage
+	calls     int
+}
+
+// OCRFixture is the JSON shape accepted by LoadOCRFixture.
+type OCRFixture struct {
+	DocumentSHA256 string    `json:"document_sha256"`
+	Pages          []OCRPage `json:"pages"`
+}
+
+// NewFixtureOCREngine returns a fixture engine answering every document with pages.
+func NewFixtureOCREngine(pages ...OCRPage) *FixtureOCREngine {
+	return &FixtureOCREngine{
+		documents: map[string][]OCRPage{},
+		Default:   append([]OCRPage{}, pages...),
+	}
+}
+
+// LoadOCRFixture decodes one OCRFixture (or a JSON array of them) into a
+// fixture engine. Fixtures without a digest become the default response.
+func LoadOCRFixture(r io.Reader) (*FixtureOCREngine, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fixtures := []OCRFixture{}
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &fixtures)
+	} else {
+		var fixture OCRFixture
+		err = json.Unmarshal(trimmed, &fixture)
+		fixtures = append(fixtures, fixture)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode ocr fixture: %w", err)
+	}
+	engine := NewFixtureOCREngine()
+	for _, fixture := range fixtures {
+		digest := strings.ToLower(strings.TrimSpace(fixture.DocumentSHA256))
+		if digest == "" {
+			engine.Default = append([]OCRPage{}, fixture.Pages...)
+			continue
+		}
+		engine.documents[digest] = append([]OCRPage{}, fixture.Pages...)
+	}
+	return engine, nil
+}
+
+// AddDocument registers pages for a specific document payload.
+func (e *FixtureOCREngine) AddDocument(document []byte, pages ...OCRPage) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.documents == nil {
+		e.documents = map[string][]OCRPage{}
+	}
+	e.documents[ocrDocumentDigest(document)] = append([]OCRPage{}, pages...)
+}
+
+// Calls reports how many times Recognize ran, for cost assertions in tests.
+func (e *FixtureOCREngine) Calls() int {
+	if e == nil {
+		return 0
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.calls
+}
+
+func (e *FixtureOCREngine) Recognize(ctx context.Context, req OCRRequest) ([]OCRPage, error) {
+	if e == nil {
+		return nil, errors.New("ocr fixture engine not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls++
+	if pages, ok := e.documents[ocrDocumentDigest(req.DocumentBytes)]; ok {
+		return append([]OCRPage{}, pages...), nil
+	}
+	return append([]OCRPage{}, e.Default...), nil
+}
+
+func ocrDocumentDigest(document []byte) string {
+	sum := sha256.Sum256(document)
+	return hex.EncodeToString(sum[:])
+}
+
+// CommandOCREngine runs a local OCR binary that prints Tesseract-compatible
+// TSV (level, page_num, ..., left, top, width, height, conf, text) to stdout.
+// The document is written to a temporary file whose path replaces the
+// "{input}" argument placeholder, or is appended when no placeholder exists.
+// A wrapper script such as `pdftoppm -r 300 "$1" - | tesseract - - tsv`
+// satisfies the contract.
+type CommandOCREngine struct {
+	Binary  string
+	Args    []string
+	Timeout time.Duration
+	TempDir string
+}
+
+const ocrInputPlaceholder = "{input}"
+
+func (e CommandOCREngine) Recognize(ctx context.Context, req OCRRequest) ([]OCRPage, error) {
+	binary := strings.TrimSpace(e.Binary)
+	if binary == "" {
+		return nil, errors.New("ocr command binary not configured")
+	}
+	file, err := os.CreateTemp(e.TempDir, "placement-ocr-*.pdf")
+	if err != nil {
+		return nil, fmt.Errorf("create ocr input: %w", err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(req.DocumentBytes); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("write ocr input: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("write ocr input: %w", err)
+	}
+
+	if e.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.Timeout)
+		defer cancel()
+	}
+	args := make([]string, 0, len(e.Args)+1)
+	replaced := false
+	for _, arg := range e.Args {
+		if strings.Contains(arg, ocrInputPlaceholder) {
+			arg = strings.ReplaceAll(arg, ocrInputPlaceholder, file.Name())
+			replaced = true
+		}
+		args = append(args, arg)
+	}
+	if !replaced {
+		args = append(args, file.Name())
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, fmt.Errorf("ocr command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return ParseTesseractTSV(&stdout)
+}
+
+// ParseTesseractTSV converts Tesseract TSV output into OCR pages. Level 1
+// rows provide page dimensions; level 5 rows provide words.
+func ParseTesseractTSV(r io.Reader) ([]OCRPage, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	pages := map[int]*OCRPage{}
+	header := true
+	for scanner.Scan() {
+		line := scanner.Text()
+		if header {
+			header = false
+			if strings.HasPrefix(line, "level") {
+				continue
+			}
+		}
+		cols := strings.Split(line, "\t")
+		if len(cols) < 11 {
+			continue
+		}
+		level, errLevel := strconv.Atoi(cols[0])
+		pageNum, errPage := strconv.Atoi(cols[1])
+		if errLevel != nil || errPage != nil {
+			continue
+		}
+		box, ok := parseTSVBox(cols[6:10])
+		if !ok {
+			continue
+		}
+		page := pages[pageNum]
+		if page == nil {
+			page = &OCRPage{PageNumber: pageNum}
+			pages[pageNum] = page
+		}
+		switch level {
+		case 1:
+			page.Width, page.Height = box[2], box[3]
+		case 5:
+			text := ""
+			if len(cols) > 11 {
+				text = strings.TrimSpace(cols[11])
+			}
+			if text == "" {
+				continue
+			}
+			confidence, _ := strconv.ParseFloat(strings.TrimSpace(cols[10]), 64)
+			page.Words = append(page.Words, OCRWord{
+				Text:       text,
+				X:          box[0],
+				Y:          box[1],
+				Width:      box[2],
+				Height:     box[3],
+				Confidence: confidence / 100,
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	out := make([]OCRPage, 0, len(pages))
+	for _, page := range pages {
+		out = append(out, *page)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].PageNumber < out[j].PageNumber })
+	return out, nil
+}
+
+func parseTSVBox(cols []string) ([4]float64, bool) {
+	var out [4]float64
+	for i, col := range cols {
+		value, err := strconv.ParseFloat(strings.TrimSpace(col), 64)
+		if err != nil {
+			return out, false
+		}
+		out[i] = value
+	}
+	return out, true
+}
@@ -0,0 +1,266 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+// AcroForm field flag bits (PDF 32000-1, tables 226 and 228).
+const (
+	acroFormFlagRequired   = 1 << 1
+	acroFormFlagRadio      = 1 << 15
+	acroFormFlagPushButton = 1 << 16
+)
+
+const maxAcroFormDepth = 16
+
+var errNoAcroFormFields = errors.New("pdf: no form fields found")
+
+// acroFormField accumulates inheritable field attributes down the field tree.
+type acroFormField struct {
+	name     string
+	label    string
+	ft       string
+	flags    int
+	required bool
+}
+
+type acroFormWalker struct {
+	doc         *pdfDocument
+	pageByRef   map[int]int
+	pageByAnnot map[int]int
+	visited     map[int]bool
+	fields      []models.NativeFormField
+}
+
+// ExtractNativeFormFields walks the document AcroForm field tree and returns
+// one entry per widget annotation, in page then top-to-bottom order. When the
+// catalog has no /AcroForm dictionary, orphan widget annotations are used.
+func ExtractNativeFormFields(pdf []byte) ([]models.NativeFormField, error) {
+	return ExtractNativeFormFieldsContext(context.Background(), pdf)
+}
+
+// ExtractNativeFormFieldsContext is ExtractNativeFormFields reusing the parsed
+// document cached on ctx by WithDocumentCache.
+func ExtractNativeFormFieldsContext(ctx context.Context, pdf []byte) ([]models.NativeFormField, error) {
+	if len(pdf) == 0 {
+		return nil, errNoAcroFormFields
+	}
+	doc, err := loadPDFDocument(ctx, pdf, "")
+	if err != nil {
+		return nil, err
+	}
+	pages := doc.pages()
+	walker := &acroFormWalker{
+		doc:         doc,
+		pageByRef:   doc.pageIndex(pages),
+		pageByAnnot: widgetPageIndex(doc, pages),
+		visited:     map[int]bool{},
+	}
+	if catalog := doc.catalog(); catalog != nil {
+		if acroForm := doc.dict(catalog["AcroForm"]); acroForm != nil {
+			for _, field := range doc.array(acroForm["Fields"]) {
+				walker.walk(field, acroFormField{}, 0)
+			}
+		}
+	}
+	if len(walker.fields) == 0 {
+		walker.collectOrphanWidgets()
+	}
+	if len(walker.fields) == 0 {
+		return nil, errNoAcroFormFields
+	}
+	sortNativeFormFields(walker.fields)
+	return walker.fields, nil
+}
+
+// widgetPageIndex maps annotation object numbers to page numbers using each
+// page's /Annots array, for widgets that omit the optional /P entry.
+func widgetPageIndex(doc *pdfDocument, pages []pdfPage) map[int]int {
+	out := map[int]int{}
+	for _, page := range pages {
+		for _, annot := range doc.array(page.Dict["Annots"]) {
+			if ref, ok := annot.(pdfRef); ok {
+				out[ref.Num] = page.Number
+			}
+		}
+	}
+	return out
+}
+
+func (w *acroFormWalker) walk(node any, parent acroFormField, depth int) {
+	if depth > maxAcroFormDepth {
+		return
+	}
+	ref, _ := node.(pdfRef)
+	if ref.Num > 0 {
+		if w.visited[ref.Num] {
+			return
+		}
+		w.visited[ref.Num] = true
+	}
+	dict := w.doc.dict(node)
+	if dict == nil {
+		return
+	}
+	field := w.inherit(parent, dict)
+	kids := w.doc.array(dict["Kids"])
+	if len(kids) == 0 {
+		w.addWidget(ref, dict, field)
+		return
+	}
+	for _, kid := range kids {
+		kidDict := w.doc.dict(kid)
+		if kidDict == nil {
+			continue
+		}
+		if _, named := kidDict["T"]; !named && kidDict.name("Subtype") == "Widget" {
+			kidRef, _ := kid.(pdfRef)
+			if kidRef.Num > 0 {
+				w.visited[kidRef.Num] = true
+			}
+			w.addWidget(kidRef, kidDict, field)
+			continue
+		}
+		w.walk(kid, field, depth+1)
+	}
+}
+
+func (w *acroFormWalker) inherit(parent acroFormField, dict pdfDict) acroFormField {
+	field := parent
+	if partial := w.textValue(dict["T"]); partial != "" {
+		if field.name == "" {
+			field.name = partial
+		} else {
+			field.name += "." + partial
+		}
+	}
+	if label := w.textValue(dict["TU"]); label != "" {
+		field.label = label
+	}
+	if ft := dict.name("FT"); ft != "" {
+		field.ft = ft
+	}
+	if flags, ok := w.doc.intValue(dict["Ff"]); ok {
+		field.flags = flags
+		field.required = flags&acroFormFlagRequired != 0
+	}
+	return field
+}
+
+func (w *acroFormWalker) textValue(value any) string {
+	if raw, ok := w.doc.resolve(value).(pdfString); ok {
+		return strings.TrimSpace(decodePDFTextString(raw))
+	}
+	return ""
+}
+
+func (w *acroFormWalker) addWidget(ref pdfRef, dict pdfDict, field acroFormField) {
+	rect, ok := w.doc.rect(dict["Rect"])
+	if !ok || rect[2]-rect[0] <= 0 || rect[3]-rect[1] <= 0 {
+		return
+	}
+	if field.name == "" {
+		return
+	}
+	page := 1
+	if pageRef, ok := dict["P"].(pdfRef); ok {
+		if number, found := w.pageByRef[pageRef.Num]; found {
+			page = number
+		}
+	} else if number, found := w.pageByAnnot[ref.Num]; found {
+		page = number
+	}
+	w.fields = append(w.fields, models.NativeFormField{
+		Name:          field.name,
+		Label:         field.label,
+		FieldTypeHint: acroFormTypeHint(field),
+		Required:      field.required,
+		Geometry: models.Geometry{
+			PageNumber: page,
+			X:          rect[0],
+			Y:          rect[1],
+			Width:      rect[2] - rect[0],
+			Height:     rect[3] - rect[1],
+		},
+	})
+}
+
+// collectOrphanWidgets handles documents whose widgets are not reachable
+// from a catalog AcroForm, pulling names from the widget or its /Parent.
+func (w *acroFormWalker) collectOrphanWidgets() {
+	for _, num := range w.doc.sortedObjectNumbers() {
+		dict := w.doc.dict(w.doc.objects[num])
+		if dict == nil || dict.name("Subtype") != "Widget" {
+			continue
+		}
+		field := acroFormField{}
+		if parent := w.doc.dict(dict["Parent"]); parent != nil {
+			field = w.inherit(field, parent)
+		}
+		field = w.inherit(field, dict)
+		w.addWidget(pdfRef{Num: num}, dict, field)
+	}
+}
+
+// acroFormTypeHint maps AcroForm field types onto placement field types,
+// refining text fields from the field name ("Date Signed" -> date).
+func acroFormTypeHint(field acroFormField) string {
+	switch field.ft {
+	case "Sig":
+		return "signature"
+	case "Btn":
+		if field.flags&acroFormFlagPushButton != 0 {
+			return "button"
+		}
+		if field.flags&acroFormFlagRadio != 0 {
+			return "radio"
+		}
+		return "checkbox"
+	case "Ch":
+		return "choice"
+	}
+	name := canonicalToken(field.name + " " + field.label)
+	for _, hint := range []string{"signature", "initials", "initial", "date", "email", "name", "title", "company"} {
+		if strings.Contains(name, hint) {
+			return canonicalFieldType(hint)
+		}
+	}
+	if field.ft == "Tx" {
+		return "text"
+	}
+	return ""
+}
+
+func sortNativeFormFields(fields []models.NativeFormField) {
+	sort.SliceStable(fields, func(i, j int) bool {
+		left, right := fields[i].Geometry, fields[j].Geometry
+		if left.PageNumber != right.PageNumber {
+			return left.PageNumber < right.PageNumber
+		}
+		if left.Y != right.Y {
+			return left.Y > right.Y
+		}
+		if left.X != right.X {
+			return left.X < right.X
+		}
+		return fields[i].Name < fields[j].Name
+	})
+}
+
+// decodePDFTextString decodes PDF text strings (UTF-16BE with BOM or
+// PDFDocEncoding, approximated as Latin-1) used for field names and labels.
+func decodePDFTextString(raw pdfString) string {
+	if len(raw) >= 2 && raw[0] == 0xFE && raw[1] == 0xFF {
+		return decodeUTF16BE(raw[2:])
+	}
+	runes := make([]rune, 0, len(raw))
+	for _, b := range raw {
+		runes = append(runes, rune(b))
+	}
+	return string(runes)
+}
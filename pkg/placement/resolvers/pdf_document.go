@@ -0,0 +1,897 @@
+package resolvers
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync/atomic"
+)
+
+// pdfName, pdfString and friends model the subset of the PDF object graph the
+// placement resolvers need. Parsing is intentionally lenient: objects are
+// located by scanning for "N G obj" headers instead of trusting xref offsets,
+// which keeps incrementally updated and slightly damaged uploads readable.
+type (
+	pdfName    string
+	pdfString  []byte
+	pdfKeyword string
+	pdfArray   []any
+	pdfDict    map[pdfName]any
+)
+
+type pdfRef struct {
+	Num int
+	Gen int
+}
+
+type pdfStream struct {
+	Dict pdfDict
+	Raw  []byte
+}
+
+const (
+	maxPDFResolveDepth = 32
+	// maxPDFObjectDepth bounds dictionary and array nesting while lexing.
+	maxPDFObjectDepth = 64
+	// maxPDFStreamDecodedBytes and maxPDFDocumentDecodedBytes cap filter
+	// output so a small compressed upload cannot expand without bound.
+	maxPDFStreamDecodedBytes   = 32 << 20
+	maxPDFDocumentDecodedBytes = 128 << 20
+)
+
+var (
+	errPDFUnexpectedEOF = errors.New("pdf: unexpected end of data")
+	errPDFNestingDepth  = errors.New("pdf: object nesting too deep")
+	errPDFDecodeLimit   = errors.New("pdf: decoded stream exceeds size limit")
+	pdfObjectHeader     = regexp.MustCompile(`(?m)(\d+)\s+(\d+)\s+obj\b`)
+	pdfTrailerKeyword   = []byte("trailer")
+	pdfStreamKeyword    = []byte("stream")
+	pdfEndStreamKeyword = []byte("endstream")
+)
+
+// pdfDocument is an in-memory index of parsed indirect objects. It is
+// read-only after parsing apart from the decode budget, so one parsed
+// document can be shared between resolver calls.
+type pdfDocument struct {
+	objects map[int]any
+	trailer pdfDict
+	decoded atomic.Int64
+}
+
+func parsePDFDocument(data []byte) (*pdfDocument, error) {
+	if !bytes.Contains(data, []byte("%PDF")) {
+		return nil, errors.New("pdf: missing header")
+	}
+	doc := &pdfDocument{objects: map[int]any{}, trailer: pdfDict{}}
+	consumed := 0
+	for _, loc := range pdfObjectHeader.FindAllSubmatchIndex(data, -1) {
+		if loc[0] < consumed {
+			// Header-looking bytes inside a stream body we already consumed.
+			continue
+		}
+		num, err := strconv.Atoi(string(data[loc[2]:loc[3]]))
+		if err != nil {
+			continue
+		}
+		lexer := &pdfLexer{data: data, pos: loc[1]}
+		value, err := lexer.readObject()
+		if err != nil {
+			continue
+		}
+		if dict, ok := value.(pdfDict); ok {
+			if raw, ok := lexer.readStreamBody(dict); ok {
+				value = pdfStream{Dict: dict, Raw: raw}
+			}
+		}
+		consumed = lexer.pos
+		doc.objects[num] = value
+	}
+	if len(doc.objects) == 0 {
+		return nil, errors.New("pdf: no indirect objects found")
+	}
+	if err := doc.expandObjectStreams(); err != nil {
+		return nil, err
+	}
+	doc.trailer = doc.findTrailer(data)
+	return doc, nil
+}
+
+func (d *pdfDocument) findTrailer(data []byte) pdfDict {
+	trailer := pdfDict{}
+	offset := 0
+	for {
+		idx := bytes.Index(data[offset:], pdfTrailerKeyword)
+		if idx < 0 {
+			break
+		}
+		lexer := &pdfLexer{data: data, pos: offset + idx + len(pdfTrailerKeyword)}
+		if value, err := lexer.readObject(); err == nil {
+			if dict, ok := value.(pdfDict); ok {
+				for key, item := range dict {
+					trailer[key] = item
+				}
+			}
+		}
+		offset += idx + len(pdfTrailerKeyword)
+	}
+	if _, ok := trailer["Root"]; ok {
+		return trailer
+	}
+	for _, num := range d.sortedObjectNumbers() {
+		stream, ok := d.objects[num].(pdfStream)
+		if !ok || stream.Dict.name("Type") != "XRef" {
+			continue
+		}
+		for key, item := range stream.Dict {
+			trailer[key] = item
+		}
+	}
+	return trailer
+}
+
+// expandObjectStreams inlines objects packed in /ObjStm containers. Objects
+// defined directly in the file body take precedence over packed copies.
+// Damaged containers are skipped; exceeding the decode limit is an error.
+func (d *pdfDocument) expandObjectStreams() error {
+	for _, num := range d.sortedObjectNumbers() {
+		stream, ok := d.objects[num].(pdfStream)
+		if !ok || stream.Dict.name("Type") != "ObjStm" {
+			continue
+		}
+		decoded, err := d.decodeStream(stream)
+		if errors.Is(err, errPDFDecodeLimit) {
+			return err
+		}
+		if err != nil {
+			continue
+		}
+		count, _ := d.intValue(stream.Dict["N"])
+		first, _ := d.intValue(stream.Dict["First"])
+		if count <= 0 || first <= 0 || first > len(decoded) {
+			continue
+		}
+		header := &pdfLexer{data: decoded[:first]}
+		for range count {
+			objNum, errNum := header.readObject()
+			objOffset, errOffset := header.readObject()
+			if errNum != nil || errOffset != nil {
+				break
+			}
+			n, okNum := objNum.(int)
+			off, okOff := objOffset.(int)
+			if !okNum || !okOff || first+off >= len(decoded) {
+				continue
+			}
+			if _, exists := d.objects[n]; exists {
+				continue
+			}
+			body := &pdfLexer{data: decoded, pos: first + off}
+			value, err := body.readObject()
+			if err != nil {
+				continue
+			}
+			d.objects[n] = value
+		}
+	}
+	return nil
+}
+
+func (d *pdfDocument) sortedObjectNumbers() []int {
+	out := make([]int, 0, len(d.objects))
+	for num := range d.objects {
+		out = append(out, num)
+	}
+	sort.Ints(out)
+	return out
+}
+
+// resolve dereferences indirect references until a direct value is reached.
+func (d *pdfDocument) resolve(value any) any {
+	for range maxPDFResolveDepth {
+		ref, ok := value.(pdfRef)
+		if !ok {
+			return value
+		}
+		value = d.objects[ref.Num]
+	}
+	return nil
+}
+
+func (d *pdfDocument) dict(value any) pdfDict {
+	switch typed := d.resolve(value).(type) {
+	case pdfDict:
+		return typed
+	case pdfStream:
+		return typed.Dict
+	}
+	return nil
+}
+
+func (d *pdfDocument) array(value any) pdfArray {
+	if typed, ok := d.resolve(value).(pdfArray); ok {
+		return typed
+	}
+	return nil
+}
+
+func (d *pdfDocument) intValue(value any) (int, bool) {
+	switch typed := d.resolve(value).(type) {
+	case int:
+		return typed, true
+	case float64:
+		return int(typed), true
+	}
+	return 0, false
+}
+
+func (d *pdfDocument) number(value any) (float64, bool) {
+	switch typed := d.resolve(value).(type) {
+	case int:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	}
+	return 0, false
+}
+
+func (d *pdfDocument) rect(value any) ([4]float64, bool) {
+	var out [4]float64
+	items := d.array(value)
+	if len(items) != 4 {
+		return out, false
+	}
+	for i, item := range items {
+		parsed, ok := d.number(item)
+		if !ok {
+			return out, false
+		}
+		out[i] = parsed
+	}
+	return normalizePDFRect(out), true
+}
+
+func normalizePDFRect(in [4]float64) [4]float64 {
+	return [4]float64{min(in[0], in[2]), min(in[1], in[3]), max(in[0], in[2]), max(in[1], in[3])}
+}
+
+func (dict pdfDict) name(key pdfName) string {
+	if value, ok := dict[key].(pdfName); ok {
+		return string(value)
+	}
+	return ""
+}
+
+func (d *pdfDocument) catalog() pdfDict {
+	if root := d.dict(d.trailer["Root"]); root != nil {
+		return root
+	}
+	for _, num := range d.sortedObjectNumbers() {
+		if dict := d.dict(d.objects[num]); dict != nil && dict.name("Type") == "Catalog" {
+			return dict
+		}
+	}
+	return nil
+}
+
+// pdfPage is a flattened page tree leaf with inherited attributes applied.
+type pdfPage struct {
+	Number    int
+	Ref       pdfRef
+	Dict      pdfDict
+	Resources pdfDict
+	MediaBox  [4]float64
+}
+
+func (d *pdfDocument) pages() []pdfPage {
+	out := make([]pdfPage, 0)
+	if catalog := d.catalog(); catalog != nil {
+		visited := map[int]bool{}
+		d.walkPageTree(catalog["Pages"], pdfDict{}, visited, &out, 0)
+	}
+	if len(out) > 0 {
+		return out
+	}
+	for _, num := range d.sortedObjectNumbers() {
+		dict := d.dict(d.objects[num])
+		if dict == nil || dict.name("Type") != "Page" {
+			continue
+		}
+		out = append(out, d.newPage(len(out)+1, pdfRef{Num: num}, dict, pdfDict{}))
+	}
+	return out
+}
+
+func (d *pdfDocument) walkPageTree(node any, inherited pdfDict, visited map[int]bool, out *[]pdfPage, depth int) {
+	if depth > maxPDFResolveDepth {
+		return
+	}
+	ref, _ := node.(pdfRef)
+	if ref.Num > 0 {
+		if visited[ref.Num] {
+			return
+		}
+		visited[ref.Num] = true
+	}
+	dict := d.dict(node)
+	if dict == nil {
+		return
+	}
+	attrs := pdfDict{}
+	for key, value := range inherited {
+		attrs[key] = value
+	}
+	for _, key := range []pdfName{"Resources", "MediaBox", "CropBox", "Rotate"} {
+		if value, ok := dict[key]; ok {
+			attrs[key] = value
+		}
+	}
+	if dict.name("Type") == "Page" || dict["Kids"] == nil {
+		*out = append(*out, d.newPage(len(*out)+1, ref, dict, attrs))
+		return
+	}
+	for _, kid := range d.array(dict["Kids"]) {
+		d.walkPageTree(kid, attrs, visited, out, depth+1)
+	}
+}
+
+func (d *pdfDocument) newPage(number int, ref pdfRef, dict pdfDict, inherited pdfDict) pdfPage {
+	page := pdfPage{
+		Number:   number,
+		Ref:      ref,
+		Dict:     dict,
+		MediaBox: [4]float64{0, 0, 612, 792},
+	}
+	resources := dict["Resources"]
+	if resources == nil {
+		resources = inherited["Resources"]
+	}
+	page.Resources = d.dict(resources)
+	box := dict["MediaBox"]
+	if box == nil {
+		box = inherited["MediaBox"]
+	}
+	if rect, ok := d.rect(box); ok {
+		page.MediaBox = rect
+	}
+	return page
+}
+
+// pageIndex maps page object numbers to 1-based page numbers.
+func (d *pdfDocument) pageIndex(pages []pdfPage) map[int]int {
+	out := make(map[int]int, len(pages))
+	for _, page := range pages {
+		if page.Ref.Num > 0 {
+			out[page.Ref.Num] = page.Number
+		}
+	}
+	return out
+}
+
+// pageContents concatenates the decoded content streams of page. Streams that
+// fail to decode are skipped, except when the decode limit is reached.
+func (d *pdfDocument) pageContents(page pdfPage) ([]byte, error) {
+	var buf bytes.Buffer
+	contents := page.Dict["Contents"]
+	streams := []any{contents}
+	if items := d.array(contents); items != nil {
+		streams = items
+	}
+	for _, item := range streams {
+		stream, ok := d.resolve(item).(pdfStream)
+		if !ok {
+			continue
+		}
+		decoded, err := d.decodeStream(stream)
+		if errors.Is(err, errPDFDecodeLimit) {
+			return nil, err
+		}
+		if err != nil {
+			continue
+		}
+		buf.Write(decoded)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func (d *pdfDocument) decodeStream(stream pdfStream) ([]byte, error) {
+	data := stream.Raw
+	filters := []any{stream.Dict["Filter"]}
+	if items := d.array(stream.Dict["Filter"]); items != nil {
+		filters = items
+	}
+	params := []any{stream.Dict["DecodeParms"]}
+	if items := d.array(stream.Dict["DecodeParms"]); items != nil {
+		params = items
+	}
+	for i, filter := range filters {
+		name, _ := d.resolve(filter).(pdfName)
+		if name == "" {
+			continue
+		}
+		var parms pdfDict
+		if i < len(params) {
+			parms = d.dict(params[i])
+		}
+		decoded, err := d.applyFilter(name, data, parms)
+		if err != nil {
+			return nil, err
+		}
+		data = decoded
+	}
+	return data, nil
+}
+
+func (d *pdfDocument) applyFilter(name pdfName, data []byte, parms pdfDict) ([]byte, error) {
+	switch name {
+	case "FlateDecode", "Fl":
+		reader, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		limit, err := d.decodeLimit()
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := io.ReadAll(io.LimitReader(reader, limit+1))
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		if int64(len(decoded)) > limit {
+			return nil, errPDFDecodeLimit
+		}
+		d.decoded.Add(int64(len(decoded)))
+		return d.applyPredictor(decoded, parms)
+	case "ASCIIHexDecode", "AHx":
+		cleaned := make([]byte, 0, len(data))
+		for _, c := range data {
+			if c == '>' {
+				break
+			}
+			if isPDFHexDigit(c) {
+				cleaned = append(cleaned, c)
+			}
+		}
+		if len(cleaned)%2 == 1 {
+			cleaned = append(cleaned, '0')
+		}
+		limit, err := d.decodeLimit()
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(cleaned)/2) > limit {
+			return nil, errPDFDecodeLimit
+		}
+		out := make([]byte, len(cleaned)/2)
+		if _, err := hex.Decode(out, cleaned); err != nil {
+			return nil, err
+		}
+		d.decoded.Add(int64(len(out)))
+		return out, nil
+	}
+	return nil, fmt.Errorf("pdf: unsupported filter %s", name)
+}
+
+// decodeLimit returns how many bytes the next filter may produce: the
+// per-stream cap, reduced to what is left of the per-document budget.
+func (d *pdfDocument) decodeLimit() (int64, error) {
+	remaining := maxPDFDocumentDecodedBytes - d.decoded.Load()
+	if remaining <= 0 {
+		return 0, errPDFDecodeLimit
+	}
+	return min(int64(maxPDFStreamDecodedBytes), remaining), nil
+}
+
+// applyPredictor reverses PNG row predictors (Predictor >= 10), which PDF
+// writers commonly apply to xref and object streams.
+func (d *pdfDocument) applyPredictor(data []byte, parms pdfDict) ([]byte, error) {
+	if parms == nil {
+		return data, nil
+	}
+	predictor, _ := d.intValue(parms["Predictor"])
+	if predictor < 10 {
+		return data, nil
+	}
+	columns, ok := d.intValue(parms["Columns"])
+	if !ok || columns <= 0 {
+		columns = 1
+	}
+	colors, ok := d.intValue(parms["Colors"])
+	if !ok || colors <= 0 {
+		colors = 1
+	}
+	bits, ok := d.intValue(parms["BitsPerComponent"])
+	if !ok || bits <= 0 {
+		bits = 8
+	}
+	bpp := max((colors*bits+7)/8, 1)
+	rowLen := (columns*colors*bits + 7) / 8
+	out := make([]byte, 0, len(data))
+	prev := make([]byte, rowLen)
+	for offset := 0; offset+rowLen+1 <= len(data); offset += rowLen + 1 {
+		filterType := data[offset]
+		row := append([]byte{}, data[offset+1:offset+1+rowLen]...)
+		for i := range row {
+			var left, up, upLeft byte
+			if i >= bpp {
+				left = row[i-bpp]
+				upLeft = prev[i-bpp]
+			}
+			up = prev[i]
+			switch filterType {
+			case 1:
+				row[i] += left
+			case 2:
+				row[i] += up
+			case 3:
+				row[i] += byte((int(left) + int(up)) / 2)
+			case 4:
+				row[i] += paethPredictor(left, up, upLeft)
+			}
+		}
+		out = append(out, row...)
+		prev = row
+	}
+	return out, nil
+}
+
+func paethPredictor(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa := absInt(p - int(a))
+	pb := absInt(p - int(b))
+	pc := absInt(p - int(c))
+	if pa <= pb && pa <= pc {
+		return a
+	}
+	if pb <= pc {
+		return b
+	}
+	return c
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// pdfLexer tokenizes PDF object syntax and content stream operators.
+type pdfLexer struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func (l *pdfLexer) eof() bool { return l.pos >= len(l.data) }
+
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if !isPDFWhitespace(c) {
+			return
+		}
+		l.pos++
+	}
+}
+
+// readObject returns the next value. Unrecognized bare words are returned as
+// pdfKeyword so content stream operators flow through the same lexer.
+func (l *pdfLexer) readObject() (any, error) {
+	l.skipSpace()
+	if l.eof() {
+		return nil, errPDFUnexpectedEOF
+	}
+	c := l.data[l.pos]
+	switch {
+	case c == '<' && l.peek(1) == '<':
+		l.pos += 2
+		return l.nested(l.readDict)
+	case c == '<':
+		l.pos++
+		return l.readHexString()
+	case c == '[':
+		l.pos++
+		return l.nested(l.readArray)
+	case c == '(':
+		l.pos++
+		return l.readLiteralString()
+	case c == '/':
+		l.pos++
+		return l.readName(), nil
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return l.readNumberOrRef()
+	case c == ']' || c == '>' || c == ')' || c == '}' || c == '{':
+		l.pos++
+		if c == '>' && l.peek(0) == '>' {
+			l.pos++
+			return pdfKeyword(">>"), nil
+		}
+		return pdfKeyword(string(c)), nil
+	}
+	word := l.readWord()
+	switch word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	return pdfKeyword(word), nil
+}
+
+// nested reads a dictionary or array body, failing once nesting exceeds
+// maxPDFObjectDepth instead of recursing without bound.
+func (l *pdfLexer) nested(read func() (any, error)) (any, error) {
+	if l.depth >= maxPDFObjectDepth {
+		return nil, errPDFNestingDepth
+	}
+	l.depth++
+	defer func() { l.depth-- }()
+	return read()
+}
+
+func (l *pdfLexer) peek(offset int) byte {
+	if l.pos+offset >= len(l.data) {
+		return 0
+	}
+	return l.data[l.pos+offset]
+}
+
+func (l *pdfLexer) readWord() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	if l.pos == start {
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+func (l *pdfLexer) readDict() (any, error) {
+	dict := pdfDict{}
+	for {
+		l.skipSpace()
+		if l.eof() {
+			return nil, errPDFUnexpectedEOF
+		}
+		if l.data[l.pos] == '>' && l.peek(1) == '>' {
+			l.pos += 2
+			return dict, nil
+		}
+		key, err := l.readObject()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(pdfName)
+		if !ok {
+			continue
+		}
+		value, err := l.readObject()
+		if err != nil {
+			return nil, err
+		}
+		if kw, ok := value.(pdfKeyword); ok && kw == ">>" {
+			return dict, nil
+		}
+		dict[name] = value
+	}
+}
+
+func (l *pdfLexer) readArray() (any, error) {
+	out := pdfArray{}
+	for {
+		l.skipSpace()
+		if l.eof() {
+			return nil, errPDFUnexpectedEOF
+		}
+		if l.data[l.pos] == ']' {
+			l.pos++
+			return out, nil
+		}
+		value, err := l.readObject()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, value)
+	}
+}
+
+func (l *pdfLexer) readName() pdfName {
+	var buf []byte
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		c := l.data[l.pos]
+		if c == '#' && l.pos+2 < len(l.data) && isPDFHexDigit(l.data[l.pos+1]) && isPDFHexDigit(l.data[l.pos+2]) {
+			decoded, _ := strconv.ParseUint(string(l.data[l.pos+1:l.pos+3]), 16, 8)
+			buf = append(buf, byte(decoded))
+			l.pos += 3
+			continue
+		}
+		buf = append(buf, c)
+		l.pos++
+	}
+	return pdfName(buf)
+}
+
+func (l *pdfLexer) readHexString() (any, error) {
+	digits := make([]byte, 0)
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if isPDFHexDigit(l.data[l.pos]) {
+			digits = append(digits, l.data[l.pos])
+		}
+		l.pos++
+	}
+	if l.eof() {
+		return nil, errPDFUnexpectedEOF
+	}
+	l.pos++
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	if _, err := hex.Decode(out, digits); err != nil {
+		return nil, err
+	}
+	return pdfString(out), nil
+}
+
+func (l *pdfLexer) readLiteralString() (any, error) {
+	out := make([]byte, 0)
+	depth := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfString(out), nil
+			}
+		case '\\':
+			if l.eof() {
+				return nil, errPDFUnexpectedEOF
+			}
+			out = l.readEscape(out)
+			continue
+		}
+		out = append(out, c)
+	}
+	return nil, errPDFUnexpectedEOF
+}
+
+func (l *pdfLexer) readEscape(out []byte) []byte {
+	c := l.data[l.pos]
+	l.pos++
+	switch c {
+	case 'n':
+		return append(out, '\n')
+	case 'r':
+		return append(out, '\r')
+	case 't':
+		return append(out, '\t')
+	case 'b':
+		return append(out, '\b')
+	case 'f':
+		return append(out, '\f')
+	case '\r':
+		if l.peek(0) == '\n' {
+			l.pos++
+		}
+		return out
+	case '\n':
+		return out
+	}
+	if c >= '0' && c <= '7' {
+		value := int(c - '0')
+		for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+			value = value*8 + int(l.data[l.pos]-'0')
+			l.pos++
+		}
+		return append(out, byte(value))
+	}
+	return append(out, c)
+}
+
+func (l *pdfLexer) readNumberOrRef() (any, error) {
+	word := l.readWord()
+	if intValue, err := strconv.Atoi(word); err == nil {
+		if intValue >= 0 {
+			if ref, ok := l.tryReadRef(intValue); ok {
+				return ref, nil
+			}
+		}
+		return intValue, nil
+	}
+	floatValue, err := strconv.ParseFloat(word, 64)
+	if err != nil {
+		return pdfKeyword(word), nil
+	}
+	return floatValue, nil
+}
+
+func (l *pdfLexer) tryReadRef(num int) (pdfRef, bool) {
+	saved := l.pos
+	l.skipSpace()
+	genStart := l.pos
+	for l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '9' {
+		l.pos++
+	}
+	if l.pos == genStart {
+		l.pos = saved
+		return pdfRef{}, false
+	}
+	gen, _ := strconv.Atoi(string(l.data[genStart:l.pos]))
+	l.skipSpace()
+	if l.peek(0) == 'R' && (l.pos+1 >= len(l.data) || isPDFWhitespace(l.data[l.pos+1]) || isPDFDelimiter(l.data[l.pos+1])) {
+		l.pos++
+		return pdfRef{Num: num, Gen: gen}, true
+	}
+	l.pos = saved
+	return pdfRef{}, false
+}
+
+// readStreamBody consumes a "stream ... endstream" body following dict.
+func (l *pdfLexer) readStreamBody(dict pdfDict) ([]byte, bool) {
+	l.skipSpace()
+	if !bytes.HasPrefix(l.data[l.pos:], pdfStreamKeyword) {
+		return nil, false
+	}
+	start := l.pos + len(pdfStreamKeyword)
+	if start < len(l.data) && l.data[start] == '\r' {
+		start++
+	}
+	if start < len(l.data) && l.data[start] == '\n' {
+		start++
+	}
+	if length, ok := dict["Length"].(int); ok && length >= 0 && start+length <= len(l.data) {
+		tail := bytes.TrimLeft(l.data[start+length:], "\r\n \t")
+		if bytes.HasPrefix(tail, pdfEndStreamKeyword) {
+			l.pos = start + length
+			return l.data[start : start+length], true
+		}
+	}
+	end := bytes.Index(l.data[start:], pdfEndStreamKeyword)
+	if end < 0 {
+		return nil, false
+	}
+	raw := bytes.TrimRight(l.data[start:start+end], "\r\n")
+	l.pos = start + end + len(pdfEndStreamKeyword)
+	return raw, true
+}
+
+func isPDFWhitespace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+func isPDFHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,105 @@
+package resolvers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+type pdfDocumentCacheKey struct{}
+
+// pdfDocumentCache shares parsed documents and extracted text layers between
+// the resolver calls of one placement run, so Estimate and Resolve do not
+// parse and decode the same upload again.
+type pdfDocumentCache struct {
+	mu      sync.Mutex
+	entries map[string]*pdfDocumentCacheEntry
+}
+
+type pdfDocumentCacheEntry struct {
+	parse sync.Once
+	doc   *pdfDocument
+	err   error
+
+	layerMu sync.Mutex
+	layer   *pdfTextLayer
+}
+
+// WithDocumentCache returns a context that memoizes PDF parsing for resolver
+// calls made with it. The orchestrator installs one per run; resolvers called
+// without it parse on every call.
+func WithDocumentCache(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Value(pdfDocumentCacheKey{}).(*pdfDocumentCache); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, pdfDocumentCacheKey{}, &pdfDocumentCache{entries: map[string]*pdfDocumentCacheEntry{}})
+}
+
+func (c *pdfDocumentCache) entry(data []byte, fingerprint string) *pdfDocumentCacheEntry {
+	fingerprint = strings.TrimSpace(fingerprint)
+	if fingerprint == "" {
+		fingerprint = models.DocumentFingerprint(data)
+	}
+	key := fingerprint + ":" + strconv.Itoa(len(data))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &pdfDocumentCacheEntry{}
+		c.entries[key] = entry
+	}
+	return entry
+}
+
+func documentCacheEntry(ctx context.Context, data []byte, fingerprint string) *pdfDocumentCacheEntry {
+	if ctx == nil {
+		return nil
+	}
+	cache, ok := ctx.Value(pdfDocumentCacheKey{}).(*pdfDocumentCache)
+	if !ok || cache == nil {
+		return nil
+	}
+	return cache.entry(data, fingerprint)
+}
+
+// loadPDFDocument parses data, reusing the run cache when ctx carries one.
+func loadPDFDocument(ctx context.Context, data []byte, fingerprint string) (*pdfDocument, error) {
+	entry := documentCacheEntry(ctx, data, fingerprint)
+	if entry == nil {
+		return parsePDFDocument(data)
+	}
+	entry.parse.Do(func() {
+		entry.doc, entry.err = parsePDFDocument(data)
+	})
+	return entry.doc, entry.err
+}
+
+// loadPDFTextLayer extracts the input text layer, reusing the run cache when
+// ctx carries one. Cancelled extractions are not cached.
+func loadPDFTextLayer(ctx context.Context, input ResolveInput) (pdfTextLayer, error) {
+	entry := documentCacheEntry(ctx, input.DocumentBytes, input.DocumentFingerprint)
+	if entry == nil {
+		return extractPDFTextLayer(ctx, input.DocumentBytes)
+	}
+	entry.layerMu.Lock()
+	defer entry.layerMu.Unlock()
+	if entry.layer != nil {
+		return *entry.layer, nil
+	}
+	doc, err := loadPDFDocument(ctx, input.DocumentBytes, input.DocumentFingerprint)
+	if err != nil {
+		return pdfTextLayer{}, err
+	}
+	layer, err := extractPDFTextLayerFromDocument(ctx, doc)
+	if err != nil {
+		return layer, err
+	}
+	entry.layer = &layer
+	return layer, nil
+}
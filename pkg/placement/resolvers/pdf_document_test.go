@@ -0,0 +1,83 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExtractPDFTextLayerRejectsOversizedFlateStreams(t *testing.T) {
+	pdf := buildTextPDF(t, "BT "+strings.Repeat(" ", maxPDFStreamDecodedBytes+1)+" ET")
+	if len(pdf) > 1<<20 {
+		t.Fatalf("expected a small compressed fixture, got %d bytes", len(pdf))
+	}
+	_, err := extractPDFTextLayer(context.Background(), pdf)
+	if !errors.Is(err, errPDFDecodeLimit) {
+		t.Fatalf("expected decode limit error, got %v", err)
+	}
+}
+
+func TestPDFDocumentDecodeBudgetIsSharedAcrossStreams(t *testing.T) {
+	doc, err := parsePDFDocument(buildTextPDF(t, "BT (Date) Tj ET"))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	doc.decoded.Store(maxPDFDocumentDecodedBytes - 4)
+	_, err = doc.pageContents(doc.pages()[0])
+	if !errors.Is(err, errPDFDecodeLimit) {
+		t.Fatalf("expected exhausted document budget to fail, got %v", err)
+	}
+}
+
+func TestPDFLexerLimitsNestingDepth(t *testing.T) {
+	lexer := &pdfLexer{data: []byte(strings.Repeat("[", maxPDFObjectDepth+1) + strings.Repeat("]", maxPDFObjectDepth+1))}
+	if _, err := lexer.readObject(); !errors.Is(err, errPDFNestingDepth) {
+		t.Fatalf("expected nesting depth error, got %v", err)
+	}
+	lexer = &pdfLexer{data: []byte(strings.Repeat("<< /K ", maxPDFObjectDepth-1) + "1" + strings.Repeat(" >>", maxPDFObjectDepth-1))}
+	if _, err := lexer.readObject(); err != nil {
+		t.Fatalf("expected nesting within the limit to parse, got %v", err)
+	}
+}
+
+func TestDocumentCacheParsesOncePerRun(t *testing.T) {
+	pdf := buildTextPDF(t, "BT /F1 12 Tf 72 700 Td (Signature) Tj ET")
+	input := ResolveInput{DocumentBytes: pdf}
+
+	ctx := WithDocumentCache(context.Background())
+	first, err := loadPDFDocument(ctx, pdf, "")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	second, err := loadPDFDocument(WithDocumentCache(ctx), pdf, "")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected cached document to be reused")
+	}
+	uncached, err := loadPDFDocument(context.Background(), pdf, "")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if uncached == first {
+		t.Fatalf("expected a fresh parse without a run cache")
+	}
+
+	layer, err := loadPDFTextLayer(ctx, input)
+	if err != nil {
+		t.Fatalf("text layer: %v", err)
+	}
+	decoded := first.decoded.Load()
+	again, err := loadPDFTextLayer(ctx, input)
+	if err != nil {
+		t.Fatalf("text layer: %v", err)
+	}
+	if first.decoded.Load() != decoded {
+		t.Fatalf("expected cached text layer not to decode streams again")
+	}
+	if len(layer.Lines) != 1 || len(again.Lines) != 1 || again.Lines[0].Text != "Signature" {
+		t.Fatalf("unexpected cached text layer %+v", again.Lines)
+	}
+}
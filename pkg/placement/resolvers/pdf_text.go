@@ -0,0 +1,644 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// pdfTextRun is one positioned text-showing operation in PDF user space
+// (origin at the lower-left corner of the page, Y is the text baseline).
+// Quality is the recognition confidence for OCR words; zero means exact.
+type pdfTextRun struct {
+	Page    int
+	X       float64
+	Y       float64
+	Width   float64
+	Height  float64
+	Text    string
+	Quality float64
+}
+
+// pdfTextLine groups runs that share a baseline, in reading order.
+type pdfTextLine struct {
+	Page    int
+	Y       float64
+	Height  float64
+	Text    string
+	Quality float64
+	spans   []pdfTextSpan
+}
+
+// pdfTextSpan maps a byte range of pdfTextLine.Text back to page X coordinates.
+type pdfTextSpan struct {
+	start int
+	end   int
+	x0    float64
+	x1    float64
+}
+
+// pdfTextLayer is the extracted text layer of a document.
+type pdfTextLayer struct {
+	Pages []pdfPage
+	Lines []pdfTextLine
+}
+
+func (layer pdfTextLayer) empty() bool {
+	for _, line := range layer.Lines {
+		if strings.TrimSpace(line.Text) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (layer pdfTextLayer) page(number int) (pdfPage, bool) {
+	for _, page := range layer.Pages {
+		if page.Number == number {
+			return page, true
+		}
+	}
+	return pdfPage{}, false
+}
+
+// extractPDFTextLayer parses the document and interprets each page content
+// stream, honouring context cancellation between pages.
+func extractPDFTextLayer(ctx context.Context, data []byte) (pdfTextLayer, error) {
+	doc, err := parsePDFDocument(data)
+	if err != nil {
+		return pdfTextLayer{}, err
+	}
+	return extractPDFTextLayerFromDocument(ctx, doc)
+}
+
+func extractPDFTextLayerFromDocument(ctx context.Context, doc *pdfDocument) (pdfTextLayer, error) {
+	layer := pdfTextLayer{Pages: doc.pages()}
+	for _, page := range layer.Pages {
+		if err := ctx.Err(); err != nil {
+			return layer, err
+		}
+		contents, err := doc.pageContents(page)
+		if err != nil {
+			return layer, err
+		}
+		interpreter := newPDFTextInterpreter(doc, page)
+		interpreter.run(contents)
+		layer.Lines = append(layer.Lines, groupPDFTextLines(interpreter.runs)...)
+	}
+	return layer, nil
+}
+
+type pdfMatrix [6]float64
+
+var pdfIdentityMatrix = pdfMatrix{1, 0, 0, 1, 0, 0}
+
+func (m pdfMatrix) multiply(other pdfMatrix) pdfMatrix {
+	return pdfMatrix{
+		m[0]*other[0] + m[1]*other[2],
+		m[0]*other[1] + m[1]*other[3],
+		m[2]*other[0] + m[3]*other[2],
+		m[2]*other[1] + m[3]*other[3],
+		m[4]*other[0] + m[5]*other[2] + other[4],
+		m[4]*other[1] + m[5]*other[3] + other[5],
+	}
+}
+
+func (m pdfMatrix) apply(x, y float64) (float64, float64) {
+	return x*m[0] + y*m[2] + m[4], x*m[1] + y*m[3] + m[5]
+}
+
+type pdfGraphicsState struct {
+	ctm        pdfMatrix
+	font       *pdfFont
+	fontSize   float64
+	charSpace  float64
+	wordSpace  float64
+	hScale     float64
+	leading    float64
+	rise       float64
+	textMatrix pdfMatrix
+	lineMatrix pdfMatrix
+}
+
+type pdfTextInterpreter struct {
+	doc      *pdfDocument
+	page     pdfPage
+	fonts    map[pdfName]*pdfFont
+	state    pdfGraphicsState
+	stack    []pdfGraphicsState
+	runs     []pdfTextRun
+	operands []any
+}
+
+func newPDFTextInterpreter(doc *pdfDocument, page pdfPage) *pdfTextInterpreter {
+	return &pdfTextInterpreter{
+		doc:   doc,
+		page:  page,
+		fonts: map[pdfName]*pdfFont{},
+		state: pdfGraphicsState{
+			ctm:        pdfIdentityMatrix,
+			hScale:     1,
+			textMatrix: pdfIdentityMatrix,
+			lineMatrix: pdfIdentityMatrix,
+		},
+	}
+}
+
+func (in *pdfTextInterpreter) run(content []byte) {
+	lexer := &pdfLexer{data: content}
+	for {
+		value, err := lexer.readObject()
+		if err != nil {
+			return
+		}
+		op, ok := value.(pdfKeyword)
+		if !ok {
+			in.operands = append(in.operands, value)
+			continue
+		}
+		if op == "BI" {
+			skipPDFInlineImage(lexer)
+		} else {
+			in.apply(string(op))
+		}
+		in.operands = in.operands[:0]
+	}
+}
+
+func skipPDFInlineImage(lexer *pdfLexer) {
+	idx := bytes.Index(lexer.data[lexer.pos:], []byte("ID"))
+	if idx < 0 {
+		lexer.pos = len(lexer.data)
+		return
+	}
+	lexer.pos += idx + 2
+	for lexer.pos < len(lexer.data) {
+		end := bytes.Index(lexer.data[lexer.pos:], []byte("EI"))
+		if end < 0 {
+			lexer.pos = len(lexer.data)
+			return
+		}
+		lexer.pos += end + 2
+		if lexer.pos >= len(lexer.data) || isPDFWhitespace(lexer.data[lexer.pos]) {
+			return
+		}
+	}
+}
+
+func (in *pdfTextInterpreter) number(index int) float64 {
+	if index < 0 || index >= len(in.operands) {
+		return 0
+	}
+	value, _ := in.doc.number(in.operands[index])
+	return value
+}
+
+func (in *pdfTextInterpreter) matrixOperand() pdfMatrix {
+	var m pdfMatrix
+	for i := range 6 {
+		m[i] = in.number(len(in.operands) - 6 + i)
+	}
+	return m
+}
+
+func (in *pdfTextInterpreter) apply(op string) {
+	st := &in.state
+	switch op {
+	case "q":
+		in.stack = append(in.stack, *st)
+	case "Q":
+		if n := len(in.stack); n > 0 {
+			*st = in.stack[n-1]
+			in.stack = in.stack[:n-1]
+		}
+	case "cm":
+		if len(in.operands) >= 6 {
+			st.ctm = in.matrixOperand().multiply(st.ctm)
+		}
+	case "BT":
+		st.textMatrix = pdfIdentityMatrix
+		st.lineMatrix = pdfIdentityMatrix
+	case "Tf":
+		if len(in.operands) >= 2 {
+			if name, ok := in.operands[0].(pdfName); ok {
+				st.font = in.font(name)
+			}
+			st.fontSize = in.number(1)
+		}
+	case "Tc":
+		st.charSpace = in.number(0)
+	case "Tw":
+		st.wordSpace = in.number(0)
+	case "Tz":
+		st.hScale = in.number(0) / 100
+	case "TL":
+		st.leading = in.number(0)
+	case "Ts":
+		st.rise = in.number(0)
+	case "Td":
+		in.moveText(in.number(0), in.number(1))
+	case "TD":
+		st.leading = -in.number(1)
+		in.moveText(in.number(0), in.number(1))
+	case "Tm":
+		if len(in.operands) >= 6 {
+			st.textMatrix = in.matrixOperand()
+			st.lineMatrix = st.textMatrix
+		}
+	case "T*":
+		in.moveText(0, -st.leading)
+	case "Tj":
+		if len(in.operands) >= 1 {
+			in.showText(pdfArray{in.operands[len(in.operands)-1]})
+		}
+	case "TJ":
+		if len(in.operands) >= 1 {
+			in.showText(in.doc.array(in.operands[len(in.operands)-1]))
+		}
+	case "'":
+		in.moveText(0, -st.leading)
+		if len(in.operands) >= 1 {
+			in.showText(pdfArray{in.operands[len(in.operands)-1]})
+		}
+	case "\"":
+		if len(in.operands) >= 3 {
+			st.wordSpace = in.number(0)
+			st.charSpace = in.number(1)
+			in.moveText(0, -st.leading)
+			in.showText(pdfArray{in.operands[2]})
+		}
+	}
+}
+
+func (in *pdfTextInterpreter) moveText(tx, ty float64) {
+	st := &in.state
+	st.lineMatrix = pdfMatrix{1, 0, 0, 1, tx, ty}.multiply(st.lineMatrix)
+	st.textMatrix = st.lineMatrix
+}
+
+func (in *pdfTextInterpreter) font(name pdfName) *pdfFont {
+	if font, ok := in.fonts[name]; ok {
+		return font
+	}
+	fonts := in.doc.dict(in.page.Resources["Font"])
+	font := newPDFFont(in.doc, in.doc.dict(fonts[name]))
+	in.fonts[name] = font
+	return font
+}
+
+// showText advances the text matrix across a TJ-style array and records the
+// decoded text as a single run. Large negative kerning adjustments are
+// treated as word gaps so "Sign" + kern + "here" reads as "Sign here".
+func (in *pdfTextInterpreter) showText(items pdfArray) {
+	st := &in.state
+	font := st.font
+	if font == nil {
+		font = newPDFFont(in.doc, nil)
+	}
+	hScale := st.hScale
+	if hScale == 0 {
+		hScale = 1
+	}
+	var text strings.Builder
+	advance := 0.0
+	for _, item := range items {
+		switch typed := in.doc.resolve(item).(type) {
+		case pdfString:
+			for _, glyph := range font.decode(typed) {
+				width := glyph.width/1000*st.fontSize + st.charSpace
+				if glyph.code == 32 && glyph.bytes == 1 {
+					width += st.wordSpace
+				}
+				advance += width * hScale
+				text.WriteString(glyph.text)
+			}
+		case int, float64:
+			adjust, _ := in.doc.number(typed)
+			advance -= adjust / 1000 * st.fontSize * hScale
+			if adjust < -200 && text.Len() > 0 && !strings.HasSuffix(text.String(), " ") {
+				text.WriteByte(' ')
+			}
+		}
+	}
+	rendering := st.textMatrix.multiply(st.ctm)
+	x0, y0 := rendering.apply(0, st.rise)
+	x1, _ := rendering.apply(advance, st.rise)
+	_, yTop := rendering.apply(0, st.rise+st.fontSize)
+	st.textMatrix = pdfMatrix{1, 0, 0, 1, advance, 0}.multiply(st.textMatrix)
+
+	value := text.String()
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	in.runs = append(in.runs, pdfTextRun{
+		Page:   in.page.Number,
+		X:      math.Min(x0, x1),
+		Y:      y0,
+		Width:  math.Abs(x1 - x0),
+		Height: math.Max(math.Abs(yTop-y0), 1),
+		Text:   value,
+	})
+}
+
+// groupPDFTextLines merges runs sharing a baseline into reading-order lines.
+func groupPDFTextLines(runs []pdfTextRun) []pdfTextLine {
+	if len(runs) == 0 {
+		return nil
+	}
+	sorted := append([]pdfTextRun{}, runs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Page != sorted[j].Page {
+			return sorted[i].Page < sorted[j].Page
+		}
+		if math.Abs(sorted[i].Y-sorted[j].Y) > 0.5 {
+			return sorted[i].Y > sorted[j].Y
+		}
+		return sorted[i].X < sorted[j].X
+	})
+	lines := make([]pdfTextLine, 0)
+	var current []pdfTextRun
+	flush := func() {
+		if len(current) > 0 {
+			lines = append(lines, buildPDFTextLine(current))
+		}
+		current = nil
+	}
+	for _, run := range sorted {
+		if len(current) > 0 {
+			last := current[len(current)-1]
+			tolerance := math.Max(math.Min(last.Height, run.Height)*0.4, 1)
+			if last.Page != run.Page || math.Abs(last.Y-run.Y) > tolerance {
+				flush()
+			}
+		}
+		current = append(current, run)
+	}
+	flush()
+	return lines
+}
+
+func buildPDFTextLine(runs []pdfTextRun) pdfTextLine {
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].X < runs[j].X })
+	line := pdfTextLine{Page: runs[0].Page, Y: runs[0].Y}
+	var text strings.Builder
+	qualitySum, qualityCount := 0.0, 0
+	for i, run := range runs {
+		line.Height = math.Max(line.Height, run.Height)
+		if run.Quality > 0 {
+			qualitySum += run.Quality
+			qualityCount++
+		}
+		if i > 0 {
+			prev := runs[i-1]
+			gap := run.X - (prev.X + prev.Width)
+			if gap > math.Max(prev.Height, run.Height)*0.2 && !strings.HasSuffix(text.String(), " ") && !strings.HasPrefix(run.Text, " ") {
+				text.WriteByte(' ')
+			}
+		}
+		start := text.Len()
+		text.WriteString(run.Text)
+		line.spans = append(line.spans, pdfTextSpan{start: start, end: text.Len(), x0: run.X, x1: run.X + run.Width})
+	}
+	line.Text = text.String()
+	if qualityCount > 0 {
+		line.Quality = qualitySum / float64(qualityCount)
+	}
+	return line
+}
+
+// xRange interpolates the horizontal extent of Text[start:end].
+func (line pdfTextLine) xRange(start, end int) (float64, float64) {
+	x0, x1 := math.NaN(), math.NaN()
+	for _, span := range line.spans {
+		if end <= span.start || start >= span.end {
+			continue
+		}
+		perByte := 0.0
+		if span.end > span.start {
+			perByte = (span.x1 - span.x0) / float64(span.end-span.start)
+		}
+		from := span.x0 + float64(max(start, span.start)-span.start)*perByte
+		to := span.x0 + float64(min(end, span.end)-span.start)*perByte
+		if math.IsNaN(x0) || from < x0 {
+			x0 = from
+		}
+		if math.IsNaN(x1) || to > x1 {
+			x1 = to
+		}
+	}
+	if math.IsNaN(x0) {
+		if len(line.spans) == 0 {
+			return 0, 0
+		}
+		last := line.spans[len(line.spans)-1]
+		return last.x1, last.x1
+	}
+	return x0, x1
+}
+
+type pdfGlyph struct {
+	code  int
+	bytes int
+	width float64
+	text  string
+}
+
+// pdfFont carries the decoding and metrics needed to position text runs.
+type pdfFont struct {
+	composite    bool
+	firstChar    int
+	widths       []float64
+	defaultWidth float64
+	cidWidths    map[int]float64
+	toUnicode    map[int]string
+	codeBytes    int
+}
+
+func newPDFFont(doc *pdfDocument, dict pdfDict) *pdfFont {
+	font := &pdfFont{defaultWidth: 500, codeBytes: 1}
+	if dict == nil {
+		return font
+	}
+	if dict.name("Subtype") == "Type0" {
+		font.composite = true
+		font.codeBytes = 2
+		font.defaultWidth = 1000
+		if descendants := doc.array(dict["DescendantFonts"]); len(descendants) > 0 {
+			descendant := doc.dict(descendants[0])
+			if dw, ok := doc.number(descendant["DW"]); ok {
+				font.defaultWidth = dw
+			}
+			font.cidWidths = parsePDFCIDWidths(doc, doc.array(descendant["W"]))
+		}
+	} else {
+		font.firstChar, _ = doc.intValue(dict["FirstChar"])
+		for _, width := range doc.array(dict["Widths"]) {
+			value, _ := doc.number(width)
+			font.widths = append(font.widths, value)
+		}
+	}
+	if stream, ok := doc.resolve(dict["ToUnicode"]).(pdfStream); ok {
+		if decoded, err := doc.decodeStream(stream); err == nil {
+			font.toUnicode = parsePDFToUnicodeCMap(decoded)
+		}
+	}
+	return font
+}
+
+func (f *pdfFont) decode(raw pdfString) []pdfGlyph {
+	out := make([]pdfGlyph, 0, len(raw))
+	step := max(f.codeBytes, 1)
+	for i := 0; i < len(raw); i += step {
+		code := 0
+		n := 0
+		for j := i; j < i+step && j < len(raw); j++ {
+			code = code<<8 | int(raw[j])
+			n++
+		}
+		out = append(out, pdfGlyph{code: code, bytes: n, width: f.width(code), text: f.text(code)})
+	}
+	return out
+}
+
+func (f *pdfFont) width(code int) float64 {
+	if f.composite {
+		if width, ok := f.cidWidths[code]; ok {
+			return width
+		}
+		return f.defaultWidth
+	}
+	if idx := code - f.firstChar; idx >= 0 && idx < len(f.widths) && f.widths[idx] > 0 {
+		return f.widths[idx]
+	}
+	return f.defaultWidth
+}
+
+func (f *pdfFont) text(code int) string {
+	if value, ok := f.toUnicode[code]; ok {
+		return value
+	}
+	if f.composite {
+		return ""
+	}
+	return string(rune(code))
+}
+
+func parsePDFCIDWidths(doc *pdfDocument, items pdfArray) map[int]float64 {
+	out := map[int]float64{}
+	for i := 0; i < len(items); {
+		first, ok := doc.intValue(items[i])
+		if !ok || i+1 >= len(items) {
+			break
+		}
+		if widths := doc.array(items[i+1]); widths != nil {
+			for offset, width := range widths {
+				value, _ := doc.number(width)
+				out[first+offset] = value
+			}
+			i += 2
+			continue
+		}
+		if i+2 >= len(items) {
+			break
+		}
+		last, _ := doc.intValue(items[i+1])
+		value, _ := doc.number(items[i+2])
+		for code := first; code <= last && code-first < 65536; code++ {
+			out[code] = value
+		}
+		i += 3
+	}
+	return out
+}
+
+// parsePDFToUnicodeCMap reads bfchar/bfrange mappings from a ToUnicode CMap.
+func parsePDFToUnicodeCMap(data []byte) map[int]string {
+	out := map[int]string{}
+	lexer := &pdfLexer{data: data}
+	var operands []any
+	mode := ""
+	for {
+		value, err := lexer.readObject()
+		if err != nil {
+			return out
+		}
+		keyword, ok := value.(pdfKeyword)
+		if !ok {
+			if mode != "" {
+				operands = append(operands, value)
+			}
+			continue
+		}
+		switch keyword {
+		case "beginbfchar", "beginbfrange":
+			mode = string(keyword)
+			operands = nil
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, okSrc := operands[i].(pdfString)
+				dst, okDst := operands[i+1].(pdfString)
+				if okSrc && okDst {
+					out[pdfCode(src)] = decodeUTF16BE(dst)
+				}
+			}
+			mode = ""
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				applyPDFBFRange(out, operands[i], operands[i+1], operands[i+2])
+			}
+			mode = ""
+		}
+	}
+}
+
+func applyPDFBFRange(out map[int]string, lowValue, highValue, dstValue any) {
+	low, okLow := lowValue.(pdfString)
+	high, okHigh := highValue.(pdfString)
+	if !okLow || !okHigh {
+		return
+	}
+	start, end := pdfCode(low), pdfCode(high)
+	if end < start || end-start > 65535 {
+		return
+	}
+	switch dst := dstValue.(type) {
+	case pdfString:
+		base := []rune(decodeUTF16BE(dst))
+		if len(base) == 0 {
+			return
+		}
+		for code := start; code <= end; code++ {
+			runes := append([]rune{}, base...)
+			runes[len(runes)-1] += rune(code - start)
+			out[code] = string(runes)
+		}
+	case pdfArray:
+		for offset, item := range dst {
+			if str, ok := item.(pdfString); ok && start+offset <= end {
+				out[start+offset] = decodeUTF16BE(str)
+			}
+		}
+	}
+}
+
+func pdfCode(raw pdfString) int {
+	code := 0
+	for _, b := range raw {
+		code = code<<8 | int(b)
+	}
+	return code
+}
+
+func decodeUTF16BE(raw pdfString) string {
+	if len(raw)%2 == 1 {
+		return string(raw)
+	}
+	units := make([]uint16, 0, len(raw)/2)
+	for i := 0; i+1 < len(raw); i += 2 {
+		units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+	}
+	return string(utf16.Decode(units))
+}
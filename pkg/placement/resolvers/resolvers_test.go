@@ -19,6 +19,7 @@ func TestResolverContractConformance(t *testing.T) {
 		TextAnchorResolver{},
 		OCRAnchorResolver{},
 		MLLayoutResolver{},
+		HistoryResolver{},
 	}
 	for _, resolver := range resolvers {
 		id := resolver.ID()
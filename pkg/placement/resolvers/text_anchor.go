@@ -2,21 +2,419 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
 
+	"github.com/goliatone/go-admin/internal/primitives"
 	"github.com/goliatone/go-admin/pkg/placement/models"
+	"github.com/google/uuid"
 )
 
 const TextAnchorResolverID = "text_anchor_resolver"
 
-// TextAnchorResolver is a contract-first stub for label/anchor placement strategies.
+const (
+	textAnchorLabelConfidence   = 0.86
+	textAnchorKeywordConfidence = 0.62
+	textAnchorPlaceholderBonus  = 0.08
+	textAnchorStandaloneBonus   = 0.03
+	textAnchorPageMargin        = 18.0
+	textAnchorGap               = 6.0
+)
+
+// textAnchorKeywords lists fallback anchor strings per field type, used when
+// the definition label itself does not appear in the text layer.
+var textAnchorKeywords = map[string][]string{
+	"signature": {"signature", "sign here", "signed by", "signed"},
+	"initials":  {"initials", "initial here", "initial"},
+	"date":      {"date signed", "date"},
+	"name":      {"printed name", "print name", "full name", "name"},
+	"title":     {"title"},
+	"email":     {"email", "e-mail"},
+	"company":   {"company", "organization"},
+	"checkbox":  {"i agree", "agree"},
+}
+
+// textAnchorFieldSizes is the default box size (width, height) per field type.
+var textAnchorFieldSizes = map[string][2]float64{
+	"signature": {180, 36},
+	"initials":  {64, 28},
+	"date":      {120, 24},
+	"checkbox":  {14, 14},
+	"text":      {180, 24},
+}
+
+// TextAnchorResolver places fields next to label anchors ("Signature:",
+// "Date", "Initials") found in the PDF text layer. Geometry uses PDF user
+// space like NativePDFFormsResolver: origin at the lower-left page corner.
 type TextAnchorResolver struct{}
 
 func (TextAnchorResolver) ID() string { return TextAnchorResolverID }
 
-func (TextAnchorResolver) Estimate(_ context.Context, _ ResolveInput) (models.Estimate, error) {
-	return unsupportedEstimate(TextAnchorResolverID, 0.55, 0.45, 0.6), nil
+func (TextAnchorResolver) Estimate(ctx context.Context, input ResolveInput) (models.Estimate, error) {
+	estimate := models.Estimate{
+		ResolverID: TextAnchorResolverID,
+		Accuracy:   0.55,
+		Cost:       0.3,
+		Latency:    0.35,
+	}
+	if len(input.DocumentBytes) == 0 {
+		estimate.Reason = "document_missing"
+		return estimate, nil
+	}
+	layer, err := loadPDFTextLayer(ctx, input)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return estimate, ctxErr
+		}
+		estimate.Reason = "document_unparseable"
+		return estimate, nil
+	}
+	if layer.empty() {
+		estimate.Reason = "text_layer_missing"
+		return estimate, nil
+	}
+	estimate.Supported = true
+	estimate.Reason = "text_layer_detected"
+	if len(input.FieldDefinitions) == 0 {
+		return estimate, nil
+	}
+	anchored := 0
+	for _, definition := range input.FieldDefinitions {
+		if len(findTextAnchorMatches(layer, definition)) > 0 {
+			anchored++
+		}
+	}
+	ratio := float64(anchored) / float64(len(input.FieldDefinitions))
+	estimate.Accuracy = 0.4 + 0.5*ratio
+	if anchored > 0 {
+		estimate.Reason = "text_anchors_detected"
+	}
+	return estimate, nil
+}
+
+func (TextAnchorResolver) Resolve(ctx context.Context, input ResolveInput) (models.ResolveResult, error) {
+	definitions := append([]models.FieldDefinition{}, input.FieldDefinitions...)
+	if len(definitions) == 0 {
+		return models.ResolveResult{}, nil
+	}
+	if len(input.DocumentBytes) == 0 {
+		return unresolvedDefinitions(input), nil
+	}
+	layer, err := loadPDFTextLayer(ctx, input)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return models.ResolveResult{}, ctxErr
+		}
+		return unresolvedDefinitions(input), nil
+	}
+	return resolveTextAnchors(TextAnchorResolverID, definitions, layer), nil
+}
+
+// resolveTextAnchors assigns each definition to at most one anchor in the
+// layer. It is shared by the text-layer and OCR anchor resolvers.
+func resolveTextAnchors(resolverID string, definitions []models.FieldDefinition, layer pdfTextLayer) models.ResolveResult {
+	candidates := make([]textAnchorCandidate, 0)
+	for index, definition := range definitions {
+		for _, match := range findTextAnchorMatches(layer, definition) {
+			match.definitionIndex = index
+			candidates = append(candidates, match)
+		}
+	}
+	sortTextAnchorCandidates(candidates)
+
+	usedDefinition := map[string]bool{}
+	usedAnchor := map[string]bool{}
+	suggestions := make([]models.Suggestion, 0)
+	for _, candidate := range candidates {
+		def := definitions[candidate.definitionIndex]
+		if usedDefinition[def.ID] || usedAnchor[candidate.anchorKey()] {
+			continue
+		}
+		page, _ := layer.page(candidate.line.Page)
+		usedDefinition[def.ID] = true
+		usedAnchor[candidate.anchorKey()] = true
+		suggestions = append(suggestions, buildTextAnchorSuggestion(resolverID, def, candidate, page))
+	}
+
+	unresolved := make([]string, 0)
+	for _, definition := range definitions {
+		if usedDefinition[definition.ID] {
+			continue
+		}
+		unresolved = append(unresolved, definition.ID)
+	}
+	sort.Strings(unresolved)
+
+	return models.ResolveResult{
+		Suggestions:             suggestions,
+		UnresolvedDefinitionIDs: unresolved,
+	}
+}
+
+// textAnchorTerm is one string searched for in the text layer.
+type textAnchorTerm struct {
+	value     string
+	matchType string
+}
+
+// textAnchorCandidate is a located anchor for a specific field definition.
+type textAnchorCandidate struct {
+	definitionIndex int
+	line            pdfTextLine
+	lineIndex       int
+	start           int
+	end             int
+	term            textAnchorTerm
+	confidence      float64
+	placeholder     [2]int
+}
+
+func (c textAnchorCandidate) anchorKey() string {
+	return fmt.Sprintf("%d:%d:%d", c.line.Page, c.lineIndex, c.start)
+}
+
+func (c textAnchorCandidate) hasPlaceholder() bool {
+	return c.placeholder[1] > c.placeholder[0]
+}
+
+func textAnchorTerms(def models.FieldDefinition) []textAnchorTerm {
+	out := make([]textAnchorTerm, 0)
+	seen := map[string]bool{}
+	add := func(value, matchType string) {
+		value = normalizeAnchorText(value)
+		if value == "" || seen[value] {
+			return
+		}
+		seen[value] = true
+		out = append(out, textAnchorTerm{value: value, matchType: matchType})
+	}
+	add(def.Label, "label")
+	for _, keyword := range textAnchorKeywords[canonicalFieldType(def.FieldType)] {
+		add(keyword, "keyword")
+	}
+	return out
+}
+
+func canonicalFieldType(fieldType string) string {
+	value := canonicalToken(fieldType)
+	switch value {
+	case "initial":
+		return "initials"
+	case "datesigned", "signdate", "signaturedate":
+		return "date"
+	case "fullname", "printedname", "signername":
+		return "name"
+	case "check", "checkbox", "boolean":
+		return "checkbox"
+	}
+	return value
+}
+
+// normalizeAnchorText lowercases, collapses whitespace and drops trailing
+// label punctuation so "Signature:" and "signature" compare equal.
+func normalizeAnchorText(value string) string {
+	value = strings.ToLower(strings.Join(strings.Fields(value), " "))
+	return strings.TrimRight(value, ":.*_ ")
+}
+
+func findTextAnchorMatches(layer pdfTextLayer, def models.FieldDefinition) []textAnchorCandidate {
+	terms := textAnchorTerms(def)
+	if len(terms) == 0 {
+		return nil
+	}
+	out := make([]textAnchorCandidate, 0)
+	for lineIndex, line := range layer.Lines {
+		lower := lowerASCII(line.Text)
+		claimed := make([][2]int, 0)
+		for _, term := range terms {
+			for _, start := range findAnchorOccurrences(lower, term.value) {
+				end := start + len(term.value)
+				if overlapsAnchorRange(claimed, start, end) {
+					continue
+				}
+				claimed = append(claimed, [2]int{start, end})
+				candidate := textAnchorCandidate{
+					line:      line,
+					lineIndex: lineIndex,
+					start:     start,
+					end:       end,
+					term:      term,
+				}
+				candidate.placeholder = findAnchorPlaceholder(line.Text, end)
+				candidate.confidence = scoreTextAnchor(candidate, lower)
+				out = append(out, candidate)
+			}
+		}
+	}
+	return out
+}
+
+// lowerASCII lowercases ASCII letters only so byte offsets stay aligned with
+// the original line text.
+func lowerASCII(value string) string {
+	out := []byte(value)
+	for i, c := range out {
+		if c >= 'A' && c <= 'Z' {
+			out[i] = c + ('a' - 'A')
+		}
+	}
+	return string(out)
+}
+
+// findAnchorOccurrences returns byte offsets where term appears on word
+// boundaries so "date" does not match inside "update".
+func findAnchorOccurrences(text, term string) []int {
+	out := make([]int, 0)
+	offset := 0
+	for offset < len(text) {
+		idx := strings.Index(text[offset:], term)
+		if idx < 0 {
+			break
+		}
+		start := offset + idx
+		end := start + len(term)
+		if isAnchorBoundary(text, start-1) && isAnchorBoundary(text, end) {
+			out = append(out, start)
+		}
+		offset = start + max(len(term), 1)
+	}
+	return out
+}
+
+func isAnchorBoundary(text string, index int) bool {
+	if index < 0 || index >= len(text) {
+		return true
+	}
+	r := rune(text[index])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func overlapsAnchorRange(ranges [][2]int, start, end int) bool {
+	for _, existing := range ranges {
+		if start < existing[1] && end > existing[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// findAnchorPlaceholder locates a blank line ("_____" or ".....") that
+// directly follows the anchor, optionally after a colon.
+func findAnchorPlaceholder(text string, from int) [2]int {
+	i := from
+	for i < len(text) && (text[i] == ' ' || text[i] == ':' || text[i] == '\t') {
+		i++
+	}
+	start := i
+	for i < len(text) && (text[i] == '_' || text[i] == '.') {
+		i++
+	}
+	if i-start < 3 {
+		return [2]int{}
+	}
+	return [2]int{start, i}
+}
+
+func scoreTextAnchor(candidate textAnchorCandidate, lowerLine string) float64 {
+	confidence := textAnchorKeywordConfidence
+	if candidate.term.matchType == "label" {
+		confidence = textAnchorLabelConfidence
+	}
+	if candidate.hasPlaceholder() {
+		confidence += textAnchorPlaceholderBonus
+	} else if after := strings.TrimSpace(lowerLine[candidate.end:]); after == "" || after == ":" {
+		confidence += textAnchorStandaloneBonus
+	}
+	if quality := candidate.line.Quality; quality > 0 && quality < 1 {
+		confidence *= quality
+	}
+	return math.Min(confidence, 0.97)
+}
+
+// sortTextAnchorCandidates orders by confidence, then reading order, then
+// definition order so repeated anchors ("Signature" for two signers) are
+// assigned to definitions deterministically top-to-bottom.
+func sortTextAnchorCandidates(candidates []textAnchorCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		left, right := candidates[i], candidates[j]
+		if left.confidence != right.confidence {
+			return left.confidence > right.confidence
+		}
+		if left.line.Page != right.line.Page {
+			return left.line.Page < right.line.Page
+		}
+		if left.lineIndex != right.lineIndex {
+			return left.lineIndex < right.lineIndex
+		}
+		if left.start != right.start {
+			return left.start < right.start
+		}
+		return left.definitionIndex < right.definitionIndex
+	})
+}
+
+func textAnchorFieldSize(fieldType string) (float64, float64) {
+	if size, ok := textAnchorFieldSizes[canonicalFieldType(fieldType)]; ok {
+		return size[0], size[1]
+	}
+	size := textAnchorFieldSizes["text"]
+	return size[0], size[1]
 }
 
-func (TextAnchorResolver) Resolve(_ context.Context, input ResolveInput) (models.ResolveResult, error) {
-	return unresolvedDefinitions(input), nil
+func buildTextAnchorSuggestion(resolverID string, def models.FieldDefinition, candidate textAnchorCandidate, page pdfPage) models.Suggestion {
+	geometry, placement := textAnchorGeometry(def, candidate, page)
+	anchorText := strings.TrimSpace(candidate.line.Text[candidate.start:candidate.end])
+	return models.Suggestion{
+		ID:                uuid.NewString(),
+		FieldDefinitionID: def.ID,
+		ResolverID:        resolverID,
+		Confidence:        candidate.confidence,
+		Geometry:          geometry,
+		Label:             primitives.FirstNonEmpty(strings.TrimSpace(def.Label), anchorText),
+		Metadata: map[string]any{
+			"anchor_text": anchorText,
+			"anchor_line": strings.TrimSpace(candidate.line.Text),
+			"match_type":  candidate.term.matchType,
+			"placement":   placement,
+		},
+	}
+}
+
+// textAnchorGeometry prefers a blank placeholder after the anchor, then the
+// space to the right of the anchor, then the area just below it.
+func textAnchorGeometry(def models.FieldDefinition, candidate textAnchorCandidate, page pdfPage) (models.Geometry, string) {
+	width, height := textAnchorFieldSize(def.FieldType)
+	line := candidate.line
+	anchorX0, anchorX1 := line.xRange(candidate.start, candidate.end)
+	pageLeft := page.MediaBox[0] + textAnchorPageMargin
+	pageRight := page.MediaBox[2] - textAnchorPageMargin
+	baseline := line.Y - line.Height*0.25
+	geometry := models.Geometry{PageNumber: line.Page, Height: height}
+
+	if candidate.hasPlaceholder() {
+		x0, x1 := line.xRange(candidate.placeholder[0], candidate.placeholder[1])
+		geometry.X = x0
+		geometry.Y = baseline
+		geometry.Width = math.Max(x1-x0, math.Min(width, pageRight-x0))
+		return geometry, "placeholder"
+	}
+	if canonicalFieldType(def.FieldType) == "checkbox" && anchorX0-width-textAnchorGap >= pageLeft {
+		geometry.X = anchorX0 - width - textAnchorGap
+		geometry.Y = line.Y
+		geometry.Width = width
+		return geometry, "left"
+	}
+	if available := pageRight - (anchorX1 + textAnchorGap); available >= width*0.6 {
+		geometry.X = anchorX1 + textAnchorGap
+		geometry.Y = baseline
+		geometry.Width = math.Min(width, available)
+		return geometry, "right"
+	}
+	geometry.X = math.Max(anchorX0, pageLeft)
+	geometry.Y = math.Max(line.Y-line.Height-height-textAnchorGap, page.MediaBox[1])
+	geometry.Width = math.Min(width, pageRight-geometry.X)
+	return geometry, "below"
 }
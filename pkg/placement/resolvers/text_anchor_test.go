@@ -0,0 +1,182 @@
+package resolvers
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+// buildTextPDF renders a minimal multi-page PDF whose page content streams are
+// Flate-compressed, mirroring what common PDF writers emit.
+func buildTextPDF(t *testing.T, pages ...string) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.7\n")
+	kids := ""
+	for i := range pages {
+		kids += fmt.Sprintf("%d 0 R ", 4+i*2)
+	}
+	fmt.Fprintf(&out, "1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	fmt.Fprintf(&out, "2 0 obj\n<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> >>\nendobj\n", kids, len(pages))
+	fmt.Fprintf(&out, "3 0 obj\n<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>\nendobj\n")
+	for i, content := range pages {
+		var compressed bytes.Buffer
+		writer := zlib.NewWriter(&compressed)
+		if _, err := writer.Write([]byte(content)); err != nil {
+			t.Fatalf("compress page: %v", err)
+		}
+		if err := writer.Close(); err != nil {
+			t.Fatalf("compress page: %v", err)
+		}
+		pageNum := 4 + i*2
+		fmt.Fprintf(&out, "%d 0 obj\n<< /Type /Page /Parent 2 0 R /Contents %d 0 R >>\nendobj\n", pageNum, pageNum+1)
+		fmt.Fprintf(&out, "%d 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", pageNum+1, compressed.Len())
+		out.Write(compressed.Bytes())
+		out.WriteString("\nendstream\nendobj\n")
+	}
+	out.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+	return out.Bytes()
+}
+
+func TestTextAnchorResolverPlacesFieldsNextToAnchors(t *testing.T) {
+	pdf := buildTextPDF(t,
+		"BT /F1 12 Tf 72 700 Td (Terms and conditions apply to this update.) Tj ET\n"+
+			"BT /F1 12 Tf 72 200 Td (Signature: ____________) Tj ET\n"+
+			"BT /F1 12 Tf 360 200 Td [(Da) -20 (te)] TJ ET",
+		"BT /F1 12 Tf 1 0 0 1 72 150 Tm (Counterparty Signature) Tj ET",
+	)
+	result, err := TextAnchorResolver{}.Resolve(context.Background(), ResolveInput{
+		DocumentBytes: pdf,
+		FieldDefinitions: []models.FieldDefinition{
+			{ID: "sig-1", FieldType: "signature", Label: "Signature:"},
+			{ID: "sig-2", FieldType: "signature", Label: "Counterparty Signature"},
+			{ID: "date-1", FieldType: "date_signed", Label: "Date"},
+			{ID: "initials-1", FieldType: "initials", Label: "Initials"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if len(result.UnresolvedDefinitionIDs) != 1 || result.UnresolvedDefinitionIDs[0] != "initials-1" {
+		t.Fatalf("expected only initials unresolved, got %v", result.UnresolvedDefinitionIDs)
+	}
+	byField := map[string]models.Suggestion{}
+	for _, suggestion := range result.Suggestions {
+		byField[suggestion.FieldDefinitionID] = suggestion
+		if suggestion.ResolverID != TextAnchorResolverID {
+			t.Fatalf("expected resolver %q, got %q", TextAnchorResolverID, suggestion.ResolverID)
+		}
+	}
+
+	sig := byField["sig-1"]
+	if sig.Geometry.PageNumber != 1 || sig.Metadata["placement"] != "placeholder" {
+		t.Fatalf("expected placeholder placement on page 1, got %+v", sig)
+	}
+	if sig.Geometry.X <= 72 || sig.Geometry.Y >= 200 || sig.Geometry.Width <= 0 {
+		t.Fatalf("expected geometry over the signature line, got %+v", sig.Geometry)
+	}
+
+	counter := byField["sig-2"]
+	if counter.Geometry.PageNumber != 2 || counter.Metadata["placement"] != "right" {
+		t.Fatalf("expected right-of-anchor placement on page 2, got %+v", counter)
+	}
+	if counter.Confidence <= sig.Confidence-0.1 || counter.Confidence > 1 {
+		t.Fatalf("expected comparable label confidence, got %.2f vs %.2f", counter.Confidence, sig.Confidence)
+	}
+
+	date := byField["date-1"]
+	if date.Geometry.PageNumber != 1 || date.Geometry.X <= 360 {
+		t.Fatalf("expected date placed after the kerned anchor, got %+v", date.Geometry)
+	}
+}
+
+func TestTextAnchorResolverAssignsRepeatedKeywordsInReadingOrder(t *testing.T) {
+	pdf := buildTextPDF(t,
+		"BT /F1 11 Tf 72 500 Td (Sign here) Tj 0 -200 Td (Sign here) Tj ET",
+	)
+	result, err := TextAnchorResolver{}.Resolve(context.Background(), ResolveInput{
+		DocumentBytes: pdf,
+		FieldDefinitions: []models.FieldDefinition{
+			{ID: "a", FieldType: "signature", Label: "Buyer"},
+			{ID: "b", FieldType: "signature", Label: "Seller"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if len(result.Suggestions) != 2 {
+		t.Fatalf("expected 2 suggestions, got %+v", result)
+	}
+	first, second := result.Suggestions[0], result.Suggestions[1]
+	if first.FieldDefinitionID != "a" || second.FieldDefinitionID != "b" {
+		t.Fatalf("expected definition order to follow reading order, got %s then %s", first.FieldDefinitionID, second.FieldDefinitionID)
+	}
+	if first.Geometry.Y <= second.Geometry.Y {
+		t.Fatalf("expected first suggestion above the second, got %.1f <= %.1f", first.Geometry.Y, second.Geometry.Y)
+	}
+	if first.Metadata["match_type"] != "keyword" {
+		t.Fatalf("expected keyword match, got %v", first.Metadata["match_type"])
+	}
+}
+
+func TestTextAnchorResolverEstimateReflectsTextLayer(t *testing.T) {
+	ctx := context.Background()
+	definitions := []models.FieldDefinition{{ID: "sig", FieldType: "signature", Label: "Signature"}}
+
+	estimate, err := TextAnchorResolver{}.Estimate(ctx, ResolveInput{FieldDefinitions: definitions})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if estimate.Supported || estimate.Reason != "document_missing" {
+		t.Fatalf("expected unsupported estimate without document, got %+v", estimate)
+	}
+
+	scanned := buildTextPDF(t, "q 612 0 0 792 0 0 cm Q")
+	estimate, err = TextAnchorResolver{}.Estimate(ctx, ResolveInput{DocumentBytes: scanned, FieldDefinitions: definitions})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if estimate.Supported || estimate.Reason != "text_layer_missing" {
+		t.Fatalf("expected unsupported estimate for image-only page, got %+v", estimate)
+	}
+
+	text := buildTextPDF(t, "BT /F1 12 Tf 72 200 Td (Signature) Tj ET")
+	estimate, err = TextAnchorResolver{}.Estimate(ctx, ResolveInput{DocumentBytes: text, FieldDefinitions: definitions})
+	if err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+	if !estimate.Supported || estimate.Reason != "text_anchors_detected" || estimate.Accuracy < 0.85 {
+		t.Fatalf("expected supported high-accuracy estimate, got %+v", estimate)
+	}
+}
+
+func TestExtractPDFTextLayerDecodesToUnicodeFonts(t *testing.T) {
+	cmap := "/CIDInit /ProcSet findresource begin 12 dict begin begincmap\n" +
+		"1 begincodespacerange <0000> <FFFF> endcodespacerange\n" +
+		"2 beginbfchar <0001> <0044> <0002> <0061> endbfchar\n" +
+		"1 beginbfrange <0003> <0004> <0074> endbfrange\n" +
+		"endcmap end end"
+	pdf := []byte("%PDF-1.7\n" +
+		"1 0 obj << /Type /Catalog /Pages 2 0 R >> endobj\n" +
+		"2 0 obj << /Type /Pages /Kids [3 0 R] /Count 1 >> endobj\n" +
+		"3 0 obj << /Type /Page /Parent 2 0 R /Resources << /Font << /F0 5 0 R >> >> /Contents 4 0 R >> endobj\n" +
+		"4 0 obj << /Length 38 >>\nstream\nBT /F0 10 Tf 50 60 Td <0001000200030004> Tj ET\nendstream\nendobj\n" +
+		"5 0 obj << /Type /Font /Subtype /Type0 /ToUnicode 6 0 R /DescendantFonts [<< /DW 500 >>] >> endobj\n" +
+		fmt.Sprintf("6 0 obj << /Length %d >>\nstream\n%s\nendstream\nendobj\n", len(cmap), cmap) +
+		"trailer << /Root 1 0 R >>\n%%EOF")
+
+	layer, err := extractPDFTextLayer(context.Background(), pdf)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+	if len(layer.Lines) != 1 || layer.Lines[0].Text != "Datu" {
+		t.Fatalf("expected decoded line %q, got %+v", "Datu", layer.Lines)
+	}
+	if layer.Lines[0].Page != 1 || layer.Lines[0].Y != 60 {
+		t.Fatalf("expected baseline at y=60 on page 1, got %+v", layer.Lines[0])
+	}
+}
@@ -0,0 +1,327 @@
+// Package bunstore implements the placement RunStore on top of Bun. Apply
+// data.PlacementRunMigrations before use.
+package bunstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+	"github.com/goliatone/go-admin/pkg/placement/store"
+	"github.com/uptrace/bun"
+)
+
+type bunPlacementRunRecord struct {
+	bun.BaseModel `bun:"table:placement_runs,alias:pr"`
+
+	ID                  string     `bun:"id,pk" json:"id"`
+	AgreementID         string     `bun:"agreement_id" json:"agreement_id"`
+	OrgID               string     `bun:"org_id" json:"org_id"`
+	DocumentFingerprint string     `bun:"document_fingerprint" json:"document_fingerprint"`
+	Status              string     `bun:"status" json:"status"`
+	ReasonCode          string     `bun:"reason_code" json:"reason_code"`
+	SelectedSource      string     `bun:"selected_source" json:"selected_source"`
+	CreatedByUserID     string     `bun:"created_by_user_id" json:"created_by_user_id"`
+	PayloadJSON         string     `bun:"payload_json" json:"payload_json"`
+	CreatedAt           time.Time  `bun:"created_at" json:"created_at"`
+	CompletedAt         *time.Time `bun:"completed_at,nullzero" json:"completed_at"`
+}
+
+type bunPlacementFeedbackRecord struct {
+	bun.BaseModel `bun:"table:placement_feedback,alias:pf"`
+
+	ID                    string    `bun:"id,pk" json:"id"`
+	RunID                 string    `bun:"run_id" json:"run_id"`
+	SuggestionID          string    `bun:"suggestion_id" json:"suggestion_id"`
+	FieldDefinitionID     string    `bun:"field_definition_id" json:"field_definition_id"`
+	FieldType             string    `bun:"field_type" json:"field_type"`
+	FieldLabel            string    `bun:"field_label" json:"field_label"`
+	ResolverID            string    `bun:"resolver_id" json:"resolver_id"`
+	Decision              string    `bun:"decision" json:"decision"`
+	Source                string    `bun:"source" json:"source"`
+	SuggestedGeometryJSON string    `bun:"suggested_geometry_json" json:"suggested_geometry_json"`
+	FinalGeometryJSON     string    `bun:"final_geometry_json" json:"final_geometry_json"`
+	ActorID               string    `bun:"actor_id" json:"actor_id"`
+	RecordedAt            time.Time `bun:"recorded_at" json:"recorded_at"`
+}
+
+// BunRunStore persists placement runs and feedback in SQL via Bun.
+type BunRunStore struct {
+	db  *bun.DB
+	now func() time.Time
+}
+
+var _ store.RunStore = (*BunRunStore)(nil)
+
+// NewBunRunStore returns a Bun-backed run store, or nil when db is nil.
+func NewBunRunStore(db *bun.DB) *BunRunStore {
+	if db == nil {
+		return nil
+	}
+	return &BunRunStore{
+		db:  db,
+		now: func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *BunRunStore) SaveRun(ctx context.Context, run models.Run) error {
+	if s == nil || s.db == nil {
+		return errors.New("placement run store not configured")
+	}
+	run.ID = strings.TrimSpace(run.ID)
+	if run.ID == "" {
+		return errors.New("placement run id required")
+	}
+	record, err := placementRunRecord(run)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.NewInsert().
+		Model(&record).
+		On("CONFLICT (id) DO UPDATE").
+		Set("agreement_id = EXCLUDED.agreement_id").
+		Set("org_id = EXCLUDED.org_id").
+		Set("document_fingerprint = EXCLUDED.document_fingerprint").
+		Set("status = EXCLUDED.status").
+		Set("reason_code = EXCLUDED.reason_code").
+		Set("selected_source = EXCLUDED.selected_source").
+		Set("created_by_user_id = EXCLUDED.created_by_user_id").
+		Set("payload_json = EXCLUDED.payload_json").
+		Set("completed_at = EXCLUDED.completed_at").
+		Exec(ctx)
+	return err
+}
+
+func (s *BunRunStore) GetRun(ctx context.Context, id string) (models.Run, error) {
+	if s == nil || s.db == nil {
+		return models.Run{}, errors.New("placement run store not configured")
+	}
+	return s.getRun(ctx, s.db, id)
+}
+
+func (s *BunRunStore) getRun(ctx context.Context, db bun.IDB, id string) (models.Run, error) {
+	record := bunPlacementRunRecord{}
+	err := db.NewSelect().Model(&record).Where("id = ?", strings.TrimSpace(id)).Limit(1).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Run{}, store.ErrRunNotFound
+	}
+	if err != nil {
+		return models.Run{}, err
+	}
+	return placementRunFromRecord(record)
+}
+
+func (s *BunRunStore) ListRuns(ctx context.Context, filter store.RunFilter) ([]models.Run, error) {
+	if s == nil || s.db == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	records := []bunPlacementRunRecord{}
+	query := s.db.NewSelect().Model(&records)
+	if orgID := strings.TrimSpace(filter.OrgID); orgID != "" {
+		query.Where("org_id = ?", orgID)
+	}
+	if agreementID := strings.TrimSpace(filter.AgreementID); agreementID != "" {
+		query.Where("agreement_id = ?", agreementID)
+	}
+	if fingerprint := strings.TrimSpace(filter.DocumentFingerprint); fingerprint != "" {
+		query.Where("document_fingerprint = ?", fingerprint)
+	}
+	query.OrderExpr("created_at DESC").OrderExpr("id ASC")
+	if filter.Limit > 0 {
+		query.Limit(filter.Limit)
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	out := make([]models.Run, 0, len(records))
+	for _, record := range records {
+		run, err := placementRunFromRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, run)
+	}
+	return out, nil
+}
+
+func (s *BunRunStore) RecordFeedback(ctx context.Context, feedback ...models.PlacementFeedback) ([]models.PlacementFeedback, error) {
+	if s == nil || s.db == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	if len(feedback) == 0 {
+		return nil, nil
+	}
+	out := make([]models.PlacementFeedback, 0, len(feedback))
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		now := s.now()
+		runs := map[string]models.Run{}
+		records := make([]bunPlacementFeedbackRecord, 0, len(feedback))
+		for _, entry := range feedback {
+			runID := strings.TrimSpace(entry.RunID)
+			run, ok := runs[runID]
+			if !ok {
+				loaded, err := s.getRun(ctx, tx, runID)
+				if err != nil {
+					return err
+				}
+				runs[runID] = loaded
+				run = loaded
+			}
+			normalized, err := store.NormalizeFeedback(run, entry, now)
+			if err != nil {
+				return err
+			}
+			record, err := placementFeedbackRecord(normalized)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+			out = append(out, normalized)
+		}
+		_, err := tx.NewInsert().Model(&records).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *BunRunStore) ListFeedback(ctx context.Context, runID string) ([]models.PlacementFeedback, error) {
+	if s == nil || s.db == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	records := []bunPlacementFeedbackRecord{}
+	err := s.db.NewSelect().
+		Model(&records).
+		Where("run_id = ?", strings.TrimSpace(runID)).
+		OrderExpr("recorded_at ASC").
+		OrderExpr("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return placementFeedbackFromRecords(records)
+}
+
+func (s *BunRunStore) LearnedPlacements(ctx context.Context, orgID, fingerprint string) ([]models.LearnedPlacement, error) {
+	if s == nil || s.db == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	fingerprint = strings.TrimSpace(fingerprint)
+	if fingerprint == "" {
+		return nil, nil
+	}
+	runs := s.db.NewSelect().
+		Model((*bunPlacementRunRecord)(nil)).
+		Column("id").
+		Where("document_fingerprint = ?", fingerprint)
+	if orgID = strings.TrimSpace(orgID); orgID != "" {
+		runs.Where("org_id = ?", orgID)
+	}
+	records := []bunPlacementFeedbackRecord{}
+	err := s.db.NewSelect().
+		Model(&records).
+		Where("run_id IN (?)", runs).
+		OrderExpr("recorded_at ASC").
+		OrderExpr("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feedback, err := placementFeedbackFromRecords(records)
+	if err != nil {
+		return nil, err
+	}
+	return store.LearnPlacements(feedback), nil
+}
+
+func placementRunRecord(run models.Run) (bunPlacementRunRecord, error) {
+	payload, err := json.Marshal(run)
+	if err != nil {
+		return bunPlacementRunRecord{}, err
+	}
+	record := bunPlacementRunRecord{
+		ID:                  run.ID,
+		AgreementID:         strings.TrimSpace(run.AgreementID),
+		OrgID:               strings.TrimSpace(run.OrgID),
+		DocumentFingerprint: strings.TrimSpace(run.DocumentFingerprint),
+		Status:              run.Status,
+		ReasonCode:          run.ReasonCode,
+		SelectedSource:      run.SelectedSource,
+		CreatedByUserID:     strings.TrimSpace(run.CreatedByUserID),
+		PayloadJSON:         string(payload),
+		CreatedAt:           run.CreatedAt.UTC(),
+	}
+	if !run.CompletedAt.IsZero() {
+		completedAt := run.CompletedAt.UTC()
+		record.CompletedAt = &completedAt
+	}
+	return record, nil
+}
+
+func placementRunFromRecord(record bunPlacementRunRecord) (models.Run, error) {
+	run := models.Run{}
+	if err := json.Unmarshal([]byte(record.PayloadJSON), &run); err != nil {
+		return models.Run{}, err
+	}
+	run.ID = record.ID
+	return run, nil
+}
+
+func placementFeedbackRecord(feedback models.PlacementFeedback) (bunPlacementFeedbackRecord, error) {
+	suggested, err := json.Marshal(feedback.SuggestedGeometry)
+	if err != nil {
+		return bunPlacementFeedbackRecord{}, err
+	}
+	final, err := json.Marshal(feedback.FinalGeometry)
+	if err != nil {
+		return bunPlacementFeedbackRecord{}, err
+	}
+	return bunPlacementFeedbackRecord{
+		ID:                    feedback.ID,
+		RunID:                 feedback.RunID,
+		SuggestionID:          feedback.SuggestionID,
+		FieldDefinitionID:     feedback.FieldDefinitionID,
+		FieldType:             feedback.FieldType,
+		FieldLabel:            feedback.FieldLabel,
+		ResolverID:            feedback.ResolverID,
+		Decision:              feedback.Decision,
+		Source:                feedback.Source,
+		SuggestedGeometryJSON: string(suggested),
+		FinalGeometryJSON:     string(final),
+		ActorID:               strings.TrimSpace(feedback.ActorID),
+		RecordedAt:            feedback.RecordedAt.UTC(),
+	}, nil
+}
+
+func placementFeedbackFromRecords(records []bunPlacementFeedbackRecord) ([]models.PlacementFeedback, error) {
+	out := make([]models.PlacementFeedback, 0, len(records))
+	for _, record := range records {
+		feedback := models.PlacementFeedback{
+			ID:                record.ID,
+			RunID:             record.RunID,
+			SuggestionID:      record.SuggestionID,
+			FieldDefinitionID: record.FieldDefinitionID,
+			FieldType:         record.FieldType,
+			FieldLabel:        record.FieldLabel,
+			ResolverID:        record.ResolverID,
+			Decision:          record.Decision,
+			Source:            record.Source,
+			ActorID:           record.ActorID,
+			RecordedAt:        record.RecordedAt,
+		}
+		if err := json.Unmarshal([]byte(record.SuggestedGeometryJSON), &feedback.SuggestedGeometry); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(record.FinalGeometryJSON), &feedback.FinalGeometry); err != nil {
+			return nil, err
+		}
+		out = append(out, feedback)
+	}
+	return out, nil
+}
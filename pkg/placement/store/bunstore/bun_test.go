@@ -0,0 +1,175 @@
+package bunstore_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	admindata "github.com/goliatone/go-admin/data"
+	"github.com/goliatone/go-admin/pkg/placement/models"
+	"github.com/goliatone/go-admin/pkg/placement/store"
+	"github.com/goliatone/go-admin/pkg/placement/store/bunstore"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/driver/sqliteshim"
+)
+
+func setupPlacementRunStore(t *testing.T) *bunstore.BunRunStore {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "placement.db") + "?cache=shared&_fk=1"
+	sqlDB, err := sql.Open(sqliteshim.ShimName, dsn)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	db := bun.NewDB(sqlDB, sqlitedialect.New())
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("close placement database: %v", err)
+		}
+	})
+	migration, err := fs.ReadFile(admindata.PlacementRunMigrations(), "0016_placement_runs.up.sql")
+	if err != nil {
+		t.Fatalf("read migration: %v", err)
+	}
+	if _, err := db.ExecContext(context.Background(), string(migration)); err != nil {
+		t.Fatalf("apply migration: %v", err)
+	}
+	return bunstore.NewBunRunStore(db)
+}
+
+func placementTestRun(id, orgID, fingerprint string, createdAt time.Time) models.Run {
+	return models.Run{
+		ID:                  id,
+		OrgID:               orgID,
+		AgreementID:         "agreement-" + id,
+		DocumentFingerprint: fingerprint,
+		Status:              models.RunStatusCompleted,
+		CreatedAt:           createdAt,
+		FieldDefinitions: []models.FieldDefinition{
+			{ID: id + "-sig", FieldType: "signature", Label: "Buyer Signature"},
+		},
+		Suggestions: []models.Suggestion{
+			{ID: id + "-s1", FieldDefinitionID: id + "-sig", ResolverID: "text_anchor_resolver", Geometry: models.Geometry{PageNumber: 2, X: 72, Y: 120, Width: 180, Height: 36}},
+		},
+	}
+}
+
+func TestBunRunStoreSavesAndListsRuns(t *testing.T) {
+	ctx := context.Background()
+	runs := setupPlacementRunStore(t)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	first := placementTestRun("run-1", "org-1", "abc", base)
+	if err := runs.SaveRun(ctx, first); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := runs.SaveRun(ctx, placementTestRun("run-2", "org-1", "abc", base.Add(time.Hour))); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := runs.SaveRun(ctx, placementTestRun("run-3", "org-2", "abc", base.Add(2*time.Hour))); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+
+	first.Status = models.RunStatusFailed
+	first.SelectedSource = "history_resolver"
+	if err := runs.SaveRun(ctx, first); err != nil {
+		t.Fatalf("SaveRun upsert: %v", err)
+	}
+	loaded, err := runs.GetRun(ctx, "run-1")
+	if err != nil {
+		t.Fatalf("GetRun: %v", err)
+	}
+	if loaded.Status != models.RunStatusFailed || loaded.SelectedSource != "history_resolver" || len(loaded.Suggestions) != 1 {
+		t.Fatalf("expected upserted run payload, got %+v", loaded)
+	}
+	if _, err := runs.GetRun(ctx, "missing"); !errors.Is(err, store.ErrRunNotFound) {
+		t.Fatalf("expected ErrRunNotFound, got %v", err)
+	}
+	if err := runs.SaveRun(ctx, models.Run{ID: " "}); err == nil {
+		t.Fatalf("expected blank run id to be rejected")
+	}
+
+	listed, err := runs.ListRuns(ctx, store.RunFilter{OrgID: "org-1", DocumentFingerprint: "abc"})
+	if err != nil {
+		t.Fatalf("ListRuns: %v", err)
+	}
+	if len(listed) != 2 || listed[0].ID != "run-2" || listed[1].ID != "run-1" {
+		t.Fatalf("expected org-1 runs newest first, got %+v", listed)
+	}
+	limited, err := runs.ListRuns(ctx, store.RunFilter{DocumentFingerprint: "abc", Limit: 1})
+	if err != nil || len(limited) != 1 || limited[0].ID != "run-3" {
+		t.Fatalf("expected newest run across orgs, got %+v (%v)", limited, err)
+	}
+}
+
+func TestBunRunStoreRecordsFeedbackAndLearnsPlacements(t *testing.T) {
+	ctx := context.Background()
+	runs := setupPlacementRunStore(t)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, run := range []models.Run{
+		placementTestRun("run-1", "org-1", "abc", base),
+		placementTestRun("run-2", "org-2", "abc", base),
+	} {
+		if err := runs.SaveRun(ctx, run); err != nil {
+			t.Fatalf("SaveRun: %v", err)
+		}
+	}
+
+	moved := models.Geometry{PageNumber: 2, X: 310, Y: 480, Width: 180, Height: 36}
+	recorded, err := runs.RecordFeedback(ctx, models.PlacementFeedback{
+		RunID:         "run-1",
+		SuggestionID:  "run-1-s1",
+		Decision:      models.FeedbackDecisionMoved,
+		FinalGeometry: moved,
+		ActorID:       "user-1",
+	})
+	if err != nil {
+		t.Fatalf("RecordFeedback: %v", err)
+	}
+	if len(recorded) != 1 || recorded[0].ID == "" || recorded[0].Source != models.PlacementSourceManual {
+		t.Fatalf("expected normalized feedback, got %+v", recorded)
+	}
+	if _, err := runs.RecordFeedback(ctx,
+		models.PlacementFeedback{RunID: "run-1", SuggestionID: "run-1-s1", Decision: models.FeedbackDecisionAccepted},
+		models.PlacementFeedback{RunID: "run-1", SuggestionID: "unknown", Decision: models.FeedbackDecisionRejected},
+	); !errors.Is(err, store.ErrInvalidFeedback) {
+		t.Fatalf("expected ErrInvalidFeedback, got %v", err)
+	}
+	if _, err := runs.RecordFeedback(ctx, models.PlacementFeedback{RunID: "missing", SuggestionID: "x", Decision: models.FeedbackDecisionAccepted}); !errors.Is(err, store.ErrRunNotFound) {
+		t.Fatalf("expected ErrRunNotFound for unknown run, got %v", err)
+	}
+
+	stored, err := runs.ListFeedback(ctx, "run-1")
+	if err != nil {
+		t.Fatalf("ListFeedback: %v", err)
+	}
+	if len(stored) != 1 || stored[0].FinalGeometry != moved || stored[0].SuggestedGeometry.X != 72 || stored[0].ActorID != "user-1" {
+		t.Fatalf("expected invalid batch discarded and geometry round-tripped, got %+v", stored)
+	}
+
+	learned, err := runs.LearnedPlacements(ctx, "org-1", "abc")
+	if err != nil {
+		t.Fatalf("LearnedPlacements: %v", err)
+	}
+	if len(learned) != 1 || learned[0].Geometry != moved || learned[0].SourceRunID != "run-1" {
+		t.Fatalf("expected learned moved placement, got %+v", learned)
+	}
+	other, err := runs.LearnedPlacements(ctx, "org-2", "abc")
+	if err != nil || len(other) != 0 {
+		t.Fatalf("expected no learned placements for another org, got %+v (%v)", other, err)
+	}
+}
+
+func TestBunRunStoreRequiresDatabase(t *testing.T) {
+	if bunstore.NewBunRunStore(nil) != nil {
+		t.Fatalf("expected nil store without a database")
+	}
+	var runs *bunstore.BunRunStore
+	if err := runs.SaveRun(context.Background(), models.Run{ID: "run-1"}); err == nil {
+		t.Fatalf("expected unconfigured store error")
+	}
+}
@@ -0,0 +1,164 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+// MemoryRunStore is an in-process RunStore for tests and single-node setups.
+type MemoryRunStore struct {
+	mu       sync.RWMutex
+	runs     map[string]models.Run
+	feedback map[string][]models.PlacementFeedback
+	now      func() time.Time
+}
+
+// NewMemoryRunStore returns an empty in-memory run store.
+func NewMemoryRunStore() *MemoryRunStore {
+	return &MemoryRunStore{
+		runs:     map[string]models.Run{},
+		feedback: map[string][]models.PlacementFeedback{},
+		now:      func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *MemoryRunStore) SaveRun(ctx context.Context, run models.Run) error {
+	if s == nil {
+		return errors.New("placement run store not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	run.ID = strings.TrimSpace(run.ID)
+	if run.ID == "" {
+		return errors.New("placement run id required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[run.ID] = cloneRun(run)
+	return nil
+}
+
+func (s *MemoryRunStore) GetRun(ctx context.Context, id string) (models.Run, error) {
+	if s == nil {
+		return models.Run{}, errors.New("placement run store not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return models.Run{}, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	run, ok := s.runs[strings.TrimSpace(id)]
+	if !ok {
+		return models.Run{}, ErrRunNotFound
+	}
+	return cloneRun(run), nil
+}
+
+func (s *MemoryRunStore) ListRuns(ctx context.Context, filter RunFilter) ([]models.Run, error) {
+	if s == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]models.Run, 0)
+	for _, run := range s.runs {
+		if filter.matches(run) {
+			out = append(out, cloneRun(run))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.After(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (s *MemoryRunStore) RecordFeedback(ctx context.Context, feedback ...models.PlacementFeedback) ([]models.PlacementFeedback, error) {
+	if s == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	out := make([]models.PlacementFeedback, 0, len(feedback))
+	for _, entry := range feedback {
+		run, ok := s.runs[strings.TrimSpace(entry.RunID)]
+		if !ok {
+			return nil, ErrRunNotFound
+		}
+		normalized, err := NormalizeFeedback(run, entry, now)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, normalized)
+	}
+	for _, entry := range out {
+		s.feedback[entry.RunID] = append(s.feedback[entry.RunID], entry)
+	}
+	return out, nil
+}
+
+func (s *MemoryRunStore) ListFeedback(ctx context.Context, runID string) ([]models.PlacementFeedback, error) {
+	if s == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]models.PlacementFeedback{}, s.feedback[strings.TrimSpace(runID)]...), nil
+}
+
+func (s *MemoryRunStore) LearnedPlacements(ctx context.Context, orgID, fingerprint string) ([]models.LearnedPlacement, error) {
+	if s == nil {
+		return nil, errors.New("placement run store not configured")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	fingerprint = strings.TrimSpace(fingerprint)
+	if fingerprint == "" {
+		return nil, nil
+	}
+	filter := RunFilter{OrgID: orgID, DocumentFingerprint: fingerprint}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	feedback := make([]models.PlacementFeedback, 0)
+	for runID, run := range s.runs {
+		if filter.matches(run) {
+			feedback = append(feedback, s.feedback[runID]...)
+		}
+	}
+	return LearnPlacements(feedback), nil
+}
+
+func cloneRun(run models.Run) models.Run {
+	out := run
+	out.FieldDefinitions = append([]models.FieldDefinition{}, run.FieldDefinitions...)
+	out.ResolverOrder = append([]string{}, run.ResolverOrder...)
+	out.ExecutedResolvers = append([]string{}, run.ExecutedResolvers...)
+	out.Estimates = append([]models.Estimate{}, run.Estimates...)
+	out.Scores = append([]models.ResolverScore{}, run.Scores...)
+	out.Suggestions = append([]models.Suggestion{}, run.Suggestions...)
+	out.UnresolvedDefinitionIDs = append([]string{}, run.UnresolvedDefinitionIDs...)
+	return out
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/pkg/placement/models"
+)
+
+func feedbackTestRun(id, fingerprint string, createdAt time.Time) models.Run {
+	return models.Run{
+		ID:                  id,
+		OrgID:               "org-1",
+		AgreementID:         "agreement-" + id,
+		DocumentFingerprint: fingerprint,
+		CreatedAt:           createdAt,
+		FieldDefinitions: []models.FieldDefinition{
+			{ID: id + "-sig", FieldType: "signature", Label: "Buyer Signature"},
+			{ID: id + "-date", FieldType: "date", Label: "Date"},
+		},
+		Suggestions: []models.Suggestion{
+			{ID: id + "-s1", FieldDefinitionID: id + "-sig", ResolverID: "text_anchor_resolver", Geometry: models.Geometry{PageNumber: 2, X: 72, Y: 120, Width: 180, Height: 36}},
+			{ID: id + "-s2", FieldDefinitionID: id + "-date", ResolverID: "text_anchor_resolver", Geometry: models.Geometry{PageNumber: 2, X: 300, Y: 500, Width: 120, Height: 24}},
+		},
+	}
+}
+
+func TestMemoryRunStoreRecordsRunsAndFeedback(t *testing.T) {
+	ctx := context.Background()
+	runs := NewMemoryRunStore()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := runs.SaveRun(ctx, feedbackTestRun("run-1", "abc", base)); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := runs.SaveRun(ctx, feedbackTestRun("run-2", "abc", base.Add(time.Hour))); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	listed, err := runs.ListRuns(ctx, RunFilter{DocumentFingerprint: "abc", Limit: 1})
+	if err != nil {
+		t.Fatalf("ListRuns: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != "run-2" {
+		t.Fatalf("expected newest run first, got %+v", listed)
+	}
+	if _, err := runs.GetRun(ctx, "missing"); !errors.Is(err, ErrRunNotFound) {
+		t.Fatalf("expected ErrRunNotFound, got %v", err)
+	}
+
+	moved := models.Geometry{PageNumber: 2, X: 310, Y: 480, Width: 120, Height: 24}
+	recorded, err := runs.RecordFeedback(ctx,
+		models.PlacementFeedback{RunID: "run-1", SuggestionID: "run-1-s1", Decision: models.FeedbackDecisionAccepted},
+		models.PlacementFeedback{RunID: "run-1", SuggestionID: "run-1-s2", Decision: models.FeedbackDecisionMoved, FinalGeometry: moved},
+	)
+	if err != nil {
+		t.Fatalf("RecordFeedback: %v", err)
+	}
+	accepted, movedEntry := recorded[0], recorded[1]
+	if accepted.Source != models.PlacementSourceAuto || accepted.FieldType != "signature" || accepted.FinalGeometry.X != 72 {
+		t.Fatalf("expected accepted feedback enriched from run, got %+v", accepted)
+	}
+	if movedEntry.Source != models.PlacementSourceManual || movedEntry.SuggestedGeometry.X != 300 || movedEntry.FinalGeometry != moved {
+		t.Fatalf("expected moved feedback to keep both geometries, got %+v", movedEntry)
+	}
+
+	if _, err := runs.RecordFeedback(ctx,
+		models.PlacementFeedback{RunID: "run-1", SuggestionID: "run-1-s1", Decision: models.FeedbackDecisionAccepted},
+		models.PlacementFeedback{RunID: "run-1", SuggestionID: "unknown", Decision: models.FeedbackDecisionRejected},
+	); !errors.Is(err, ErrInvalidFeedback) {
+		t.Fatalf("expected ErrInvalidFeedback, got %v", err)
+	}
+	stored, err := runs.ListFeedback(ctx, "run-1")
+	if err != nil {
+		t.Fatalf("ListFeedback: %v", err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("expected invalid batch to be discarded, got %d entries", len(stored))
+	}
+}
+
+func TestLearnPlacementsClustersConfirmationsAndDropsRejected(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sig := models.Geometry{PageNumber: 2, X: 72, Y: 120, Width: 180, Height: 36}
+	nudged := models.Geometry{PageNumber: 2, X: 74, Y: 121, Width: 180, Height: 36}
+	wrong := models.Geometry{PageNumber: 1, X: 10, Y: 700, Width: 120, Height: 24}
+	learned := LearnPlacements([]models.PlacementFeedback{
+		{RunID: "run-2", FieldType: "signature", FieldLabel: "Buyer  Signature", Decision: models.FeedbackDecisionMoved, Source: models.PlacementSourceManual, SuggestedGeometry: sig, FinalGeometry: nudged, RecordedAt: base.Add(time.Hour)},
+		{RunID: "run-1", FieldType: "signature", FieldLabel: "Buyer Signature", Decision: models.FeedbackDecisionAccepted, Source: models.PlacementSourceAuto, FinalGeometry: sig, RecordedAt: base},
+		{RunID: "run-1", FieldType: "date", FieldLabel: "Date", Decision: models.FeedbackDecisionPlaced, Source: models.PlacementSourceManual, FinalGeometry: wrong, RecordedAt: base},
+		{RunID: "run-2", FieldType: "date", FieldLabel: "Date", Decision: models.FeedbackDecisionRejected, SuggestedGeometry: wrong, RecordedAt: base.Add(time.Hour)},
+	})
+	if len(learned) != 1 {
+		t.Fatalf("expected rejected date placement to be dropped, got %+v", learned)
+	}
+	got := learned[0]
+	if got.FieldKey != "signature|buyer signature" || got.Confirmations != 2 || got.Geometry != nudged || got.SourceRunID != "run-2" {
+		t.Fatalf("expected latest confirmed signature geometry, got %+v", got)
+	}
+}
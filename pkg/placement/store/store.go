@@ -0,0 +1,214 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/internal/primitives"
+	"github.com/goliatone/go-admin/pkg/placement/models"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrRunNotFound is returned when a run ID has no stored record.
+	ErrRunNotFound = errors.New("placement run not found")
+	// ErrInvalidFeedback is returned when feedback cannot be applied to its run.
+	ErrInvalidFeedback = errors.New("invalid placement feedback")
+)
+
+// learnedGeometryTolerance is the distance in PDF points under which two
+// confirmed placements of the same field are treated as the same position.
+const learnedGeometryTolerance = 4.0
+
+// RunStore persists placement runs and the user decisions taken on their
+// suggestions.
+type RunStore interface {
+	SaveRun(ctx context.Context, run models.Run) error
+	GetRun(ctx context.Context, id string) (models.Run, error)
+	ListRuns(ctx context.Context, filter RunFilter) ([]models.Run, error)
+	RecordFeedback(ctx context.Context, feedback ...models.PlacementFeedback) ([]models.PlacementFeedback, error)
+	ListFeedback(ctx context.Context, runID string) ([]models.PlacementFeedback, error)
+	LearnedPlacements(ctx context.Context, orgID, fingerprint string) ([]models.LearnedPlacement, error)
+}
+
+// RunFilter narrows ListRuns. Empty fields match every run; runs are
+// returned newest first.
+type RunFilter struct {
+	OrgID               string `json:"org_id"`
+	AgreementID         string `json:"agreement_id"`
+	DocumentFingerprint string `json:"document_fingerprint"`
+	Limit               int    `json:"limit"`
+}
+
+func (f RunFilter) matches(run models.Run) bool {
+	if orgID := strings.TrimSpace(f.OrgID); orgID != "" && run.OrgID != orgID {
+		return false
+	}
+	if agreementID := strings.TrimSpace(f.AgreementID); agreementID != "" && run.AgreementID != agreementID {
+		return false
+	}
+	if fingerprint := strings.TrimSpace(f.DocumentFingerprint); fingerprint != "" && run.DocumentFingerprint != fingerprint {
+		return false
+	}
+	return true
+}
+
+// NormalizeFeedback validates feedback against its run and fills the field
+// type, label, resolver and suggested geometry from the stored run so later
+// learning does not depend on callers echoing them back.
+func NormalizeFeedback(run models.Run, feedback models.PlacementFeedback, now time.Time) (models.PlacementFeedback, error) {
+	feedback.RunID = run.ID
+	feedback.SuggestionID = strings.TrimSpace(feedback.SuggestionID)
+	feedback.FieldDefinitionID = strings.TrimSpace(feedback.FieldDefinitionID)
+	feedback.Decision = strings.ToLower(strings.TrimSpace(feedback.Decision))
+
+	if feedback.SuggestionID != "" {
+		suggestion, ok := findSuggestion(run.Suggestions, feedback.SuggestionID)
+		if !ok {
+			return feedback, fmt.Errorf("%w: suggestion %q not in run %q", ErrInvalidFeedback, feedback.SuggestionID, run.ID)
+		}
+		feedback.FieldDefinitionID = primitives.FirstNonEmpty(feedback.FieldDefinitionID, suggestion.FieldDefinitionID)
+		feedback.ResolverID = primitives.FirstNonEmpty(feedback.ResolverID, suggestion.ResolverID)
+		if feedback.SuggestedGeometry.Empty() {
+			feedback.SuggestedGeometry = suggestion.Geometry
+		}
+	}
+	if feedback.FieldDefinitionID == "" {
+		return feedback, fmt.Errorf("%w: field definition id required", ErrInvalidFeedback)
+	}
+	for _, definition := range run.FieldDefinitions {
+		if strings.TrimSpace(definition.ID) != feedback.FieldDefinitionID {
+			continue
+		}
+		feedback.FieldType = primitives.FirstNonEmpty(feedback.FieldType, definition.FieldType)
+		feedback.FieldLabel = primitives.FirstNonEmpty(feedback.FieldLabel, definition.Label)
+		break
+	}
+
+	switch feedback.Decision {
+	case models.FeedbackDecisionAccepted:
+		if feedback.SuggestionID == "" {
+			return feedback, fmt.Errorf("%w: accepted feedback requires a suggestion", ErrInvalidFeedback)
+		}
+		if feedback.FinalGeometry.Empty() {
+			feedback.FinalGeometry = feedback.SuggestedGeometry
+		}
+		feedback.Source = models.PlacementSourceAuto
+	case models.FeedbackDecisionMoved:
+		if feedback.SuggestionID == "" || feedback.FinalGeometry.Empty() {
+			return feedback, fmt.Errorf("%w: moved feedback requires a suggestion and final geometry", ErrInvalidFeedback)
+		}
+		feedback.Source = models.PlacementSourceManual
+	case models.FeedbackDecisionRejected:
+		if feedback.SuggestionID == "" {
+			return feedback, fmt.Errorf("%w: rejected feedback requires a suggestion", ErrInvalidFeedback)
+		}
+		feedback.FinalGeometry = models.Geometry{}
+		feedback.Source = models.PlacementSourceAuto
+	case models.FeedbackDecisionPlaced:
+		if feedback.FinalGeometry.Empty() {
+			return feedback, fmt.Errorf("%w: placed feedback requires final geometry", ErrInvalidFeedback)
+		}
+		feedback.Source = models.PlacementSourceManual
+	default:
+		return feedback, fmt.Errorf("%w: unknown decision %q", ErrInvalidFeedback, feedback.Decision)
+	}
+
+	feedback.ID = primitives.FirstNonEmpty(strings.TrimSpace(feedback.ID), uuid.NewString())
+	if feedback.RecordedAt.IsZero() {
+		feedback.RecordedAt = now
+	}
+	return feedback, nil
+}
+
+func findSuggestion(suggestions []models.Suggestion, id string) (models.Suggestion, bool) {
+	for _, suggestion := range suggestions {
+		if strings.TrimSpace(suggestion.ID) == id {
+			return suggestion, true
+		}
+	}
+	return models.Suggestion{}, false
+}
+
+// LearnPlacements folds feedback from runs over one document into learned
+// placements. Confirmed geometry (accepted, moved or placed) of the same field
+// key is clustered by position; rejections count against the suggested
+// position. Clusters rejected at least as often as they were confirmed are
+// dropped. Results are ordered by field key, then page and top-to-bottom.
+func LearnPlacements(feedback []models.PlacementFeedback) []models.LearnedPlacement {
+	ordered := append([]models.PlacementFeedback{}, feedback...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].RecordedAt.Before(ordered[j].RecordedAt) })
+
+	clusters := map[string][]*models.LearnedPlacement{}
+	keys := make([]string, 0)
+	find := func(key string, geometry models.Geometry) *models.LearnedPlacement {
+		for _, cluster := range clusters[key] {
+			if sameLearnedPosition(cluster.Geometry, geometry) {
+				return cluster
+			}
+		}
+		return nil
+	}
+	for _, entry := range ordered {
+		key := models.FieldKey(entry.FieldType, entry.FieldLabel)
+		switch entry.Decision {
+		case models.FeedbackDecisionAccepted, models.FeedbackDecisionMoved, models.FeedbackDecisionPlaced:
+			cluster := find(key, entry.FinalGeometry)
+			if cluster == nil {
+				cluster = &models.LearnedPlacement{
+					FieldKey:   key,
+					FieldType:  strings.TrimSpace(entry.FieldType),
+					FieldLabel: strings.TrimSpace(entry.FieldLabel),
+				}
+				if _, seen := clusters[key]; !seen {
+					keys = append(keys, key)
+				}
+				clusters[key] = append(clusters[key], cluster)
+			}
+			cluster.Confirmations++
+			cluster.Geometry = entry.FinalGeometry
+			cluster.Source = entry.Source
+			cluster.SourceRunID = entry.RunID
+			cluster.LastConfirmedAt = entry.RecordedAt
+		case models.FeedbackDecisionRejected:
+			if cluster := find(key, entry.SuggestedGeometry); cluster != nil {
+				cluster.Rejections++
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	out := make([]models.LearnedPlacement, 0)
+	for _, key := range keys {
+		group := make([]models.LearnedPlacement, 0, len(clusters[key]))
+		for _, cluster := range clusters[key] {
+			if cluster.Rejections >= cluster.Confirmations {
+				continue
+			}
+			group = append(group, *cluster)
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			left, right := group[i].Geometry, group[j].Geometry
+			if left.PageNumber != right.PageNumber {
+				return left.PageNumber < right.PageNumber
+			}
+			if left.Y != right.Y {
+				return left.Y > right.Y
+			}
+			return left.X < right.X
+		})
+		out = append(out, group...)
+	}
+	return out
+}
+
+func sameLearnedPosition(left, right models.Geometry) bool {
+	return left.PageNumber == right.PageNumber &&
+		math.Abs(left.X-right.X) <= learnedGeometryTolerance &&
+		math.Abs(left.Y-right.Y) <= learnedGeometryTolerance
+}
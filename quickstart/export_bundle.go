@@ -346,6 +346,9 @@ type exportHTTPRegistrar struct {
 	pathSuffix          string
 	maxBufferBytes      int64
 	logger              export.Logger
+	admin               *admin.Admin
+	schedules           *admin.ExportScheduleService
+	resolvedBasePath    string
 }
 
 func (r *exportHTTPRegistrar) RegisterExportRoutes(router admin.AdminRouter, opts admin.ExportRouteOptions) error {
@@ -358,6 +361,8 @@ func (r *exportHTTPRegistrar) RegisterExportRoutes(router admin.AdminRouter, opt
 	if strings.TrimSpace(r.historyPath) != "" {
 		historyPath = resolveExportPath(opts.BasePath, r.historyPath, "")
 	}
+	r.resolvedBasePath = basePath
+	r.registerScheduleRoutes(router, basePath, opts.Wrap)
 
 	handler := exportrouter.NewHandler(exportapi.Config{
 		Service:             r.service,
@@ -138,3 +138,37 @@ func TestExportBundleRegistersConfiguredCollectionPath(t *testing.T) {
 		})
 	}
 }
+
+func TestExportBundleRegistersScheduleRoutesWhenEnabled(t *testing.T) {
+	bundle := NewExportBundle()
+	registrar := bundle.Registrar.(*exportHTTPRegistrar)
+	schedules, err := admin.NewExportScheduleService(nil, exportScheduleRunner{})
+	if err != nil {
+		t.Fatalf("schedule service: %v", err)
+	}
+	registrar.schedules = schedules
+
+	recorder := &recordingRouter{}
+	if err := bundle.Registrar.RegisterExportRoutes(recorder, admin.ExportRouteOptions{BasePath: "/admin"}); err != nil {
+		t.Fatalf("register routes: %v", err)
+	}
+	base := "/admin/exports"
+	for _, route := range []recordedRoute{
+		{method: "GET", path: base + "/schedules"},
+		{method: "POST", path: base + "/schedules"},
+		{method: "PUT", path: base + "/schedules/:id"},
+		{method: "DELETE", path: base + "/schedules/:id"},
+		{method: "POST", path: base + "/schedules/:id/run"},
+		{method: "GET", path: base + "/scheduled/:token"},
+	} {
+		if !recorder.has(route.method, route.path) {
+			t.Fatalf("expected %s %s, got %+v", route.method, route.path, recorder.routes)
+		}
+	}
+	if !recorder.has("POST", base) {
+		t.Fatalf("expected export POST route to stay registered")
+	}
+	if got := registrar.scheduledDownloadPath("abc"); got != base+"/scheduled/abc" {
+		t.Fatalf("unexpected download path %q", got)
+	}
+}
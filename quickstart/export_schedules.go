@@ -0,0 +1,278 @@
+package quickstart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-admin/admin"
+	"github.com/goliatone/go-export/export"
+	router "github.com/goliatone/go-router"
+)
+
+const exportScheduledDownloadSegment = "scheduled"
+
+// ExportScheduleConfig configures recurring exports for an export bundle.
+type ExportScheduleConfig struct {
+	// Store keeps schedules; nil keeps them in memory.
+	Store admin.ExportScheduleStore `json:"-"`
+	// Secret signs download links and must be at least 32 bytes.
+	Secret []byte `json:"-"`
+	// PublicURL is the absolute origin prepended to signed download paths
+	// ("https://admin.example.com").
+	PublicURL string        `json:"public_url"`
+	LinkTTL   time.Duration `json:"link_ttl"`
+	// Mailer emails download links to schedule recipients.
+	Mailer admin.ScheduledExportMailer `json:"-"`
+	// RecipientDomains restricts recipient emails to these domains; any
+	// domain is accepted when empty.
+	RecipientDomains []string `json:"recipient_domains"`
+	// Schedule is the cron expression of the due-schedule job; every minute
+	// when empty.
+	Schedule string                              `json:"schedule"`
+	Options  []admin.ExportScheduleServiceOption `json:"-"`
+}
+
+// ConfigureExportSchedules enables recurring exports on the bundle. Schedules
+// run through the bundle's export service as their owner, so the bundle needs
+// an actor provider that reads the owner from the context. Call it before
+// export routes are registered to mount the schedule endpoints and the signed
+// download route.
+func ConfigureExportSchedules(adm *admin.Admin, bundle *ExportBundle, cfg ExportScheduleConfig) (*admin.ExportScheduleService, error) {
+	if adm == nil || bundle == nil || bundle.Service == nil || bundle.Runner == nil {
+		return nil, fmt.Errorf("admin and export bundle are required")
+	}
+	registrar, ok := bundle.Registrar.(*exportHTTPRegistrar)
+	if !ok || registrar == nil {
+		return nil, fmt.Errorf("export schedules require the default export registrar")
+	}
+	if registrar.actorProvider == nil {
+		return nil, fmt.Errorf("export schedules require an export actor provider")
+	}
+	signer, err := admin.NewExportDownloadSigner(cfg.Secret)
+	if err != nil {
+		return nil, err
+	}
+	publicURL := strings.TrimRight(strings.TrimSpace(cfg.PublicURL), "/")
+	opts := []admin.ExportScheduleServiceOption{
+		admin.WithExportScheduleLinks(signer, func(token string) string {
+			return publicURL + registrar.scheduledDownloadPath(token)
+		}),
+		admin.WithExportScheduleLinkTTL(cfg.LinkTTL),
+	}
+	if cfg.Mailer != nil {
+		opts = append(opts, admin.WithExportScheduleMailer(cfg.Mailer))
+	}
+	if len(cfg.RecipientDomains) > 0 {
+		opts = append(opts, admin.WithExportScheduleRecipientDomains(cfg.RecipientDomains...))
+	}
+	runner := exportScheduleRunner{
+		service: bundle.Service,
+		tracker: bundle.Runner.Tracker,
+		actors:  registrar.actorProvider,
+	}
+	svc, err := adm.WithExportSchedules(cfg.Store, runner, append(opts, cfg.Options...)...)
+	if err != nil {
+		return nil, err
+	}
+	if err := admin.RegisterExportScheduleCommands(adm.Commands(), svc, cfg.Schedule); err != nil {
+		return nil, err
+	}
+	registrar.admin = adm
+	registrar.schedules = svc
+	return svc, nil
+}
+
+// exportScheduleRunner generates scheduled exports through the go-export
+// service, resolving the export actor from the owner context.
+type exportScheduleRunner struct {
+	service export.Service
+	tracker export.ProgressTracker
+	actors  export.ActorProvider
+}
+
+func (r exportScheduleRunner) RunScheduledExport(ctx context.Context, req admin.ScheduledExportRequest) (admin.ScheduledExportArtifact, error) {
+	if r.service == nil || r.tracker == nil || r.actors == nil {
+		return admin.ScheduledExportArtifact{}, export.NewError(export.KindNotImpl, "export service not configured", nil)
+	}
+	actor, err := r.actors.FromContext(ctx)
+	if err != nil {
+		return admin.ScheduledExportArtifact{}, err
+	}
+	var query any
+	if len(req.Query) > 0 {
+		raw, err := json.Marshal(req.Query)
+		if err != nil {
+			return admin.ScheduledExportArtifact{}, err
+		}
+		if query, err = decodeDatagridQuery(req.Definition, req.Variant, raw); err != nil {
+			return admin.ScheduledExportArtifact{}, err
+		}
+	}
+	exportReq := export.ExportRequest{
+		Definition:    req.Definition,
+		SourceVariant: req.Variant,
+		Format:        export.Format(req.Format),
+		Columns:       req.Columns,
+		Query:         query,
+		Delivery:      export.DeliveryAsync,
+	}
+	record, err := r.service.RequestExport(ctx, actor, exportReq)
+	if err != nil {
+		return admin.ScheduledExportArtifact{}, err
+	}
+	if _, err := r.service.GenerateExport(ctx, actor, record.ID, exportReq); err != nil {
+		return admin.ScheduledExportArtifact{}, err
+	}
+	status, err := r.tracker.Status(ctx, record.ID)
+	if err != nil {
+		return admin.ScheduledExportArtifact{}, err
+	}
+	key := strings.TrimSpace(status.Artifact.Key)
+	if key == "" {
+		return admin.ScheduledExportArtifact{}, export.NewError(export.KindNotFound, "export artifact not found", nil)
+	}
+	return admin.ScheduledExportArtifact{
+		ExportID:    record.ID,
+		Key:         key,
+		FileName:    req.FileName,
+		ContentType: exportContentType(req.Format),
+		Rows:        int64(status.Counts.Processed),
+		Bytes:       int64(status.BytesWritten),
+	}, nil
+}
+
+func exportContentType(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "csv":
+		return "text/csv"
+	case "json":
+		return "application/json"
+	case "ndjson":
+		return "application/x-ndjson"
+	case "xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case "pdf":
+		return "application/pdf"
+	case "template", "html":
+		return "text/html"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+func (r *exportHTTPRegistrar) scheduledDownloadPath(token string) string {
+	base := r.resolvedBasePath
+	if base == "" {
+		base = resolveExportPath("", r.basePath, r.pathSuffix)
+	}
+	return path.Join(base, exportScheduledDownloadSegment) + "/" + token
+}
+
+// registerScheduleRoutes mounts the schedule API behind the export wrapper
+// and the signed download route outside of it, since link recipients may not
+// have an admin session.
+func (r *exportHTTPRegistrar) registerScheduleRoutes(rt admin.AdminRouter, basePath string, wrap admin.ExportRouteWrapper) {
+	if r.schedules == nil {
+		return
+	}
+	schedulesPath := path.Join(basePath, "schedules")
+	wrapped := wrapExportRouter(rt, wrap)
+	wrapped.Get(schedulesPath, r.listSchedules)
+	wrapped.Post(schedulesPath, r.createSchedule)
+	wrapped.Get(schedulesPath+"/:id", r.getSchedule)
+	wrapped.Put(schedulesPath+"/:id", r.updateSchedule)
+	wrapped.Delete(schedulesPath+"/:id", r.deleteSchedule)
+	wrapped.Post(schedulesPath+"/:id/run", r.runSchedule)
+	rt.Get(path.Join(basePath, exportScheduledDownloadSegment)+"/:token", r.downloadScheduledExport)
+}
+
+func (r *exportHTTPRegistrar) scheduleContext(c router.Context) context.Context {
+	return adminContextFromRequest(r.admin, c, "").Context
+}
+
+func (r *exportHTTPRegistrar) listSchedules(c router.Context) error {
+	schedules, err := r.schedules.List(r.scheduleContext(c))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, map[string]any{"schedules": schedules})
+}
+
+func (r *exportHTTPRegistrar) createSchedule(c router.Context) error {
+	req := admin.ExportSchedule{}
+	if err := parseJSONBody(c, &req); err != nil {
+		return err
+	}
+	created, err := r.schedules.Create(r.scheduleContext(c), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, created)
+}
+
+func (r *exportHTTPRegistrar) getSchedule(c router.Context) error {
+	schedule, err := r.schedules.Get(r.scheduleContext(c), strings.TrimSpace(c.Param("id")))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, schedule)
+}
+
+func (r *exportHTTPRegistrar) updateSchedule(c router.Context) error {
+	req := admin.ExportSchedule{}
+	if err := parseJSONBody(c, &req); err != nil {
+		return err
+	}
+	updated, err := r.schedules.Update(r.scheduleContext(c), strings.TrimSpace(c.Param("id")), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, updated)
+}
+
+func (r *exportHTTPRegistrar) deleteSchedule(c router.Context) error {
+	if err := r.schedules.Delete(r.scheduleContext(c), strings.TrimSpace(c.Param("id"))); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, map[string]any{"status": "deleted"})
+}
+
+func (r *exportHTTPRegistrar) runSchedule(c router.Context) error {
+	run, err := r.schedules.RunNow(r.scheduleContext(c), strings.TrimSpace(c.Param("id")))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, run)
+}
+
+func (r *exportHTTPRegistrar) downloadScheduledExport(c router.Context) error {
+	claims, err := r.schedules.VerifyDownload(c.Context(), c.Param("token"))
+	if err != nil {
+		return err
+	}
+	if r.store == nil {
+		return export.NewError(export.KindNotImpl, "export artifact store not configured", nil)
+	}
+	httpCtx, ok := c.(router.HTTPContext)
+	if !ok || httpCtx.Response() == nil {
+		return export.NewError(export.KindNotImpl, "http response writer not available", nil)
+	}
+	reader, _, err := r.store.Open(c.Context(), claims.Key)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	w := httpCtx.Response()
+	w.Header().Set("Content-Type", claims.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", claims.FileName))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	_, err = io.Copy(w, reader)
+	return err
+}
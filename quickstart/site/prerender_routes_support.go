@@ -0,0 +1,312 @@
+package site
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-admin/admin"
+)
+
+const (
+	SitePrerenderSourceLocale     = "locale"
+	SitePrerenderSourceContent    = "content"
+	SitePrerenderSourceCollection = "collection"
+	SitePrerenderSourceNavigation = "navigation"
+	SitePrerenderSourceExtra      = "extra"
+)
+
+// SitePrerenderRoute is one public request path discovered for pre-rendering.
+// Page is set on collection pages after the first, which are requested with
+// Query ("page=2").
+type SitePrerenderRoute struct {
+	Path      string `json:"path"`
+	Query     string `json:"query,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	Locale    string `json:"locale,omitempty"`
+	Source    string `json:"source"`
+	ContentID string `json:"content_id,omitempty"`
+}
+
+// RequestURI returns the path and query the route is rendered from.
+func (r SitePrerenderRoute) RequestURI() string {
+	return (&url.URL{Path: r.Path, RawQuery: r.Query}).String()
+}
+
+// Routes discovers the public paths served by the delivery runtime: locale
+// roots, published content records, collection list routes and their pages,
+// internal menu links, and configured extra paths. Paths are locale- and
+// base-path-aware and deduplicated in discovery order.
+func (p *SitePrerenderer) Routes(ctx context.Context) ([]SitePrerenderRoute, error) {
+	if p == nil || p.runtime == nil {
+		return nil, nil
+	}
+	capabilities, err := p.runtime.capabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set := sitePrerenderRouteSet{seen: map[string]struct{}{}}
+	cache := newSiteContentCache()
+	for _, locale := range p.locales {
+		set.add(SitePrerenderRoute{
+			Path:   sitePrerenderPublicPath(p.siteCfg, "/", locale),
+			Locale: locale,
+			Source: SitePrerenderSourceLocale,
+		})
+		if err := p.collectContentRoutes(ctx, &set, capabilities, locale, cache); err != nil {
+			return nil, err
+		}
+		p.collectNavigationRoutes(ctx, &set, locale, cache)
+	}
+	for _, extra := range p.extraPaths {
+		if strings.TrimSpace(extra) == "" || localePathUnsafe(extra) {
+			continue
+		}
+		set.add(SitePrerenderRoute{
+			Path:   normalizeLocalePath(extra),
+			Source: SitePrerenderSourceExtra,
+		})
+	}
+	return set.routes, nil
+}
+
+func (p *SitePrerenderer) collectContentRoutes(
+	ctx context.Context,
+	set *sitePrerenderRouteSet,
+	capabilities []deliveryCapability,
+	locale string,
+	cache *siteContentCache,
+) error {
+	for _, capability := range capabilities {
+		kind := capability.normalizedKind()
+		listRoute := capability.listRoutePattern()
+		listed := (kind == "collection" || kind == "hybrid") && !strings.Contains(listRoute, ":")
+		if listed {
+			set.add(SitePrerenderRoute{
+				Path:   sitePrerenderPublicPath(p.siteCfg, listRoute, locale),
+				Locale: locale,
+				Source: SitePrerenderSourceCollection,
+			})
+		}
+		paginated := listed && p.collectionPageSize > 0
+		if kind == "collection" && !paginated {
+			continue
+		}
+		records, err := p.runtime.listSiteContentsForCapabilityCached(ctx, capability, locale, cache)
+		if err != nil {
+			return err
+		}
+		if paginated {
+			p.collectCollectionPages(set, capability, listRoute, locale, records)
+		}
+		if kind == "collection" {
+			continue
+		}
+		found := []SitePrerenderRoute{}
+		for _, record := range records {
+			if !matchesCapabilityType(record, capability.TypeSlug) || !publishedStatus(record.Status) {
+				continue
+			}
+			if !sitePrerenderRecordInLocale(record, locale) {
+				continue
+			}
+			canonical := recordDeliveryPath(record, capability)
+			if !contentDeliveryPathPublicRoutable(p.siteCfg, canonical) {
+				continue
+			}
+			found = append(found, SitePrerenderRoute{
+				Path:      sitePrerenderPublicPath(p.siteCfg, canonical, locale),
+				Locale:    locale,
+				Source:    SitePrerenderSourceContent,
+				ContentID: strings.TrimSpace(record.ID),
+			})
+		}
+		slices.SortStableFunc(found, func(left, right SitePrerenderRoute) int {
+			return strings.Compare(left.Path, right.Path)
+		})
+		for _, route := range found {
+			set.add(route)
+		}
+	}
+	return nil
+}
+
+// collectCollectionPages adds the pages after the first of a collection
+// list, counting the records the list route renders for locale.
+func (p *SitePrerenderer) collectCollectionPages(
+	set *sitePrerenderRouteSet,
+	capability deliveryCapability,
+	listRoute string,
+	locale string,
+	records []admin.CMSContent,
+) {
+	state := p.requestState(locale)
+	listed := resolveLocaleRecordsForList(
+		filterCapabilityRecords(records, capability, state),
+		state,
+		capability,
+		p.siteCfg.AllowLocaleFallback,
+		p.siteCfg.DefaultLocale,
+	)
+	pages := (len(listed) + p.collectionPageSize - 1) / p.collectionPageSize
+	publicPath := sitePrerenderPublicPath(p.siteCfg, listRoute, locale)
+	for page := 2; page <= pages; page++ {
+		set.add(SitePrerenderRoute{
+			Path:   publicPath,
+			Query:  url.Values{p.collectionPageParam: []string{strconv.Itoa(page)}}.Encode(),
+			Page:   page,
+			Locale: locale,
+			Source: SitePrerenderSourceCollection,
+		})
+	}
+}
+
+func (p *SitePrerenderer) requestState(locale string) RequestState {
+	return RequestState{
+		Locale:              locale,
+		DefaultLocale:       p.siteCfg.DefaultLocale,
+		SupportedLocales:    cloneStrings(p.siteCfg.SupportedLocales),
+		Environment:         p.siteCfg.Environment,
+		ContentChannel:      p.siteCfg.ContentChannel,
+		AllowLocaleFallback: p.siteCfg.AllowLocaleFallback,
+		BasePath:            p.siteCfg.BasePath,
+	}
+}
+
+func (p *SitePrerenderer) collectNavigationRoutes(ctx context.Context, set *sitePrerenderRouteSet, locale string, cache *siteContentCache) {
+	nav := p.runtime.navigation
+	if nav == nil {
+		return
+	}
+	if p.siteCfg.Navigation.EnableGeneratedFallback {
+		ctx = context.WithValue(ctx, navigationGeneratedFallbackCacheKey{}, cache)
+	}
+	state := p.requestState(locale)
+	opts := navigationReadOptions{
+		Locale:                   locale,
+		IncludeContributions:     true,
+		DedupPolicy:              menuDedupByURL,
+		ContributionLocalePolicy: normalizeContributionLocalePolicy(p.siteCfg.Navigation.ContributionLocalePolicy),
+	}
+	for _, location := range p.menuLocations {
+		menu := nav.resolveMenuForLocation(ctx, state, location, "/", opts, false)
+		items, _ := menu["items"].([]map[string]any)
+		for _, href := range sitePrerenderMenuHrefs(items) {
+			set.add(SitePrerenderRoute{
+				Path:   normalizeLocalePath(admin.PrefixBasePath(p.siteCfg.BasePath, href)),
+				Locale: locale,
+				Source: SitePrerenderSourceNavigation,
+			})
+		}
+	}
+}
+
+type sitePrerenderRouteSet struct {
+	seen   map[string]struct{}
+	routes []SitePrerenderRoute
+}
+
+func (s *sitePrerenderRouteSet) add(route SitePrerenderRoute) {
+	route.Path = strings.TrimSpace(route.Path)
+	if route.Path == "" {
+		return
+	}
+	key := route.RequestURI()
+	if _, ok := s.seen[key]; ok {
+		return
+	}
+	s.seen[key] = struct{}{}
+	s.routes = append(s.routes, route)
+}
+
+// sitePrerenderPublicPath applies the same locale and base path rules as
+// ResolveContentDeliveryPath with IncludeLocale and IncludeBase set.
+func sitePrerenderPublicPath(cfg ResolvedSiteConfig, canonical, locale string) string {
+	publicPath := normalizeLocalePath(canonical)
+	if cfg.Features.EnableI18N && strings.TrimSpace(locale) != "" {
+		publicPath = localizedPublicPathForStoredPath(
+			publicPath,
+			locale,
+			cfg.DefaultLocale,
+			cfg.LocalePrefixMode,
+			cfg.SupportedLocales,
+		)
+	}
+	return normalizeLocalePath(admin.PrefixBasePath(cfg.BasePath, publicPath))
+}
+
+func sitePrerenderLocales(cfg ResolvedSiteConfig, requested []string) []string {
+	defaultLocale := strings.ToLower(strings.TrimSpace(cfg.DefaultLocale))
+	if !cfg.Features.EnableI18N {
+		return []string{defaultLocale}
+	}
+	candidates := requested
+	if len(candidates) == 0 {
+		candidates = cfg.SupportedLocales
+	}
+	out := []string{}
+	seen := map[string]struct{}{}
+	for _, candidate := range candidates {
+		locale := matchSupportedLocale(candidate, cfg.SupportedLocales)
+		if locale == "" {
+			continue
+		}
+		if _, ok := seen[locale]; ok {
+			continue
+		}
+		seen[locale] = struct{}{}
+		out = append(out, locale)
+	}
+	if len(out) == 0 {
+		out = append(out, defaultLocale)
+	}
+	return out
+}
+
+func sitePrerenderMenuLocations(cfg ResolvedSiteConfig, requested []string) []string {
+	candidates := requested
+	if len(candidates) == 0 {
+		candidates = []string{cfg.Navigation.MainMenuLocation, cfg.Navigation.FooterMenuLocation}
+	}
+	out := []string{}
+	for _, location := range candidates {
+		location = strings.TrimSpace(location)
+		if location == "" || slices.Contains(out, location) {
+			continue
+		}
+		out = append(out, location)
+	}
+	return out
+}
+
+// sitePrerenderRecordInLocale keeps records stored for the requested locale.
+// Locale fallbacks are reached through their own locale's routes instead.
+func sitePrerenderRecordInLocale(record admin.CMSContent, locale string) bool {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	recordLocale := strings.ToLower(strings.TrimSpace(firstNonEmpty(record.ResolvedLocale, record.Locale)))
+	return locale == "" || recordLocale == "" || recordLocale == locale
+}
+
+func sitePrerenderMenuHrefs(items []map[string]any) []string {
+	out := []string{}
+	for _, item := range items {
+		if href := anyString(item["href"]); sitePrerenderInternalHref(href) {
+			out = append(out, normalizeLocalePath(href))
+		}
+		if children, ok := item["children"].([]map[string]any); ok {
+			out = append(out, sitePrerenderMenuHrefs(children)...)
+		}
+	}
+	return out
+}
+
+func sitePrerenderInternalHref(href string) bool {
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") || localePathUnsafe(href) {
+		return false
+	}
+	first, _, _ := strings.Cut(strings.TrimLeft(href, "/"), "/")
+	return !strings.Contains(first, ":")
+}
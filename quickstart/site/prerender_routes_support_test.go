@@ -0,0 +1,272 @@
+package site
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/goliatone/go-admin/admin"
+)
+
+func TestSitePrerenderRoutesWalkContentMenusAndLocales(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	seedDeliveryPageType(t, content)
+	seedDeliveryPageRecord(t, content, "page-home", "home", "/home")
+	seedDeliveryPageRecord(t, content, "page-about", "about", "/about")
+	if _, err := content.CreateContent(t.Context(), admin.CMSContent{
+		ID:              "page-about-es",
+		Title:           "Acerca",
+		Slug:            "acerca",
+		Locale:          "es",
+		Status:          "published",
+		ContentType:     "page",
+		ContentTypeSlug: "page",
+		Data:            map[string]any{"path": "/acerca"},
+	}); err != nil {
+		t.Fatalf("create es content: %v", err)
+	}
+	if _, err := content.CreateContent(t.Context(), admin.CMSContent{
+		ID:              "page-draft",
+		Title:           "Draft",
+		Slug:            "draft",
+		Locale:          "en",
+		Status:          "draft",
+		ContentType:     "page",
+		ContentTypeSlug: "page",
+		Data:            map[string]any{"path": "/draft"},
+	}); err != nil {
+		t.Fatalf("create draft content: %v", err)
+	}
+
+	menuSvc := &siteNavigationMenuStub{
+		byLocation: map[string]*admin.Menu{
+			"site.main": {
+				Code:     "site_main",
+				Location: "site.main",
+				Items: []admin.MenuItem{
+					{ID: "main.home", Label: "Home", Position: new(1), Target: map[string]any{"url": "/home"}},
+					{
+						ID: "main.company", Label: "Company", Position: new(2), Target: map[string]any{"url": "/company"},
+						Children: []admin.MenuItem{
+							{ID: "main.company.team", Label: "Team", Position: new(1), Target: map[string]any{"url": "/company/team?tab=all"}},
+						},
+					},
+					{ID: "main.external", Label: "External", Position: new(3), Target: map[string]any{"url": "https://example.com/docs"}},
+					{ID: "main.mail", Label: "Mail", Position: new(4), Target: map[string]any{"url": "mailto:team@example.com"}},
+				},
+			},
+		},
+	}
+	adm := adminWithMenuStub(t, menuSvc, nil)
+
+	prerenderer, err := NewSitePrerenderer(adm, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		SupportedLocales: []string{"en", "es"},
+	}, SitePrerenderConfig{
+		Handler:            http.NotFoundHandler(),
+		ContentService:     content,
+		ContentTypeService: content,
+		ExtraPaths:         []string{"/sitemap.xml", "/../etc/passwd", "/home"},
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+	routes, err := prerenderer.Routes(t.Context())
+	if err != nil {
+		t.Fatalf("routes: %v", err)
+	}
+
+	expected := []SitePrerenderRoute{
+		{Path: "/", Locale: "en", Source: SitePrerenderSourceLocale},
+		{Path: "/about", Locale: "en", Source: SitePrerenderSourceContent, ContentID: "page-about"},
+		{Path: "/home", Locale: "en", Source: SitePrerenderSourceContent, ContentID: "page-home"},
+		{Path: "/company", Locale: "en", Source: SitePrerenderSourceNavigation},
+		{Path: "/company/team", Locale: "en", Source: SitePrerenderSourceNavigation},
+		{Path: "/es", Locale: "es", Source: SitePrerenderSourceLocale},
+		{Path: "/es/acerca", Locale: "es", Source: SitePrerenderSourceContent, ContentID: "page-about-es"},
+		{Path: "/es/home", Locale: "es", Source: SitePrerenderSourceNavigation},
+		{Path: "/es/company", Locale: "es", Source: SitePrerenderSourceNavigation},
+		{Path: "/es/company/team", Locale: "es", Source: SitePrerenderSourceNavigation},
+		{Path: "/sitemap.xml", Source: SitePrerenderSourceExtra},
+	}
+	if len(routes) != len(expected) {
+		t.Fatalf("expected %d routes, got %d: %+v", len(expected), len(routes), routes)
+	}
+	for i, want := range expected {
+		if routes[i] != want {
+			t.Fatalf("route %d: expected %+v, got %+v", i, want, routes[i])
+		}
+	}
+}
+
+func TestSitePrerenderRoutesHonorBasePathAndCollections(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	if _, err := content.CreateContentType(t.Context(), admin.CMSContentType{
+		ID:     "post-type",
+		Name:   "Post",
+		Slug:   "post",
+		Schema: map[string]any{"type": "object"},
+		Capabilities: map[string]any{
+			"delivery": map[string]any{
+				"enabled": true,
+				"kind":    "hybrid",
+				"routes": map[string]any{
+					"list":   "/posts",
+					"detail": "/posts/:slug",
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("create content type: %v", err)
+	}
+	if _, err := content.CreateContent(t.Context(), admin.CMSContent{
+		ID:              "post-1",
+		Title:           "Hello",
+		Slug:            "hello",
+		Locale:          "en",
+		Status:          "published",
+		ContentType:     "post",
+		ContentTypeSlug: "post",
+	}); err != nil {
+		t.Fatalf("create content: %v", err)
+	}
+
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		BasePath:         "/site",
+		SupportedLocales: []string{"en", "fr"},
+		LocalePrefixMode: LocalePrefixAlways,
+	}, SitePrerenderConfig{
+		Handler:            http.NotFoundHandler(),
+		ContentService:     content,
+		ContentTypeService: content,
+		Locales:            []string{"en", "de"},
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+	routes, err := prerenderer.Routes(t.Context())
+	if err != nil {
+		t.Fatalf("routes: %v", err)
+	}
+	paths := []string{}
+	for _, route := range routes {
+		paths = append(paths, route.Path)
+	}
+	expected := []string{"/site/en", "/site/en/posts", "/site/en/posts/hello"}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected paths %v, got %v", expected, paths)
+	}
+	for i := range expected {
+		if paths[i] != expected[i] {
+			t.Fatalf("expected paths %v, got %v", expected, paths)
+		}
+	}
+}
+
+func TestSitePrerenderRoutesPaginateCollections(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	seedPrerenderNewsCollection(t, content, 5, 1)
+
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		Features: SiteFeatures{EnableI18N: new(false)},
+	}, SitePrerenderConfig{
+		Handler:            http.NotFoundHandler(),
+		ContentService:     content,
+		ContentTypeService: content,
+		CollectionPageSize: 2,
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+	routes, err := prerenderer.Routes(t.Context())
+	if err != nil {
+		t.Fatalf("routes: %v", err)
+	}
+	expected := []SitePrerenderRoute{
+		{Path: "/", Locale: "en", Source: SitePrerenderSourceLocale},
+		{Path: "/news", Locale: "en", Source: SitePrerenderSourceCollection},
+		{Path: "/news", Query: "page=2", Page: 2, Locale: "en", Source: SitePrerenderSourceCollection},
+		{Path: "/news", Query: "page=3", Page: 3, Locale: "en", Source: SitePrerenderSourceCollection},
+	}
+	if len(routes) != len(expected) {
+		t.Fatalf("expected %d routes, got %d: %+v", len(expected), len(routes), routes)
+	}
+	for i, want := range expected {
+		if routes[i] != want {
+			t.Fatalf("route %d: expected %+v, got %+v", i, want, routes[i])
+		}
+	}
+	if got := routes[2].RequestURI(); got != "/news?page=2" {
+		t.Fatalf("unexpected page request uri %q", got)
+	}
+
+	unpaginated, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		Features: SiteFeatures{EnableI18N: new(false)},
+	}, SitePrerenderConfig{
+		Handler:            http.NotFoundHandler(),
+		ContentService:     content,
+		ContentTypeService: content,
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+	if routes, _ := unpaginated.Routes(t.Context()); len(routes) != 2 {
+		t.Fatalf("expected only the first collection page without a page size, got %+v", routes)
+	}
+}
+
+// seedPrerenderNewsCollection creates a /news collection with published and
+// draft items.
+func seedPrerenderNewsCollection(t *testing.T, content *admin.InMemoryContentService, published, drafts int) {
+	t.Helper()
+	if _, err := content.CreateContentType(t.Context(), admin.CMSContentType{
+		ID:     "news-type",
+		Name:   "News",
+		Slug:   "news",
+		Schema: map[string]any{"type": "object"},
+		Capabilities: map[string]any{
+			"delivery": map[string]any{
+				"enabled": true,
+				"kind":    "collection",
+				"routes":  map[string]any{"list": "/news"},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("create content type: %v", err)
+	}
+	for i := range published + drafts {
+		status := "published"
+		if i >= published {
+			status = "draft"
+		}
+		if _, err := content.CreateContent(t.Context(), admin.CMSContent{
+			ID:              fmt.Sprintf("news-%d", i),
+			Title:           fmt.Sprintf("News %d", i),
+			Slug:            fmt.Sprintf("news-%d", i),
+			Locale:          "en",
+			Status:          status,
+			ContentType:     "news",
+			ContentTypeSlug: "news",
+		}); err != nil {
+			t.Fatalf("create content: %v", err)
+		}
+	}
+}
+
+func TestSitePrerenderInternalHref(t *testing.T) {
+	cases := map[string]bool{
+		"/about":                 true,
+		"/about?tab=1":           true,
+		"":                       false,
+		"#top":                   false,
+		"https://example.com":    false,
+		"//cdn.example.com/x.js": false,
+		"mailto:team@x.test":     false,
+		"/tel:123":               false,
+		"/../secret":             false,
+	}
+	for href, want := range cases {
+		if got := sitePrerenderInternalHref(href); got != want {
+			t.Fatalf("sitePrerenderInternalHref(%q) = %v, want %v", href, got, want)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-admin/admin"
+)
+
+const (
+	defaultSitePrerenderConcurrency = 4
+	defaultSitePrerenderPageParam   = "page"
+)
+
+// SitePrerenderConfig configures route discovery and rendering for a
+// SitePrerenderer.
+type SitePrerenderConfig struct {
+	// Handler serves the registered site routes, typically the host server's
+	// wrapped router. Routes render through it so the render cache lifecycle
+	// stores each response exactly as it would for an anonymous visitor.
+	Handler http.Handler `json:"-"`
+	// ContentService and ContentTypeService override the admin CMS services,
+	// mirroring WithDeliveryServices.
+	ContentService     admin.CMSContentService     `json:"-"`
+	ContentTypeService admin.CMSContentTypeService `json:"-"`
+	// Locales limits discovery to a subset of the supported locales.
+	Locales []string `json:"locales"`
+	// MenuLocations lists the menus whose links are crawled. Empty uses the
+	// configured main and footer menu locations.
+	MenuLocations []string `json:"menu_locations"`
+	// ExtraPaths are public request paths rendered as-is, for routes the CMS
+	// does not know about such as module pages.
+	ExtraPaths []string `json:"extra_paths"`
+	// CollectionPageSize is the number of records the site templates show per
+	// collection page. When set, pages after the first are rendered with the
+	// CollectionPageParam query ("?page=2"); add the parameter to the render
+	// cache QueryAllowlist so warmed pages are stored. Zero renders only the
+	// first page.
+	CollectionPageSize int `json:"collection_page_size"`
+	// CollectionPageParam names the page query parameter; defaults to "page".
+	CollectionPageParam string `json:"collection_page_param"`
+	// Concurrency bounds parallel renders; defaults to 4.
+	Concurrency int `json:"concurrency"`
+	// Timeout bounds each route render. Zero disables the per-route deadline.
+	Timeout time.Duration `json:"timeout"`
+	// Header is added to every render request.
+	Header http.Header `json:"-"`
+}
+
+// SitePrerenderer walks the routes known to the delivery runtime and renders
+// them ahead of visitors, warming the RenderCacheStore or writing a static
+// HTML export.
+type SitePrerenderer struct {
+	siteCfg             ResolvedSiteConfig
+	runtime             *deliveryRuntime
+	handler             http.Handler
+	locales             []string
+	menuLocations       []string
+	extraPaths          []string
+	collectionPageSize  int
+	collectionPageParam string
+	concurrency         int
+	timeout             time.Duration
+	header              http.Header
+}
+
+// SitePrerenderResult records the outcome of one rendered route.
+type SitePrerenderResult struct {
+	Route       SitePrerenderRoute `json:"route"`
+	Status      int                `json:"status"`
+	Duration    time.Duration      `json:"duration"`
+	Bytes       int                `json:"bytes"`
+	CacheStatus string             `json:"cache_status,omitempty"`
+	Location    string             `json:"location,omitempty"`
+	File        string             `json:"file,omitempty"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// Failed reports whether the route could not be rendered or written.
+func (r SitePrerenderResult) Failed() bool {
+	return strings.TrimSpace(r.Error) != ""
+}
+
+// SitePrerenderReport summarizes a warm or export run.
+type SitePrerenderReport struct {
+	StartedAt  time.Time             `json:"started_at"`
+	FinishedAt time.Time             `json:"finished_at"`
+	Duration   time.Duration         `json:"duration"`
+	Total      int                   `json:"total"`
+	Rendered   int                   `json:"rendered"`
+	Failed     int                   `json:"failed"`
+	Assets     int                   `json:"assets,omitempty"`
+	Results    []SitePrerenderResult `json:"results"`
+}
+
+// Failures returns the results of routes that failed, in discovery order.
+func (r SitePrerenderReport) Failures() []SitePrerenderResult {
+	out := []SitePrerenderResult{}
+	for _, result := range r.Results {
+		if result.Failed() {
+			out = append(out, result)
+		}
+	}
+	return out
+}
+
+// Slowest returns up to n results ordered by descending render duration.
+func (r SitePrerenderReport) Slowest(n int) []SitePrerenderResult {
+	if n <= 0 {
+		return nil
+	}
+	out := slices.Clone(r.Results)
+	slices.SortStableFunc(out, func(left, right SitePrerenderResult) int {
+		switch {
+		case left.Duration > right.Duration:
+			return -1
+		case left.Duration < right.Duration:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// NewSitePrerenderer builds a pre-renderer for the same site configuration
+// passed to RegisterSiteRoutes.
+func NewSitePrerenderer(adm *admin.Admin, cfg admin.Config, siteCfg SiteConfig, prerenderCfg SitePrerenderConfig) (*SitePrerenderer, error) {
+	if err := ValidateSiteConfig(cfg, siteCfg); err != nil {
+		return nil, fmt.Errorf("invalid site config: %w", err)
+	}
+	if prerenderCfg.Handler == nil {
+		return nil, fmt.Errorf("site prerender handler is required")
+	}
+	contentSvc := prerenderCfg.ContentService
+	contentTypeSvc := prerenderCfg.ContentTypeService
+	if contentSvc == nil && adm != nil {
+		contentSvc = adm.ContentService()
+	}
+	if contentTypeSvc == nil && adm != nil {
+		contentTypeSvc = adm.ContentTypeService()
+	}
+	resolved := ResolveSiteConfig(cfg, siteCfg)
+	runtime := newDeliveryRuntime(resolved, adm, contentSvc, contentTypeSvc)
+	if runtime == nil {
+		return nil, fmt.Errorf("site prerender requires content and content type services")
+	}
+	concurrency := prerenderCfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultSitePrerenderConcurrency
+	}
+	return &SitePrerenderer{
+		siteCfg:             resolved,
+		runtime:             runtime,
+		handler:             prerenderCfg.Handler,
+		locales:             sitePrerenderLocales(resolved, prerenderCfg.Locales),
+		menuLocations:       sitePrerenderMenuLocations(resolved, prerenderCfg.MenuLocations),
+		extraPaths:          cloneStrings(prerenderCfg.ExtraPaths),
+		collectionPageSize:  max(prerenderCfg.CollectionPageSize, 0),
+		collectionPageParam: firstNonEmpty(strings.TrimSpace(prerenderCfg.CollectionPageParam), defaultSitePrerenderPageParam),
+		concurrency:         concurrency,
+		timeout:             prerenderCfg.Timeout,
+		header:              prerenderCfg.Header.Clone(),
+	}, nil
+}
+
+// Warm renders every discovered route through the site handler. Cacheable
+// responses are stored by the render cache as a side effect; route failures
+// are reported instead of aborting the run. Cancelling ctx stops the run and
+// reports the routes not rendered yet as failed.
+func (p *SitePrerenderer) Warm(ctx context.Context) (SitePrerenderReport, error) {
+	routes, err := p.Routes(ctx)
+	if err != nil {
+		return SitePrerenderReport{}, err
+	}
+	return p.render(ctx, routes, nil), ctx.Err()
+}
+
+func (p *SitePrerenderer) render(ctx context.Context, routes []SitePrerenderRoute, write sitePrerenderWriteFunc) SitePrerenderReport {
+	report := SitePrerenderReport{
+		StartedAt: time.Now(),
+		Total:     len(routes),
+		Results:   make([]SitePrerenderResult, len(routes)),
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(p.concurrency, max(len(routes), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				if err := ctx.Err(); err != nil {
+					report.Results[index] = SitePrerenderResult{Route: routes[index], Error: err.Error()}
+					continue
+				}
+				report.Results[index] = p.renderRoute(ctx, routes[index], write)
+			}
+		}()
+	}
+	sent := 0
+dispatch:
+	for ; sent < len(routes); sent++ {
+		select {
+		case jobs <- sent:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	for index := sent; index < len(routes); index++ {
+		report.Results[index] = SitePrerenderResult{Route: routes[index], Error: ctx.Err().Error()}
+	}
+
+	for _, result := range report.Results {
+		if result.Failed() {
+			report.Failed++
+			continue
+		}
+		report.Rendered++
+	}
+	report.FinishedAt = time.Now()
+	report.Duration = report.FinishedAt.Sub(report.StartedAt)
+	return report
+}
+
+type sitePrerenderWriteFunc func(route SitePrerenderRoute, header http.Header, body []byte) (string, error)
+
+func (p *SitePrerenderer) renderRoute(ctx context.Context, route SitePrerenderRoute, write sitePrerenderWriteFunc) (result SitePrerenderResult) {
+	result = SitePrerenderResult{Route: route}
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, route.RequestURI(), nil)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	for key, values := range p.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set(DeliveryProvenanceRequestHeader, "1")
+
+	writer := &sitePrerenderResponseWriter{header: http.Header{}}
+	started := time.Now()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result.Duration = time.Since(started)
+			result.Error = fmt.Sprintf("render panicked: %v", recovered)
+		}
+	}()
+	p.handler.ServeHTTP(writer, req)
+	result.Duration = time.Since(started)
+	result.Status = writer.statusCode()
+	result.Bytes = writer.body.Len()
+	result.CacheStatus = strings.TrimSpace(writer.header.Get(DeliveryProvenanceCacheStatusHeader))
+
+	switch {
+	case ctx.Err() != nil:
+		result.Error = ctx.Err().Error()
+	case result.Status >= http.StatusMultipleChoices && result.Status < http.StatusBadRequest:
+		result.Location = strings.TrimSpace(writer.header.Get("Location"))
+		result.Error = fmt.Sprintf("route redirected with status %d", result.Status)
+	case result.Status != http.StatusOK:
+		result.Error = fmt.Sprintf("route rendered with status %d", result.Status)
+	case write != nil:
+		file, writeErr := write(route, writer.header, writer.body.Bytes())
+		if writeErr != nil {
+			result.Error = writeErr.Error()
+		}
+		result.File = file
+	}
+	return result
+}
+
+// sitePrerenderResponseWriter captures an in-process render.
+type sitePrerenderResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *sitePrerenderResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *sitePrerenderResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *sitePrerenderResponseWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(data)
+}
+
+func (w *sitePrerenderResponseWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
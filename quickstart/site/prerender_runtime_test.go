@@ -0,0 +1,215 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-admin/admin"
+	router "github.com/goliatone/go-router"
+)
+
+func TestSitePrerenderWarmFillsRenderCacheThroughRegisteredRoutes(t *testing.T) {
+	store := newTestRenderCacheStore()
+	services := newRenderCacheDeliveryServices(t)
+	siteCfg := SiteConfig{Features: SiteFeatures{EnableI18N: new(false)}}
+	server := router.NewHTTPServer()
+	if err := RegisterSiteRoutes(
+		server.Router(),
+		nil,
+		admin.Config{DefaultLocale: "en"},
+		siteCfg,
+		WithDeliveryServices(services, services),
+		WithRenderCache(store, RenderCachePolicy{
+			Enabled:          true,
+			FreshTTL:         time.Minute,
+			TemplateRenderer: &testRenderCacheRenderer{},
+		}),
+	); err != nil {
+		t.Fatalf("register site routes: %v", err)
+	}
+
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, siteCfg, SitePrerenderConfig{
+		Handler:            server.WrappedRouter(),
+		ContentService:     services,
+		ContentTypeService: services,
+		Concurrency:        1,
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+
+	first, err := prerenderer.Warm(t.Context())
+	if err != nil {
+		t.Fatalf("warm: %v", err)
+	}
+	about := sitePrerenderResultForPath(t, first, "/about")
+	if about.Failed() || about.Status != http.StatusOK {
+		t.Fatalf("expected /about to render, got %+v", about)
+	}
+	if about.CacheStatus != DeliveryCacheStatusMiss {
+		t.Fatalf("expected first warm to miss, got %q", about.CacheStatus)
+	}
+	if len(store.items) == 0 {
+		t.Fatalf("expected warm to populate the render cache store")
+	}
+
+	second, err := prerenderer.Warm(t.Context())
+	if err != nil {
+		t.Fatalf("second warm: %v", err)
+	}
+	if got := sitePrerenderResultForPath(t, second, "/about").CacheStatus; got != DeliveryCacheStatusHit {
+		t.Fatalf("expected second warm to hit, got %q", got)
+	}
+}
+
+func TestSitePrerenderWarmReportsFailedRoutesAndDurations(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	seedDeliveryPageType(t, content)
+	seedDeliveryPageRecord(t, content, "page-home", "home", "/home")
+	seedDeliveryPageRecord(t, content, "page-broken", "broken", "/broken")
+	seedDeliveryPageRecord(t, content, "page-moved", "moved", "/moved")
+
+	var mu sync.Mutex
+	requests := map[string]http.Header{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path] = r.Header.Clone()
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/moved":
+			w.Header().Set("Location", "/home")
+			w.WriteHeader(http.StatusMovedPermanently)
+		case "/panic":
+			panic("boom")
+		default:
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Header().Set(DeliveryProvenanceCacheStatusHeader, DeliveryCacheStatusMiss)
+			_, _ = w.Write([]byte("<p>" + r.URL.Path + "</p>"))
+		}
+	})
+
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		Features: SiteFeatures{EnableI18N: new(false)},
+	}, SitePrerenderConfig{
+		Handler:            handler,
+		ContentService:     content,
+		ContentTypeService: content,
+		ExtraPaths:         []string{"/panic"},
+		Header:             http.Header{"X-Warm": []string{"yes"}},
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+	report, err := prerenderer.Warm(t.Context())
+	if err != nil {
+		t.Fatalf("warm: %v", err)
+	}
+
+	if report.Total != len(report.Results) || report.Rendered+report.Failed != report.Total {
+		t.Fatalf("unexpected report totals %+v", report)
+	}
+	if report.Failed != 3 {
+		t.Fatalf("expected three failed routes, got %+v", report.Failures())
+	}
+	broken := sitePrerenderResultForPath(t, report, "/broken")
+	if broken.Status != http.StatusInternalServerError || !strings.Contains(broken.Error, "500") {
+		t.Fatalf("expected /broken failure with status, got %+v", broken)
+	}
+	moved := sitePrerenderResultForPath(t, report, "/moved")
+	if moved.Status != http.StatusMovedPermanently || moved.Location != "/home" || !moved.Failed() {
+		t.Fatalf("expected /moved redirect failure, got %+v", moved)
+	}
+	if panicked := sitePrerenderResultForPath(t, report, "/panic"); !strings.Contains(panicked.Error, "panicked") {
+		t.Fatalf("expected recovered panic, got %+v", panicked)
+	}
+	home := sitePrerenderResultForPath(t, report, "/home")
+	if home.Failed() || home.Bytes == 0 || home.Duration <= 0 || home.CacheStatus != DeliveryCacheStatusMiss {
+		t.Fatalf("expected /home render details, got %+v", home)
+	}
+	if header := requests["/home"]; header.Get("Accept") != "text/html" || header.Get("X-Warm") != "yes" || header.Get(DeliveryProvenanceRequestHeader) == "" {
+		t.Fatalf("unexpected render request headers %v", header)
+	}
+	if slowest := report.Slowest(2); len(slowest) != 2 || slowest[0].Duration < slowest[1].Duration {
+		t.Fatalf("expected slowest results ordered by duration, got %+v", slowest)
+	}
+}
+
+func TestSitePrerenderWarmStopsDispatchingWhenCancelled(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	seedDeliveryPageType(t, content)
+	for _, slug := range []string{"a", "b", "c", "d"} {
+		seedDeliveryPageRecord(t, content, "page-"+slug, slug, "/"+slug)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	var mu sync.Mutex
+	rendered := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		rendered = append(rendered, r.URL.Path)
+		mu.Unlock()
+		cancel()
+		_, _ = w.Write([]byte("<p>ok</p>"))
+	})
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		Features: SiteFeatures{EnableI18N: new(false)},
+	}, SitePrerenderConfig{
+		Handler:            handler,
+		ContentService:     content,
+		ContentTypeService: content,
+		Concurrency:        1,
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+
+	report, err := prerenderer.Warm(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+	if len(rendered) != 1 {
+		t.Fatalf("expected no renders after cancellation, got %v", rendered)
+	}
+	if report.Total != 5 || report.Failed != report.Total || len(report.Results) != report.Total {
+		t.Fatalf("expected every route reported as failed, got %+v", report)
+	}
+	for _, result := range report.Results {
+		if result.Route.Path == "" || !strings.Contains(result.Error, "canceled") {
+			t.Fatalf("expected cancelled result with its route, got %+v", result)
+		}
+	}
+}
+
+func TestNewSitePrerendererRequiresHandlerAndServices(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	if _, err := NewSitePrerenderer(nil, admin.Config{}, SiteConfig{}, SitePrerenderConfig{
+		ContentService:     content,
+		ContentTypeService: content,
+	}); err == nil {
+		t.Fatalf("expected missing handler error")
+	}
+	if _, err := NewSitePrerenderer(nil, admin.Config{}, SiteConfig{}, SitePrerenderConfig{
+		Handler: http.NotFoundHandler(),
+	}); err == nil {
+		t.Fatalf("expected missing content services error")
+	}
+}
+
+func sitePrerenderResultForPath(t *testing.T, report SitePrerenderReport, path string) SitePrerenderResult {
+	t.Helper()
+	for _, result := range report.Results {
+		if result.Route.Path == path {
+			return result
+		}
+	}
+	t.Fatalf("missing prerender result for %q in %+v", path, report.Results)
+	return SitePrerenderResult{}
+}
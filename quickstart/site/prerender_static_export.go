@@ -0,0 +1,158 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	sitePrerenderIndexFile   = "index.html"
+	sitePrerenderPageSegment = "page"
+)
+
+// SiteStaticExportConfig configures a static HTML export for CDN-only hosting.
+type SiteStaticExportConfig struct {
+	// Dir receives the export. Existing files are overwritten; nothing is
+	// removed.
+	Dir string `json:"dir"`
+	// Assets are copied below AssetPath so rendered asset URLs resolve.
+	Assets fs.FS `json:"-"`
+	// AssetPath is the public asset prefix. Empty uses the site asset base
+	// path, then the site base path.
+	AssetPath string `json:"asset_path"`
+}
+
+// Export renders every discovered route and writes successful responses
+// below cfg.Dir as <path>/index.html, keeping locale prefixes and the site
+// base path. Paths with a file extension are written as-is. Collection pages
+// after the first are written as <path>/page/<n>/index.html, since static
+// hosts ignore the query they were rendered from. Rendering goes through the
+// site handler, so the render cache is warmed as well.
+func (p *SitePrerenderer) Export(ctx context.Context, cfg SiteStaticExportConfig) (SitePrerenderReport, error) {
+	dir := strings.TrimSpace(cfg.Dir)
+	if dir == "" {
+		return SitePrerenderReport{}, fmt.Errorf("site static export directory is required")
+	}
+	assetPath := ""
+	if cfg.Assets != nil {
+		assetPath = normalizeAssetPath(firstNonEmpty(cfg.AssetPath, p.siteCfg.Views.AssetBasePath, p.siteCfg.BasePath, "/"))
+		if !strings.HasPrefix(assetPath, "/") || strings.HasPrefix(assetPath, "//") {
+			return SitePrerenderReport{}, fmt.Errorf("site static export asset path %q must be a site path", assetPath)
+		}
+	}
+	routes, err := p.Routes(ctx)
+	if err != nil {
+		return SitePrerenderReport{}, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return SitePrerenderReport{}, fmt.Errorf("create site static export directory: %w", err)
+	}
+	report := p.render(ctx, routes, func(route SitePrerenderRoute, header http.Header, body []byte) (string, error) {
+		return writeSiteStaticExportFile(dir, siteStaticExportRoutePath(route), header, body)
+	})
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	if cfg.Assets != nil {
+		copied, copyErr := copySiteStaticExportAssets(cfg.Assets, dir, assetPath)
+		report.Assets = copied
+		if copyErr != nil {
+			return report, fmt.Errorf("copy site static export assets: %w", copyErr)
+		}
+	}
+	return report, nil
+}
+
+func siteStaticExportRoutePath(route SitePrerenderRoute) string {
+	if route.Page > 1 {
+		return pathpkg.Join(normalizeLocalePath(route.Path), sitePrerenderPageSegment, strconv.Itoa(route.Page))
+	}
+	return route.Path
+}
+
+func writeSiteStaticExportFile(dir, routePath string, header http.Header, body []byte) (string, error) {
+	routePath = normalizeLocalePath(routePath)
+	if pathpkg.Ext(routePath) == "" {
+		if !siteStaticExportHTML(header.Get("Content-Type")) {
+			return "", fmt.Errorf("route rendered non-HTML content type %q", header.Get("Content-Type"))
+		}
+		routePath = pathpkg.Join(routePath, sitePrerenderIndexFile)
+	}
+	target, err := siteStaticExportTarget(dir, routePath)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(target, body, 0o644); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+func siteStaticExportHTML(contentType string) bool {
+	if strings.TrimSpace(contentType) == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// siteStaticExportTarget maps a public path into dir, rejecting paths that
+// would escape it.
+func siteStaticExportTarget(dir, publicPath string) (string, error) {
+	if localePathUnsafe(publicPath) {
+		return "", fmt.Errorf("unsafe static export path %q", publicPath)
+	}
+	relative := strings.TrimPrefix(pathpkg.Clean("/"+publicPath), "/")
+	if relative == "" {
+		return "", fmt.Errorf("static export path %q does not name a file", publicPath)
+	}
+	target := filepath.Join(dir, filepath.FromSlash(relative))
+	rel, err := filepath.Rel(dir, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("static export path %q escapes the export directory", publicPath)
+	}
+	return target, nil
+}
+
+func copySiteStaticExportAssets(assets fs.FS, dir, assetPath string) (int, error) {
+	copied := 0
+	err := fs.WalkDir(assets, ".", func(name string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		data, err := fs.ReadFile(assets, name)
+		if err != nil {
+			return err
+		}
+		target, err := siteStaticExportTarget(dir, pathpkg.Join(assetPath, name))
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, data, 0o644); err != nil {
+			return err
+		}
+		copied++
+		return nil
+	})
+	return copied, err
+}
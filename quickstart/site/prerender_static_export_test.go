@@ -0,0 +1,172 @@
+package site
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goliatone/go-admin/admin"
+)
+
+func TestSiteStaticExportWritesLocalePrefixedIndexFilesAndAssets(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	seedDeliveryPageType(t, content)
+	seedDeliveryPageRecord(t, content, "page-about", "about", "/about")
+	if _, err := content.CreateContent(t.Context(), admin.CMSContent{
+		ID:              "page-about-es",
+		Title:           "Acerca",
+		Slug:            "acerca",
+		Locale:          "es",
+		Status:          "published",
+		ContentType:     "page",
+		ContentTypeSlug: "page",
+		Data:            map[string]any{"path": "/acerca"},
+	}); err != nil {
+		t.Fatalf("create es content: %v", err)
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed.json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		case "/api/status":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		default:
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte("<main>" + r.URL.Path + "</main>"))
+		}
+	})
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		SupportedLocales: []string{"en", "es"},
+	}, SitePrerenderConfig{
+		Handler:            handler,
+		ContentService:     content,
+		ContentTypeService: content,
+		ExtraPaths:         []string{"/feed.json", "/api/status"},
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+
+	dir := t.TempDir()
+	report, err := prerenderer.Export(t.Context(), SiteStaticExportConfig{
+		Dir: dir,
+		Assets: fstest.MapFS{
+			"output.css":      {Data: []byte("body{}")},
+			"js/site.js":      {Data: []byte("console.log(1)")},
+			"images/.keep":    {Data: []byte{}},
+			"images/logo.svg": {Data: []byte("svg")},
+		},
+		AssetPath: "/static",
+	})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	for file, body := range map[string]string{
+		"index.html":           "<main>/</main>",
+		"about/index.html":     "<main>/about</main>",
+		"es/index.html":        "<main>/es</main>",
+		"es/acerca/index.html": "<main>/es/acerca</main>",
+		"feed.json":            `{"items":[]}`,
+		"static/output.css":    "body{}",
+		"static/js/site.js":    "console.log(1)",
+	} {
+		data, readErr := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if readErr != nil {
+			t.Fatalf("read exported %s: %v", file, readErr)
+		}
+		if string(data) != body {
+			t.Fatalf("expected %s to contain %q, got %q", file, body, string(data))
+		}
+	}
+	if report.Assets != 4 {
+		t.Fatalf("expected 4 copied assets, got %d", report.Assets)
+	}
+	if about := sitePrerenderResultForPath(t, report, "/about"); about.File != filepath.Join(dir, "about", "index.html") {
+		t.Fatalf("expected /about export file, got %+v", about)
+	}
+	status := sitePrerenderResultForPath(t, report, "/api/status")
+	if !status.Failed() || !strings.Contains(status.Error, "non-HTML") {
+		t.Fatalf("expected non-HTML extensionless route to fail, got %+v", status)
+	}
+	if _, statErr := os.Stat(filepath.Join(dir, "api", "status")); !os.IsNotExist(statErr) {
+		t.Fatalf("expected failed route not to be written, got %v", statErr)
+	}
+	if report.Failed != 1 {
+		t.Fatalf("expected one failed route, got %+v", report.Failures())
+	}
+}
+
+func TestSiteStaticExportWritesCollectionPagesAsPaths(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	seedPrerenderNewsCollection(t, content, 3, 0)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<main>" + r.URL.RequestURI() + "</main>"))
+	})
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{
+		Features: SiteFeatures{EnableI18N: new(false)},
+	}, SitePrerenderConfig{
+		Handler:             handler,
+		ContentService:      content,
+		ContentTypeService:  content,
+		CollectionPageSize:  1,
+		CollectionPageParam: "p",
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+
+	dir := t.TempDir()
+	report, err := prerenderer.Export(t.Context(), SiteStaticExportConfig{Dir: dir})
+	if err != nil || report.Failed != 0 {
+		t.Fatalf("export: %+v (%v)", report.Failures(), err)
+	}
+	for file, body := range map[string]string{
+		"news/index.html":        "<main>/news</main>",
+		"news/page/2/index.html": "<main>/news?p=2</main>",
+		"news/page/3/index.html": "<main>/news?p=3</main>",
+	} {
+		data, readErr := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if readErr != nil || string(data) != body {
+			t.Fatalf("expected %s to contain %q, got %q (%v)", file, body, string(data), readErr)
+		}
+	}
+}
+
+func TestSiteStaticExportRequiresDirectory(t *testing.T) {
+	content := admin.NewInMemoryContentService()
+	prerenderer, err := NewSitePrerenderer(nil, admin.Config{DefaultLocale: "en"}, SiteConfig{}, SitePrerenderConfig{
+		Handler:            http.NotFoundHandler(),
+		ContentService:     content,
+		ContentTypeService: content,
+	})
+	if err != nil {
+		t.Fatalf("new site prerenderer: %v", err)
+	}
+	if _, err := prerenderer.Export(t.Context(), SiteStaticExportConfig{Dir: " "}); err == nil {
+		t.Fatalf("expected missing export directory error")
+	}
+}
+
+func TestSiteStaticExportTargetStaysInsideDirectory(t *testing.T) {
+	dir := t.TempDir()
+	target, err := siteStaticExportTarget(dir, "/es/about/index.html")
+	if err != nil {
+		t.Fatalf("expected safe target, got %v", err)
+	}
+	if target != filepath.Join(dir, "es", "about", "index.html") {
+		t.Fatalf("unexpected export target %q", target)
+	}
+	for _, publicPath := range []string{"/../outside.html", "/a/../../outside.html", "/", `/a\b.html`, "//cdn.example.com/x.js"} {
+		if _, err := siteStaticExportTarget(dir, publicPath); err == nil {
+			t.Fatalf("expected %q to be rejected", publicPath)
+		}
+	}
+}
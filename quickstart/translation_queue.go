@@ -38,6 +38,19 @@ type TranslationQueueConfig struct {
 	SuggestionPermission    string                                            `json:"-"`
 	SuggestionResource      string                                            `json:"-"`
 
+	// AssigneeSelector auto-assigns auto-created and released open-pool assignments
+	// among AssigneeCandidates and ActorOptionProvider assignee options.
+	AssigneeSelector   admin.TranslationAssigneeSelector `json:"-"`
+	AssigneeCandidates []string                          `json:"assignee_candidates,omitempty"`
+
+	// SLAPolicies enables the scheduled SLA escalation job and dashboard breach counts.
+	SLAPolicies admin.TranslationSLAPolicies `json:"sla_policies,omitempty"`
+	// SLASchedule is the escalation cron expression. Empty runs every 15 minutes.
+	SLASchedule string `json:"sla_schedule,omitempty"`
+	// SLALedger records applied escalations and is required with SLAPolicies.
+	// Use admin.NewBunTranslationSLAEscalationLedger to share it across instances.
+	SLALedger admin.TranslationSLAEscalationLedger `json:"-"`
+
 	PermissionRegister PermissionRegisterFunc `json:"-"`
 }
 
@@ -110,6 +123,7 @@ func RegisterTranslationQueueWiring(adm *admin.Admin, cfg TranslationQueueConfig
 			Activity:      adm.ActivityFeed(),
 			Notifications: adm.NotificationService(),
 			URLs:          adm.URLs(),
+			AutoAssigner:  newTranslationQueueAutoAssigner(adm, cfg),
 		}
 	}
 	if err := admin.RegisterTranslationQueueCommands(adm.Commands(), service); err != nil {
@@ -130,6 +144,22 @@ func RegisterTranslationQueueWiring(adm *admin.Admin, cfg TranslationQueueConfig
 			return err
 		}
 	}
+	if len(cfg.SLAPolicies) > 0 {
+		if cfg.SLALedger == nil {
+			return translationQueueConfigError{Missing: []string{"sla_ledger"}}
+		}
+		adm.WithTranslationSLAPolicies(cfg.SLAPolicies)
+		if err := admin.RegisterTranslationSLACommands(adm.Commands(), &admin.TranslationSLAEscalator{
+			Repository:    repo,
+			Policies:      cfg.SLAPolicies,
+			Ledger:        cfg.SLALedger,
+			Activity:      adm.ActivityFeed(),
+			Notifications: adm.NotificationService(),
+			URLs:          adm.URLs(),
+		}, cfg.SLASchedule); err != nil {
+			return err
+		}
+	}
 	if cfg.PermissionRegister != nil {
 		if err := RegisterTranslationQueuePermissions(cfg.PermissionRegister); err != nil {
 			return err
@@ -253,6 +283,13 @@ func sortQueueLocales(set map[string]struct{}) []string {
 // When enabled, workflow transitions blocked by missing translations will automatically
 // create/reuse queue assignments for the missing locales.
 func NewTranslationQueueAutoCreateHook(cfg TranslationQueueConfig) admin.TranslationQueueAutoCreateHook {
+	return NewTranslationQueueAutoCreateHookWithAdmin(nil, cfg)
+}
+
+// NewTranslationQueueAutoCreateHookWithAdmin is NewTranslationQueueAutoCreateHook
+// with adm supplying the activity feed, notifications and URLs used when
+// cfg.AssigneeSelector auto-assigns the created work; nil records neither.
+func NewTranslationQueueAutoCreateHookWithAdmin(adm *admin.Admin, cfg TranslationQueueConfig) admin.TranslationQueueAutoCreateHook {
 	if !cfg.Enabled || !cfg.EnableAutoCreate {
 		return nil
 	}
@@ -261,6 +298,25 @@ func NewTranslationQueueAutoCreateHook(cfg TranslationQueueConfig) admin.Transla
 		return nil
 	}
 	return &admin.DefaultTranslationQueueAutoCreateHook{
-		Repository: repo,
+		Repository:   repo,
+		AutoAssigner: newTranslationQueueAutoAssigner(adm, cfg),
+	}
+}
+
+func newTranslationQueueAutoAssigner(adm *admin.Admin, cfg TranslationQueueConfig) *admin.TranslationQueueAutoAssigner {
+	if cfg.AssigneeSelector == nil || cfg.Repository == nil {
+		return nil
+	}
+	assigner := &admin.TranslationQueueAutoAssigner{
+		Repository:        cfg.Repository,
+		Selector:          cfg.AssigneeSelector,
+		Candidates:        append([]string{}, cfg.AssigneeCandidates...),
+		CandidateProvider: cfg.ActorOptionProvider,
+	}
+	if adm != nil {
+		assigner.Activity = adm.ActivityFeed()
+		assigner.Notifications = adm.NotificationService()
+		assigner.URLs = adm.URLs()
 	}
+	return assigner
 }
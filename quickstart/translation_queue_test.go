@@ -527,3 +527,18 @@ func TestNewTranslationQueueAutoCreateHookReturnsNilWhenRepoMissing(t *testing.T
 		t.Fatalf("expected nil hook when repository is missing")
 	}
 }
+
+func TestNewTranslationQueueAutoCreateHookWithAdminWiresAutoAssigner(t *testing.T) {
+	repo := admin.NewInMemoryTranslationAssignmentRepository()
+	hook := NewTranslationQueueAutoCreateHookWithAdmin(nil, TranslationQueueConfig{
+		Enabled:            true,
+		EnableAutoCreate:   true,
+		Repository:         repo,
+		AssigneeSelector:   admin.NewRoundRobinTranslationAssigneeSelector(),
+		AssigneeCandidates: []string{"translator-1"},
+	})
+	defaultHook, ok := hook.(*admin.DefaultTranslationQueueAutoCreateHook)
+	if !ok || defaultHook.AutoAssigner == nil {
+		t.Fatalf("expected auto-create hook with an auto-assigner, got %#v", hook)
+	}
+}
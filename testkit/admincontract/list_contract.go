@@ -26,11 +26,15 @@ type ListOptions struct {
 	Predicates []ListPredicate `json:"predicates"`
 	Fields     []string        `json:"fields"`
 	Search     string          `json:"search"`
+	Cursor     string          `json:"cursor,omitempty"`
 }
 
 // ListFunc describes the contract target list function.
 type ListFunc func(context.Context, ListOptions) ([]map[string]any, int, error)
 
+// CursorListFunc describes a keyset list function returning the next page cursor.
+type CursorListFunc func(context.Context, ListOptions) ([]map[string]any, int, string, error)
+
 // PaginationContractConfig configures assertions for a list total/pagination contract.
 type PaginationContractConfig struct {
 	TotalExpected int             `json:"total_expected"`
@@ -104,6 +108,81 @@ func AssertPaginationContract(t *testing.T, list ListFunc, cfg PaginationContrac
 	}
 }
 
+// AssertCursorPaginationContract walks every page by cursor and verifies that pages
+// are full until the last one, the cursor ends there, no record repeats, every
+// record is visited once, and the walk matches offset page order.
+func AssertCursorPaginationContract(t *testing.T, list CursorListFunc, cfg PaginationContractConfig) {
+	t.Helper()
+	if list == nil {
+		t.Fatalf("cursor list function is required")
+	}
+	key := cfg.UniqueKey
+	if key == "" {
+		key = "id"
+	}
+	perPage := cfg.PerPage
+	if perPage <= 0 {
+		perPage = 10
+	}
+
+	offsetOrder := []string{}
+	for page := 1; ; page++ {
+		records, _, _, err := list(context.Background(), buildListOptions(cfg, page, perPage))
+		if err != nil {
+			t.Fatalf("offset page %d list failed: %v", page, err)
+		}
+		for _, record := range records {
+			offsetOrder = append(offsetOrder, keyValue(record, key))
+		}
+		if len(records) < perPage {
+			break
+		}
+	}
+
+	seen := map[string]struct{}{}
+	walked := []string{}
+	cursor := ""
+	for pageNum := 1; ; pageNum++ {
+		if pageNum > cfg.TotalExpected+2 {
+			t.Fatalf("cursor walk did not terminate after %d pages", pageNum-1)
+		}
+		opts := buildListOptions(cfg, 1, perPage)
+		opts.Cursor = cursor
+		records, _, next, err := list(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("cursor page %d list failed: %v", pageNum, err)
+		}
+		if len(records) > perPage {
+			t.Fatalf("cursor page %d size %d exceeds per page %d", pageNum, len(records), perPage)
+		}
+		for idx, record := range records {
+			id := keyValue(record, key)
+			if id == "" {
+				t.Fatalf("cursor page %d record %d missing %q", pageNum, idx, key)
+			}
+			if _, exists := seen[id]; exists {
+				t.Fatalf("cursor overlap detected for key %q=%q on page %d", key, id, pageNum)
+			}
+			seen[id] = struct{}{}
+			walked = append(walked, id)
+		}
+		if next == "" {
+			break
+		}
+		if len(records) < perPage {
+			t.Fatalf("cursor page %d returned a next cursor on a short page", pageNum)
+		}
+		cursor = next
+	}
+
+	if len(walked) != cfg.TotalExpected {
+		t.Fatalf("cursor walk visited %d records, expected %d", len(walked), cfg.TotalExpected)
+	}
+	if fmt.Sprint(walked) != fmt.Sprint(offsetOrder) {
+		t.Fatalf("cursor walk order %v differs from offset order %v", walked, offsetOrder)
+	}
+}
+
 func buildListOptions(cfg PaginationContractConfig, page, perPage int) ListOptions {
 	return ListOptions{
 		Page:       page,
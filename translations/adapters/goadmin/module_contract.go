@@ -40,6 +40,7 @@ func ModuleAPIRouteDeclarations() map[string]routing.RouteDeclaration {
 		"translations.families.id":                    {Method: router.GET, Path: "/families/:family_id"},
 		"translations.families.variants":              {Method: router.POST, Path: "/families/:family_id/variants"},
 		"translations.sync.resources.id":              {Method: router.GET, Path: "/sync/resources/:kind/:id"},
+		"translations.sync.resources.events":          {Method: router.GET, Path: "/sync/resources/:kind/:id/events"},
 		"translations.assignments":                    {Method: router.GET, Path: "/assignments"},
 		"translations.assignments.family_assignments": {Method: router.GET, Path: "/families/:family_id/assignments"},
 		"translations.assignments.id":                 {Method: router.GET, Path: "/assignments/:assignment_id"},
@@ -121,6 +122,7 @@ func coreAPIRouteKeys() []string {
 		"translations.families.id",
 		"translations.families.variants",
 		"translations.sync.resources.id",
+		"translations.sync.resources.events",
 	}
 }
 
@@ -37,10 +37,10 @@ func TestModuleContractForCapabilitiesOnlyAdvertisesMountedSurfaces(t *testing.T
 		{name: "none"},
 		{name: "exchange only", exchange: true, wantUI: 1, wantAPI: 5, wantExchange: true},
 		{name: "queue only", queue: true, wantUI: 4, wantAPI: 10, wantQueue: true},
-		{name: "core", core: true, wantUI: 3, wantAPI: 7},
-		{name: "core exchange", core: true, exchange: true, wantUI: 4, wantAPI: 12, wantExchange: true},
-		{name: "core queue", core: true, queue: true, wantUI: 7, wantAPI: 17, wantQueue: true},
-		{name: "full", core: true, exchange: true, queue: true, wantUI: 8, wantAPI: 22, wantExchange: true, wantQueue: true},
+		{name: "core", core: true, wantUI: 3, wantAPI: 8},
+		{name: "core exchange", core: true, exchange: true, wantUI: 4, wantAPI: 13, wantExchange: true},
+		{name: "core queue", core: true, queue: true, wantUI: 7, wantAPI: 18, wantQueue: true},
+		{name: "full", core: true, exchange: true, queue: true, wantUI: 8, wantAPI: 23, wantExchange: true, wantQueue: true},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {